@@ -0,0 +1,59 @@
+package request
+
+import (
+	"github.com/google/uuid"
+)
+
+// GetRunNotesRequest is a request object for `GET /aim/runs/:id/note` endpoint.
+type GetRunNotesRequest struct {
+	RunID string `params:"id" json:"-"`
+}
+
+// CreateRunNoteRequest is a request object for `POST /aim/runs/:id/note` endpoint.
+type CreateRunNoteRequest struct {
+	RunID   string `params:"id" json:"-"`
+	Content string `json:"content"`
+}
+
+// GetRunNoteRequest is a request object for `GET /aim/runs/:id/note/:note_id` endpoint.
+type GetRunNoteRequest struct {
+	RunID  string    `params:"id" json:"-"`
+	NoteID uuid.UUID `params:"note_id" json:"-"`
+}
+
+// UpdateRunNoteRequest is a request object for `PUT /aim/runs/:id/note/:note_id` endpoint.
+type UpdateRunNoteRequest struct {
+	RunID   string    `params:"id" json:"-"`
+	NoteID  uuid.UUID `params:"note_id" json:"-"`
+	Content string    `json:"content"`
+}
+
+// DeleteRunNoteRequest is a request object for `DELETE /aim/runs/:id/note/:note_id` endpoint.
+type DeleteRunNoteRequest = GetRunNoteRequest
+
+// GetExperimentNotesRequest is a request object for `GET /aim/experiments/:id/note` endpoint.
+type GetExperimentNotesRequest struct {
+	ExperimentID int32 `params:"id" json:"-"`
+}
+
+// CreateExperimentNoteRequest is a request object for `POST /aim/experiments/:id/note` endpoint.
+type CreateExperimentNoteRequest struct {
+	ExperimentID int32  `params:"id" json:"-"`
+	Content      string `json:"content"`
+}
+
+// GetExperimentNoteRequest is a request object for `GET /aim/experiments/:id/note/:note_id` endpoint.
+type GetExperimentNoteRequest struct {
+	ExperimentID int32     `params:"id" json:"-"`
+	NoteID       uuid.UUID `params:"note_id" json:"-"`
+}
+
+// UpdateExperimentNoteRequest is a request object for `PUT /aim/experiments/:id/note/:note_id` endpoint.
+type UpdateExperimentNoteRequest struct {
+	ExperimentID int32     `params:"id" json:"-"`
+	NoteID       uuid.UUID `params:"note_id" json:"-"`
+	Content      string    `json:"content"`
+}
+
+// DeleteExperimentNoteRequest is a request object for `DELETE /aim/experiments/:id/note/:note_id` endpoint.
+type DeleteExperimentNoteRequest = GetExperimentNoteRequest
@@ -0,0 +1,28 @@
+package request
+
+import "github.com/google/uuid"
+
+// CreateReportRequest is a request object for `POST /aim/reports` endpoint.
+type CreateReportRequest struct {
+	Name        string `json:"name"`
+	Code        string `json:"code"`
+	Description string `json:"description"`
+}
+
+// GetReportRequest is a request object for `GET /aim/reports/:id` endpoint.
+type GetReportRequest struct {
+	ID uuid.UUID `params:"id"`
+}
+
+// DeleteReportRequest is a request object for `DELETE /aim/reports/:id` endpoint.
+type DeleteReportRequest struct {
+	ID uuid.UUID `params:"id"`
+}
+
+// UpdateReportRequest is a request object for `PUT /aim/reports/:id` endpoint.
+type UpdateReportRequest struct {
+	ID          uuid.UUID `params:"id" json:"-"`
+	Name        *string   `json:"name"`
+	Code        *string   `json:"code"`
+	Description *string   `json:"description"`
+}
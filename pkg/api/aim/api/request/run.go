@@ -31,14 +31,47 @@ type GetRunImagesRequest []struct {
 	Context map[string]string `json:"context"`
 }
 
+// GetRunTextsRequest is a request object for `POST /runs/:id/texts/get-batch` endpoint.
+type GetRunTextsRequest []struct {
+	Name    string         `json:"name"`
+	Context map[string]any `json:"context"`
+}
+
+// GetRunDistributionsRequest is a request object for `POST /runs/:id/distributions/get-batch` endpoint.
+type GetRunDistributionsRequest []struct {
+	Name    string         `json:"name"`
+	Context map[string]any `json:"context"`
+}
+
+// GetRunFiguresRequest is a request object for `POST /runs/:id/figures/get-batch` endpoint.
+type GetRunFiguresRequest []struct {
+	Name    string         `json:"name"`
+	Context map[string]any `json:"context"`
+}
+
+// GetRunAudiosRequest is a request object for `POST /runs/:id/audios/get-batch` endpoint.
+type GetRunAudiosRequest []struct {
+	Name    string         `json:"name"`
+	Context map[string]any `json:"context"`
+}
+
 // GetRunImagesBatchRequest is a request object for `POST /runs/images/get-batch` endpoint.
 type GetRunImagesBatchRequest []string
 
+// GetRunAudiosBatchRequest is a request object for `POST /runs/audios/get-batch` endpoint.
+type GetRunAudiosBatchRequest []string
+
 // GetRunsActiveRequest is a request object for `GET /runs/active` endpoint.
 type GetRunsActiveRequest struct {
 	BaseSearchRequest
 }
 
+// GetRunsLiveRequest is a request object for `GET /runs/live` endpoint.
+type GetRunsLiveRequest struct {
+	RunIDs []string `query:"run_id"`
+	Query  string   `query:"q"`
+}
+
 // UpdateRunRequest is a request struct for `PUT /runs/:id` endpoint.
 type UpdateRunRequest struct {
 	ID          string  `params:"id"`
@@ -85,6 +118,12 @@ type SearchMetricsRequest struct {
 	SkipSystem bool          `json:"skip_system"`
 }
 
+// SearchAggregatedMetricsRequest is a request struct for `POST /runs/search/metric/aggregate` endpoint.
+type SearchAggregatedMetricsRequest struct {
+	SearchMetricsRequest
+	GroupBy []string `json:"group_by"`
+}
+
 // SearchAlignedMetricsRequest is a request struct for `GET /runs/search/metric/align` endpoint.
 type SearchAlignedMetricsRequest struct {
 	Runs []struct {
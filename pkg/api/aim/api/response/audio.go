@@ -0,0 +1,63 @@
+package response
+
+import (
+	"database/sql"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/database"
+)
+
+// convertAudioToSequenceRecord converts models.Audio into the streamable sequence record.
+func convertAudioToSequenceRecord(audio models.Audio) sequenceRecord {
+	return sequenceRecord{
+		RunID:   audio.RunID,
+		Name:    audio.Name,
+		Context: audio.Context,
+		Step:    audio.Step,
+		Iter:    audio.Iter,
+		Value: fiber.Map{
+			"blob_uri":    audio.BlobURI,
+			"caption":     audio.Caption,
+			"format":      audio.Format,
+			"sample_rate": audio.SampleRate,
+			"index":       audio.Index,
+			"step":        audio.Step,
+			"iter":        audio.Iter,
+		},
+	}
+}
+
+// NewStreamAudiosResponse streams the provided sql.Rows of audios to the fiber context.
+func NewStreamAudiosResponse(ctx *fiber.Ctx, rows *sql.Rows, runs map[string]models.Run,
+	summary repositories.ArtifactSearchSummary, req request.SearchArtifactsRequest,
+) {
+	newStreamSequenceResponse(
+		ctx, rows, runs, summary, req, sequenceOptions{Name: "audios", UseList: true},
+		func(rows *sql.Rows) (*sequenceRecord, error) {
+			var audio models.Audio
+			if err := database.DB.ScanRows(rows, &audio); err != nil {
+				return nil, err
+			}
+			record := convertAudioToSequenceRecord(audio)
+			return &record, nil
+		},
+	)
+}
+
+// NewRunAudiosStreamResponse streams the provided run audios to the fiber context.
+func NewRunAudiosStreamResponse(ctx *fiber.Ctx, audios []models.Audio) error {
+	records := make([]sequenceRecord, len(audios))
+	for i, audio := range audios {
+		records[i] = convertAudioToSequenceRecord(audio)
+	}
+	return newRunSequenceStreamResponse(ctx, records, sequenceOptions{Name: "audios", UseList: true})
+}
+
+// NewRunAudiosBatchStreamResponse streams the provided audio blobs to the fiber context.
+func NewRunAudiosBatchStreamResponse(ctx *fiber.Ctx, audiosMap map[string]any) error {
+	return newRunArtifactsBatchStreamResponse(ctx, audiosMap)
+}
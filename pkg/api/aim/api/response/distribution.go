@@ -0,0 +1,72 @@
+package response
+
+import (
+	"database/sql"
+	"encoding/json"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rotisserie/eris"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/database"
+)
+
+// convertDistributionToSequenceRecord converts models.Distribution into the streamable sequence record.
+func convertDistributionToSequenceRecord(distribution models.Distribution) (*sequenceRecord, error) {
+	var binEdges, counts []float64
+	if err := json.Unmarshal(distribution.BinEdges, &binEdges); err != nil {
+		return nil, eris.Wrap(err, "error unmarshalling distribution `bin_edges`")
+	}
+	if err := json.Unmarshal(distribution.Counts, &counts); err != nil {
+		return nil, eris.Wrap(err, "error unmarshalling distribution `counts`")
+	}
+	valueRange := []float64{0, 0}
+	if len(binEdges) > 0 {
+		valueRange = []float64{binEdges[0], binEdges[len(binEdges)-1]}
+	}
+	return &sequenceRecord{
+		RunID:   distribution.RunID,
+		Name:    distribution.Name,
+		Context: distribution.Context,
+		Step:    distribution.Step,
+		Iter:    distribution.Iter,
+		Value: fiber.Map{
+			"type":      "distribution",
+			"data":      toNumpy(counts),
+			"bin_count": len(counts),
+			"bin_edges": toNumpy(binEdges),
+			"range":     valueRange,
+		},
+	}, nil
+}
+
+// NewStreamDistributionsResponse streams the provided sql.Rows of distributions to the fiber context.
+func NewStreamDistributionsResponse(ctx *fiber.Ctx, rows *sql.Rows, runs map[string]models.Run,
+	summary repositories.ArtifactSearchSummary, req request.SearchArtifactsRequest,
+) {
+	newStreamSequenceResponse(
+		ctx, rows, runs, summary, req, sequenceOptions{Name: "distributions"},
+		func(rows *sql.Rows) (*sequenceRecord, error) {
+			var distribution models.Distribution
+			if err := database.DB.ScanRows(rows, &distribution); err != nil {
+				return nil, err
+			}
+			return convertDistributionToSequenceRecord(distribution)
+		},
+	)
+}
+
+// NewRunDistributionsStreamResponse streams the provided run distributions to the fiber context.
+func NewRunDistributionsStreamResponse(ctx *fiber.Ctx, distributions []models.Distribution) error {
+	records := make([]sequenceRecord, len(distributions))
+	for i, distribution := range distributions {
+		record, err := convertDistributionToSequenceRecord(distribution)
+		if err != nil {
+			return err
+		}
+		records[i] = *record
+	}
+	return newRunSequenceStreamResponse(ctx, records, sequenceOptions{Name: "distributions"})
+}
@@ -0,0 +1,85 @@
+package response
+
+import (
+	"database/sql"
+	"encoding/json"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rotisserie/eris"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/database"
+)
+
+// FigureBlobLoader loads the content of the figure blob stored under provided URI.
+type FigureBlobLoader func(blobURI string) ([]byte, error)
+
+// convertFigureToSequenceRecord converts models.Figure into the streamable sequence record.
+func convertFigureToSequenceRecord(figure models.Figure) sequenceRecord {
+	return sequenceRecord{
+		RunID:   figure.RunID,
+		Name:    figure.Name,
+		Context: figure.Context,
+		Step:    figure.Step,
+		Iter:    figure.Iter,
+		Value: fiber.Map{
+			"blob_uri": figure.BlobURI,
+			"caption":  figure.Caption,
+			"step":     figure.Step,
+			"iter":     figure.Iter,
+		},
+	}
+}
+
+// newFigureSequenceOptions creates the options to stream figures, which have their Plotly JSON
+// loaded from the artifact storage in place of the blob uri.
+func newFigureSequenceOptions(loadBlob FigureBlobLoader) sequenceOptions {
+	return sequenceOptions{
+		Name: "figures",
+		ResolveValue: func(value fiber.Map) error {
+			blobURI, ok := value["blob_uri"].(string)
+			if !ok {
+				return nil
+			}
+			blob, err := loadBlob(blobURI)
+			if err != nil {
+				return eris.Wrapf(err, "error loading figure blob %s", blobURI)
+			}
+			var data any
+			if err := json.Unmarshal(blob, &data); err != nil {
+				return eris.Wrapf(err, "error unmarshalling figure blob %s", blobURI)
+			}
+			value["data"] = data
+			delete(value, "blob_uri")
+			return nil
+		},
+	}
+}
+
+// NewStreamFiguresResponse streams the provided sql.Rows of figures to the fiber context.
+func NewStreamFiguresResponse(ctx *fiber.Ctx, rows *sql.Rows, runs map[string]models.Run,
+	summary repositories.ArtifactSearchSummary, req request.SearchArtifactsRequest, loadBlob FigureBlobLoader,
+) {
+	newStreamSequenceResponse(
+		ctx, rows, runs, summary, req, newFigureSequenceOptions(loadBlob),
+		func(rows *sql.Rows) (*sequenceRecord, error) {
+			var figure models.Figure
+			if err := database.DB.ScanRows(rows, &figure); err != nil {
+				return nil, err
+			}
+			record := convertFigureToSequenceRecord(figure)
+			return &record, nil
+		},
+	)
+}
+
+// NewRunFiguresStreamResponse streams the provided run figures to the fiber context.
+func NewRunFiguresStreamResponse(ctx *fiber.Ctx, figures []models.Figure, loadBlob FigureBlobLoader) error {
+	records := make([]sequenceRecord, len(figures))
+	for i, figure := range figures {
+		records[i] = convertFigureToSequenceRecord(figure)
+	}
+	return newRunSequenceStreamResponse(ctx, records, newFigureSequenceOptions(loadBlob))
+}
@@ -0,0 +1,86 @@
+package response
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rotisserie/eris"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/fasttrackml/pkg/common/services/live"
+)
+
+// liveUpdatesKeepAliveInterval is the interval of keep-alive comments, which prevent proxies from closing
+// the idle stream and detect disconnected clients.
+const liveUpdatesKeepAliveInterval = 15 * time.Second
+
+// NewRunsLiveStreamResponse creates a new response object for `GET /runs/live` endpoint.
+// Events of the matching runs are sent as server-sent events until the client disconnects.
+func NewRunsLiveStreamResponse(
+	ctx *fiber.Ctx, subscription *live.Subscription, match func(context.Context, string) (bool, error),
+) {
+	ctx.Set("Content-Type", "text/event-stream")
+	ctx.Set("Cache-Control", "no-cache")
+	ctx.Set("Connection", "keep-alive")
+	ctx.Set("X-Accel-Buffering", "no")
+
+	method, path := strings.Clone(ctx.Method()), strings.Clone(ctx.Path())
+	ctx.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer subscription.Close()
+
+		// write returns false once the client has disconnected.
+		write := func(data string) bool {
+			if _, err := w.WriteString(data); err != nil {
+				return false
+			}
+			return w.Flush() == nil
+		}
+
+		keepAlive := time.NewTicker(liveUpdatesKeepAliveInterval)
+		defer keepAlive.Stop()
+
+		start := time.Now()
+		if err := func() error {
+			if !write(": connected\n\n") {
+				return nil
+			}
+			for {
+				select {
+				case event, ok := <-subscription.Events():
+					if !ok {
+						return nil
+					}
+					matched, err := match(context.Background(), event.RunID)
+					if err != nil {
+						return err
+					}
+					if matched {
+						data, err := json.Marshal(event)
+						if err != nil {
+							return eris.Wrap(err, "error serializing run event")
+						}
+						if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.Type, data); err != nil {
+							return nil
+						}
+					}
+					// flush once all the pending events are written, so bursts of events are sent together.
+					if len(subscription.Events()) == 0 && w.Buffered() > 0 && w.Flush() != nil {
+						return nil
+					}
+				case <-keepAlive.C:
+					if !write(": keep-alive\n\n") {
+						return nil
+					}
+				}
+			}
+		}(); err != nil {
+			log.Errorf("error encountered in %s %s: error streaming live updates: %s", method, path, err)
+		}
+		log.Infof("body - %s %s %s", time.Since(start), method, path)
+	})
+}
@@ -0,0 +1,61 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/dao/models"
+)
+
+// NoteResponse represents a run or experiment note.
+type NoteResponse struct {
+	ID        uuid.UUID `json:"id"`
+	Content   string    `json:"content"`
+	Author    string    `json:"author"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// GetNotesResponse represents a list of notes.
+type GetNotesResponse []NoteResponse
+
+// DeleteNoteResponse is a response object for `DELETE /runs/:id/note/:note_id` endpoint.
+type DeleteNoteResponse struct {
+	ID     uuid.UUID `json:"id"`
+	Status string    `json:"status"`
+}
+
+// NewGetNotesResponse creates new response object for `GET /runs/:id/note` endpoint.
+func NewGetNotesResponse(notes []models.Note) GetNotesResponse {
+	resp := GetNotesResponse{}
+	for i := range notes {
+		resp = append(resp, NewCreateNoteResponse(&notes[i]))
+	}
+	return resp
+}
+
+// NewCreateNoteResponse creates new response object for `POST /runs/:id/note` endpoint.
+func NewCreateNoteResponse(note *models.Note) NoteResponse {
+	return NoteResponse{
+		ID:        note.ID,
+		Content:   note.Content,
+		Author:    note.Author,
+		CreatedAt: note.CreatedAt,
+		UpdatedAt: note.UpdatedAt,
+	}
+}
+
+// NewGetNoteResponse creates new response object for `GET /runs/:id/note/:note_id` endpoint.
+var NewGetNoteResponse = NewCreateNoteResponse
+
+// NewUpdateNoteResponse creates new response object for `PUT /runs/:id/note/:note_id` endpoint.
+var NewUpdateNoteResponse = NewCreateNoteResponse
+
+// NewDeleteNoteResponse creates new response object for `DELETE /runs/:id/note/:note_id` endpoint.
+func NewDeleteNoteResponse(id uuid.UUID, status string) *DeleteNoteResponse {
+	return &DeleteNoteResponse{
+		ID:     id,
+		Status: status,
+	}
+}
@@ -1,10 +1,12 @@
 package response
 
 import (
+	"bytes"
 	"encoding/json"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/rotisserie/eris"
+	"gorm.io/datatypes"
 
 	"github.com/G-Research/fasttrackml/pkg/api/aim/dao/models"
 )
@@ -51,6 +53,14 @@ func NewGetProjectResponse(name, dialector string, liveUpdatesEnabled bool) *Get
 	}
 }
 
+// paramExampleTypes maps param value types onto Aim example types.
+var paramExampleTypes = map[string]string{
+	models.ParamValueTypeInt:   "<class 'int'>",
+	models.ParamValueTypeFloat: "<class 'float'>",
+	models.ParamValueTypeBool:  "<class 'bool'>",
+	models.ParamValueTypeStr:   "<class 'str'>",
+}
+
 // ProjectParamsResponse is a response object for `GET /projects/params` endpoint.
 type ProjectParamsResponse struct {
 	Metric        *map[string][]fiber.Map `json:"metric,omitempty"`
@@ -67,11 +77,20 @@ func NewProjectParamsResponse(projectParams *models.ProjectParams,
 	excludeParams bool, sequences []string,
 ) (*ProjectParamsResponse, error) {
 	// process params and tags
-	params := make(map[string]any, len(projectParams.ParamKeys)+1)
-	for _, paramKey := range projectParams.ParamKeys {
-		params[paramKey] = map[string]string{
-			"__example_type__": "<class 'str'>",
+	params := make(map[string]any, len(projectParams.Params)+1)
+	for _, param := range projectParams.Params {
+		if param.ValueType != models.ParamValueTypeJSON {
+			setParamExampleType(params, param.Key, paramExampleTypes[param.ValueType])
+			continue
 		}
+		// numbers are decoded as json.Number, so `int` and `float` values could be distinguished.
+		var value any
+		decoder := json.NewDecoder(bytes.NewReader(param.ValueJSON))
+		decoder.UseNumber()
+		if err := decoder.Decode(&value); err != nil {
+			return nil, eris.Wrapf(err, "error unmarshalling value of param '%s'", param.Key)
+		}
+		mergeParamExampleTypes(params, param.Key, value)
 	}
 
 	tags := make(map[string]map[string]string, len(projectParams.TagKeys))
@@ -107,6 +126,34 @@ func NewProjectParamsResponse(projectParams *models.ProjectParams,
 		images[imageName] = []fiber.Map{}
 	}
 
+	// process texts, distributions, figures and audios
+	texts, err := newSequenceContextsMap(projectParams.Texts, func(text models.Text) (string, datatypes.JSON) {
+		return text.Name, text.Context
+	})
+	if err != nil {
+		return nil, err
+	}
+	distributions, err := newSequenceContextsMap(
+		projectParams.Distributions, func(distribution models.Distribution) (string, datatypes.JSON) {
+			return distribution.Name, distribution.Context
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	figures, err := newSequenceContextsMap(projectParams.Figures, func(figure models.Figure) (string, datatypes.JSON) {
+		return figure.Name, figure.Context
+	})
+	if err != nil {
+		return nil, err
+	}
+	audios, err := newSequenceContextsMap(projectParams.Audios, func(audio models.Audio) (string, datatypes.JSON) {
+		return audio.Name, audio.Context
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	rsp := ProjectParamsResponse{}
 	if !excludeParams {
 		rsp.Params = &params
@@ -126,16 +173,70 @@ func NewProjectParamsResponse(projectParams *models.ProjectParams,
 		case "images":
 			rsp.Images = &images
 		case "texts":
-			rsp.Texts = &fiber.Map{}
+			rsp.Texts = &texts
 		case "figures":
-			rsp.Figures = &fiber.Map{}
+			rsp.Figures = &figures
 		case "distributions":
-			rsp.Distributions = &fiber.Map{}
+			rsp.Distributions = &distributions
 		case "audios":
-			rsp.Audios = &fiber.Map{}
+			rsp.Audios = &audios
 		case "metric":
 			rsp.Metric = &metrics
 		}
 	}
 	return &rsp, nil
 }
+
+// newSequenceContextsMap groups contexts of the sequence items by the sequence name.
+func newSequenceContextsMap[T any](items []T, nameAndContext func(T) (string, datatypes.JSON)) (fiber.Map, error) {
+	sequences := make(fiber.Map, len(items))
+	for _, item := range items {
+		name, data := nameAndContext(item)
+		context := fiber.Map{}
+		if err := json.Unmarshal(data, &context); err != nil {
+			return nil, eris.Wrap(err, "error unmarshalling `context` json to `fiber.Map` object")
+		}
+		contexts, _ := sequences[name].([]fiber.Map)
+		sequences[name] = append(contexts, context)
+	}
+	return sequences, nil
+}
+
+// setParamExampleType sets the example type of the param, unless the param is already described.
+func setParamExampleType(params map[string]any, key, exampleType string) {
+	if _, ok := params[key]; !ok {
+		params[key] = map[string]string{
+			"__example_type__": exampleType,
+		}
+	}
+}
+
+// mergeParamExampleTypes merges the structure of nested (dict) param value into the params.
+// Dict values take precedence over plain values, so the structure of nested params is always kept.
+func mergeParamExampleTypes(params map[string]any, key string, value any) {
+	switch value := value.(type) {
+	case map[string]any:
+		nested, ok := params[key].(map[string]any)
+		if !ok {
+			nested = make(map[string]any, len(value))
+			params[key] = nested
+		}
+		for nestedKey, nestedValue := range value {
+			mergeParamExampleTypes(nested, nestedKey, nestedValue)
+		}
+	case []any:
+		setParamExampleType(params, key, "<class 'list'>")
+	case json.Number:
+		if _, err := value.Int64(); err == nil {
+			setParamExampleType(params, key, paramExampleTypes[models.ParamValueTypeInt])
+		} else {
+			setParamExampleType(params, key, paramExampleTypes[models.ParamValueTypeFloat])
+		}
+	case bool:
+		setParamExampleType(params, key, paramExampleTypes[models.ParamValueTypeBool])
+	case string:
+		setParamExampleType(params, key, paramExampleTypes[models.ParamValueTypeStr])
+	default:
+		setParamExampleType(params, key, "<class 'NoneType'>")
+	}
+}
@@ -0,0 +1,46 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/dao/models"
+)
+
+// Report represents the response json in Report endpoints.
+type Report struct {
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	Code        string    `json:"code"`
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// NewGetReportsResponse creates new response object for `GET /reports` endpoint.
+func NewGetReportsResponse(reports []models.Report) []Report {
+	resp := make([]Report, len(reports))
+	for i := range reports {
+		resp[i] = NewCreateReportResponse(&reports[i])
+	}
+	return resp
+}
+
+// NewCreateReportResponse creates new response object for `POST /reports` endpoint.
+func NewCreateReportResponse(report *models.Report) Report {
+	return Report{
+		ID:          report.ID,
+		Name:        report.Name,
+		Code:        report.Code,
+		Description: report.Description,
+		CreatedAt:   report.CreatedAt,
+		UpdatedAt:   report.UpdatedAt,
+	}
+}
+
+// NewGetReportResponse creates new response object for `GET /reports/:id` endpoint.
+var NewGetReportResponse = NewCreateReportResponse
+
+// NewUpdateReportResponse creates new response object for `PUT /reports/:id` endpoint.
+var NewUpdateReportResponse = NewCreateReportResponse
@@ -214,6 +214,69 @@ type SearchAlignedMetricsResponse struct {
 	XAxisIters  fiber.Map `json:"x_axis_iters"`
 }
 
+// SearchAggregatedMetricsResponse is a response object for `POST /runs/search/metric/aggregate` endpoint.
+type SearchAggregatedMetricsResponse []SearchAggregatedMetricsSeriesPartial
+
+// SearchAggregatedMetricsSeriesPartial represents the aggregated metric series of the group of runs.
+type SearchAggregatedMetricsSeriesPartial struct {
+	Group   map[string]*string                   `json:"group"`
+	Name    string                               `json:"name"`
+	Context fiber.Map                            `json:"context"`
+	Values  SearchAggregatedMetricsValuesPartial `json:"values"`
+}
+
+// SearchAggregatedMetricsValuesPartial represents the aggregated values of the metric series.
+// Count holds the number of runs, which have a value at the x-axis value.
+type SearchAggregatedMetricsValuesPartial struct {
+	X      []float64 `json:"x"`
+	Mean   []float64 `json:"mean"`
+	Std    []float64 `json:"std"`
+	Min    []float64 `json:"min"`
+	Max    []float64 `json:"max"`
+	Median []float64 `json:"median"`
+	Count  []int64   `json:"count"`
+}
+
+// NewSearchAggregatedMetricsResponse creates new response object for `POST /runs/search/metric/aggregate` endpoint.
+// Aggregations are ordered by the group, metric and x-axis value, so each series is a sequence of aggregations.
+func NewSearchAggregatedMetricsResponse(
+	groupBy []string, aggregations []models.MetricAggregation,
+) (SearchAggregatedMetricsResponse, error) {
+	resp := SearchAggregatedMetricsResponse{}
+	var series *SearchAggregatedMetricsSeriesPartial
+	var seriesID string
+	for _, aggregation := range aggregations {
+		id, err := json.Marshal([]any{aggregation.Group, aggregation.Key, aggregation.Context})
+		if err != nil {
+			return nil, eris.Wrap(err, "error marshaling aggregated metric series id")
+		}
+		if series == nil || seriesID != string(id) {
+			context := fiber.Map{}
+			if err := json.Unmarshal(aggregation.Context, &context); err != nil {
+				return nil, eris.Wrap(err, "error unmarshalling `context` json to `fiber.Map` object")
+			}
+			group := make(map[string]*string, len(groupBy))
+			for i, field := range groupBy {
+				group[field] = aggregation.Group[i]
+			}
+			resp = append(resp, SearchAggregatedMetricsSeriesPartial{
+				Group:   group,
+				Name:    aggregation.Key,
+				Context: context,
+			})
+			series, seriesID = &resp[len(resp)-1], string(id)
+		}
+		series.Values.X = append(series.Values.X, aggregation.X)
+		series.Values.Mean = append(series.Values.Mean, aggregation.Mean)
+		series.Values.Std = append(series.Values.Std, aggregation.Std())
+		series.Values.Min = append(series.Values.Min, aggregation.Min)
+		series.Values.Max = append(series.Values.Max, aggregation.Max)
+		series.Values.Median = append(series.Values.Median, aggregation.Median)
+		series.Values.Count = append(series.Values.Count, aggregation.Count)
+	}
+	return resp, nil
+}
+
 // NewSearchAlignedMetricsResponse creates a new response object for `GET /runs/search/metric/align` endpoint.
 func NewSearchAlignedMetricsResponse(
 	ctx *fiber.Ctx, rows *sql.Rows, next func(*sql.Rows) (*models.AlignedMetric, error), capacity int,
@@ -1090,10 +1153,15 @@ func NewRunImagesStreamResponse(ctx *fiber.Ctx, images []models.Image) error {
 
 // NewRunImagesBatchStreamResponse streams the provided images to the fiber context.
 func NewRunImagesBatchStreamResponse(ctx *fiber.Ctx, imagesMap map[string]any) error {
+	return newRunArtifactsBatchStreamResponse(ctx, imagesMap)
+}
+
+// newRunArtifactsBatchStreamResponse streams the provided artifact blobs to the fiber context.
+func newRunArtifactsBatchStreamResponse(ctx *fiber.Ctx, artifactsMap map[string]any) error {
 	ctx.Context().Response.SetBodyStreamWriter(func(w *bufio.Writer) {
 		start := time.Now()
 		if err := func() error {
-			if err := encoding.EncodeTree(w, imagesMap); err != nil {
+			if err := encoding.EncodeTree(w, artifactsMap); err != nil {
 				return err
 			}
 			if err := w.Flush(); err != nil {
@@ -0,0 +1,344 @@
+package response
+
+import (
+	"bufio"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rotisserie/eris"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/datatypes"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/encoding"
+)
+
+// sequenceRecord is a single record of the sequence (texts, distributions etc.) which is streamed to the client.
+type sequenceRecord struct {
+	RunID   string
+	Name    string
+	Context datatypes.JSON
+	Step    int64
+	Iter    int64
+	Value   fiber.Map
+}
+
+// sequenceOptions describes how the sequence has to be streamed to the client.
+type sequenceOptions struct {
+	// Name is the sequence name (texts, distributions etc.) used in the response.
+	Name string
+	// UseList shows whether each step holds the list of values or a single value.
+	UseList bool
+	// ResolveValue is optional and called for each value which is going to be streamed,
+	// so blobs are loaded only for the values selected by the requested density.
+	ResolveValue func(value fiber.Map) error
+}
+
+// resolveSequenceTraceValues calls the options resolver for every value of the trace.
+func resolveSequenceTraceValues(trace fiber.Map, options sequenceOptions) error {
+	if options.ResolveValue == nil {
+		return nil
+	}
+	steps, ok := trace["values"].([][]fiber.Map)
+	if !ok {
+		return nil
+	}
+	for _, step := range steps {
+		for _, value := range step {
+			if err := options.ResolveValue(value); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// traceKey returns the key of the trace which the record belongs to.
+func (r sequenceRecord) traceKey() string {
+	return fmt.Sprintf("%s-%s", r.Name, r.Context)
+}
+
+// decodeSequenceContext decodes the context json into a map, which is required by Aim UI.
+func decodeSequenceContext(data datatypes.JSON) (fiber.Map, error) {
+	context := fiber.Map{}
+	if len(data) == 0 {
+		return context, nil
+	}
+	if err := json.Unmarshal(data, &context); err != nil {
+		return nil, eris.Wrap(err, "error unmarshalling `context` json to `fiber.Map` object")
+	}
+	return context, nil
+}
+
+// selectSequenceTraceValues limits the trace steps to the requested record density
+// and the values of each step to the requested index density.
+func selectSequenceTraceValues(trace fiber.Map, stepCount, itemCount int) fiber.Map {
+	steps, ok := trace["values"].([][]fiber.Map)
+	if !ok {
+		return trace
+	}
+	iters, ok := trace["iters"].([]int64)
+	if !ok {
+		return trace
+	}
+	filteredSteps := [][]fiber.Map{}
+	filteredIters := []int64{}
+	stepInterval := len(steps) / stepCount
+	for stepIndex := 0; stepIndex < len(steps); stepIndex++ {
+		if stepCount == -1 ||
+			len(steps) <= stepCount ||
+			stepIndex%stepInterval == 0 {
+			step := steps[stepIndex]
+			newStep := []fiber.Map{}
+			itemInterval := len(step) / itemCount
+			for itemIndex := 0; itemIndex < len(step); itemIndex++ {
+				if itemCount == -1 ||
+					len(step) <= itemCount ||
+					itemIndex%itemInterval == 0 {
+					newStep = append(newStep, step[itemIndex])
+				}
+			}
+			filteredSteps = append(filteredSteps, newStep)
+			filteredIters = append(filteredIters, iters[stepIndex])
+		}
+	}
+	trace["values"] = filteredSteps
+	trace["iters"] = filteredIters
+	return trace
+}
+
+// flattenSequenceTraceValues replaces the list of values of each step by its single value,
+// which is how Aim UI expects sequences holding one value per step (e.g. distributions).
+func flattenSequenceTraceValues(trace fiber.Map) fiber.Map {
+	steps, ok := trace["values"].([][]fiber.Map)
+	if !ok {
+		return trace
+	}
+	values := make([]fiber.Map, len(steps))
+	for i, step := range steps {
+		if len(step) > 0 {
+			values[i] = step[0]
+		}
+	}
+	trace["values"] = values
+	return trace
+}
+
+// newStreamSequenceResponse streams the provided sql.Rows of the sequence to the fiber context.
+// Records are grouped into traces by name and context, the rows have to be ordered by run.
+func newStreamSequenceResponse(
+	ctx *fiber.Ctx,
+	rows *sql.Rows,
+	runs map[string]models.Run,
+	summary repositories.ArtifactSearchSummary,
+	req request.SearchArtifactsRequest,
+	options sequenceOptions,
+	next func(*sql.Rows) (*sequenceRecord, error),
+) {
+	ctx.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		//nolint:errcheck
+		defer rows.Close()
+
+		start := time.Now()
+
+		if err := func() error {
+			var (
+				runID     string
+				runData   fiber.Map
+				traceKeys []string
+				tracesMap map[string]fiber.Map
+				cur       int64
+			)
+			reportProgress := func() error {
+				if !req.ReportProgress {
+					return nil
+				}
+				err := encoding.EncodeTree(w, fiber.Map{
+					fmt.Sprintf("progress_%d", cur): []int64{cur, int64(len(runs))},
+				})
+				if err != nil {
+					return err
+				}
+				cur++
+				return w.Flush()
+			}
+			addRecord := func(record *sequenceRecord, run models.Run) error {
+				maxIndex := summary.MaxIndex(record.RunID, record.Name)
+				maxStep := summary.MaxStep(record.RunID, record.Name)
+				if runData == nil {
+					runData = fiber.Map{
+						"ranges": fiber.Map{
+							"record_range_total": []int{0, maxStep},
+							"record_range_used":  []int{req.RecordRangeMin(), req.RecordRangeMax(maxStep)},
+							"index_range_total":  []int{0, maxIndex},
+							"index_range_used":   []int{req.IndexRangeMin(), req.IndexRangeMax(maxIndex)},
+						},
+						"params": fiber.Map{
+							fmt.Sprintf("%s_per_step", options.Name): maxIndex,
+						},
+						"props": renderProps(run),
+					}
+					traceKeys, tracesMap = []string{}, map[string]fiber.Map{}
+				}
+				trace, ok := tracesMap[record.traceKey()]
+				if !ok {
+					context, err := decodeSequenceContext(record.Context)
+					if err != nil {
+						return err
+					}
+					trace = fiber.Map{
+						"name":    record.Name,
+						"context": context,
+						"values":  make([][]fiber.Map, maxStep+1),
+						"iters":   make([]int64, maxStep+1),
+					}
+					traceKeys = append(traceKeys, record.traceKey())
+					tracesMap[record.traceKey()] = trace
+				}
+				// the summary could be outdated if new records were logged after it was collected.
+				values, iters := trace["values"].([][]fiber.Map), trace["iters"].([]int64)
+				if record.Step >= int64(len(values)) {
+					return nil
+				}
+				values[record.Step] = append(values[record.Step], record.Value)
+				iters[record.Step] = record.Iter
+				return nil
+			}
+			flushRun := func() error {
+				if runID == "" {
+					return nil
+				}
+				traces := make([]fiber.Map, len(traceKeys))
+				for i, key := range traceKeys {
+					traces[i] = selectSequenceTraceValues(tracesMap[key], req.StepCount(), req.ItemsPerStep())
+					if err := resolveSequenceTraceValues(traces[i], options); err != nil {
+						return err
+					}
+					if !options.UseList {
+						traces[i] = flattenSequenceTraceValues(traces[i])
+					}
+				}
+				runData["traces"] = traces
+				if err := encoding.EncodeTree(w, fiber.Map{
+					runID: runData,
+				}); err != nil {
+					return err
+				}
+				if err := reportProgress(); err != nil {
+					return err
+				}
+				return w.Flush()
+			}
+			hasRows := false
+			for rows.Next() {
+				record, err := next(rows)
+				if err != nil {
+					return err
+				}
+				// flush after each change in runID
+				// (assumes order by runID)
+				if record.RunID != runID {
+					if err := flushRun(); err != nil {
+						return err
+					}
+					runID = record.RunID
+					runData = nil
+				}
+				if err := addRecord(record, runs[record.RunID]); err != nil {
+					return err
+				}
+				hasRows = true
+			}
+
+			if hasRows {
+				if err := flushRun(); err != nil {
+					return err
+				}
+				if err := reportProgress(); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		}(); err != nil {
+			log.Errorf(
+				"Error encountered in %s %s: error streaming %s: %s", ctx.Method(), ctx.Path(), options.Name, err,
+			)
+		}
+
+		log.Infof("body - %s %s %s", time.Since(start), ctx.Method(), ctx.Path())
+	})
+}
+
+// newRunSequenceStreamResponse streams the traces of the single run sequence to the fiber context.
+// Records have to be ordered by name, context and step.
+func newRunSequenceStreamResponse(ctx *fiber.Ctx, records []sequenceRecord, options sequenceOptions) error {
+	ctx.Set("Content-Type", "application/octet-stream")
+	ctx.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		start := time.Now()
+		if err := func() error {
+			var (
+				traceKeys []string
+				tracesMap = map[string]fiber.Map{}
+			)
+			for _, record := range records {
+				trace, ok := tracesMap[record.traceKey()]
+				if !ok {
+					context, err := decodeSequenceContext(record.Context)
+					if err != nil {
+						return err
+					}
+					trace = fiber.Map{
+						"name":         record.Name,
+						"context":      context,
+						"values":       [][]fiber.Map{},
+						"iters":        []int64{},
+						"record_range": []int64{record.Step, record.Step + 1},
+						"index_range":  []int64{0, 1},
+					}
+					traceKeys = append(traceKeys, record.traceKey())
+					tracesMap[record.traceKey()] = trace
+				}
+
+				values, iters := trace["values"].([][]fiber.Map), trace["iters"].([]int64)
+				recordRange, indexRange := trace["record_range"].([]int64), trace["index_range"].([]int64)
+				if len(values) == 0 || recordRange[1] != record.Step+1 {
+					values, iters = append(values, []fiber.Map{}), append(iters, record.Iter)
+					recordRange[1] = record.Step + 1
+				}
+				values[len(values)-1] = append(values[len(values)-1], record.Value)
+				if index, ok := record.Value["index"].(int64); ok && index+1 > indexRange[1] {
+					indexRange[1] = index + 1
+				}
+				trace["values"], trace["iters"] = values, iters
+			}
+
+			traces := make([]fiber.Map, len(traceKeys))
+			for i, key := range traceKeys {
+				traces[i] = tracesMap[key]
+				if err := resolveSequenceTraceValues(traces[i], options); err != nil {
+					return err
+				}
+				if !options.UseList {
+					traces[i] = flattenSequenceTraceValues(traces[i])
+				}
+			}
+			if err := encoding.EncodeList(w, traces); err != nil {
+				return err
+			}
+			return w.Flush()
+		}(); err != nil {
+			log.Errorf(
+				"Error encountered in %s %s: error streaming run %s: %s", ctx.Method(), ctx.Path(), options.Name, err,
+			)
+		}
+
+		log.Infof("body - %s %s %s", time.Since(start), ctx.Method(), ctx.Path())
+	})
+	return nil
+}
@@ -0,0 +1,55 @@
+package response
+
+import (
+	"database/sql"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/database"
+)
+
+// convertTextToSequenceRecord converts models.Text into the streamable sequence record.
+func convertTextToSequenceRecord(text models.Text) sequenceRecord {
+	return sequenceRecord{
+		RunID:   text.RunID,
+		Name:    text.Name,
+		Context: text.Context,
+		Step:    text.Step,
+		Iter:    text.Iter,
+		Value: fiber.Map{
+			"data":  text.Value,
+			"index": text.Index,
+			"step":  text.Step,
+			"iter":  text.Iter,
+		},
+	}
+}
+
+// NewStreamTextsResponse streams the provided sql.Rows of texts to the fiber context.
+func NewStreamTextsResponse(ctx *fiber.Ctx, rows *sql.Rows, runs map[string]models.Run,
+	summary repositories.ArtifactSearchSummary, req request.SearchArtifactsRequest,
+) {
+	newStreamSequenceResponse(
+		ctx, rows, runs, summary, req, sequenceOptions{Name: "texts", UseList: true},
+		func(rows *sql.Rows) (*sequenceRecord, error) {
+			var text models.Text
+			if err := database.DB.ScanRows(rows, &text); err != nil {
+				return nil, err
+			}
+			record := convertTextToSequenceRecord(text)
+			return &record, nil
+		},
+	)
+}
+
+// NewRunTextsStreamResponse streams the provided run texts to the fiber context.
+func NewRunTextsStreamResponse(ctx *fiber.Ctx, texts []models.Text) error {
+	records := make([]sequenceRecord, len(texts))
+	for i, text := range texts {
+		records[i] = convertTextToSequenceRecord(text)
+	}
+	return newRunSequenceStreamResponse(ctx, records, sequenceOptions{Name: "texts", UseList: true})
+}
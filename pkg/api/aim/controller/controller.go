@@ -4,7 +4,9 @@ import (
 	"github.com/G-Research/fasttrackml/pkg/api/aim/services/app"
 	"github.com/G-Research/fasttrackml/pkg/api/aim/services/dashboard"
 	"github.com/G-Research/fasttrackml/pkg/api/aim/services/experiment"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/services/note"
 	"github.com/G-Research/fasttrackml/pkg/api/aim/services/project"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/services/report"
 	"github.com/G-Research/fasttrackml/pkg/api/aim/services/run"
 	"github.com/G-Research/fasttrackml/pkg/api/aim/services/tag"
 	"github.com/G-Research/fasttrackml/pkg/common/services/artifact"
@@ -19,6 +21,8 @@ type Controller struct {
 	projectService    *project.Service
 	dashboardService  *dashboard.Service
 	experimentService *experiment.Service
+	noteService       *note.Service
+	reportService     *report.Service
 }
 
 // NewController creates new Controller instance.
@@ -30,6 +34,8 @@ func NewController(
 	projectService *project.Service,
 	dashboardService *dashboard.Service,
 	experimentService *experiment.Service,
+	noteService *note.Service,
+	reportService *report.Service,
 ) *Controller {
 	return &Controller{
 		tagService:        tagService,
@@ -39,5 +45,7 @@ func NewController(
 		projectService:    projectService,
 		dashboardService:  dashboardService,
 		experimentService: experimentService,
+		noteService:       noteService,
+		reportService:     reportService,
 	}
 }
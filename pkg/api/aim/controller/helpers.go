@@ -7,7 +7,6 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/rotisserie/eris"
 
-	"github.com/G-Research/fasttrackml/pkg/api/aim/api/request"
 	"github.com/G-Research/fasttrackml/pkg/common/api"
 )
 
@@ -22,18 +21,21 @@ func convertError(err error) error {
 	return err
 }
 
-func convertImagesToMap(
-	images []io.ReadCloser, req request.GetRunImagesBatchRequest,
+// convertArtifactsToMap reads the provided artifact blobs into the map keyed by the requested URIs.
+func convertArtifactsToMap(
+	artifacts []io.ReadCloser, uris []string,
 ) (map[string]any, error) {
-	imagesMap := make(map[string]any)
+	artifactsMap := make(map[string]any)
 
-	for i, image := range images {
+	for i, artifact := range artifacts {
 		var buffer bytes.Buffer
-		_, err := io.CopyBuffer(&buffer, image, make([]byte, 4096))
+		_, err := io.CopyBuffer(&buffer, artifact, make([]byte, 4096))
+		//nolint:errcheck,gosec
+		artifact.Close()
 		if err != nil {
 			return nil, eris.Wrap(err, "error copying artifact Reader to output stream")
 		}
-		imagesMap[req[i]] = buffer.Bytes()
+		artifactsMap[uris[i]] = buffer.Bytes()
 	}
-	return imagesMap, nil
+	return artifactsMap, nil
 }
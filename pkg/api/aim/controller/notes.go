@@ -0,0 +1,245 @@
+package controller
+
+import (
+	"github.com/gofiber/fiber/v2"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/api/response"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/middleware"
+)
+
+// GetRunNotes handles `GET /runs/:id/note` endpoint.
+func (c Controller) GetRunNotes(ctx *fiber.Ctx) error {
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("getRunNotes namespace: %s", ns.Code)
+
+	req := request.GetRunNotesRequest{}
+	if err := ctx.ParamsParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+	notes, err := c.noteService.GetRunNotes(ctx.Context(), ns.ID, &req)
+	if err != nil {
+		return convertError(err)
+	}
+
+	resp := response.NewGetNotesResponse(notes)
+	log.Debugf("getRunNotes response: %#v", resp)
+	return ctx.JSON(resp)
+}
+
+// CreateRunNote handles `POST /runs/:id/note` endpoint.
+func (c Controller) CreateRunNote(ctx *fiber.Ctx) error {
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("createRunNote namespace: %s", ns.Code)
+
+	req := request.CreateRunNoteRequest{}
+	if err := ctx.ParamsParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+	if err := ctx.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+	note, err := c.noteService.CreateRunNote(
+		ctx.Context(), ns.ID, middleware.GetUsernameFromContext(ctx.Context()), &req,
+	)
+	if err != nil {
+		return convertError(err)
+	}
+
+	resp := response.NewCreateNoteResponse(note)
+	log.Debugf("createRunNote response: %#v", resp)
+	return ctx.Status(fiber.StatusCreated).JSON(resp)
+}
+
+// GetRunNote handles `GET /runs/:id/note/:note_id` endpoint.
+func (c Controller) GetRunNote(ctx *fiber.Ctx) error {
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("getRunNote namespace: %s", ns.Code)
+
+	req := request.GetRunNoteRequest{}
+	if err := ctx.ParamsParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+	note, err := c.noteService.GetRunNote(ctx.Context(), ns.ID, &req)
+	if err != nil {
+		return convertError(err)
+	}
+
+	resp := response.NewGetNoteResponse(note)
+	log.Debugf("getRunNote response: %#v", resp)
+	return ctx.JSON(resp)
+}
+
+// UpdateRunNote handles `PUT /runs/:id/note/:note_id` endpoint.
+func (c Controller) UpdateRunNote(ctx *fiber.Ctx) error {
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("updateRunNote namespace: %s", ns.Code)
+
+	req := request.UpdateRunNoteRequest{}
+	if err := ctx.ParamsParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+	if err := ctx.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+	note, err := c.noteService.UpdateRunNote(ctx.Context(), ns.ID, &req)
+	if err != nil {
+		return convertError(err)
+	}
+
+	resp := response.NewUpdateNoteResponse(note)
+	log.Debugf("updateRunNote response: %#v", resp)
+	return ctx.JSON(resp)
+}
+
+// DeleteRunNote handles `DELETE /runs/:id/note/:note_id` endpoint.
+func (c Controller) DeleteRunNote(ctx *fiber.Ctx) error {
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("deleteRunNote namespace: %s", ns.Code)
+
+	req := request.DeleteRunNoteRequest{}
+	if err := ctx.ParamsParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+	if err := c.noteService.DeleteRunNote(ctx.Context(), ns.ID, &req); err != nil {
+		return convertError(err)
+	}
+
+	resp := response.NewDeleteNoteResponse(req.NoteID, "OK")
+	log.Debugf("deleteRunNote response: %#v", resp)
+	return ctx.JSON(resp)
+}
+
+// GetExperimentNotes handles `GET /experiments/:id/note` endpoint.
+func (c Controller) GetExperimentNotes(ctx *fiber.Ctx) error {
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("getExperimentNotes namespace: %s", ns.Code)
+
+	req := request.GetExperimentNotesRequest{}
+	if err := ctx.ParamsParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+	notes, err := c.noteService.GetExperimentNotes(ctx.Context(), ns.ID, &req)
+	if err != nil {
+		return convertError(err)
+	}
+
+	resp := response.NewGetNotesResponse(notes)
+	log.Debugf("getExperimentNotes response: %#v", resp)
+	return ctx.JSON(resp)
+}
+
+// CreateExperimentNote handles `POST /experiments/:id/note` endpoint.
+func (c Controller) CreateExperimentNote(ctx *fiber.Ctx) error {
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("createExperimentNote namespace: %s", ns.Code)
+
+	req := request.CreateExperimentNoteRequest{}
+	if err := ctx.ParamsParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+	if err := ctx.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+	note, err := c.noteService.CreateExperimentNote(
+		ctx.Context(), ns.ID, middleware.GetUsernameFromContext(ctx.Context()), &req,
+	)
+	if err != nil {
+		return convertError(err)
+	}
+
+	resp := response.NewCreateNoteResponse(note)
+	log.Debugf("createExperimentNote response: %#v", resp)
+	return ctx.Status(fiber.StatusCreated).JSON(resp)
+}
+
+// GetExperimentNote handles `GET /experiments/:id/note/:note_id` endpoint.
+func (c Controller) GetExperimentNote(ctx *fiber.Ctx) error {
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("getExperimentNote namespace: %s", ns.Code)
+
+	req := request.GetExperimentNoteRequest{}
+	if err := ctx.ParamsParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+	note, err := c.noteService.GetExperimentNote(ctx.Context(), ns.ID, &req)
+	if err != nil {
+		return convertError(err)
+	}
+
+	resp := response.NewGetNoteResponse(note)
+	log.Debugf("getExperimentNote response: %#v", resp)
+	return ctx.JSON(resp)
+}
+
+// UpdateExperimentNote handles `PUT /experiments/:id/note/:note_id` endpoint.
+func (c Controller) UpdateExperimentNote(ctx *fiber.Ctx) error {
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("updateExperimentNote namespace: %s", ns.Code)
+
+	req := request.UpdateExperimentNoteRequest{}
+	if err := ctx.ParamsParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+	if err := ctx.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+	note, err := c.noteService.UpdateExperimentNote(ctx.Context(), ns.ID, &req)
+	if err != nil {
+		return convertError(err)
+	}
+
+	resp := response.NewUpdateNoteResponse(note)
+	log.Debugf("updateExperimentNote response: %#v", resp)
+	return ctx.JSON(resp)
+}
+
+// DeleteExperimentNote handles `DELETE /experiments/:id/note/:note_id` endpoint.
+func (c Controller) DeleteExperimentNote(ctx *fiber.Ctx) error {
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("deleteExperimentNote namespace: %s", ns.Code)
+
+	req := request.DeleteExperimentNoteRequest{}
+	if err := ctx.ParamsParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+	if err := c.noteService.DeleteExperimentNote(ctx.Context(), ns.ID, &req); err != nil {
+		return convertError(err)
+	}
+
+	resp := response.NewDeleteNoteResponse(req.NoteID, "OK")
+	log.Debugf("deleteExperimentNote response: %#v", resp)
+	return ctx.JSON(resp)
+}
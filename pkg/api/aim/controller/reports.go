@@ -0,0 +1,128 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/api/response"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/middleware"
+)
+
+// GetReports handles `GET /reports` endpoint.
+func (c Controller) GetReports(ctx *fiber.Ctx) error {
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("getReports namespace: %s", ns.Code)
+
+	reports, err := c.reportService.GetReports(ctx.Context(), ns.ID)
+	if err != nil {
+		return err
+	}
+
+	resp := response.NewGetReportsResponse(reports)
+	log.Debugf("getReports response: %#v", resp)
+
+	return ctx.JSON(resp)
+}
+
+// CreateReport handles `POST /reports` endpoint.
+func (c Controller) CreateReport(ctx *fiber.Ctx) error {
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("createReport namespace: %s", ns.Code)
+
+	req := request.CreateReportRequest{}
+	if err := ctx.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	report, err := c.reportService.Create(ctx.Context(), ns.ID, &req)
+	if err != nil {
+		return err
+	}
+
+	resp := response.NewCreateReportResponse(report)
+	log.Debugf("createReport response: %#v", resp)
+
+	return ctx.Status(fiber.StatusCreated).JSON(resp)
+}
+
+// GetReport handles `GET /reports/:id` endpoint.
+func (c Controller) GetReport(ctx *fiber.Ctx) error {
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("getReport namespace: %s", ns.Code)
+
+	req := request.GetReportRequest{}
+	if err := ctx.ParamsParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	report, err := c.reportService.Get(ctx.Context(), ns.ID, &req)
+	if err != nil {
+		return convertError(err)
+	}
+
+	resp := response.NewGetReportResponse(report)
+	log.Debugf("getReport response: %#v", resp)
+
+	return ctx.JSON(resp)
+}
+
+// UpdateReport handles `PUT /reports/:id` endpoint.
+func (c Controller) UpdateReport(ctx *fiber.Ctx) error {
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("updateReport namespace: %s", ns.Code)
+
+	req := request.UpdateReportRequest{}
+	if err := ctx.ParamsParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	if err := ctx.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	report, err := c.reportService.Update(ctx.Context(), ns.ID, &req)
+	if err != nil {
+		return convertError(err)
+	}
+
+	resp := response.NewUpdateReportResponse(report)
+	log.Debugf("updateReport response: %#v", resp)
+
+	return ctx.JSON(resp)
+}
+
+// DeleteReport handles `DELETE /reports/:id` endpoint.
+func (c Controller) DeleteReport(ctx *fiber.Ctx) error {
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("deleteReport namespace: %s", ns.Code)
+
+	req := request.DeleteReportRequest{}
+	if err := ctx.ParamsParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	if err := c.reportService.Delete(ctx.Context(), ns.ID, &req); err != nil {
+		return convertError(err)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(nil)
+}
@@ -93,6 +93,90 @@ func (c Controller) GetRunImages(ctx *fiber.Ctx) error {
 	return response.NewRunImagesStreamResponse(ctx, images)
 }
 
+// GetRunTexts handles `POST /runs/:id/texts/get-batch` endpoint.
+func (c Controller) GetRunTexts(ctx *fiber.Ctx) error {
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("getRunTexts namespace: %s", ns.Code)
+
+	req := request.GetRunTextsRequest{}
+	if err := ctx.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	texts, err := c.runService.GetRunTexts(ctx.Context(), ns.ID, ctx.Params("id"), &req)
+	if err != nil {
+		return err
+	}
+
+	return response.NewRunTextsStreamResponse(ctx, texts)
+}
+
+// GetRunDistributions handles `POST /runs/:id/distributions/get-batch` endpoint.
+func (c Controller) GetRunDistributions(ctx *fiber.Ctx) error {
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("getRunDistributions namespace: %s", ns.Code)
+
+	req := request.GetRunDistributionsRequest{}
+	if err := ctx.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	distributions, err := c.runService.GetRunDistributions(ctx.Context(), ns.ID, ctx.Params("id"), &req)
+	if err != nil {
+		return err
+	}
+
+	return response.NewRunDistributionsStreamResponse(ctx, distributions)
+}
+
+// GetRunFigures handles `POST /runs/:id/figures/get-batch` endpoint.
+func (c Controller) GetRunFigures(ctx *fiber.Ctx) error {
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("getRunFigures namespace: %s", ns.Code)
+
+	req := request.GetRunFiguresRequest{}
+	if err := ctx.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	figures, err := c.runService.GetRunFigures(ctx.Context(), ns.ID, ctx.Params("id"), &req)
+	if err != nil {
+		return err
+	}
+
+	return response.NewRunFiguresStreamResponse(ctx, figures, c.newFigureBlobLoader(ctx))
+}
+
+// GetRunAudios handles `POST /runs/:id/audios/get-batch` endpoint.
+func (c Controller) GetRunAudios(ctx *fiber.Ctx) error {
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("getRunAudios namespace: %s", ns.Code)
+
+	req := request.GetRunAudiosRequest{}
+	if err := ctx.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	audios, err := c.runService.GetRunAudios(ctx.Context(), ns.ID, ctx.Params("id"), &req)
+	if err != nil {
+		return err
+	}
+
+	return response.NewRunAudiosStreamResponse(ctx, audios)
+}
+
 // GetRunImagesBatch handles `POST /runs/images/get-batch` endpoint.
 func (c Controller) GetRunImagesBatch(ctx *fiber.Ctx) error {
 	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
@@ -110,7 +194,7 @@ func (c Controller) GetRunImagesBatch(ctx *fiber.Ctx) error {
 	if err != nil {
 		return err
 	}
-	imagesMap, err := convertImagesToMap(images, req)
+	imagesMap, err := convertArtifactsToMap(images, req)
 	if err != nil {
 		return err
 	}
@@ -118,6 +202,31 @@ func (c Controller) GetRunImagesBatch(ctx *fiber.Ctx) error {
 	return response.NewRunImagesBatchStreamResponse(ctx, imagesMap)
 }
 
+// GetRunAudiosBatch handles `POST /runs/audios/get-batch` endpoint.
+func (c Controller) GetRunAudiosBatch(ctx *fiber.Ctx) error {
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("getRunAudios namespace: %s", ns.Code)
+
+	req := request.GetRunAudiosBatchRequest{}
+	if err := ctx.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	audios, err := c.runService.GetRunAudiosBatch(ctx.Context(), &req)
+	if err != nil {
+		return err
+	}
+	audiosMap, err := convertArtifactsToMap(audios, req)
+	if err != nil {
+		return err
+	}
+
+	return response.NewRunAudiosBatchStreamResponse(ctx, audiosMap)
+}
+
 // GetRunsActive handles `GET /runs/active` endpoint.
 func (c Controller) GetRunsActive(ctx *fiber.Ctx) error {
 	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
@@ -143,6 +252,33 @@ func (c Controller) GetRunsActive(ctx *fiber.Ctx) error {
 	return response.NewActiveRunsStreamResponse(ctx, runs, req.ReportProgress)
 }
 
+// GetRunsLive handles `GET /runs/live` endpoint.
+func (c Controller) GetRunsLive(ctx *fiber.Ctx) error {
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("getRunsLive namespace: %s", ns.Code)
+
+	tzOffset, err := strconv.Atoi(ctx.Get("x-timezone-offset", "0"))
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, "x-timezone-offset header is not a valid integer")
+	}
+
+	req := request.GetRunsLiveRequest{}
+	if err := ctx.QueryParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	subscription, match, err := c.runService.SubscribeLiveUpdates(ctx.Context(), ns.ID, tzOffset, &req)
+	if err != nil {
+		return err
+	}
+
+	response.NewRunsLiveStreamResponse(ctx, subscription, match)
+	return nil
+}
+
 // SearchRuns handles `GET /runs/search` endpoint.
 func (c Controller) SearchRuns(ctx *fiber.Ctx) error {
 	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
@@ -218,6 +354,37 @@ func (c Controller) SearchMetrics(ctx *fiber.Ctx) error {
 	return nil
 }
 
+// SearchAggregatedMetrics handles `POST /runs/search/metric/aggregate` endpoint.
+func (c Controller) SearchAggregatedMetrics(ctx *fiber.Ctx) error {
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("searchAggregatedMetrics namespace: %s", ns.Code)
+
+	req := request.SearchAggregatedMetricsRequest{}
+	if err = ctx.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	tzOffset, err := strconv.Atoi(ctx.Get("x-timezone-offset", "0"))
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, "x-timezone-offset header is not a valid integer")
+	}
+
+	aggregations, err := c.runService.AggregateMetrics(ctx.Context(), ns.ID, tzOffset, &req)
+	if err != nil {
+		return err
+	}
+
+	resp, err := response.NewSearchAggregatedMetricsResponse(req.GroupBy, aggregations)
+	if err != nil {
+		return api.NewInternalError("error building aggregated metrics response: %s", err)
+	}
+	log.Debugf("searchAggregatedMetrics response: %#v", resp)
+	return ctx.JSON(resp)
+}
+
 // SearchAlignedMetrics handles `POST /runs/search/metric/align` endpoint.
 func (c Controller) SearchAlignedMetrics(ctx *fiber.Ctx) error {
 	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
@@ -272,6 +439,137 @@ func (c Controller) SearchImages(ctx *fiber.Ctx) error {
 	return nil
 }
 
+// SearchTexts handles `POST /runs/search/texts` endpoint.
+func (c Controller) SearchTexts(ctx *fiber.Ctx) error {
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("searchTexts namespace: %s", ns.Code)
+
+	req := request.SearchArtifactsRequest{}
+	if err = ctx.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+	if ctx.Query("report_progress") == "" {
+		req.ReportProgress = true
+	}
+
+	tzOffset, err := strconv.Atoi(ctx.Get("x-timezone-offset", "0"))
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, "x-timezone-offset header is not a valid integer")
+	}
+
+	//nolint:rowserrcheck
+	rows, runs, result, err := c.runService.SearchTexts(ctx.Context(), ns.ID, tzOffset, req)
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+
+	response.NewStreamTextsResponse(ctx, rows, runs, result, req)
+	return nil
+}
+
+// SearchDistributions handles `POST /runs/search/distributions` endpoint.
+func (c Controller) SearchDistributions(ctx *fiber.Ctx) error {
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("searchDistributions namespace: %s", ns.Code)
+
+	req := request.SearchArtifactsRequest{}
+	if err = ctx.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+	if ctx.Query("report_progress") == "" {
+		req.ReportProgress = true
+	}
+
+	tzOffset, err := strconv.Atoi(ctx.Get("x-timezone-offset", "0"))
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, "x-timezone-offset header is not a valid integer")
+	}
+
+	//nolint:rowserrcheck
+	rows, runs, result, err := c.runService.SearchDistributions(ctx.Context(), ns.ID, tzOffset, req)
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+
+	response.NewStreamDistributionsResponse(ctx, rows, runs, result, req)
+	return nil
+}
+
+// SearchFigures handles `POST /runs/search/figures` endpoint.
+func (c Controller) SearchFigures(ctx *fiber.Ctx) error {
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("searchFigures namespace: %s", ns.Code)
+
+	req := request.SearchArtifactsRequest{}
+	if err = ctx.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+	if ctx.Query("report_progress") == "" {
+		req.ReportProgress = true
+	}
+
+	tzOffset, err := strconv.Atoi(ctx.Get("x-timezone-offset", "0"))
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, "x-timezone-offset header is not a valid integer")
+	}
+
+	//nolint:rowserrcheck
+	rows, runs, result, err := c.runService.SearchFigures(ctx.Context(), ns.ID, tzOffset, req)
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+
+	response.NewStreamFiguresResponse(ctx, rows, runs, result, req, c.newFigureBlobLoader(ctx))
+	return nil
+}
+
+// SearchAudios handles `POST /runs/search/audios` endpoint.
+func (c Controller) SearchAudios(ctx *fiber.Ctx) error {
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("searchAudios namespace: %s", ns.Code)
+
+	req := request.SearchArtifactsRequest{}
+	if err = ctx.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+	if ctx.Query("report_progress") == "" {
+		req.ReportProgress = true
+	}
+
+	tzOffset, err := strconv.Atoi(ctx.Get("x-timezone-offset", "0"))
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, "x-timezone-offset header is not a valid integer")
+	}
+
+	//nolint:rowserrcheck
+	rows, runs, result, err := c.runService.SearchAudios(ctx.Context(), ns.ID, tzOffset, req)
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+
+	response.NewStreamAudiosResponse(ctx, rows, runs, result, req)
+	return nil
+}
+
+// newFigureBlobLoader creates the loader of figure blobs bound to the request context.
+func (c Controller) newFigureBlobLoader(ctx *fiber.Ctx) response.FigureBlobLoader {
+	return func(blobURI string) ([]byte, error) {
+		return c.runService.GetFigureBlob(ctx.Context(), blobURI)
+	}
+}
+
 // DeleteRun handles `DELETE /runs/:id` endpoint.
 func (c Controller) DeleteRun(ctx *fiber.Ctx) error {
 	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
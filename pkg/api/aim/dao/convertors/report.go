@@ -0,0 +1,21 @@
+package convertors
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/dao/models"
+)
+
+// ConvertCreateReportRequestToDBModel converts request.CreateReportRequest into actual models.Report model.
+func ConvertCreateReportRequestToDBModel(
+	namespaceID uint, req *request.CreateReportRequest,
+) *models.Report {
+	return &models.Report{
+		Base:        models.Base{ID: uuid.New()},
+		Name:        req.Name,
+		Code:        req.Code,
+		Description: req.Description,
+		NamespaceID: namespaceID,
+	}
+}
@@ -0,0 +1,27 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+// Audio represents model to work with `audios` table.
+type Audio struct {
+	ID         uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Name       string
+	Iter       int64
+	Step       int64
+	Index      int64
+	Caption    string
+	Format     string
+	SampleRate int64
+	BlobURI    string
+	RunID      string `gorm:"column:run_uuid"`
+	ContextID  uint
+	Context    datatypes.JSON `gorm:"column:context_json"`
+}
+
+// TableName returns current table name.
+func (a Audio) TableName() string {
+	return "audios"
+}
@@ -0,0 +1,24 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+// Distribution represents model to work with `distributions` table.
+type Distribution struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Name      string
+	Iter      int64
+	Step      int64
+	BinEdges  datatypes.JSON
+	Counts    datatypes.JSON
+	RunID     string `gorm:"column:run_uuid"`
+	ContextID uint
+	Context   datatypes.JSON `gorm:"column:context_json"`
+}
+
+// TableName returns current table name.
+func (d Distribution) TableName() string {
+	return "distributions"
+}
@@ -0,0 +1,24 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+// Figure represents model to work with `figures` table.
+type Figure struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Name      string
+	Iter      int64
+	Step      int64
+	Caption   string
+	BlobURI   string
+	RunID     string `gorm:"column:run_uuid"`
+	ContextID uint
+	Context   datatypes.JSON `gorm:"column:context_json"`
+}
+
+// TableName returns current table name.
+func (f Figure) TableName() string {
+	return "figures"
+}
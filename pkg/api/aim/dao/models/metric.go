@@ -3,6 +3,7 @@ package models
 import (
 	"crypto/sha256"
 	"fmt"
+	"math"
 
 	"gorm.io/datatypes"
 
@@ -76,3 +77,36 @@ type MetricKeysItem struct {
 
 // MetricKeysMap represents map with composite keys.
 type MetricKeysMap map[MetricKeysItem]any
+
+// List of supported group by fields of metric aggregation.
+// Tags and params are grouped by the key, which follows the prefix, e.g. `params.lr`.
+const (
+	MetricAggregationGroupByExperiment  = "experiment"
+	MetricAggregationGroupByTagPrefix   = "tags."
+	MetricAggregationGroupByParamPrefix = "params."
+)
+
+// MetricAggregation represents metric values of the group of runs aggregated at a single x-axis value.
+// Group holds values of requested group by fields, nil value means the run has no such param or tag.
+type MetricAggregation struct {
+	Group      []*string
+	Key        string
+	Context    types.JSONB
+	X          float64
+	Mean       float64
+	MeanSquare float64
+	Min        float64
+	Max        float64
+	Median     float64
+	Count      int64
+}
+
+// Std returns the population standard deviation of aggregated values.
+func (a MetricAggregation) Std() float64 {
+	// variance could be slightly negative because of float rounding.
+	variance := a.MeanSquare - a.Mean*a.Mean
+	if variance <= 0 {
+		return 0
+	}
+	return math.Sqrt(variance)
+}
@@ -0,0 +1,36 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetricAggregationStd(t *testing.T) {
+	tests := []struct {
+		name        string
+		aggregation MetricAggregation
+		want        float64
+	}{
+		{
+			name:        "SingleValue",
+			aggregation: MetricAggregation{Mean: 3, MeanSquare: 9},
+			want:        0,
+		},
+		{
+			name:        "DifferentValues",
+			aggregation: MetricAggregation{Mean: 2, MeanSquare: 5},
+			want:        1,
+		},
+		{
+			name:        "RoundingError",
+			aggregation: MetricAggregation{Mean: 0.1, MeanSquare: 0.01 - 1e-18},
+			want:        0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.aggregation.Std())
+		})
+	}
+}
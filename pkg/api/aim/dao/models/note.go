@@ -0,0 +1,26 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Note represents model to work with `notes` table.
+type Note struct {
+	ID           uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Content      string    `gorm:"type:text;not null"`
+	Author       string    `gorm:"type:varchar(256)"`
+	RunID        *string   `gorm:"column:run_uuid"`
+	ExperimentID *int32
+	NamespaceID  uint `gorm:"not null"`
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// BeforeCreate supplies a UUID for Note.
+func (note *Note) BeforeCreate(tx *gorm.DB) error {
+	note.ID = uuid.New()
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/G-Research/fasttrackml/pkg/common"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/types"
 )
 
 func TestValueAny(t *testing.T) {
@@ -29,6 +30,16 @@ func TestValueAny(t *testing.T) {
 			param: Param{ValueStr: common.GetPointer("abc")},
 			want:  "abc",
 		},
+		{
+			name:  "BoolValue",
+			param: Param{ValueBool: common.GetPointer(true)},
+			want:  true,
+		},
+		{
+			name:  "JSONValue",
+			param: Param{ValueJSON: types.JSONB(`{"optim": {"lr": 0.001}}`)},
+			want:  map[string]any{"optim": map[string]any{"lr": 0.001}},
+		},
 	}
 
 	for _, tt := range tests {
@@ -39,3 +50,38 @@ func TestValueAny(t *testing.T) {
 		})
 	}
 }
+
+func TestValueString(t *testing.T) {
+	tests := []struct {
+		name  string
+		param Param
+		want  string
+	}{
+		{
+			name:  "IntegerValue",
+			param: Param{ValueInt: common.GetPointer(int64(123))},
+			want:  "123",
+		},
+		{
+			name:  "BoolValue",
+			param: Param{ValueBool: common.GetPointer(false)},
+			want:  "false",
+		},
+		{
+			name:  "JSONValue",
+			param: Param{ValueJSON: types.JSONB(`{"optim": {"name": "adam", "lr": 1e-3}}`)},
+			want:  `{"optim":{"lr":1e-3,"name":"adam"}}`,
+		},
+		{
+			name:  "NullJSONValue",
+			param: Param{ValueJSON: types.JSONB("null"), ValueStr: common.GetPointer("abc")},
+			want:  "abc",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.param.ValueString())
+		})
+	}
+}
@@ -1,5 +1,9 @@
 package models
 
+import (
+	"github.com/G-Research/fasttrackml/pkg/common/dao/types"
+)
+
 // ProjectActivity represents object to store and transfer project activity.
 type ProjectActivity struct {
 	NumRuns         int64          `json:"num_runs"`
@@ -11,8 +15,20 @@ type ProjectActivity struct {
 
 // ProjectParams represents object to store and transfer project parameters.
 type ProjectParams struct {
-	Metrics   []LatestMetric
-	TagKeys   []string
-	ParamKeys []string
-	Images    []string
+	Metrics       []LatestMetric
+	TagKeys       []string
+	Params        []ProjectParam
+	Images        []string
+	Texts         []Text
+	Distributions []Distribution
+	Figures       []Figure
+	Audios        []Audio
+}
+
+// ProjectParam represents object to store and transfer the key and the type of project parameter.
+// ValueJSON holds the nested (dict or list) value, so its structure could be described.
+type ProjectParam struct {
+	Key       string
+	ValueType string
+	ValueJSON types.JSONB
 }
@@ -0,0 +1,11 @@
+package models
+
+// Report represents a model to work with `reports` table.
+type Report struct {
+	Base
+	Name        string    `gorm:"not null" json:"name"`
+	Code        string    `gorm:"type:text" json:"code"`
+	Description string    `json:"description"`
+	Namespace   Namespace `json:"-"`
+	NamespaceID uint      `gorm:"not null" json:"-"`
+}
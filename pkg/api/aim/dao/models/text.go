@@ -0,0 +1,24 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+// Text represents model to work with `texts` table.
+type Text struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Name      string
+	Iter      int64
+	Step      int64
+	Index     int64
+	Value     string
+	RunID     string `gorm:"column:run_uuid"`
+	ContextID uint
+	Context   datatypes.JSON `gorm:"column:context_json"`
+}
+
+// TableName returns current table name.
+func (t Text) TableName() string {
+	return "texts"
+}
@@ -3,9 +3,7 @@ package repositories
 import (
 	"context"
 	"database/sql"
-	"fmt"
 	"math"
-	"strings"
 
 	"gorm.io/gorm"
 
@@ -13,7 +11,6 @@ import (
 
 	"github.com/G-Research/fasttrackml/pkg/api/aim/api/request"
 	"github.com/G-Research/fasttrackml/pkg/api/aim/dao/models"
-	"github.com/G-Research/fasttrackml/pkg/api/aim/query"
 	"github.com/G-Research/fasttrackml/pkg/common/dao/repositories"
 )
 
@@ -93,72 +90,16 @@ func (r ArtifactRepository) Search(
 	timeZoneOffset int,
 	req request.SearchArtifactsRequest,
 ) (*sql.Rows, map[string]models.Run, ArtifactSearchSummary, error) {
-	qp := query.QueryParser{
-		Default: query.DefaultExpression{
-			Contains:   "run.archived",
-			Expression: "not run.archived",
-		},
-		Tables: map[string]string{
-			"runs":        "runs",
-			"experiments": "experiments",
-			"artifacts":   "artifacts",
-		},
-		TzOffset:  timeZoneOffset,
-		Dialector: r.GetDB().Dialector.Name(),
-	}
-	pq, err := qp.Parse(req.Query)
+	runIDs, runMap, err := findSequenceRuns(ctx, r.GetDB(), namespaceID, timeZoneOffset, req.Query, "artifacts")
 	if err != nil {
 		return nil, nil, nil, err
 	}
 
-	runIDs := []string{}
-	runs := []models.Run{}
-	if tx := pq.Filter(r.GetDB().WithContext(ctx).
-		Table("runs").
-		Joins(`INNER JOIN experiments
-                        ON experiments.experiment_id = runs.experiment_id
-                        AND experiments.namespace_id = ?`,
-			namespaceID,
-		)).
-		Preload("Experiment").
-		Find(&runs); tx.Error != nil {
-		return nil, nil, nil, eris.Wrap(err, "error finding runs for artifact search")
-	}
-
-	runMap := make(map[string]models.Run, len(runs))
-	for _, run := range runs {
-		if _, ok := runMap[run.ID]; !ok {
-			runIDs = append(runIDs, run.ID)
-			runMap[run.ID] = run
-		}
-	}
-
-	// collect some summary data for progress indicator
-	stepInfo := []ArtifactSearchStepInfo{}
-	if tx := r.GetDB().WithContext(ctx).
-		Raw(`SELECT run_uuid, name, step, count(id) as img_count, max("index") as max_index
-			FROM artifacts
-			WHERE run_uuid IN (?)
-			GROUP BY run_uuid, name, step;`,
-			runIDs).
-		Find(&stepInfo); tx.Error != nil {
-		return nil, nil, nil, eris.Wrap(err, "error find result summary for artifact search")
-	}
-
-	imageNames := []string{}
-	imageNameQueryTemplate := `images.name == "%s"`
-	resultSummary := make(ArtifactSearchSummary, len(runIDs))
-	for _, rslt := range stepInfo {
-		traceMap, ok := resultSummary[rslt.RunUUID]
-		if !ok {
-			traceMap = map[string][]ArtifactSearchStepInfo{}
-		}
-		traceMap[rslt.Name] = append(traceMap[rslt.Name], rslt)
-		resultSummary[rslt.RunUUID] = traceMap
-		qImage := fmt.Sprintf(imageNameQueryTemplate, rslt.Name)
-		if strings.Contains(req.Query, qImage) {
-			imageNames = append(imageNames, rslt.Name)
-		}
+	resultSummary, imageNames, err := getSequenceSearchSummary(
+		ctx, r.GetDB(), runIDs, req.Query, "artifacts", "images", true,
+	)
+	if err != nil {
+		return nil, nil, nil, err
 	}
 
 	// get a cursor for the artifacts
@@ -0,0 +1,158 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"math"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/types"
+)
+
+// AudioRepositoryProvider provides an interface to work with `audio` entity.
+type AudioRepositoryProvider interface {
+	repositories.BaseRepositoryProvider
+	// Search will find audios based on the request.
+	Search(
+		ctx context.Context,
+		namespaceID uint,
+		timeZoneOffset int,
+		req request.SearchArtifactsRequest,
+	) (*sql.Rows, map[string]models.Run, ArtifactSearchSummary, error)
+	// GetRunAudios returns audios of the run selected by the requested names and contexts.
+	GetRunAudios(ctx context.Context, runID string, req request.GetRunAudiosRequest) ([]models.Audio, error)
+	// GetAudioNamesAndContextsByExperiments returns unique audio names and contexts by provided experiments.
+	GetAudioNamesAndContextsByExperiments(
+		ctx context.Context, namespaceID uint, experiments []int,
+	) ([]models.Audio, error)
+}
+
+// AudioRepository repository to work with `audio` entity.
+type AudioRepository struct {
+	repositories.BaseRepositoryProvider
+}
+
+// NewAudioRepository creates a repository to work with `audio` entity.
+func NewAudioRepository(db *gorm.DB) *AudioRepository {
+	return &AudioRepository{
+		repositories.NewBaseRepository(db),
+	}
+}
+
+// Search will find audios based on the request.
+func (r AudioRepository) Search(
+	ctx context.Context,
+	namespaceID uint,
+	timeZoneOffset int,
+	req request.SearchArtifactsRequest,
+) (*sql.Rows, map[string]models.Run, ArtifactSearchSummary, error) {
+	runIDs, runMap, err := findSequenceRuns(ctx, r.GetDB(), namespaceID, timeZoneOffset, req.Query, "audios")
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	resultSummary, audioNames, err := getSequenceSearchSummary(ctx, r.GetDB(), runIDs, req.Query, "audios", "audios", true)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// get a cursor for the audios
+	tx := r.GetDB().WithContext(ctx).
+		Raw(`
+                    SELECT audios.*, contexts.json AS context_json
+                    FROM audios
+                    INNER JOIN contexts ON contexts.id = audios.context_id
+                    WHERE run_uuid IN ?
+                    AND step BETWEEN ? AND ?
+                    AND "index" BETWEEN ? AND ?
+                    AND name IN ?
+                    ORDER BY run_uuid, name, context_id, step, "index"
+                `,
+			runIDs,
+			req.RecordRangeMin(),
+			req.RecordRangeMax(math.MaxInt32),
+			req.IndexRangeMin(),
+			req.IndexRangeMax(math.MaxInt32),
+			audioNames)
+
+	rows, err := tx.Rows()
+	if err != nil {
+		return nil, nil, nil, eris.Wrap(err, "error searching audios")
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, nil, eris.Wrap(err, "error getting audios rows cursor")
+	}
+
+	return rows, runMap, resultSummary, nil
+}
+
+// GetRunAudios returns audios of the run selected by the requested names and contexts.
+func (r AudioRepository) GetRunAudios(
+	ctx context.Context, runID string, req request.GetRunAudiosRequest,
+) ([]models.Audio, error) {
+	var audios []models.Audio
+	if len(req) == 0 {
+		return audios, nil
+	}
+
+	subQuery := r.GetDB().WithContext(ctx)
+	for _, item := range req {
+		audioContext := item.Context
+		if audioContext == nil {
+			audioContext = map[string]any{}
+		}
+		serializedContext, err := json.Marshal(audioContext)
+		if err != nil {
+			return nil, eris.Wrap(err, "error marshaling audio context")
+		}
+		subQuery = subQuery.Or("audios.name = ? AND contexts.json = ?", item.Name, types.JSONB(serializedContext))
+	}
+
+	if err := r.GetDB().WithContext(ctx).
+		Select("audios.*, contexts.json AS context_json").
+		Joins("INNER JOIN contexts ON contexts.id = audios.context_id").
+		Where("audios.run_uuid = ?", runID).
+		Where(subQuery).
+		Order("audios.name").
+		Order("audios.context_id").
+		Order("audios.step").
+		Order(`audios."index"`).
+		Find(&audios).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting run audios")
+	}
+	return audios, nil
+}
+
+// GetAudioNamesAndContextsByExperiments returns unique audio names and contexts by provided experiments.
+func (r AudioRepository) GetAudioNamesAndContextsByExperiments(
+	ctx context.Context, namespaceID uint, experiments []int,
+) ([]models.Audio, error) {
+	query := r.GetDB().WithContext(ctx).Distinct().Select(
+		"audios.name", "audios.context_id", "contexts.json AS context_json",
+	).Model(
+		&models.Audio{},
+	).Joins(
+		"INNER JOIN contexts ON contexts.id = audios.context_id",
+	).Joins(
+		"INNER JOIN runs ON runs.run_uuid = audios.run_uuid",
+	).Joins(
+		"INNER JOIN experiments ON experiments.experiment_id = runs.experiment_id AND experiments.namespace_id = ?",
+		namespaceID,
+	).Where(
+		"runs.lifecycle_stage = ?", models.LifecycleStageActive,
+	)
+	if len(experiments) != 0 {
+		query = query.Where("experiments.experiment_id IN ?", experiments)
+	}
+	var audios []models.Audio
+	if err := query.Find(&audios).Error; err != nil {
+		return nil, eris.Wrap(err, "error getting audios by provided experiments")
+	}
+	return audios, nil
+}
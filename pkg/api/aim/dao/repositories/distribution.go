@@ -0,0 +1,160 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"math"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/types"
+)
+
+// DistributionRepositoryProvider provides an interface to work with `distribution` entity.
+type DistributionRepositoryProvider interface {
+	repositories.BaseRepositoryProvider
+	// Search will find distributions based on the request.
+	Search(
+		ctx context.Context,
+		namespaceID uint,
+		timeZoneOffset int,
+		req request.SearchArtifactsRequest,
+	) (*sql.Rows, map[string]models.Run, ArtifactSearchSummary, error)
+	// GetRunDistributions returns distributions of the run selected by the requested names and contexts.
+	GetRunDistributions(
+		ctx context.Context, runID string, req request.GetRunDistributionsRequest,
+	) ([]models.Distribution, error)
+	// GetDistributionNamesAndContextsByExperiments returns unique distribution names and contexts by provided experiments.
+	GetDistributionNamesAndContextsByExperiments(
+		ctx context.Context, namespaceID uint, experiments []int,
+	) ([]models.Distribution, error)
+}
+
+// DistributionRepository repository to work with `distribution` entity.
+type DistributionRepository struct {
+	repositories.BaseRepositoryProvider
+}
+
+// NewDistributionRepository creates a repository to work with `distribution` entity.
+func NewDistributionRepository(db *gorm.DB) *DistributionRepository {
+	return &DistributionRepository{
+		repositories.NewBaseRepository(db),
+	}
+}
+
+// Search will find distributions based on the request.
+func (r DistributionRepository) Search(
+	ctx context.Context,
+	namespaceID uint,
+	timeZoneOffset int,
+	req request.SearchArtifactsRequest,
+) (*sql.Rows, map[string]models.Run, ArtifactSearchSummary, error) {
+	runIDs, runMap, err := findSequenceRuns(ctx, r.GetDB(), namespaceID, timeZoneOffset, req.Query, "distributions")
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	resultSummary, distributionNames, err := getSequenceSearchSummary(
+		ctx, r.GetDB(), runIDs, req.Query, "distributions", "distributions", false,
+	)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// get a cursor for the distributions
+	tx := r.GetDB().WithContext(ctx).
+		Raw(`
+                    SELECT distributions.*, contexts.json AS context_json
+                    FROM distributions
+                    INNER JOIN contexts ON contexts.id = distributions.context_id
+                    WHERE run_uuid IN ?
+                    AND step BETWEEN ? AND ?
+                    AND name IN ?
+                    ORDER BY run_uuid, name, context_id, step
+                `,
+			runIDs,
+			req.RecordRangeMin(),
+			req.RecordRangeMax(math.MaxInt32),
+			distributionNames)
+
+	rows, err := tx.Rows()
+	if err != nil {
+		return nil, nil, nil, eris.Wrap(err, "error searching distributions")
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, nil, eris.Wrap(err, "error getting distributions rows cursor")
+	}
+
+	return rows, runMap, resultSummary, nil
+}
+
+// GetRunDistributions returns distributions of the run selected by the requested names and contexts.
+func (r DistributionRepository) GetRunDistributions(
+	ctx context.Context, runID string, req request.GetRunDistributionsRequest,
+) ([]models.Distribution, error) {
+	var distributions []models.Distribution
+	if len(req) == 0 {
+		return distributions, nil
+	}
+
+	subQuery := r.GetDB().WithContext(ctx)
+	for _, item := range req {
+		distributionContext := item.Context
+		if distributionContext == nil {
+			distributionContext = map[string]any{}
+		}
+		serializedContext, err := json.Marshal(distributionContext)
+		if err != nil {
+			return nil, eris.Wrap(err, "error marshaling distribution context")
+		}
+		subQuery = subQuery.Or(
+			"distributions.name = ? AND contexts.json = ?", item.Name, types.JSONB(serializedContext),
+		)
+	}
+
+	if err := r.GetDB().WithContext(ctx).
+		Select("distributions.*, contexts.json AS context_json").
+		Joins("INNER JOIN contexts ON contexts.id = distributions.context_id").
+		Where("distributions.run_uuid = ?", runID).
+		Where(subQuery).
+		Order("distributions.name").
+		Order("distributions.context_id").
+		Order("distributions.step").
+		Find(&distributions).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting run distributions")
+	}
+	return distributions, nil
+}
+
+// GetDistributionNamesAndContextsByExperiments returns unique distribution names and contexts by provided experiments.
+func (r DistributionRepository) GetDistributionNamesAndContextsByExperiments(
+	ctx context.Context, namespaceID uint, experiments []int,
+) ([]models.Distribution, error) {
+	query := r.GetDB().WithContext(ctx).Distinct().Select(
+		"distributions.name", "distributions.context_id", "contexts.json AS context_json",
+	).Model(
+		&models.Distribution{},
+	).Joins(
+		"INNER JOIN contexts ON contexts.id = distributions.context_id",
+	).Joins(
+		"INNER JOIN runs ON runs.run_uuid = distributions.run_uuid",
+	).Joins(
+		"INNER JOIN experiments ON experiments.experiment_id = runs.experiment_id AND experiments.namespace_id = ?",
+		namespaceID,
+	).Where(
+		"runs.lifecycle_stage = ?", models.LifecycleStageActive,
+	)
+	if len(experiments) != 0 {
+		query = query.Where("experiments.experiment_id IN ?", experiments)
+	}
+	var distributions []models.Distribution
+	if err := query.Find(&distributions).Error; err != nil {
+		return nil, eris.Wrap(err, "error getting distributions by provided experiments")
+	}
+	return distributions, nil
+}
@@ -0,0 +1,160 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"math"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/types"
+)
+
+// FigureRepositoryProvider provides an interface to work with `figure` entity.
+type FigureRepositoryProvider interface {
+	repositories.BaseRepositoryProvider
+	// Search will find figures based on the request.
+	Search(
+		ctx context.Context,
+		namespaceID uint,
+		timeZoneOffset int,
+		req request.SearchArtifactsRequest,
+	) (*sql.Rows, map[string]models.Run, ArtifactSearchSummary, error)
+	// GetRunFigures returns figures of the run selected by the requested names and contexts.
+	GetRunFigures(
+		ctx context.Context, runID string, req request.GetRunFiguresRequest,
+	) ([]models.Figure, error)
+	// GetFigureNamesAndContextsByExperiments returns unique figure names and contexts by provided experiments.
+	GetFigureNamesAndContextsByExperiments(
+		ctx context.Context, namespaceID uint, experiments []int,
+	) ([]models.Figure, error)
+}
+
+// FigureRepository repository to work with `figure` entity.
+type FigureRepository struct {
+	repositories.BaseRepositoryProvider
+}
+
+// NewFigureRepository creates a repository to work with `figure` entity.
+func NewFigureRepository(db *gorm.DB) *FigureRepository {
+	return &FigureRepository{
+		repositories.NewBaseRepository(db),
+	}
+}
+
+// Search will find figures based on the request.
+func (r FigureRepository) Search(
+	ctx context.Context,
+	namespaceID uint,
+	timeZoneOffset int,
+	req request.SearchArtifactsRequest,
+) (*sql.Rows, map[string]models.Run, ArtifactSearchSummary, error) {
+	runIDs, runMap, err := findSequenceRuns(ctx, r.GetDB(), namespaceID, timeZoneOffset, req.Query, "figures")
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	resultSummary, figureNames, err := getSequenceSearchSummary(
+		ctx, r.GetDB(), runIDs, req.Query, "figures", "figures", false,
+	)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// get a cursor for the figures
+	tx := r.GetDB().WithContext(ctx).
+		Raw(`
+                    SELECT figures.*, contexts.json AS context_json
+                    FROM figures
+                    INNER JOIN contexts ON contexts.id = figures.context_id
+                    WHERE run_uuid IN ?
+                    AND step BETWEEN ? AND ?
+                    AND name IN ?
+                    ORDER BY run_uuid, name, context_id, step
+                `,
+			runIDs,
+			req.RecordRangeMin(),
+			req.RecordRangeMax(math.MaxInt32),
+			figureNames)
+
+	rows, err := tx.Rows()
+	if err != nil {
+		return nil, nil, nil, eris.Wrap(err, "error searching figures")
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, nil, eris.Wrap(err, "error getting figures rows cursor")
+	}
+
+	return rows, runMap, resultSummary, nil
+}
+
+// GetRunFigures returns figures of the run selected by the requested names and contexts.
+func (r FigureRepository) GetRunFigures(
+	ctx context.Context, runID string, req request.GetRunFiguresRequest,
+) ([]models.Figure, error) {
+	var figures []models.Figure
+	if len(req) == 0 {
+		return figures, nil
+	}
+
+	subQuery := r.GetDB().WithContext(ctx)
+	for _, item := range req {
+		figureContext := item.Context
+		if figureContext == nil {
+			figureContext = map[string]any{}
+		}
+		serializedContext, err := json.Marshal(figureContext)
+		if err != nil {
+			return nil, eris.Wrap(err, "error marshaling figure context")
+		}
+		subQuery = subQuery.Or(
+			"figures.name = ? AND contexts.json = ?", item.Name, types.JSONB(serializedContext),
+		)
+	}
+
+	if err := r.GetDB().WithContext(ctx).
+		Select("figures.*, contexts.json AS context_json").
+		Joins("INNER JOIN contexts ON contexts.id = figures.context_id").
+		Where("figures.run_uuid = ?", runID).
+		Where(subQuery).
+		Order("figures.name").
+		Order("figures.context_id").
+		Order("figures.step").
+		Find(&figures).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting run figures")
+	}
+	return figures, nil
+}
+
+// GetFigureNamesAndContextsByExperiments returns unique figure names and contexts by provided experiments.
+func (r FigureRepository) GetFigureNamesAndContextsByExperiments(
+	ctx context.Context, namespaceID uint, experiments []int,
+) ([]models.Figure, error) {
+	query := r.GetDB().WithContext(ctx).Distinct().Select(
+		"figures.name", "figures.context_id", "contexts.json AS context_json",
+	).Model(
+		&models.Figure{},
+	).Joins(
+		"INNER JOIN contexts ON contexts.id = figures.context_id",
+	).Joins(
+		"INNER JOIN runs ON runs.run_uuid = figures.run_uuid",
+	).Joins(
+		"INNER JOIN experiments ON experiments.experiment_id = runs.experiment_id AND experiments.namespace_id = ?",
+		namespaceID,
+	).Where(
+		"runs.lifecycle_stage = ?", models.LifecycleStageActive,
+	)
+	if len(experiments) != 0 {
+		query = query.Where("experiments.experiment_id IN ?", experiments)
+	}
+	var figures []models.Figure
+	if err := query.Find(&figures).Error; err != nil {
+		return nil, eris.Wrap(err, "error getting figures by provided experiments")
+	}
+	return figures, nil
+}
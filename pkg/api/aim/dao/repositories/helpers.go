@@ -33,6 +33,25 @@ func makeParamConflictPlaceholdersAndValues(params []models.Param) (string, []in
 	return placeholders, valuesArray
 }
 
+// paramTextExpression returns sql expression, which represents the typed value of param as text.
+func paramTextExpression(table string) string {
+	return fmt.Sprintf(
+		"COALESCE(%[1]s.value_str, CAST(%[1]s.value_int AS TEXT), CAST(%[1]s.value_float AS TEXT), "+
+			"CASE WHEN %[1]s.value_bool IS NULL THEN NULL WHEN %[1]s.value_bool THEN 'true' ELSE 'false' END, "+
+			"CAST(%[1]s.value_json AS TEXT))",
+		table,
+	)
+}
+
+// prefixColumns qualifies the list of columns with the table name.
+func prefixColumns(table string, columns []string) []string {
+	prefixed := make([]string, len(columns))
+	for i, column := range columns {
+		prefixed[i] = fmt.Sprintf("%s.%s", table, column)
+	}
+	return prefixed
+}
+
 // BuildJsonCondition creates sql and values for where condition to select items having the specified map of json paths
 // and values in the given json column. Json path is expressed as "key" or "outerkey.nestedKey".
 func BuildJsonCondition(
@@ -39,6 +39,10 @@ type MetricRepositoryProvider interface {
 	SearchMetrics(
 		ctx context.Context, namespaceID uint, timeZoneOffset int, req request.SearchMetricsRequest,
 	) (*sql.Rows, int64, SearchResultMap, error)
+	// AggregateMetrics returns metrics of the runs matching the request aggregated by requested groups.
+	AggregateMetrics(
+		ctx context.Context, namespaceID uint, timeZoneOffset int, req request.SearchAggregatedMetricsRequest,
+	) ([]models.MetricAggregation, error)
 	// GetContextListByContextObjects returns list of context by provided map of contexts.
 	GetContextListByContextObjects(
 		ctx context.Context, contextsMap map[string]types.JSONB,
@@ -89,28 +93,11 @@ func (r MetricRepository) GetMetricKeysAndContextsByExperiments(
 func (r MetricRepository) SearchMetrics(
 	ctx context.Context, namespaceID uint, timeZoneOffset int, req request.SearchMetricsRequest,
 ) (*sql.Rows, int64, SearchResultMap, error) {
-	qp := query.QueryParser{
-		Default: query.DefaultExpression{
-			Contains:   "run.archived",
-			Expression: "not run.archived",
-		},
-		Tables: map[string]string{
-			"runs":        "runs",
-			"experiments": "experiments",
-			"metrics":     "latest_metrics",
-		},
-		TzOffset:  timeZoneOffset,
-		Dialector: r.GetDB().Dialector.Name(),
-	}
-	pq, err := qp.Parse(req.Query)
+	pq, err := r.parseSearchMetricsQuery(timeZoneOffset, req)
 	if err != nil {
 		return nil, 0, nil, err
 	}
 
-	if req.Metrics == nil || len(req.Metrics) == 0 {
-		return nil, 0, nil, eris.New("No metrics are selected")
-	}
-
 	var totalRuns int64
 	if err := r.GetDB().WithContext(ctx).Model(&models.Run{}).Count(&totalRuns).Error; err != nil {
 		return nil, 0, nil, eris.Wrap(err, "error counting metrics")
@@ -173,35 +160,18 @@ func (r MetricRepository) SearchMetrics(
 		result[r.ID] = SearchResult{int64(r.RowNum), run}
 	}
 
-	ids, err := r.findContextIDs(ctx, &req)
+	subQuery, err := r.selectedLatestMetricsQuery(ctx, namespaceID, &req)
 	if err != nil {
-		return nil, 0, nil, eris.Wrap(err, "error finding context ids")
-	}
-
-	var metricKeyContextConditionSlice []string
-	for i, tuple := range req.Metrics {
-		condition := fmt.Sprintf("(latest_metrics.key = '%s' AND contexts.id = %d)", tuple.Key, ids[i])
-		metricKeyContextConditionSlice = append(metricKeyContextConditionSlice, condition)
+		return nil, 0, nil, err
 	}
-	metricKeyContextCondition := strings.Join(metricKeyContextConditionSlice, " OR ")
-
-	subQuery := r.GetDB().WithContext(ctx).
-		Select(
-			"runs.run_uuid",
-			"runs.row_num",
-			"latest_metrics.key",
-			"latest_metrics.context_id",
-			"contexts.json AS context_json",
-			fmt.Sprintf("(latest_metrics.last_iter + 1)/ %f AS interval", float32(req.Steps)),
-		).
-		Table("runs").
-		Joins(
-			"INNER JOIN experiments ON experiments.experiment_id = runs.experiment_id AND experiments.namespace_id = ?",
-			namespaceID,
-		).
-		Joins("LEFT JOIN latest_metrics USING(run_uuid)").
-		Joins("LEFT JOIN contexts ON latest_metrics.context_id = contexts.id").
-		Where(metricKeyContextCondition)
+	subQuery.Select(
+		"runs.run_uuid",
+		"runs.row_num",
+		"latest_metrics.key",
+		"latest_metrics.context_id",
+		"contexts.json AS context_json",
+		fmt.Sprintf("(latest_metrics.last_iter + 1)/ %f AS interval", float32(req.Steps)),
+	)
 
 	tx := r.GetDB().WithContext(ctx).
 		Select(`
@@ -239,6 +209,205 @@ func (r MetricRepository) SearchMetrics(
 	return rows, totalRuns, result, nil
 }
 
+// AggregateMetrics returns metrics of the runs matching the request aggregated by requested groups.
+// Values are aligned on the step (or on the value of x-axis metric) and all the runs are sampled
+// with the same interval, so the values of different runs fall on the same x-axis values.
+func (r MetricRepository) AggregateMetrics(
+	ctx context.Context, namespaceID uint, timeZoneOffset int, req request.SearchAggregatedMetricsRequest,
+) ([]models.MetricAggregation, error) {
+	pq, err := r.parseSearchMetricsQuery(timeZoneOffset, req.SearchMetricsRequest)
+	if err != nil {
+		return nil, err
+	}
+	subQuery, err := r.selectedLatestMetricsQuery(ctx, namespaceID, &req.SearchMetricsRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	columns := []string{
+		"runs.run_uuid",
+		"latest_metrics.key",
+		"latest_metrics.context_id",
+		"contexts.json AS context_json",
+		fmt.Sprintf(
+			"(MAX(latest_metrics.last_iter) OVER (PARTITION BY latest_metrics.key, latest_metrics.context_id) + 1)"+
+				" / %f AS interval",
+			float32(req.Steps),
+		),
+	}
+	groups := make([]string, len(req.GroupBy))
+	for i, field := range req.GroupBy {
+		groups[i] = fmt.Sprintf("group_%d", i)
+		switch {
+		case field == models.MetricAggregationGroupByExperiment:
+			columns = append(columns, fmt.Sprintf("experiments.name AS %s", groups[i]))
+		case strings.HasPrefix(field, models.MetricAggregationGroupByTagPrefix):
+			subQuery.Joins(
+				fmt.Sprintf("LEFT JOIN tags %s ON %s.run_uuid = runs.run_uuid AND %s.key = ?", groups[i], groups[i], groups[i]),
+				strings.TrimPrefix(field, models.MetricAggregationGroupByTagPrefix),
+			)
+			columns = append(columns, fmt.Sprintf("%s.value AS %s", groups[i], groups[i]))
+		case strings.HasPrefix(field, models.MetricAggregationGroupByParamPrefix):
+			subQuery.Joins(
+				fmt.Sprintf("LEFT JOIN params %s ON %s.run_uuid = runs.run_uuid AND %s.key = ?", groups[i], groups[i], groups[i]),
+				strings.TrimPrefix(field, models.MetricAggregationGroupByParamPrefix),
+			)
+			columns = append(columns, fmt.Sprintf("%s AS %s", paramTextExpression(groups[i]), groups[i]))
+		default:
+			return nil, eris.Errorf("unsupported group by field %q", field)
+		}
+	}
+	subQuery.Select(columns)
+
+	xAxis := "metrics.step"
+	partition := strings.Join(
+		append(prefixColumns("runmetrics", groups), "metrics.key", "metrics.context_id", xAxis), ", ",
+	)
+	points := r.GetDB().WithContext(ctx).
+		Table("metrics").
+		Joins("INNER JOIN (?) runmetrics USING(run_uuid, key, context_id)", pq.Filter(subQuery)).
+		Where("MOD(metrics.iter + 1 + runmetrics.interval / 2, runmetrics.interval) < 1").
+		Where("NOT metrics.is_nan")
+	if req.XAxis != "" {
+		xAxis = "x_axis.value"
+		partition = strings.Join(
+			append(prefixColumns("runmetrics", groups), "metrics.key", "metrics.context_id", xAxis), ", ",
+		)
+		points.
+			Joins(
+				"INNER JOIN metrics x_axis ON metrics.run_uuid = x_axis.run_uuid AND "+
+					"metrics.iter = x_axis.iter AND x_axis.context_id = metrics.context_id AND x_axis.key = ?",
+				req.XAxis,
+			).
+			Where("NOT x_axis.is_nan")
+	}
+	points.Select(append(
+		prefixColumns("runmetrics", groups),
+		"metrics.key",
+		"metrics.context_id",
+		"runmetrics.context_json",
+		fmt.Sprintf("%s AS x", xAxis),
+		"metrics.value",
+		// row number and count of values are used to find the median, which has no common SQL function.
+		fmt.Sprintf("ROW_NUMBER() OVER (PARTITION BY %s ORDER BY metrics.value) AS rn", partition),
+		fmt.Sprintf("COUNT(*) OVER (PARTITION BY %s) AS cnt", partition),
+	))
+
+	keys := append(groups[:len(groups):len(groups)], "key", "context_id", "context_json", "x")
+	rows, err := r.GetDB().WithContext(ctx).
+		Select(append(
+			keys[:len(keys):len(keys)],
+			"AVG(value) AS mean",
+			"AVG(value * value) AS mean_square",
+			"MIN(value) AS min",
+			"MAX(value) AS max",
+			"AVG(CASE WHEN rn IN ((cnt + 1) / 2, (cnt + 2) / 2) THEN value END) AS median",
+			"COUNT(*) AS count",
+		)).
+		Table("(?) points", points).
+		Group(strings.Join(keys, ", ")).
+		Order(strings.Join(keys, ", ")).
+		Rows()
+	if err != nil {
+		return nil, eris.Wrap(err, "error aggregating metrics")
+	}
+	defer rows.Close()
+
+	var aggregations []models.MetricAggregation
+	for rows.Next() {
+		var contextID uint
+		values := make([]sql.NullString, len(groups))
+		aggregation := models.MetricAggregation{}
+		dest := make([]any, 0, len(groups)+9)
+		for i := range values {
+			dest = append(dest, &values[i])
+		}
+		dest = append(
+			dest,
+			&aggregation.Key,
+			&contextID,
+			&aggregation.Context,
+			&aggregation.X,
+			&aggregation.Mean,
+			&aggregation.MeanSquare,
+			&aggregation.Min,
+			&aggregation.Max,
+			&aggregation.Median,
+			&aggregation.Count,
+		)
+		if err := rows.Scan(dest...); err != nil {
+			return nil, eris.Wrap(err, "error scanning aggregated metrics")
+		}
+		aggregation.Group = make([]*string, len(values))
+		for i := range values {
+			if values[i].Valid {
+				aggregation.Group[i] = &values[i].String
+			}
+		}
+		aggregations = append(aggregations, aggregation)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, eris.Wrap(err, "error getting aggregated metrics rows cursor")
+	}
+	return aggregations, nil
+}
+
+// parseSearchMetricsQuery parses the query of metrics search request.
+func (r MetricRepository) parseSearchMetricsQuery(
+	timeZoneOffset int, req request.SearchMetricsRequest,
+) (query.ParsedQuery, error) {
+	qp := query.QueryParser{
+		Default: query.DefaultExpression{
+			Contains:   "run.archived",
+			Expression: "not run.archived",
+		},
+		Tables: map[string]string{
+			"runs":        "runs",
+			"experiments": "experiments",
+			"metrics":     "latest_metrics",
+		},
+		TzOffset:  timeZoneOffset,
+		Dialector: r.GetDB().Dialector.Name(),
+	}
+	pq, err := qp.Parse(req.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Metrics == nil || len(req.Metrics) == 0 {
+		return nil, eris.New("No metrics are selected")
+	}
+	return pq, nil
+}
+
+// selectedLatestMetricsQuery returns the query of runs joined with latest metrics selected by the request.
+// The query has no columns selected yet, so it could be reused for different kinds of metrics search.
+func (r MetricRepository) selectedLatestMetricsQuery(
+	ctx context.Context, namespaceID uint, req *request.SearchMetricsRequest,
+) (*gorm.DB, error) {
+	ids, err := r.findContextIDs(ctx, req)
+	if err != nil {
+		return nil, eris.Wrap(err, "error finding context ids")
+	}
+
+	var metricKeyContextConditionSlice []string
+	for i, tuple := range req.Metrics {
+		condition := fmt.Sprintf("(latest_metrics.key = '%s' AND contexts.id = %d)", tuple.Key, ids[i])
+		metricKeyContextConditionSlice = append(metricKeyContextConditionSlice, condition)
+	}
+	metricKeyContextCondition := strings.Join(metricKeyContextConditionSlice, " OR ")
+
+	return r.GetDB().WithContext(ctx).
+		Table("runs").
+		Joins(
+			"INNER JOIN experiments ON experiments.experiment_id = runs.experiment_id AND experiments.namespace_id = ?",
+			namespaceID,
+		).
+		Joins("LEFT JOIN latest_metrics USING(run_uuid)").
+		Joins("LEFT JOIN contexts ON latest_metrics.context_id = contexts.id").
+		Where(metricKeyContextCondition), nil
+}
+
 // GetContextListByContextObjects returns list of context by provided map of contexts.
 func (r MetricRepository) GetContextListByContextObjects(
 	ctx context.Context, contextsMap map[string]types.JSONB,
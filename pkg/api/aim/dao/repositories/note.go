@@ -0,0 +1,117 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/repositories"
+)
+
+// NoteRepositoryProvider provides an interface to work with models.Note entity.
+type NoteRepositoryProvider interface {
+	repositories.BaseRepositoryProvider
+	// GetByNamespaceIDAndRunID returns the list of models.Note attached to the run.
+	GetByNamespaceIDAndRunID(ctx context.Context, namespaceID uint, runID string) ([]models.Note, error)
+	// GetByNamespaceIDAndExperimentID returns the list of models.Note attached to the experiment.
+	GetByNamespaceIDAndExperimentID(
+		ctx context.Context, namespaceID uint, experimentID int32,
+	) ([]models.Note, error)
+	// GetByNamespaceIDAndNoteID returns models.Note by Namespace ID and Note ID.
+	GetByNamespaceIDAndNoteID(ctx context.Context, namespaceID uint, noteID string) (*models.Note, error)
+	// Create creates new models.Note object.
+	Create(ctx context.Context, note *models.Note) error
+	// Update updates existing models.Note object.
+	Update(ctx context.Context, note *models.Note) error
+	// Delete deletes existing models.Note object.
+	Delete(ctx context.Context, note *models.Note) error
+}
+
+// NoteRepository repository to work with models.Note entity.
+type NoteRepository struct {
+	repositories.BaseRepositoryProvider
+}
+
+// NewNoteRepository creates repository to work with models.Note entity.
+func NewNoteRepository(db *gorm.DB) *NoteRepository {
+	return &NoteRepository{
+		repositories.NewBaseRepository(db),
+	}
+}
+
+// GetByNamespaceIDAndRunID returns the list of models.Note attached to the run.
+func (r NoteRepository) GetByNamespaceIDAndRunID(
+	ctx context.Context, namespaceID uint, runID string,
+) ([]models.Note, error) {
+	var notes []models.Note
+	if err := r.GetDB().WithContext(ctx).
+		Where("namespace_id = ?", namespaceID).
+		Where("run_uuid = ?", runID).
+		Order("created_at").
+		Find(&notes).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting notes by run id: %s", runID)
+	}
+	return notes, nil
+}
+
+// GetByNamespaceIDAndExperimentID returns the list of models.Note attached to the experiment.
+func (r NoteRepository) GetByNamespaceIDAndExperimentID(
+	ctx context.Context, namespaceID uint, experimentID int32,
+) ([]models.Note, error) {
+	var notes []models.Note
+	if err := r.GetDB().WithContext(ctx).
+		Where("namespace_id = ?", namespaceID).
+		Where("experiment_id = ?", experimentID).
+		Order("created_at").
+		Find(&notes).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting notes by experiment id: %d", experimentID)
+	}
+	return notes, nil
+}
+
+// GetByNamespaceIDAndNoteID returns models.Note by Namespace ID and Note ID.
+func (r NoteRepository) GetByNamespaceIDAndNoteID(
+	ctx context.Context, namespaceID uint, noteID string,
+) (*models.Note, error) {
+	var note models.Note
+	if err := r.GetDB().WithContext(ctx).
+		Where("namespace_id = ?", namespaceID).
+		Where("id = ?", noteID).
+		First(&note).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, eris.Wrapf(err, "error getting note by id: %s", noteID)
+	}
+	return &note, nil
+}
+
+// Create creates new models.Note object.
+func (r NoteRepository) Create(ctx context.Context, note *models.Note) error {
+	if err := r.GetDB().WithContext(ctx).Create(note).Error; err != nil {
+		return eris.Wrap(err, "error creating note entity")
+	}
+	return nil
+}
+
+// Update updates the content of existing models.Note object.
+func (r NoteRepository) Update(ctx context.Context, note *models.Note) error {
+	if err := r.GetDB().WithContext(ctx).
+		Model(note).
+		Update("content", note.Content).
+		Error; err != nil {
+		return eris.Wrap(err, "error updating note entity")
+	}
+	return nil
+}
+
+// Delete deletes existing models.Note object.
+func (r NoteRepository) Delete(ctx context.Context, note *models.Note) error {
+	if err := r.GetDB().WithContext(ctx).Delete(note).Error; err != nil {
+		return eris.Wrap(err, "error deleting note entity")
+	}
+	return nil
+}
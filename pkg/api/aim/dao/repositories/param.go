@@ -12,8 +12,10 @@ import (
 
 // ParamRepositoryProvider provides an interface to work with models.Param entity.
 type ParamRepositoryProvider interface {
-	// GetParamKeysByParameters returns list of param keys by requested parameters.
-	GetParamKeysByParameters(ctx context.Context, namespaceID uint, experiments []int) ([]string, error)
+	// GetProjectParamsByParameters returns list of param keys and value types by requested parameters.
+	GetProjectParamsByParameters(
+		ctx context.Context, namespaceID uint, experiments []int,
+	) ([]models.ProjectParam, error)
 }
 
 // ParamRepository repository to work with models.Param entity.
@@ -28,12 +30,25 @@ func NewParamRepository(db *gorm.DB) *ParamRepository {
 	}
 }
 
-// GetParamKeysByParameters returns list of param keys by requested parameters.
-func (r ParamRepository) GetParamKeysByParameters(
+// GetProjectParamsByParameters returns list of param keys and value types by requested parameters.
+func (r ParamRepository) GetProjectParamsByParameters(
 	ctx context.Context, namespaceID uint, experiments []int,
-) ([]string, error) {
+) ([]models.ProjectParam, error) {
 	query := r.GetDB().WithContext(ctx).Distinct().Model(
 		&models.Param{},
+	).Select(
+		`params.key,
+		 CASE WHEN params.value_int IS NOT NULL THEN ?
+		      WHEN params.value_float IS NOT NULL THEN ?
+		      WHEN params.value_bool IS NOT NULL THEN ?
+		      WHEN params.value_json IS NOT NULL THEN ?
+		      ELSE ? END AS value_type,
+		 params.value_json`,
+		models.ParamValueTypeInt,
+		models.ParamValueTypeFloat,
+		models.ParamValueTypeBool,
+		models.ParamValueTypeJSON,
+		models.ParamValueTypeStr,
 	).Joins(
 		"JOIN runs USING(run_uuid)",
 	).Joins(
@@ -45,9 +60,9 @@ func (r ParamRepository) GetParamKeysByParameters(
 	if len(experiments) != 0 {
 		query = query.Where("experiments.experiment_id IN ?", experiments)
 	}
-	var keys []string
-	if err := query.Pluck("Key", &keys).Error; err != nil {
-		return nil, eris.Wrap(err, "error getting param keys by parameters")
+	var params []models.ProjectParam
+	if err := query.Scan(&params).Error; err != nil {
+		return nil, eris.Wrap(err, "error getting project params by parameters")
 	}
-	return keys, nil
+	return params, nil
 }
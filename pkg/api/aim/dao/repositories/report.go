@@ -0,0 +1,104 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/dao/models"
+)
+
+// ReportRepositoryProvider provides an interface to work with `report` entity.
+type ReportRepositoryProvider interface {
+	// Update updates existing models.Report object.
+	Update(ctx context.Context, report *models.Report) error
+	// Create creates a new models.Report object.
+	Create(ctx context.Context, report *models.Report) error
+	// Delete deletes existing models.Report object.
+	Delete(ctx context.Context, report *models.Report) error
+	// GetByNamespaceIDAndReportID returns models.Report by Namespace and Report ID.
+	GetByNamespaceIDAndReportID(ctx context.Context, namespaceID uint, reportID string) (*models.Report, error)
+	// GetActiveReportsByNamespace returns the list of active models.Report by provided Namespace ID.
+	GetActiveReportsByNamespace(ctx context.Context, namespaceID uint) ([]models.Report, error)
+}
+
+// ReportRepository repository to work with `report` entity.
+type ReportRepository struct {
+	db *gorm.DB
+}
+
+// NewReportRepository creates a repository to work with `report` entity.
+func NewReportRepository(db *gorm.DB) *ReportRepository {
+	return &ReportRepository{
+		db: db,
+	}
+}
+
+// Update updates existing models.Report object.
+func (r ReportRepository) Update(ctx context.Context, report *models.Report) error {
+	if err := r.db.WithContext(ctx).Model(
+		&report,
+	).Select(
+		"Name", "Code", "Description",
+	).Updates(
+		report,
+	).Error; err != nil {
+		return eris.Wrapf(err, "error updating report with id: %s", report.ID)
+	}
+	return nil
+}
+
+// Create creates a new models.Report object.
+func (r ReportRepository) Create(ctx context.Context, report *models.Report) error {
+	if err := r.db.WithContext(ctx).Create(&report).Error; err != nil {
+		return eris.Wrap(err, "error creating report entity")
+	}
+	return nil
+}
+
+// GetByNamespaceIDAndReportID returns models.Report by Namespace and Report ID.
+func (r ReportRepository) GetByNamespaceIDAndReportID(
+	ctx context.Context, namespaceID uint, reportID string,
+) (*models.Report, error) {
+	var report models.Report
+	if err := r.db.WithContext(ctx).Where(
+		"NOT is_archived",
+	).Where(
+		"id = ?", reportID,
+	).Where(
+		"namespace_id = ?", namespaceID,
+	).First(&report).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, eris.Wrapf(err, "error getting report by id: %s", reportID)
+	}
+	return &report, nil
+}
+
+// GetActiveReportsByNamespace returns the list of active reports by provided Namespace ID.
+func (r ReportRepository) GetActiveReportsByNamespace(
+	ctx context.Context, namespaceID uint,
+) ([]models.Report, error) {
+	var reports []models.Report
+	if err := r.db.WithContext(ctx).Where(
+		"NOT is_archived",
+	).Where(
+		"namespace_id = ?", namespaceID,
+	).Order(
+		"updated_at DESC",
+	).Find(&reports).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting active reports by namespace id: %d", namespaceID)
+	}
+	return reports, nil
+}
+
+// Delete deletes existing models.Report object.
+func (r ReportRepository) Delete(ctx context.Context, report *models.Report) error {
+	if err := r.db.WithContext(ctx).Model(report).Update("IsArchived", true).Error; err != nil {
+		return eris.Wrapf(err, "error deleting report by id: %s", report.ID)
+	}
+	return nil
+}
@@ -50,6 +50,10 @@ type RunRepositoryProvider interface {
 	SearchRuns(
 		ctx context.Context, namespaceID uint, tzOffset int, req request.SearchRunsRequest,
 	) ([]models.Run, int64, error)
+	// GetRunIDsByQuery returns ids of the provided runs, which match the query.
+	GetRunIDsByQuery(
+		ctx context.Context, namespaceID uint, tzOffset int, q string, ids []string,
+	) ([]string, error)
 }
 
 // RunRepository repository to work with models.Run entity.
@@ -372,21 +376,9 @@ func (r RunRepository) UpdateWithTransaction(ctx context.Context, tx *gorm.DB, r
 func (r RunRepository) SearchRuns(
 	ctx context.Context, namespaceID uint, timeZoneOffset int, req request.SearchRunsRequest,
 ) ([]models.Run, int64, error) {
-	qp := query.QueryParser{
-		Default: query.DefaultExpression{
-			Contains:   "run.archived",
-			Expression: "not run.archived",
-		},
-		Tables: map[string]string{
-			"runs":        "runs",
-			"experiments": "Experiment",
-		},
-		TzOffset:  timeZoneOffset,
-		Dialector: r.GetDB().Dialector.Name(),
-	}
-	pq, err := qp.Parse(req.Query)
+	pq, err := r.parseSearchRunsQuery(timeZoneOffset, req.Query)
 	if err != nil {
-		return nil, 0, eris.Wrap(err, "problem parsing query")
+		return nil, 0, err
 	}
 
 	var total int64
@@ -484,3 +476,52 @@ func (r RunRepository) renumberRows(tx *gorm.DB, startWith models.RowNum) error
 	}
 	return nil
 }
+
+// GetRunIDsByQuery returns ids of the provided runs, which match the query.
+func (r RunRepository) GetRunIDsByQuery(
+	ctx context.Context, namespaceID uint, timeZoneOffset int, q string, ids []string,
+) ([]string, error) {
+	pq, err := r.parseSearchRunsQuery(timeZoneOffset, q)
+	if err != nil {
+		return nil, err
+	}
+
+	var runIDs []string
+	if err := pq.Filter(
+		r.GetDB().WithContext(ctx).
+			Model(&models.Run{}).
+			InnerJoins(
+				"Experiment",
+				r.GetDB().WithContext(ctx).Select(
+					"ID", "Name",
+				).Where(
+					&models.Experiment{NamespaceID: namespaceID},
+				),
+			).
+			Where("runs.run_uuid IN ?", ids),
+	).Pluck("runs.run_uuid", &runIDs).Error; err != nil {
+		return nil, eris.Wrap(err, "error getting runs by query")
+	}
+	return runIDs, nil
+}
+
+// parseSearchRunsQuery parses the query of runs search.
+func (r RunRepository) parseSearchRunsQuery(timeZoneOffset int, q string) (query.ParsedQuery, error) {
+	qp := query.QueryParser{
+		Default: query.DefaultExpression{
+			Contains:   "run.archived",
+			Expression: "not run.archived",
+		},
+		Tables: map[string]string{
+			"runs":        "runs",
+			"experiments": "Experiment",
+		},
+		TzOffset:  timeZoneOffset,
+		Dialector: r.GetDB().Dialector.Name(),
+	}
+	pq, err := qp.Parse(q)
+	if err != nil {
+		return nil, eris.Wrap(err, "problem parsing query")
+	}
+	return pq, nil
+}
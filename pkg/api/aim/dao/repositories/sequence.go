@@ -0,0 +1,97 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/query"
+)
+
+// findSequenceRuns finds runs matching the provided query for the sequence search
+// and returns their ids in the found order together with the map of runs.
+func findSequenceRuns(
+	ctx context.Context, db *gorm.DB, namespaceID uint, timeZoneOffset int, q, sequenceTable string,
+) ([]string, map[string]models.Run, error) {
+	qp := query.QueryParser{
+		Default: query.DefaultExpression{
+			Contains:   "run.archived",
+			Expression: "not run.archived",
+		},
+		Tables: map[string]string{
+			"runs":        "runs",
+			"experiments": "experiments",
+			sequenceTable: sequenceTable,
+		},
+		TzOffset:  timeZoneOffset,
+		Dialector: db.Dialector.Name(),
+	}
+	pq, err := qp.Parse(q)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	runs := []models.Run{}
+	if err := pq.Filter(db.WithContext(ctx).
+		Table("runs").
+		Joins(`INNER JOIN experiments
+                        ON experiments.experiment_id = runs.experiment_id
+                        AND experiments.namespace_id = ?`,
+			namespaceID,
+		)).
+		Preload("Experiment").
+		Find(&runs).Error; err != nil {
+		return nil, nil, eris.Wrapf(err, "error finding runs for %s search", sequenceTable)
+	}
+
+	runIDs := []string{}
+	runMap := make(map[string]models.Run, len(runs))
+	for _, run := range runs {
+		if _, ok := runMap[run.ID]; !ok {
+			runIDs = append(runIDs, run.ID)
+			runMap[run.ID] = run
+		}
+	}
+	return runIDs, runMap, nil
+}
+
+// getSequenceSearchSummary collects summary data of the sequence table for the progress indicator
+// and returns the sequence names selected by `<identifier>.name == "<name>"` query conditions.
+// Sequences without an `index` column (single value per step) have to be requested with indexed set to false.
+func getSequenceSearchSummary(
+	ctx context.Context, db *gorm.DB, runIDs []string, q, sequenceTable, identifier string, indexed bool,
+) (ArtifactSearchSummary, []string, error) {
+	maxIndex := `max("index")`
+	if !indexed {
+		maxIndex = "0"
+	}
+	stepInfo := []ArtifactSearchStepInfo{}
+	if err := db.WithContext(ctx).
+		Raw(fmt.Sprintf(`SELECT run_uuid, name, step, count(id) as img_count, %s as max_index
+			FROM %s
+			WHERE run_uuid IN (?)
+			GROUP BY run_uuid, name, step;`, maxIndex, sequenceTable),
+			runIDs).
+		Find(&stepInfo).Error; err != nil {
+		return nil, nil, eris.Wrapf(err, "error find result summary for %s search", sequenceTable)
+	}
+
+	names := []string{}
+	resultSummary := make(ArtifactSearchSummary, len(runIDs))
+	for _, rslt := range stepInfo {
+		traceMap, ok := resultSummary[rslt.RunUUID]
+		if !ok {
+			traceMap = map[string][]ArtifactSearchStepInfo{}
+		}
+		traceMap[rslt.Name] = append(traceMap[rslt.Name], rslt)
+		resultSummary[rslt.RunUUID] = traceMap
+		if strings.Contains(q, fmt.Sprintf(`%s.name == "%s"`, identifier, rslt.Name)) {
+			names = append(names, rslt.Name)
+		}
+	}
+	return resultSummary, names, nil
+}
@@ -0,0 +1,158 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"math"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/types"
+)
+
+// TextRepositoryProvider provides an interface to work with `text` entity.
+type TextRepositoryProvider interface {
+	repositories.BaseRepositoryProvider
+	// Search will find texts based on the request.
+	Search(
+		ctx context.Context,
+		namespaceID uint,
+		timeZoneOffset int,
+		req request.SearchArtifactsRequest,
+	) (*sql.Rows, map[string]models.Run, ArtifactSearchSummary, error)
+	// GetRunTexts returns texts of the run selected by the requested names and contexts.
+	GetRunTexts(ctx context.Context, runID string, req request.GetRunTextsRequest) ([]models.Text, error)
+	// GetTextNamesAndContextsByExperiments returns unique text names and contexts by provided experiments.
+	GetTextNamesAndContextsByExperiments(
+		ctx context.Context, namespaceID uint, experiments []int,
+	) ([]models.Text, error)
+}
+
+// TextRepository repository to work with `text` entity.
+type TextRepository struct {
+	repositories.BaseRepositoryProvider
+}
+
+// NewTextRepository creates a repository to work with `text` entity.
+func NewTextRepository(db *gorm.DB) *TextRepository {
+	return &TextRepository{
+		repositories.NewBaseRepository(db),
+	}
+}
+
+// Search will find texts based on the request.
+func (r TextRepository) Search(
+	ctx context.Context,
+	namespaceID uint,
+	timeZoneOffset int,
+	req request.SearchArtifactsRequest,
+) (*sql.Rows, map[string]models.Run, ArtifactSearchSummary, error) {
+	runIDs, runMap, err := findSequenceRuns(ctx, r.GetDB(), namespaceID, timeZoneOffset, req.Query, "texts")
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	resultSummary, textNames, err := getSequenceSearchSummary(ctx, r.GetDB(), runIDs, req.Query, "texts", "texts", true)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// get a cursor for the texts
+	tx := r.GetDB().WithContext(ctx).
+		Raw(`
+                    SELECT texts.*, contexts.json AS context_json
+                    FROM texts
+                    INNER JOIN contexts ON contexts.id = texts.context_id
+                    WHERE run_uuid IN ?
+                    AND step BETWEEN ? AND ?
+                    AND "index" BETWEEN ? AND ?
+                    AND name IN ?
+                    ORDER BY run_uuid, name, context_id, step, "index"
+                `,
+			runIDs,
+			req.RecordRangeMin(),
+			req.RecordRangeMax(math.MaxInt32),
+			req.IndexRangeMin(),
+			req.IndexRangeMax(math.MaxInt32),
+			textNames)
+
+	rows, err := tx.Rows()
+	if err != nil {
+		return nil, nil, nil, eris.Wrap(err, "error searching texts")
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, nil, eris.Wrap(err, "error getting texts rows cursor")
+	}
+
+	return rows, runMap, resultSummary, nil
+}
+
+// GetRunTexts returns texts of the run selected by the requested names and contexts.
+func (r TextRepository) GetRunTexts(
+	ctx context.Context, runID string, req request.GetRunTextsRequest,
+) ([]models.Text, error) {
+	var texts []models.Text
+	if len(req) == 0 {
+		return texts, nil
+	}
+
+	subQuery := r.GetDB().WithContext(ctx)
+	for _, item := range req {
+		textContext := item.Context
+		if textContext == nil {
+			textContext = map[string]any{}
+		}
+		serializedContext, err := json.Marshal(textContext)
+		if err != nil {
+			return nil, eris.Wrap(err, "error marshaling text context")
+		}
+		subQuery = subQuery.Or("texts.name = ? AND contexts.json = ?", item.Name, types.JSONB(serializedContext))
+	}
+
+	if err := r.GetDB().WithContext(ctx).
+		Select("texts.*, contexts.json AS context_json").
+		Joins("INNER JOIN contexts ON contexts.id = texts.context_id").
+		Where("texts.run_uuid = ?", runID).
+		Where(subQuery).
+		Order("texts.name").
+		Order("texts.context_id").
+		Order("texts.step").
+		Order(`texts."index"`).
+		Find(&texts).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting run texts")
+	}
+	return texts, nil
+}
+
+// GetTextNamesAndContextsByExperiments returns unique text names and contexts by provided experiments.
+func (r TextRepository) GetTextNamesAndContextsByExperiments(
+	ctx context.Context, namespaceID uint, experiments []int,
+) ([]models.Text, error) {
+	query := r.GetDB().WithContext(ctx).Distinct().Select(
+		"texts.name", "texts.context_id", "contexts.json AS context_json",
+	).Model(
+		&models.Text{},
+	).Joins(
+		"INNER JOIN contexts ON contexts.id = texts.context_id",
+	).Joins(
+		"INNER JOIN runs ON runs.run_uuid = texts.run_uuid",
+	).Joins(
+		"INNER JOIN experiments ON experiments.experiment_id = runs.experiment_id AND experiments.namespace_id = ?",
+		namespaceID,
+	).Where(
+		"runs.lifecycle_stage = ?", models.LifecycleStageActive,
+	)
+	if len(experiments) != 0 {
+		query = query.Where("experiments.experiment_id IN ?", experiments)
+	}
+	var texts []models.Text
+	if err := query.Find(&texts).Error; err != nil {
+		return nil, eris.Wrap(err, "error getting texts by provided experiments")
+	}
+	return texts, nil
+}
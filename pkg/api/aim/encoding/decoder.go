@@ -5,6 +5,7 @@ import (
 	"bytes"
 	"encoding/binary"
 	"errors"
+	"fmt"
 	"io"
 	"math"
 	"strconv"
@@ -94,7 +95,8 @@ func (d *Decoder) Next() (map[string]interface{}, error) {
 			}
 		}
 
-		if len(d.path[0]) > 0 && d.cursor == "" {
+		// root of the tree has an empty path, e.g. when the whole tree is a list.
+		if len(d.path) > 0 && len(d.path[0]) > 0 && d.cursor == "" {
 			d.cursor = d.path[0]
 		}
 
@@ -174,3 +176,168 @@ func (d *Decoder) Decode() (map[string]interface{}, error) {
 		}
 	}
 }
+
+// treeNode represents intermediate node of the tree being decoded by DecodeTree.
+type treeNode struct {
+	value    any
+	list     bool
+	object   bool
+	children map[string]*treeNode
+	indexes  map[string]int64
+}
+
+// child returns existing or creates a new child node for the provided path component.
+func (n *treeNode) child(key any) *treeNode {
+	if n.children == nil {
+		n.children = map[string]*treeNode{}
+		n.indexes = map[string]int64{}
+	}
+	name := fmt.Sprintf("%v", key)
+	node, ok := n.children[name]
+	if !ok {
+		node = &treeNode{}
+		n.children[name] = node
+		if index, ok := key.(int64); ok {
+			n.indexes[name] = index
+		}
+	}
+	return node
+}
+
+// build converts node into the actual tree of maps, slices and values.
+func (n *treeNode) build() any {
+	switch {
+	case n.list:
+		size := int64(0)
+		for _, index := range n.indexes {
+			if index+1 > size {
+				size = index + 1
+			}
+		}
+		list := make([]any, size)
+		for name, node := range n.children {
+			if index, ok := n.indexes[name]; ok {
+				list[index] = node.build()
+			}
+		}
+		return list
+	case n.object || n.children != nil:
+		object := make(map[string]any, len(n.children)+1)
+		if n.value != nil {
+			object[CustomObjectTypeKey] = n.value
+		}
+		for name, node := range n.children {
+			object[name] = node.build()
+		}
+		return object
+	default:
+		return n.value
+	}
+}
+
+// CustomObjectTypeKey is the key under which DecodeTree keeps the type name of Aim custom objects.
+const CustomObjectTypeKey = "$type"
+
+// DecodeTree decodes the whole data stream into the nested tree of maps, slices and values.
+// Integer path components of objects, which are not marked as arrays, become string keys.
+func DecodeTree(data io.Reader) (any, error) {
+	r := reader{bufio.NewReader(data)}
+	root := &treeNode{}
+	for {
+		key, err := r.readField()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return root.build(), nil
+			}
+			return nil, eris.Wrap(err, "error reading tree key")
+		}
+		path, err := decodePath(key)
+		if err != nil {
+			return nil, err
+		}
+		valuebuf, err := r.readField()
+		if err != nil {
+			return nil, eris.Wrap(err, "error reading tree value")
+		}
+
+		node := root
+		for _, component := range path {
+			node = node.child(component)
+		}
+		if err := decodeTreeValue(node, valuebuf); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// decodePath decodes encoded path into the list of string and int64 components.
+func decodePath(key []byte) ([]any, error) {
+	var path []any
+	for len(key) > 0 {
+		if key[0] == pathSentinel[0] {
+			if len(key) < 10 || key[9] != pathSentinel[0] {
+				return nil, eris.New("malformed integer path component")
+			}
+			path = append(path, int64(binary.BigEndian.Uint64(key[1:9])))
+			key = key[10:]
+			continue
+		}
+		end := bytes.IndexByte(key, pathSentinel[0])
+		if end == -1 {
+			return nil, eris.New("malformed string path component")
+		}
+		path = append(path, string(key[:end]))
+		key = key[end+1:]
+	}
+	return path, nil
+}
+
+// decodeTreeValue decodes encoded value into the provided tree node.
+func decodeTreeValue(node *treeNode, valuebuf []byte) error {
+	if len(valuebuf) == 0 {
+		return eris.New("empty tree value")
+	}
+	kind, payload := valuebuf[0], valuebuf[1:]
+	switch {
+	case kind == TypeNil:
+		node.value = nil
+	case kind == TypeBool:
+		node.value = len(payload) > 0 && payload[0] != 0
+	case kind == TypeInt:
+		switch len(payload) {
+		case 2:
+			node.value = int64(int16(binary.LittleEndian.Uint16(payload)))
+		case 4:
+			node.value = int64(int32(binary.LittleEndian.Uint32(payload)))
+		case 8:
+			node.value = int64(binary.LittleEndian.Uint64(payload))
+		default:
+			return eris.Errorf("unsupported int length %d", len(payload))
+		}
+	case kind == TypeFloat:
+		switch len(payload) {
+		case 4:
+			node.value = float64(math.Float32frombits(binary.LittleEndian.Uint32(payload)))
+		case 8:
+			node.value = math.Float64frombits(binary.LittleEndian.Uint64(payload))
+		default:
+			return eris.Errorf("unsupported float length %d", len(payload))
+		}
+	case kind == TypeString:
+		node.value = string(payload)
+	case kind&0x0f == TypeSlice:
+		// plain bytes and Aim BLOBs share the same low bits.
+		node.value = bytes.Clone(payload)
+	case kind == TypeArray:
+		node.list = true
+	case kind == TypeObject:
+		node.object = true
+	case kind&0x0f == TypeObject:
+		// custom objects, e.g. `aim.image`, carry their type name as a payload.
+		node.object = true
+		node.value = string(payload)
+	default:
+		return eris.Errorf("unsupported type %x", kind)
+	}
+	return nil
+}
@@ -21,6 +21,16 @@ func EncodeTree(w io.Writer, tree map[string]any) error {
 	return encodeTree(w, tree, []any{})
 }
 
+// EncodeList encodes the list of trees, so the list itself is the root of the encoded tree.
+func EncodeList[T any](w io.Writer, list []T) error {
+	return encodeTree(w, list, []any{})
+}
+
+// Encode encodes any supported value, so scalar values are encoded under the empty path.
+func Encode(w io.Writer, v any) error {
+	return encodeTree(w, v, []any{})
+}
+
 func encodeTree(w io.Writer, v any, p []any) error {
 	if v == nil {
 		return encodePathValue(w, v, p)
@@ -318,3 +318,208 @@ func renderDictValue(builder clause.Builder, dialector string, rv reflect.Value)
 	builder.WriteString("}'")
 	return nil
 }
+
+// JsonIn in list for where clause.
+type JsonIn struct {
+	Json   Json
+	Values []any
+}
+
+// Build renders the Json in expression.
+func (ji JsonIn) Build(builder clause.Builder) {
+	ji.Json.Build(builder)
+	if len(ji.Values) == 0 {
+		//nolint:errcheck,gosec
+		builder.WriteString(" IN (NULL)")
+		return
+	}
+	//nolint:errcheck,gosec
+	builder.WriteString(" IN (")
+	builder.AddVar(builder, ji.Values...)
+	//nolint:errcheck,gosec
+	builder.WriteString(")")
+}
+
+// NegationBuild renders the Json not-in expression.
+func (ji JsonIn) NegationBuild(builder clause.Builder) {
+	ji.Json.Build(builder)
+	if len(ji.Values) == 0 {
+		//nolint:errcheck,gosec
+		builder.WriteString(" IS NOT NULL")
+		return
+	}
+	//nolint:errcheck,gosec
+	builder.WriteString(" NOT IN (")
+	builder.AddVar(builder, ji.Values...)
+	//nolint:errcheck,gosec
+	builder.WriteString(")")
+}
+
+// ParamValue represents the value of run param, which is stored in one of the typed columns.
+// Path holds the keys of a dotted lookup into nested (dict) value, e.g. `run.hparams.optim.lr`,
+// and Functions holds the string functions applied to the value, e.g. `run.name.lower()`.
+type ParamValue struct {
+	Table     string
+	Path      []string
+	Functions []string
+	Dialector string
+}
+
+// attribute returns the value nested under the key.
+func (p ParamValue) attribute(key string) (ParamValue, error) {
+	if len(p.Functions) > 0 {
+		return p, fmt.Errorf("unsupported attribute %q of string value", key)
+	}
+	if strings.Contains(key, `"`) {
+		return p, fmt.Errorf("unsupported param key %q", key)
+	}
+	p.Path = append(p.Path[:len(p.Path):len(p.Path)], key)
+	return p, nil
+}
+
+// withFunction returns the value with applied SQL function, e.g. `LOWER`.
+func (p ParamValue) withFunction(function string) ParamValue {
+	p.Functions = append(p.Functions[:len(p.Functions):len(p.Functions)], function)
+	return p
+}
+
+// column renders the column of param table.
+func (p ParamValue) column(name string) string {
+	return columnExpression(clause.Column{Table: p.Table, Name: name})
+}
+
+// jsonPath returns the dialect specific path into nested value.
+func (p ParamValue) jsonPath(path []string) string {
+	keys := make([]string, len(path))
+	switch p.Dialector {
+	case postgres.Dialector{}.Name():
+		for i, key := range path {
+			keys[i] = fmt.Sprintf(`"%s"`, strings.ReplaceAll(key, `\`, `\\`))
+		}
+		return "{" + strings.Join(keys, ",") + "}"
+	default:
+		for i, key := range path {
+			keys[i] = fmt.Sprintf(`"%s"`, key)
+		}
+		return "$." + strings.Join(keys, ".")
+	}
+}
+
+// typedExpression returns the expression, which is NULL unless the nested value has one of provided json types.
+func (p ParamValue) typedExpression(postgresType, postgresCast string, sqliteTypes ...string) clause.Expr {
+	path := p.jsonPath(p.Path)
+	switch p.Dialector {
+	case postgres.Dialector{}.Name():
+		return clause.Expr{
+			SQL: fmt.Sprintf(
+				"CASE WHEN jsonb_typeof(%s #> ?) = '%s' THEN (%s #>> ?)%s END",
+				p.column("value_json"), postgresType, p.column("value_json"), postgresCast,
+			),
+			Vars: []any{path, path},
+		}
+	default:
+		return clause.Expr{
+			SQL: fmt.Sprintf(
+				"CASE WHEN json_type(%s, ?) IN ('%s') THEN json_extract(%s, ?) END",
+				p.column("value_json"), strings.Join(sqliteTypes, "', '"), p.column("value_json"),
+			),
+			Vars: []any{path, path},
+		}
+	}
+}
+
+// StringExpression returns the expression for string value with applied functions.
+func (p ParamValue) StringExpression() clause.Expr {
+	expression := clause.Expr{SQL: p.column("value_str")}
+	if len(p.Path) > 0 {
+		expression = p.typedExpression("string", "", "text")
+	}
+	for _, function := range p.Functions {
+		expression.SQL = fmt.Sprintf("%s(%s)", function, expression.SQL)
+	}
+	return expression
+}
+
+// NumberExpression returns the expression for int or float value.
+func (p ParamValue) NumberExpression() clause.Expr {
+	if len(p.Path) == 0 {
+		return clause.Expr{
+			SQL: fmt.Sprintf("COALESCE(%s, %s)", p.column("value_float"), p.column("value_int")),
+		}
+	}
+	return p.typedExpression("number", "::double precision", "integer", "real")
+}
+
+// BoolExpression returns the expression for bool value.
+func (p ParamValue) BoolExpression() clause.Expr {
+	if len(p.Path) == 0 {
+		return clause.Expr{SQL: p.column("value_bool")}
+	}
+	return p.typedExpression("boolean", "::boolean", "true", "false")
+}
+
+// NoneExpression returns the expression, which is true when value is missing or is `None`.
+func (p ParamValue) NoneExpression() clause.Expr {
+	if len(p.Path) == 0 {
+		return clause.Expr{SQL: fmt.Sprintf("%s IS NULL", p.column("key"))}
+	}
+	switch p.Dialector {
+	case postgres.Dialector{}.Name():
+		return clause.Expr{
+			SQL:  fmt.Sprintf("COALESCE(jsonb_typeof(%s #> ?), 'null') = 'null'", p.column("value_json")),
+			Vars: []any{p.jsonPath(p.Path)},
+		}
+	default:
+		return clause.Expr{
+			SQL:  fmt.Sprintf("COALESCE(json_type(%s, ?), 'null') = 'null'", p.column("value_json")),
+			Vars: []any{p.jsonPath(p.Path)},
+		}
+	}
+}
+
+// RegexpExpression returns the expression, which is true when string value matches regular expression.
+func (p ParamValue) RegexpExpression(pattern string) clause.Expr {
+	expression := p.StringExpression()
+	switch p.Dialector {
+	case postgres.Dialector{}.Name():
+		expression.SQL = fmt.Sprintf("%s ~ ?", expression.SQL)
+	default:
+		expression.SQL = fmt.Sprintf("IFNULL(%s, '') REGEXP ?", expression.SQL)
+	}
+	expression.Vars = append(expression.Vars, pattern)
+	return expression
+}
+
+// ContainsExpressions returns the expressions for `'value' in run.param` statement.
+// The value matches either a substring of string (or nested list) value or a key of nested dict value.
+func (p ParamValue) ContainsExpressions(value string) []clause.Expression {
+	pattern := fmt.Sprintf("%%%s%%", value)
+	if len(p.Functions) > 0 {
+		expression := p.StringExpression()
+		expression.SQL += " LIKE ?"
+		expression.Vars = append(expression.Vars, pattern)
+		return []clause.Expression{expression}
+	}
+
+	// param could be missing at all, so NULL value is replaced to keep `not in` statement consistent.
+	like := clause.Expr{
+		SQL:  fmt.Sprintf("COALESCE(%s, '') LIKE ?", p.column("value_str")),
+		Vars: []any{pattern},
+	}
+	key := clause.Expr{Vars: []any{p.jsonPath(append(p.Path[:len(p.Path):len(p.Path)], value))}}
+	switch p.Dialector {
+	case postgres.Dialector{}.Name():
+		if len(p.Path) > 0 {
+			like.SQL = fmt.Sprintf("COALESCE(%s #>> ?, '') LIKE ?", p.column("value_json"))
+			like.Vars = []any{p.jsonPath(p.Path), pattern}
+		}
+		key.SQL = fmt.Sprintf("%s #> ? IS NOT NULL", p.column("value_json"))
+	default:
+		if len(p.Path) > 0 {
+			like.SQL = fmt.Sprintf("COALESCE(json_extract(%s, ?), '') LIKE ?", p.column("value_json"))
+			like.Vars = []any{p.jsonPath(p.Path), pattern}
+		}
+		key.SQL = fmt.Sprintf("json_type(%s, ?) IS NOT NULL", p.column("value_json"))
+	}
+	return []clause.Expression{like, key}
+}
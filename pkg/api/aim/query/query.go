@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/go-python/gpython/ast"
 	"github.com/go-python/gpython/parser"
@@ -241,66 +242,20 @@ func (pq *parsedQuery) parseAttribute(node *ast.Attribute) (any, error) {
 			return nil, err
 		}
 		attribute := string(node.Attr)
-		switch strings.ToLower(attribute) {
-		case "endswith":
-			return callable(func(args []ast.Expr) (any, error) {
-				if len(args) != 1 {
-					return nil, errors.New("`endswith` function support exactly one argument")
-				}
-				arg, ok := args[0].(*ast.Str)
-				if !ok {
-					return nil, errors.New("unsupported argument type. has to be `string` only")
-				}
-				value := fmt.Sprintf("%%%s", arg.S)
-				switch c := parsedNode.(type) {
-				case clause.Column:
-					return clause.Like{
-						Value: value,
-						Column: clause.Column{
-							Table: c.Table,
-							Name:  c.Name,
-						},
-					}, nil
-				case Json:
-					return JsonLike{
-						Value: value,
-						Json:  c,
-					}, nil
-				default:
-					return nil, errors.New("unsupported node type. has to be clause.Column or Json")
-				}
-			}), nil
-		case "startswith":
-			return callable(func(args []ast.Expr) (any, error) {
-				if len(args) != 1 {
-					return nil, errors.New("`startswith` function support exactly one argument")
-				}
-				arg, ok := args[0].(*ast.Str)
-				if !ok {
-					return nil, errors.New("unsupported argument type. has to be `string` only")
-				}
-				value := fmt.Sprintf("%s%%", arg.S)
-				switch c := parsedNode.(type) {
-				case clause.Column:
-					return clause.Like{
-						Value: value,
-						Column: clause.Column{
-							Table: c.Table,
-							Name:  c.Name,
-						},
-					}, nil
-				case Json:
-					return JsonLike{
-						Value: value,
-						Json:  c,
-					}, nil
-				default:
-					return nil, errors.New("unsupported node type. has to be clause.Column or Json")
-				}
-			}), nil
+		// string methods are resolved only for string values, so they don't shadow tags or params.
+		switch parsedNode.(type) {
+		case clause.Column, Json, ParamValue:
+			switch strings.ToLower(attribute) {
+			case "startswith", "endswith":
+				return pq.newStringMatchCallable(strings.ToLower(attribute), parsedNode), nil
+			case "lower", "upper", "strip":
+				return pq.newStringFunctionCallable(strings.ToLower(attribute), parsedNode), nil
+			}
 		}
 
 		switch value := parsedNode.(type) {
+		case ParamValue:
+			return value.attribute(attribute)
 		case attributeGetter:
 			return value(attribute)
 		case attributeOrSubscript:
@@ -313,6 +268,90 @@ func (pq *parsedQuery) parseAttribute(node *ast.Attribute) (any, error) {
 	}
 }
 
+// newStringMatchCallable returns callable for `startswith` and `endswith` string methods.
+// Both methods accept either a single string or a tuple of strings, like in Python.
+func (pq *parsedQuery) newStringMatchCallable(method string, parsedNode any) callable {
+	return func(args []ast.Expr) (any, error) {
+		if len(args) != 1 {
+			return nil, fmt.Errorf("`%s` function support exactly one argument", method)
+		}
+		arg, err := pq.parseNode(args[0])
+		if err != nil {
+			return nil, err
+		}
+		var values []any
+		switch arg := arg.(type) {
+		case string:
+			values = []any{arg}
+		case []any:
+			values = arg
+		default:
+			return nil, errors.New("unsupported argument type. has to be `string` or `tuple` of strings only")
+		}
+
+		exprs := make([]clause.Expression, len(values))
+		for i, value := range values {
+			str, ok := value.(string)
+			if !ok {
+				return nil, errors.New("unsupported argument type. has to be `string` or `tuple` of strings only")
+			}
+			pattern := fmt.Sprintf("%s%%", str)
+			if method == "endswith" {
+				pattern = fmt.Sprintf("%%%s", str)
+			}
+			switch c := parsedNode.(type) {
+			case clause.Column:
+				exprs[i] = clause.Like{
+					Value:  pattern,
+					Column: c,
+				}
+			case Json:
+				exprs[i] = JsonLike{
+					Value: pattern,
+					Json:  c,
+				}
+			case ParamValue:
+				expression := c.StringExpression()
+				expression.SQL += " LIKE ?"
+				expression.Vars = append(expression.Vars, pattern)
+				exprs[i] = expression
+			default:
+				return nil, errors.New("unsupported node type. has to be clause.Column, Json or ParamValue")
+			}
+		}
+		if len(exprs) == 1 {
+			return exprs[0], nil
+		}
+		return clause.Or(exprs...), nil
+	}
+}
+
+// newStringFunctionCallable returns callable for `lower`, `upper` and `strip` string methods.
+// The result is a column, so it could be compared or chained with another string method.
+func (pq *parsedQuery) newStringFunctionCallable(method string, parsedNode any) callable {
+	return func(args []ast.Expr) (any, error) {
+		if len(args) != 0 {
+			return nil, fmt.Errorf("`%s` function does not support arguments", method)
+		}
+		function := map[string]string{
+			"lower": "LOWER",
+			"upper": "UPPER",
+			"strip": "TRIM",
+		}[method]
+		switch c := parsedNode.(type) {
+		case clause.Column:
+			return clause.Column{
+				Name: fmt.Sprintf("%s(%s)", function, columnExpression(c)),
+				Raw:  true,
+			}, nil
+		case ParamValue:
+			return c.withFunction(function), nil
+		default:
+			return nil, errors.New("unsupported node type. has to be clause.Column or ParamValue")
+		}
+	}
+}
+
 func (pq *parsedQuery) parseBoolOp(node *ast.BoolOp) (any, error) {
 	exprs := make([]clause.Expression, len(node.Values))
 	for i, v := range node.Values {
@@ -389,8 +428,35 @@ func (pq *parsedQuery) parseCompare(node *ast.Compare) (any, error) {
 			if err != nil {
 				return nil, err
 			}
+		case ParamValue:
+			exprs[i], err = newSqlParamComparison(op, left, right)
+			if err != nil {
+				return nil, err
+			}
 		default:
 			switch right := right.(type) {
+			case ParamValue:
+				switch op {
+				case ast.In, ast.NotIn:
+					// for `IN` and `NOT IN` statements, left parameter has to be always `string`.
+					value, ok := left.(string)
+					if !ok {
+						return nil, errors.New("left parameter has to be a string")
+					}
+					exprs[i] = clause.Or(right.ContainsExpressions(value)...)
+					if op == ast.NotIn {
+						exprs[i] = negativeClause(exprs[i])
+					}
+				default:
+					o, err := reverseOperator(op)
+					if err != nil {
+						return nil, err
+					}
+					exprs[i], err = newSqlParamComparison(o, right, left)
+					if err != nil {
+						return nil, err
+					}
+				}
 			case clause.Column:
 				switch op {
 				case ast.In:
@@ -398,19 +464,13 @@ func (pq *parsedQuery) parseCompare(node *ast.Compare) (any, error) {
 					if _, ok := left.(string); !ok {
 						return nil, errors.New("left parameter has to be a string")
 					}
-					return clause.Like{
-						Value:  fmt.Sprintf("%%%s%%", left),
-						Column: right,
-					}, nil
+					return newSqlContains(left.(string), right), nil
 				case ast.NotIn:
 					// for `NOT IN` statement, left parameter has to be always `string`.
 					if _, ok := left.(string); !ok {
 						return nil, errors.New("left parameter has to be a string")
 					}
-					return negativeClause(clause.Like{
-						Value:  fmt.Sprintf("%%%s%%", left),
-						Column: right,
-					}), nil
+					return negativeClause(newSqlContains(left.(string), right)), nil
 				default:
 					o, l, r, err := reverseComparison(op, left, right)
 					if err != nil {
@@ -617,9 +677,9 @@ func (pq *parsedQuery) parseName(node *ast.Name) (any, error) {
 							}
 							pq.AddJoin(joinKey, j)
 						}
-						return clause.Column{
-							Table: j.alias,
-							Name:  "value",
+						return ParamValue{
+							Table:     j.alias,
+							Dialector: pq.qp.Dialector,
 						}, nil
 					}
 				},
@@ -650,25 +710,28 @@ func (pq *parsedQuery) parseName(node *ast.Name) (any, error) {
 								if err != nil {
 									return nil, err
 								}
-								column, ok := parsedNode.(clause.Column)
-								if !ok {
-									return nil, errors.New(
-										"second argument type for re.match function has to be clause.Column",
-									)
-								}
 
 								// handle the difference between `match` and `search`.
 								if attr == "match" {
 									str = fmt.Sprintf("^%s", str)
 								}
 
-								return Regexp{
-									Eq: clause.Eq{
-										Column: column,
-										Value:  str,
-									},
-									Dialector: pq.qp.Dialector,
-								}, nil
+								switch column := parsedNode.(type) {
+								case clause.Column:
+									return Regexp{
+										Eq: clause.Eq{
+											Column: column,
+											Value:  str,
+										},
+										Dialector: pq.qp.Dialector,
+									}, nil
+								case ParamValue:
+									return column.RegexpExpression(str), nil
+								default:
+									return nil, errors.New(
+										"second argument type for re.match function has to be clause.Column or ParamValue",
+									)
+								}
 							},
 						), nil
 					default:
@@ -706,37 +769,81 @@ func (pq *parsedQuery) parseName(node *ast.Name) (any, error) {
 					).UnixMilli(), nil
 				},
 			), nil
-		case "images":
-			table, ok := pq.qp.Tables["runs"]
+		case "len":
+			return callable(
+				func(args []ast.Expr) (any, error) {
+					if len(args) != 1 {
+						return nil, errors.New("`len` function support exactly one argument")
+					}
+					arg, err := pq.parseNode(args[0])
+					if err != nil {
+						return nil, err
+					}
+					switch arg := arg.(type) {
+					case string:
+						return utf8.RuneCountInString(arg), nil
+					case []any:
+						return len(arg), nil
+					case clause.Column:
+						return clause.Column{
+							Name: fmt.Sprintf("LENGTH(%s)", columnExpression(arg)),
+							Raw:  true,
+						}, nil
+					case ParamValue:
+						return arg.withFunction("LENGTH"), nil
+					default:
+						return nil, fmt.Errorf("unsupported argument type %T for `len` function", arg)
+					}
+				},
+			), nil
+		case "metric":
+			table, ok := pq.qp.Tables["metrics"]
 			if !ok {
-				return nil, errors.New("unsupported name identifier 'runs'")
+				return nil, errors.New("unsupported name identifier 'metric'")
 			}
 			return attributeGetter(
 				func(attr string) (any, error) {
-					joinKey := fmt.Sprintf("artifacts:%s", attr)
-					j, ok := pq.joins[joinKey]
-					alias := fmt.Sprintf("artifacts_%d", len(pq.joins))
-					if !ok {
-						j = join{
-							alias: alias,
-							query: fmt.Sprintf(
-								"INNER JOIN artifacts %s ON %s.run_uuid = %s.run_uuid",
-								alias, table, alias,
-							),
-							args: []any{attr},
-						}
-						pq.AddJoin(joinKey, j)
-					}
 					switch attr {
 					case "name":
 						return clause.Column{
-							Table: j.alias,
-							Name:  "name",
+							Table: table,
+							Name:  "key",
 						}, nil
+					case "context":
+						// handle dot (attribute) or dict (subscriptSlicer) syntax
+						return attributeOrSubscript(func(v any) (any, error) {
+							switch v := v.(type) {
+							case string:
+								return pq.metricContextJson(v), nil
+							case *ast.Index:
+								val, err := pq.parseNode(v.Value)
+								if err != nil {
+									return nil, err
+								}
+								key, ok := val.(string)
+								if !ok {
+									return nil, fmt.Errorf("unsupported index value type %T", val)
+								}
+								return pq.metricContextJson(key), nil
+							default:
+								return nil, fmt.Errorf("unsupported slicer or attribute %v", v)
+							}
+						}), nil
+					default:
+						return metricAttribute(table, attr)
 					}
-					return nil, fmt.Errorf("unsupported name identifier %q", node.Id)
 				},
 			), nil
+		case "images":
+			return pq.sequenceAttributeGetter(node, "artifacts")
+		case "texts":
+			return pq.sequenceAttributeGetter(node, "texts")
+		case "distributions":
+			return pq.sequenceAttributeGetter(node, "distributions")
+		case "figures":
+			return pq.sequenceAttributeGetter(node, "figures")
+		case "audios":
+			return pq.sequenceAttributeGetter(node, "audios")
 		default:
 			return nil, fmt.Errorf("unsupported name identifier %q", node.Id)
 		}
@@ -745,6 +852,41 @@ func (pq *parsedQuery) parseName(node *ast.Name) (any, error) {
 	}
 }
 
+// sequenceAttributeGetter returns attribute getter for sequence identifiers like `images` or `texts`
+// which are stored in the provided sequence table.
+func (pq *parsedQuery) sequenceAttributeGetter(node *ast.Name, sequenceTable string) (any, error) {
+	table, ok := pq.qp.Tables["runs"]
+	if !ok {
+		return nil, errors.New("unsupported name identifier 'runs'")
+	}
+	return attributeGetter(
+		func(attr string) (any, error) {
+			joinKey := fmt.Sprintf("%s:%s", sequenceTable, attr)
+			j, ok := pq.joins[joinKey]
+			alias := fmt.Sprintf("%s_%d", sequenceTable, len(pq.joins))
+			if !ok {
+				j = join{
+					alias: alias,
+					query: fmt.Sprintf(
+						"INNER JOIN %s %s ON %s.run_uuid = %s.run_uuid",
+						sequenceTable, alias, table, alias,
+					),
+					args: []any{attr},
+				}
+				pq.AddJoin(joinKey, j)
+			}
+			switch attr {
+			case "name":
+				return clause.Column{
+					Table: j.alias,
+					Name:  "name",
+				}, nil
+			}
+			return nil, fmt.Errorf("unsupported name identifier %q", node.Id)
+		},
+	), nil
+}
+
 func (pq *parsedQuery) metricSubscriptSlicer(v any) (any, error) {
 	table, ok := pq.qp.Tables["runs"]
 	if !ok {
@@ -877,22 +1019,62 @@ func (pq *parsedQuery) latestMetricsContextJoin(exps []JsonEq, latestMetricsJoin
 
 func metricAttributeGetter(table string) (any, error) {
 	return attributeGetter(func(attr string) (any, error) {
-		var name string
-		switch attr {
-		case "last":
-			name = "value"
-		case "last_step":
-			name = "last_iter"
-		case "first_step":
-			return 0, nil
-		default:
-			return nil, fmt.Errorf("unsupported metrics attribute %q", attr)
-		}
+		return metricAttribute(table, attr)
+	}), nil
+}
+
+// metricAttribute returns the column of metric attribute, e.g. `last` or `min`.
+// The table has to reference the latest_metrics row of the metric series.
+func metricAttribute(table, attr string) (any, error) {
+	var name string
+	switch attr {
+	case "last":
+		name = "value"
+	case "last_step":
+		name = "last_iter"
+	case "first_step":
+		return 0, nil
+	case "min", "max", "mean", "first":
 		return clause.Column{
-			Table: table,
-			Name:  name,
+			Name: metricAggregateExpression(attr, table),
+			Raw:  true,
 		}, nil
-	}), nil
+	default:
+		return nil, fmt.Errorf("unsupported metrics attribute %q", attr)
+	}
+	return clause.Column{
+		Table: table,
+		Name:  name,
+	}, nil
+}
+
+// metricAggregateExpression returns correlated sub-query, which aggregates all the values
+// of the metric series referenced by the latest_metrics table. NaN values are skipped.
+func metricAggregateExpression(aggregate, table string) string {
+	conditions := fmt.Sprintf(
+		"m.run_uuid = %s.run_uuid AND m.key = %s.key AND m.context_id = %s.context_id AND NOT m.is_nan",
+		table, table, table,
+	)
+	switch aggregate {
+	case "first":
+		return fmt.Sprintf("(SELECT m.value FROM metrics m WHERE %s ORDER BY m.iter LIMIT 1)", conditions)
+	case "mean":
+		return fmt.Sprintf("(SELECT AVG(m.value) FROM metrics m WHERE %s)", conditions)
+	default:
+		return fmt.Sprintf("(SELECT %s(m.value) FROM metrics m WHERE %s)", strings.ToUpper(aggregate), conditions)
+	}
+}
+
+// metricContextJson returns Json clause for the metric context value under provided key.
+func (pq *parsedQuery) metricContextJson(key string) Json {
+	return Json{
+		Column: clause.Column{
+			Table: TableContexts,
+			Name:  "json",
+		},
+		JsonPath:  key,
+		Dialector: pq.qp.Dialector,
+	}
 }
 
 func (pq *parsedQuery) parseNameConstant(node *ast.NameConstant) (any, error) {
@@ -929,6 +1111,19 @@ func (pq *parsedQuery) parseSubscript(node *ast.Subscript) (any, error) {
 			return nil, err
 		}
 		switch v := v.(type) {
+		case ParamValue:
+			index, ok := node.Slice.(*ast.Index)
+			if !ok {
+				return nil, fmt.Errorf("unsupported slicer %q", ast.Dump(node.Slice))
+			}
+			key, err := pq.parseNode(index.Value)
+			if err != nil {
+				return nil, err
+			}
+			if key, ok := key.(string); ok {
+				return v.attribute(key)
+			}
+			return nil, fmt.Errorf("unsupported param key %#v", key)
 		case subscriptSlicer:
 			return v(node.Slice)
 		case attributeOrSubscript:
@@ -1054,25 +1249,128 @@ func (pq *parsedQuery) newSqlJsonPathComparison(op ast.CmpOp, left Json, right a
 			Value:     right,
 			Dialector: pq.qp.Dialector,
 		}, nil
+	case ast.In:
+		r, ok := right.([]any)
+		if !ok {
+			return nil, fmt.Errorf("right value in \"in\" comparison is not a list: %#v", right)
+		}
+		return JsonIn{
+			Json:   left,
+			Values: r,
+		}, nil
+	case ast.NotIn:
+		r, ok := right.([]any)
+		if !ok {
+			return nil, fmt.Errorf("right value in \"not in\" comparison is not a list: %#v", right)
+		}
+		return negativeClause(JsonIn{
+			Json:   left,
+			Values: r,
+		}), nil
 	default:
 		return nil, fmt.Errorf("unsupported comparison operation %q", op)
 	}
 }
 
+// newSqlContains returns expression for `'value' in column` statement, which matches a substring.
+func newSqlContains(value string, column clause.Column) clause.Expression {
+	return clause.Like{
+		Value:  fmt.Sprintf("%%%s%%", value),
+		Column: column,
+	}
+}
+
+// newSqlParamComparison returns expression comparing typed or nested param value.
+// The typed column (or the type of nested value) is chosen by the type of compared value.
+func newSqlParamComparison(op ast.CmpOp, left ParamValue, right any) (clause.Expression, error) {
+	switch op {
+	case ast.In, ast.NotIn:
+		values, ok := right.([]any)
+		if !ok {
+			return nil, fmt.Errorf("right value in %q comparison is not a list: %#v", op, right)
+		}
+		exprs := make([]clause.Expression, len(values))
+		for i, value := range values {
+			expression, err := newSqlParamComparison(ast.Eq, left, value)
+			if err != nil {
+				return nil, err
+			}
+			exprs[i] = expression
+		}
+		if op == ast.NotIn {
+			return negativeClause(clause.Or(exprs...)), nil
+		}
+		return clause.Or(exprs...), nil
+	}
+
+	var expression clause.Expr
+	switch right.(type) {
+	case nil:
+		switch op {
+		case ast.Eq, ast.Is:
+			return left.NoneExpression(), nil
+		case ast.NotEq, ast.IsNot:
+			return negativeClause(left.NoneExpression()), nil
+		default:
+			return nil, fmt.Errorf("comparison operation incompatible with None %q", op)
+		}
+	case bool:
+		switch op {
+		case ast.Eq, ast.Is, ast.NotEq, ast.IsNot:
+		default:
+			return nil, fmt.Errorf("comparison operation incompatible with bool %q", op)
+		}
+		expression = left.BoolExpression()
+	case int, float64:
+		expression = left.NumberExpression()
+	case string:
+		expression = left.StringExpression()
+	default:
+		return nil, fmt.Errorf("unsupported param comparison value %#v", right)
+	}
+	// string functions, e.g. `len` or `lower`, are compared as is.
+	if len(left.Functions) > 0 {
+		expression = left.StringExpression()
+	}
+
+	operator, ok := map[ast.CmpOp]string{
+		ast.Eq:    "=",
+		ast.Is:    "=",
+		ast.NotEq: "<>",
+		ast.IsNot: "<>",
+		ast.Lt:    "<",
+		ast.LtE:   "<=",
+		ast.Gt:    ">",
+		ast.GtE:   ">=",
+	}[op]
+	if !ok {
+		return nil, fmt.Errorf("unsupported comparison operation %q", op)
+	}
+	expression.SQL = fmt.Sprintf("%s %s ?", expression.SQL, operator)
+	expression.Vars = append(expression.Vars, right)
+	return expression, nil
+}
+
 func reverseComparison(op ast.CmpOp, left any, right clause.Column) (ast.CmpOp, clause.Column, any, error) {
+	o, err := reverseOperator(op)
+	return o, right, left, err
+}
+
+// reverseOperator returns the operator for the comparison with swapped operands.
+func reverseOperator(op ast.CmpOp) (ast.CmpOp, error) {
 	switch op {
 	case ast.Lt:
-		return ast.Gt, right, left, nil
+		return ast.Gt, nil
 	case ast.LtE:
-		return ast.GtE, right, left, nil
+		return ast.GtE, nil
 	case ast.Gt:
-		return ast.Lt, right, left, nil
+		return ast.Lt, nil
 	case ast.GtE:
-		return ast.LtE, right, left, nil
+		return ast.LtE, nil
 	case ast.Eq, ast.Is, ast.NotEq, ast.IsNot:
-		return op, right, left, nil
+		return op, nil
 	default:
-		return op, right, left, fmt.Errorf("unable to reverse comparison operator %q", op)
+		return op, fmt.Errorf("unable to reverse comparison operator %q", op)
 	}
 }
 
@@ -1083,3 +1381,15 @@ func negativeClause(expression clause.Expression) clause.Expression {
 		},
 	}
 }
+
+// columnExpression renders the column as SQL expression, so it could be wrapped into SQL function.
+func columnExpression(column clause.Column) string {
+	switch {
+	case column.Raw:
+		return column.Name
+	case column.Table == "":
+		return fmt.Sprintf("%q", column.Name)
+	default:
+		return fmt.Sprintf("%q.%q", column.Table, column.Name)
+	}
+}
@@ -223,6 +223,147 @@ func (s *QueryTestSuite) TestPostgresDialector_Ok() {
 			expectedSQL:  `SELECT "run_uuid" FROM "runs" WHERE "runs"."start_time" > $1 AND "runs"."lifecycle_stage" <> $2`,
 			expectedVars: []interface{}{int64(1643760000000), models.LifecycleStageDeleted},
 		},
+		{
+			name:  "TestRunNameWithStartWithTupleFunction",
+			query: `run.name.startswith(("bert", "gpt"))`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`WHERE ("runs"."name" LIKE $1 OR "runs"."name" LIKE $2) AND "runs"."lifecycle_stage" <> $3`,
+			expectedVars: []interface{}{"bert%", "gpt%", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestRunNameWithLowerFunction",
+			query: `run.name.lower() == "bert"`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`WHERE LOWER("runs"."name") = $1 AND "runs"."lifecycle_stage" <> $2`,
+			expectedVars: []interface{}{"bert", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestRunNameWithUpperAndStartWithFunctions",
+			query: `run.name.upper().startswith("BERT")`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`WHERE UPPER("runs"."name") LIKE $1 AND "runs"."lifecycle_stage" <> $2`,
+			expectedVars: []interface{}{"BERT%", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestRunNameWithLenFunction",
+			query: `len(run.name) > 3`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`WHERE LENGTH("runs"."name") > $1 AND "runs"."lifecycle_stage" <> $2`,
+			expectedVars: []interface{}{3, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestTagsWithInList",
+			query: `run.tags.stage in ["a", "b"]`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" LEFT JOIN tags tags_0 ON runs.run_uuid = tags_0.run_uuid ` +
+				`AND tags_0.key = $1 WHERE "tags_0"."value" IN ($2,$3) AND "runs"."lifecycle_stage" <> $4`,
+			expectedVars: []interface{}{"stage", "a", "b", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestParamsWithInFunction",
+			query: `'lr' in run.hparams`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" LEFT JOIN params params_0 ON runs.run_uuid = params_0.run_uuid ` +
+				`AND params_0.key = $1 WHERE (COALESCE("params_0"."value_str", '') LIKE $2 ` +
+				`OR "params_0"."value_json" #> $3 IS NOT NULL) AND "runs"."lifecycle_stage" <> $4`,
+			expectedVars: []interface{}{"hparams", "%lr%", `{"lr"}`, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestParamsNestedNumberComparison",
+			query: `run.hparams.optim.lr > 0.001`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" LEFT JOIN params params_0 ON runs.run_uuid = params_0.run_uuid ` +
+				`AND params_0.key = $1 WHERE CASE WHEN jsonb_typeof("params_0"."value_json" #> $2) = 'number' ` +
+				`THEN ("params_0"."value_json" #>> $3)::double precision END > $4 ` +
+				`AND "runs"."lifecycle_stage" <> $5`,
+			expectedVars: []interface{}{
+				"hparams", `{"optim","lr"}`, `{"optim","lr"}`, 0.001, models.LifecycleStageDeleted,
+			},
+		},
+		{
+			name:  "TestParamsNestedSubscriptStringComparison",
+			query: `run.hparams["optim"]["name"] == "adam"`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" LEFT JOIN params params_0 ON runs.run_uuid = params_0.run_uuid ` +
+				`AND params_0.key = $1 WHERE CASE WHEN jsonb_typeof("params_0"."value_json" #> $2) = 'string' ` +
+				`THEN ("params_0"."value_json" #>> $3) END = $4 ` +
+				`AND "runs"."lifecycle_stage" <> $5`,
+			expectedVars: []interface{}{
+				"hparams", `{"optim","name"}`, `{"optim","name"}`, "adam", models.LifecycleStageDeleted,
+			},
+		},
+		{
+			name:  "TestParamsNumberComparison",
+			query: `run.epochs >= 10`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" LEFT JOIN params params_0 ON runs.run_uuid = params_0.run_uuid ` +
+				`AND params_0.key = $1 WHERE COALESCE("params_0"."value_float", "params_0"."value_int") >= $2 ` +
+				`AND "runs"."lifecycle_stage" <> $3`,
+			expectedVars: []interface{}{"epochs", 10, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestParamsBoolComparison",
+			query: `run.shuffle == True`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" LEFT JOIN params params_0 ON runs.run_uuid = params_0.run_uuid ` +
+				`AND params_0.key = $1 WHERE "params_0"."value_bool" = $2 ` +
+				`AND "runs"."lifecycle_stage" <> $3`,
+			expectedVars: []interface{}{"shuffle", true, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestParamsNoneComparison",
+			query: `run.hparams.optim is not None`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" LEFT JOIN params params_0 ON runs.run_uuid = params_0.run_uuid ` +
+				`AND params_0.key = $1 WHERE NOT COALESCE(jsonb_typeof("params_0"."value_json" #> $2), 'null') = 'null' ` +
+				`AND "runs"."lifecycle_stage" <> $3`,
+			expectedVars: []interface{}{"hparams", `{"optim"}`, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestParamsReversedComparison",
+			query: `0.1 < run.lr`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" LEFT JOIN params params_0 ON runs.run_uuid = params_0.run_uuid ` +
+				`AND params_0.key = $1 WHERE COALESCE("params_0"."value_float", "params_0"."value_int") > $2 ` +
+				`AND "runs"."lifecycle_stage" <> $3`,
+			expectedVars: []interface{}{"lr", 0.1, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestParamsLowerFunction",
+			query: `run.optimizer.lower() == "adam"`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" LEFT JOIN params params_0 ON runs.run_uuid = params_0.run_uuid ` +
+				`AND params_0.key = $1 WHERE LOWER("params_0"."value_str") = $2 ` +
+				`AND "runs"."lifecycle_stage" <> $3`,
+			expectedVars: []interface{}{"optimizer", "adam", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestMetricMinAggregate",
+			query: `run.metrics['loss'].min < 0.1`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`WHERE (SELECT MIN(m.value) FROM metrics m WHERE m.run_uuid = metrics_0.run_uuid ` +
+				`AND m.key = metrics_0.key AND m.context_id = metrics_0.context_id AND NOT m.is_nan) < $2 ` +
+				`AND "runs"."lifecycle_stage" <> $3`,
+			expectedVars: []interface{}{"loss", 0.1, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestMetricFirstAggregate",
+			query: `run.metrics['loss'].first > 1`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`WHERE (SELECT m.value FROM metrics m WHERE m.run_uuid = metrics_0.run_uuid ` +
+				`AND m.key = metrics_0.key AND m.context_id = metrics_0.context_id AND NOT m.is_nan ` +
+				`ORDER BY m.iter LIMIT 1) > $2 AND "runs"."lifecycle_stage" <> $3`,
+			expectedVars: []interface{}{"loss", 1, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestMetricNameAndMeanAggregate",
+			query: `metric.name == "loss" and metric.mean > 0.5`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" WHERE ("metrics"."key" = $1 AND ` +
+				`(SELECT AVG(m.value) FROM metrics m WHERE m.run_uuid = metrics.run_uuid ` +
+				`AND m.key = metrics.key AND m.context_id = metrics.context_id AND NOT m.is_nan) > $2) ` +
+				`AND "runs"."lifecycle_stage" <> $3`,
+			expectedVars: []interface{}{"loss", 0.5, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestMetricContextWithInList",
+			query: `metric.context.subset in ("train", "val")`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`WHERE "contexts"."json"#>>$1 IN ($2,$3) AND "runs"."lifecycle_stage" <> $4`,
+			expectedVars: []interface{}{"{subset}", "train", "val", models.LifecycleStageDeleted},
+		},
 	}
 
 	for _, tt := range tests {
@@ -374,6 +515,110 @@ func (s *QueryTestSuite) TestSqliteDialector_Ok() {
 				`AND ("metrics_0"."value" < $4 AND "runs"."lifecycle_stage" <> $5)`,
 			expectedVars: []interface{}{"my_metric", "$.key1", "value1", -1, models.LifecycleStageDeleted},
 		},
+		{
+			name:  "TestRunNameWithStripFunction",
+			query: `run.name.strip() == "bert"`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`WHERE TRIM("runs"."name") = $1 AND "runs"."lifecycle_stage" <> $2`,
+			expectedVars: []interface{}{"bert", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestRunNameWithEndWithTupleFunction",
+			query: `run.name.endswith(("v1", "v2"))`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`WHERE ("runs"."name" LIKE $1 OR "runs"."name" LIKE $2) AND "runs"."lifecycle_stage" <> $3`,
+			expectedVars: []interface{}{"%v1", "%v2", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestParamsWithNotInFunction",
+			query: `'lr' not in run.hparams`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" LEFT JOIN params params_0 ON runs.run_uuid = params_0.run_uuid ` +
+				`AND params_0.key = $1 WHERE NOT (COALESCE("params_0"."value_str", '') LIKE $2 ` +
+				`OR json_type("params_0"."value_json", $3) IS NOT NULL) AND "runs"."lifecycle_stage" <> $4`,
+			expectedVars: []interface{}{"hparams", "%lr%", `$."lr"`, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestParamsNestedNumberComparison",
+			query: `run.hparams.optim.lr > 0.001`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" LEFT JOIN params params_0 ON runs.run_uuid = params_0.run_uuid ` +
+				`AND params_0.key = $1 WHERE CASE WHEN json_type("params_0"."value_json", $2) IN ('integer', 'real') ` +
+				`THEN json_extract("params_0"."value_json", $3) END > $4 ` +
+				`AND "runs"."lifecycle_stage" <> $5`,
+			expectedVars: []interface{}{
+				"hparams", `$."optim"."lr"`, `$."optim"."lr"`, 0.001, models.LifecycleStageDeleted,
+			},
+		},
+		{
+			name:  "TestParamsNestedBoolComparison",
+			query: `run.hparams.shuffle == False`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" LEFT JOIN params params_0 ON runs.run_uuid = params_0.run_uuid ` +
+				`AND params_0.key = $1 WHERE CASE WHEN json_type("params_0"."value_json", $2) IN ('true', 'false') ` +
+				`THEN json_extract("params_0"."value_json", $3) END = $4 ` +
+				`AND "runs"."lifecycle_stage" <> $5`,
+			expectedVars: []interface{}{
+				"hparams", `$."shuffle"`, `$."shuffle"`, false, models.LifecycleStageDeleted,
+			},
+		},
+		{
+			name:  "TestParamsNestedInList",
+			query: `run.hparams.optim.name in ["adam", "sgd"]`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" LEFT JOIN params params_0 ON runs.run_uuid = params_0.run_uuid ` +
+				`AND params_0.key = $1 WHERE (CASE WHEN json_type("params_0"."value_json", $2) IN ('text') ` +
+				`THEN json_extract("params_0"."value_json", $3) END = $4 ` +
+				`OR CASE WHEN json_type("params_0"."value_json", $5) IN ('text') ` +
+				`THEN json_extract("params_0"."value_json", $6) END = $7) ` +
+				`AND "runs"."lifecycle_stage" <> $8`,
+			expectedVars: []interface{}{
+				"hparams",
+				`$."optim"."name"`, `$."optim"."name"`, "adam",
+				`$."optim"."name"`, `$."optim"."name"`, "sgd",
+				models.LifecycleStageDeleted,
+			},
+		},
+		{
+			name:  "TestParamsNoneComparison",
+			query: `run.hparams is None`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" LEFT JOIN params params_0 ON runs.run_uuid = params_0.run_uuid ` +
+				`AND params_0.key = $1 WHERE "params_0"."key" IS NULL ` +
+				`AND "runs"."lifecycle_stage" <> $2`,
+			expectedVars: []interface{}{"hparams", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestParamsLenFunction",
+			query: `len(run.optimizer) > 3`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" LEFT JOIN params params_0 ON runs.run_uuid = params_0.run_uuid ` +
+				`AND params_0.key = $1 WHERE LENGTH("params_0"."value_str") > $2 ` +
+				`AND "runs"."lifecycle_stage" <> $3`,
+			expectedVars: []interface{}{"optimizer", 3, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestParamsRegexpMatch",
+			query: `re.match("ad", run.hparams.optim.name)`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" LEFT JOIN params params_0 ON runs.run_uuid = params_0.run_uuid ` +
+				`AND params_0.key = $1 WHERE IFNULL(CASE WHEN json_type("params_0"."value_json", $2) IN ('text') ` +
+				`THEN json_extract("params_0"."value_json", $3) END, '') REGEXP $4 ` +
+				`AND "runs"."lifecycle_stage" <> $5`,
+			expectedVars: []interface{}{
+				"hparams", `$."optim"."name"`, `$."optim"."name"`, "^ad", models.LifecycleStageDeleted,
+			},
+		},
+		{
+			name:  "TestMetricMaxAggregate",
+			query: `run.metrics['accuracy'].max >= 0.9`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`WHERE (SELECT MAX(m.value) FROM metrics m WHERE m.run_uuid = metrics_0.run_uuid ` +
+				`AND m.key = metrics_0.key AND m.context_id = metrics_0.context_id AND NOT m.is_nan) >= $2 ` +
+				`AND "runs"."lifecycle_stage" <> $3`,
+			expectedVars: []interface{}{"accuracy", 0.9, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestMetricContextWithNotInList",
+			query: `metric.context["subset"] not in ["train"]`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`WHERE IFNULL("contexts"."json", JSON('{}'))->>$1 NOT IN ($2) AND "runs"."lifecycle_stage" <> $3`,
+			expectedVars: []interface{}{"$.subset", "train", models.LifecycleStageDeleted},
+		},
 		{
 			name:  "TestImagesName",
 			query: `(images.name == 'my-image')`,
@@ -435,6 +680,26 @@ func (s *QueryTestSuite) Test_Error() {
 			query:         `run.metrics[{"key1": "value1"}].last < -1`,
 			expectedError: SyntaxError{},
 		},
+		{
+			name:          "TestLenFunctionWithoutArguments",
+			query:         `len() > 1`,
+			expectedError: SyntaxError{},
+		},
+		{
+			name:          "TestUnsupportedMetricAggregate",
+			query:         `run.metrics['loss'].median < 1`,
+			expectedError: SyntaxError{},
+		},
+		{
+			name:          "TestParamsNestedKeyWithQuote",
+			query:         `run.hparams['"lr'] > 1`,
+			expectedError: SyntaxError{},
+		},
+		{
+			name:          "TestParamsBoolOrderComparison",
+			query:         `run.shuffle > True`,
+			expectedError: SyntaxError{},
+		},
 	}
 	for _, tt := range tests {
 		s.Run(tt.name, func() {
@@ -50,6 +50,11 @@ func (r *Router) Init(server fiber.Router) {
 	experiments.Get("/:id/runs/", r.controller.GetExperimentRuns)
 	experiments.Delete("/:id/", r.controller.DeleteExperiment)
 	experiments.Put("/:id/", r.controller.UpdateExperiment)
+	experiments.Get("/:id/note/", r.controller.GetExperimentNotes)
+	experiments.Post("/:id/note/", r.controller.CreateExperimentNote)
+	experiments.Get("/:id/note/:note_id/", r.controller.GetExperimentNote)
+	experiments.Put("/:id/note/:note_id/", r.controller.UpdateExperimentNote)
+	experiments.Delete("/:id/note/:note_id/", r.controller.DeleteExperimentNote)
 
 	projects := mainGroup.Group("/projects")
 	projects.Get("/", r.controller.GetProject)
@@ -59,21 +64,44 @@ func (r *Router) Init(server fiber.Router) {
 	projects.Get("/params/", r.controller.GetProjectParams)
 	projects.Get("/status/", r.controller.GetProjectStatus)
 
+	reports := mainGroup.Group("/reports")
+	reports.Get("/", r.controller.GetReports)
+	reports.Post("/", r.controller.CreateReport)
+	reports.Get("/:id/", r.controller.GetReport)
+	reports.Put("/:id/", r.controller.UpdateReport)
+	reports.Delete("/:id/", r.controller.DeleteReport)
+
 	runs := mainGroup.Group("/runs")
 	runs.Get("/active/", r.controller.GetRunsActive)
+	runs.Get("/live/", r.controller.GetRunsLive)
 	runs.Get("/search/run/", r.controller.SearchRuns)
 	runs.Post("/search/metric/", r.controller.SearchMetrics)
 	runs.Post("/search/metric/align/", r.controller.SearchAlignedMetrics)
+	runs.Post("/search/metric/aggregate/", r.controller.SearchAggregatedMetrics)
 	runs.Post("/search/images/", r.controller.SearchImages)
+	runs.Post("/search/texts/", r.controller.SearchTexts)
+	runs.Post("/search/distributions/", r.controller.SearchDistributions)
+	runs.Post("/search/figures/", r.controller.SearchFigures)
+	runs.Post("/search/audios/", r.controller.SearchAudios)
 	runs.Get("/:id/info/", r.controller.GetRunInfo)
 	runs.Post("/:id/tags/new", r.controller.AddRunTag)
 	runs.Delete("/:id/tags/:tagID", r.controller.DeleteRunTag)
 	runs.Post("/:id/metric/get-batch/", r.controller.GetRunMetrics)
 	runs.Post("/:id/images/get-batch/", r.controller.GetRunImages)
+	runs.Post("/:id/texts/get-batch/", r.controller.GetRunTexts)
+	runs.Post("/:id/distributions/get-batch/", r.controller.GetRunDistributions)
+	runs.Post("/:id/figures/get-batch/", r.controller.GetRunFigures)
+	runs.Post("/:id/audios/get-batch/", r.controller.GetRunAudios)
 	runs.Post("/images/get-batch/", r.controller.GetRunImagesBatch)
+	runs.Post("/audios/get-batch/", r.controller.GetRunAudiosBatch)
 	runs.Put("/:id/", r.controller.UpdateRun)
 	runs.Get("/:id/logs", r.controller.GetRunLogs)
 	runs.Delete("/:id/", r.controller.DeleteRun)
+	runs.Get("/:id/note/", r.controller.GetRunNotes)
+	runs.Post("/:id/note/", r.controller.CreateRunNote)
+	runs.Get("/:id/note/:note_id/", r.controller.GetRunNote)
+	runs.Put("/:id/note/:note_id/", r.controller.UpdateRunNote)
+	runs.Delete("/:id/note/:note_id/", r.controller.DeleteRunNote)
 	runs.Post("/delete-batch/", r.controller.DeleteBatch)
 	runs.Post("/archive-batch/", r.controller.ArchiveBatch)
 
@@ -0,0 +1,226 @@
+package note
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+)
+
+// Service provides service layer to work with `note` business logic.
+type Service struct {
+	noteRepository       repositories.NoteRepositoryProvider
+	runRepository        repositories.RunRepositoryProvider
+	experimentRepository repositories.ExperimentRepositoryProvider
+}
+
+// NewService creates new Service instance.
+func NewService(
+	noteRepository repositories.NoteRepositoryProvider,
+	runRepository repositories.RunRepositoryProvider,
+	experimentRepository repositories.ExperimentRepositoryProvider,
+) *Service {
+	return &Service{
+		noteRepository:       noteRepository,
+		runRepository:        runRepository,
+		experimentRepository: experimentRepository,
+	}
+}
+
+// GetRunNotes returns the list of notes attached to the run.
+func (s Service) GetRunNotes(
+	ctx context.Context, namespaceID uint, req *request.GetRunNotesRequest,
+) ([]models.Note, error) {
+	if err := s.checkRunExists(ctx, namespaceID, req.RunID); err != nil {
+		return nil, err
+	}
+	notes, err := s.noteRepository.GetByNamespaceIDAndRunID(ctx, namespaceID, req.RunID)
+	if err != nil {
+		return nil, api.NewInternalError("unable to get notes of run '%s': %s", req.RunID, err)
+	}
+	return notes, nil
+}
+
+// CreateRunNote creates new note attached to the run.
+func (s Service) CreateRunNote(
+	ctx context.Context, namespaceID uint, author string, req *request.CreateRunNoteRequest,
+) (*models.Note, error) {
+	if err := s.checkRunExists(ctx, namespaceID, req.RunID); err != nil {
+		return nil, err
+	}
+	note := models.Note{
+		Content:     req.Content,
+		Author:      author,
+		RunID:       &req.RunID,
+		NamespaceID: namespaceID,
+	}
+	if err := s.noteRepository.Create(ctx, &note); err != nil {
+		return nil, api.NewInternalError("unable to create note: %s", err)
+	}
+	return &note, nil
+}
+
+// GetRunNote returns the note attached to the run.
+func (s Service) GetRunNote(
+	ctx context.Context, namespaceID uint, req *request.GetRunNoteRequest,
+) (*models.Note, error) {
+	note, err := s.getNote(ctx, namespaceID, req.NoteID)
+	if err != nil {
+		return nil, err
+	}
+	if note.RunID == nil || *note.RunID != req.RunID {
+		return nil, api.NewResourceDoesNotExistError("note '%s' not found", req.NoteID)
+	}
+	return note, nil
+}
+
+// UpdateRunNote updates the note attached to the run.
+func (s Service) UpdateRunNote(
+	ctx context.Context, namespaceID uint, req *request.UpdateRunNoteRequest,
+) (*models.Note, error) {
+	note, err := s.GetRunNote(ctx, namespaceID, &request.GetRunNoteRequest{RunID: req.RunID, NoteID: req.NoteID})
+	if err != nil {
+		return nil, err
+	}
+	return s.updateNote(ctx, note, req.Content)
+}
+
+// DeleteRunNote deletes the note attached to the run.
+func (s Service) DeleteRunNote(ctx context.Context, namespaceID uint, req *request.DeleteRunNoteRequest) error {
+	note, err := s.GetRunNote(ctx, namespaceID, req)
+	if err != nil {
+		return err
+	}
+	return s.deleteNote(ctx, note)
+}
+
+// GetExperimentNotes returns the list of notes attached to the experiment.
+func (s Service) GetExperimentNotes(
+	ctx context.Context, namespaceID uint, req *request.GetExperimentNotesRequest,
+) ([]models.Note, error) {
+	if err := s.checkExperimentExists(ctx, namespaceID, req.ExperimentID); err != nil {
+		return nil, err
+	}
+	notes, err := s.noteRepository.GetByNamespaceIDAndExperimentID(ctx, namespaceID, req.ExperimentID)
+	if err != nil {
+		return nil, api.NewInternalError("unable to get notes of experiment '%d': %s", req.ExperimentID, err)
+	}
+	return notes, nil
+}
+
+// CreateExperimentNote creates new note attached to the experiment.
+func (s Service) CreateExperimentNote(
+	ctx context.Context, namespaceID uint, author string, req *request.CreateExperimentNoteRequest,
+) (*models.Note, error) {
+	if err := s.checkExperimentExists(ctx, namespaceID, req.ExperimentID); err != nil {
+		return nil, err
+	}
+	note := models.Note{
+		Content:      req.Content,
+		Author:       author,
+		ExperimentID: &req.ExperimentID,
+		NamespaceID:  namespaceID,
+	}
+	if err := s.noteRepository.Create(ctx, &note); err != nil {
+		return nil, api.NewInternalError("unable to create note: %s", err)
+	}
+	return &note, nil
+}
+
+// GetExperimentNote returns the note attached to the experiment.
+func (s Service) GetExperimentNote(
+	ctx context.Context, namespaceID uint, req *request.GetExperimentNoteRequest,
+) (*models.Note, error) {
+	note, err := s.getNote(ctx, namespaceID, req.NoteID)
+	if err != nil {
+		return nil, err
+	}
+	if note.ExperimentID == nil || *note.ExperimentID != req.ExperimentID {
+		return nil, api.NewResourceDoesNotExistError("note '%s' not found", req.NoteID)
+	}
+	return note, nil
+}
+
+// UpdateExperimentNote updates the note attached to the experiment.
+func (s Service) UpdateExperimentNote(
+	ctx context.Context, namespaceID uint, req *request.UpdateExperimentNoteRequest,
+) (*models.Note, error) {
+	note, err := s.GetExperimentNote(ctx, namespaceID, &request.GetExperimentNoteRequest{
+		ExperimentID: req.ExperimentID,
+		NoteID:       req.NoteID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.updateNote(ctx, note, req.Content)
+}
+
+// DeleteExperimentNote deletes the note attached to the experiment.
+func (s Service) DeleteExperimentNote(
+	ctx context.Context, namespaceID uint, req *request.DeleteExperimentNoteRequest,
+) error {
+	note, err := s.GetExperimentNote(ctx, namespaceID, req)
+	if err != nil {
+		return err
+	}
+	return s.deleteNote(ctx, note)
+}
+
+// checkRunExists makes check that the run exists in the namespace.
+func (s Service) checkRunExists(ctx context.Context, namespaceID uint, runID string) error {
+	run, err := s.runRepository.GetRunByNamespaceIDAndRunID(ctx, namespaceID, runID)
+	if err != nil {
+		return api.NewInternalError("unable to find run '%s': %s", runID, err)
+	}
+	if run == nil {
+		return api.NewResourceDoesNotExistError("run '%s' not found", runID)
+	}
+	return nil
+}
+
+// checkExperimentExists makes check that the experiment exists in the namespace.
+func (s Service) checkExperimentExists(ctx context.Context, namespaceID uint, experimentID int32) error {
+	experiment, err := s.experimentRepository.GetExperimentByNamespaceIDAndExperimentID(
+		ctx, namespaceID, experimentID,
+	)
+	if err != nil {
+		return api.NewInternalError("unable to find experiment '%d': %s", experimentID, err)
+	}
+	if experiment == nil {
+		return api.NewResourceDoesNotExistError("experiment '%d' not found", experimentID)
+	}
+	return nil
+}
+
+// getNote returns existing note from the namespace.
+func (s Service) getNote(ctx context.Context, namespaceID uint, noteID uuid.UUID) (*models.Note, error) {
+	note, err := s.noteRepository.GetByNamespaceIDAndNoteID(ctx, namespaceID, noteID.String())
+	if err != nil {
+		return nil, api.NewInternalError("unable to find note by id %q: %s", noteID, err)
+	}
+	if note == nil {
+		return nil, api.NewResourceDoesNotExistError("note '%s' not found", noteID)
+	}
+	return note, nil
+}
+
+// updateNote updates the content of existing note.
+func (s Service) updateNote(ctx context.Context, note *models.Note, content string) (*models.Note, error) {
+	note.Content = content
+	if err := s.noteRepository.Update(ctx, note); err != nil {
+		return nil, api.NewInternalError("unable to update note '%s': %s", note.ID, err)
+	}
+	return note, nil
+}
+
+// deleteNote deletes existing note.
+func (s Service) deleteNote(ctx context.Context, note *models.Note) error {
+	if err := s.noteRepository.Delete(ctx, note); err != nil {
+		return api.NewInternalError("unable to delete note '%s': %s", note.ID, err)
+	}
+	return nil
+}
@@ -13,13 +13,17 @@ import (
 
 // Service provides service layer to work with `project` business logic.
 type Service struct {
-	tagRepository        repositories.TagRepositoryProvider
-	runRepository        repositories.RunRepositoryProvider
-	paramRepository      repositories.ParamRepositoryProvider
-	metricRepository     repositories.MetricRepositoryProvider
-	experimentRepository repositories.ExperimentRepositoryProvider
-	artifactRepository   repositories.ArtifactRepositoryProvider
-	liveUpdatesEnabled   bool
+	tagRepository          repositories.TagRepositoryProvider
+	runRepository          repositories.RunRepositoryProvider
+	paramRepository        repositories.ParamRepositoryProvider
+	metricRepository       repositories.MetricRepositoryProvider
+	experimentRepository   repositories.ExperimentRepositoryProvider
+	artifactRepository     repositories.ArtifactRepositoryProvider
+	textRepository         repositories.TextRepositoryProvider
+	distributionRepository repositories.DistributionRepositoryProvider
+	figureRepository       repositories.FigureRepositoryProvider
+	audioRepository        repositories.AudioRepositoryProvider
+	liveUpdatesEnabled     bool
 }
 
 // NewService creates new Service instance.
@@ -30,16 +34,24 @@ func NewService(
 	metricRepository repositories.MetricRepositoryProvider,
 	experimentRepository repositories.ExperimentRepositoryProvider,
 	artifactRepository repositories.ArtifactRepositoryProvider,
+	textRepository repositories.TextRepositoryProvider,
+	distributionRepository repositories.DistributionRepositoryProvider,
+	figureRepository repositories.FigureRepositoryProvider,
+	audioRepository repositories.AudioRepositoryProvider,
 	liveUpdatesEnabled bool,
 ) *Service {
 	return &Service{
-		tagRepository:        tagRepository,
-		runRepository:        runRepository,
-		paramRepository:      paramRepository,
-		metricRepository:     metricRepository,
-		experimentRepository: experimentRepository,
-		artifactRepository:   artifactRepository,
-		liveUpdatesEnabled:   liveUpdatesEnabled,
+		tagRepository:          tagRepository,
+		runRepository:          runRepository,
+		paramRepository:        paramRepository,
+		metricRepository:       metricRepository,
+		experimentRepository:   experimentRepository,
+		artifactRepository:     artifactRepository,
+		textRepository:         textRepository,
+		distributionRepository: distributionRepository,
+		figureRepository:       figureRepository,
+		audioRepository:        audioRepository,
+		liveUpdatesEnabled:     liveUpdatesEnabled,
 	}
 }
 
@@ -93,11 +105,11 @@ func (s Service) GetProjectParams(
 
 	projectParams := models.ProjectParams{}
 	if !req.ExcludeParams {
-		paramKeys, err := s.paramRepository.GetParamKeysByParameters(ctx, namespaceID, req.Experiments)
+		params, err := s.paramRepository.GetProjectParamsByParameters(ctx, namespaceID, req.Experiments)
 		if err != nil {
 			return nil, api.NewInternalError("error getting param keys: %s", err)
 		}
-		projectParams.ParamKeys = paramKeys
+		projectParams.Params = params
 
 		tagKeys, err := s.tagRepository.GetTagKeysByParameters(ctx, namespaceID, req.Experiments)
 		if err != nil {
@@ -126,5 +138,45 @@ func (s Service) GetProjectParams(
 		}
 		projectParams.Images = images
 	}
+	if slices.Contains(req.Sequences, "texts") {
+		// fetch texts available for requested Experiments.
+		texts, err := s.textRepository.GetTextNamesAndContextsByExperiments(
+			ctx, namespaceID, req.Experiments,
+		)
+		if err != nil {
+			return nil, api.NewInternalError("error getting texts: %s", err)
+		}
+		projectParams.Texts = texts
+	}
+	if slices.Contains(req.Sequences, "distributions") {
+		// fetch distributions available for requested Experiments.
+		distributions, err := s.distributionRepository.GetDistributionNamesAndContextsByExperiments(
+			ctx, namespaceID, req.Experiments,
+		)
+		if err != nil {
+			return nil, api.NewInternalError("error getting distributions: %s", err)
+		}
+		projectParams.Distributions = distributions
+	}
+	if slices.Contains(req.Sequences, "figures") {
+		// fetch figures available for requested Experiments.
+		figures, err := s.figureRepository.GetFigureNamesAndContextsByExperiments(
+			ctx, namespaceID, req.Experiments,
+		)
+		if err != nil {
+			return nil, api.NewInternalError("error getting figures: %s", err)
+		}
+		projectParams.Figures = figures
+	}
+	if slices.Contains(req.Sequences, "audios") {
+		// fetch audios available for requested Experiments.
+		audios, err := s.audioRepository.GetAudioNamesAndContextsByExperiments(
+			ctx, namespaceID, req.Experiments,
+		)
+		if err != nil {
+			return nil, api.NewInternalError("error getting audios: %s", err)
+		}
+		projectParams.Audios = audios
+	}
 	return &projectParams, nil
 }
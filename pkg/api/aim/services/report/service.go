@@ -0,0 +1,107 @@
+package report
+
+import (
+	"context"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/dao/convertors"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+)
+
+// Service provides service layer to work with `report` business logic.
+type Service struct {
+	reportRepository repositories.ReportRepositoryProvider
+}
+
+// NewService creates new Service instance.
+func NewService(reportRepository repositories.ReportRepositoryProvider) *Service {
+	return &Service{
+		reportRepository: reportRepository,
+	}
+}
+
+// Get returns report object.
+func (s Service) Get(
+	ctx context.Context, namespaceID uint, req *request.GetReportRequest,
+) (*models.Report, error) {
+	report, err := s.reportRepository.GetByNamespaceIDAndReportID(ctx, namespaceID, req.ID.String())
+	if err != nil {
+		return nil, api.NewInternalError("unable to find report by id %q: %s", req.ID, err)
+	}
+	if report == nil {
+		return nil, api.NewResourceDoesNotExistError("report '%s' not found", req.ID)
+	}
+	return report, nil
+}
+
+// Create creates new report object.
+func (s Service) Create(
+	ctx context.Context, namespaceID uint, req *request.CreateReportRequest,
+) (*models.Report, error) {
+	if err := ValidateCreateReportRequest(req); err != nil {
+		return nil, err
+	}
+	report := convertors.ConvertCreateReportRequestToDBModel(namespaceID, req)
+	if err := s.reportRepository.Create(ctx, report); err != nil {
+		return nil, api.NewInternalError("unable to create report: %v", err)
+	}
+	return report, nil
+}
+
+// Update updates existing report object.
+func (s Service) Update(
+	ctx context.Context, namespaceID uint, req *request.UpdateReportRequest,
+) (*models.Report, error) {
+	if err := ValidateUpdateReportRequest(req); err != nil {
+		return nil, err
+	}
+	report, err := s.reportRepository.GetByNamespaceIDAndReportID(ctx, namespaceID, req.ID.String())
+	if err != nil {
+		return nil, api.NewInternalError("unable to find report by id %s: %s", req.ID, err)
+	}
+	if report == nil {
+		return nil, api.NewResourceDoesNotExistError("report with id '%s' not found", req.ID)
+	}
+
+	if req.Name != nil {
+		report.Name = *req.Name
+	}
+	if req.Code != nil {
+		report.Code = *req.Code
+	}
+	if req.Description != nil {
+		report.Description = *req.Description
+	}
+
+	if err := s.reportRepository.Update(ctx, report); err != nil {
+		return nil, api.NewInternalError("unable to update report '%s': %s", report.ID, err)
+	}
+	return report, nil
+}
+
+// GetReports returns the list of active reports.
+func (s Service) GetReports(ctx context.Context, namespaceID uint) ([]models.Report, error) {
+	reports, err := s.reportRepository.GetActiveReportsByNamespace(ctx, namespaceID)
+	if err != nil {
+		return nil, api.NewInternalError("unable to get active reports: %v", err)
+	}
+	return reports, nil
+}
+
+// Delete deletes existing object.
+func (s Service) Delete(ctx context.Context, namespaceID uint, req *request.DeleteReportRequest) error {
+	report, err := s.reportRepository.GetByNamespaceIDAndReportID(ctx, namespaceID, req.ID.String())
+	if err != nil {
+		return api.NewInternalError("unable to find report by id %s: %s", req.ID, err)
+	}
+	if report == nil {
+		return api.NewResourceDoesNotExistError("report with id '%s' not found", req.ID)
+	}
+
+	if err := s.reportRepository.Delete(ctx, report); err != nil {
+		return api.NewInternalError("unable to delete report by id %s: %s", req.ID, err)
+	}
+	return nil
+}
@@ -0,0 +1,22 @@
+package report
+
+import (
+	"github.com/G-Research/fasttrackml/pkg/api/aim/api/request"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+)
+
+// ValidateCreateReportRequest validates `POST /reports` request.
+func ValidateCreateReportRequest(req *request.CreateReportRequest) error {
+	if req.Name == "" {
+		return api.NewInvalidParameterValueError("`%s` is not a valid report name", req.Name)
+	}
+	return nil
+}
+
+// ValidateUpdateReportRequest validates `PUT /reports/:id` request.
+func ValidateUpdateReportRequest(req *request.UpdateReportRequest) error {
+	if req.Name != nil && *req.Name == "" {
+		return api.NewInvalidParameterValueError("`%s` is not a valid report name", *req.Name)
+	}
+	return nil
+}
@@ -1,6 +1,8 @@
 package run
 
 import (
+	"strings"
+
 	"github.com/G-Research/fasttrackml/pkg/api/aim/api/request"
 )
 
@@ -11,3 +13,17 @@ func NormaliseGetRunInfoRequest(req *request.GetRunInfoRequest) *request.GetRunI
 	}
 	return req
 }
+
+// NormaliseSearchAggregatedMetricsRequest normalizes request object for `POST /runs/search/metric/aggregate` endpoint.
+// Group by fields could be written the same way, as in the query, e.g. `run.params.lr`.
+func NormaliseSearchAggregatedMetricsRequest(
+	req *request.SearchAggregatedMetricsRequest,
+) *request.SearchAggregatedMetricsRequest {
+	if req.Steps <= 0 {
+		req.Steps = 50
+	}
+	for i, field := range req.GroupBy {
+		req.GroupBy[i] = strings.TrimPrefix(field, "run.")
+	}
+	return req
+}
@@ -9,6 +9,7 @@ import (
 	"io"
 	"io/fs"
 	"net/url"
+	"time"
 
 	"github.com/rotisserie/eris"
 
@@ -19,8 +20,12 @@ import (
 	"github.com/G-Research/fasttrackml/pkg/common/api"
 	"github.com/G-Research/fasttrackml/pkg/common/dao/types"
 	"github.com/G-Research/fasttrackml/pkg/common/services/artifact/storage"
+	"github.com/G-Research/fasttrackml/pkg/common/services/live"
 )
 
+// liveUpdatesRecheckInterval is the interval to check again whether the run matches live updates query.
+const liveUpdatesRecheckInterval = 10 * time.Second
+
 // allowed batch actions.
 const (
 	BatchActionDelete  = "delete"
@@ -37,6 +42,12 @@ type Service struct {
 	sharedTagRepository    repositories.SharedTagRepositoryProvider
 	artifactStorageFactory storage.ArtifactStorageFactoryProvider
 	artifactRepository     repositories.ArtifactRepositoryProvider
+	textRepository         repositories.TextRepositoryProvider
+	distributionRepository repositories.DistributionRepositoryProvider
+	figureRepository       repositories.FigureRepositoryProvider
+	audioRepository        repositories.AudioRepositoryProvider
+	liveBroker             *live.Broker
+	liveUpdatesEnabled     bool
 }
 
 // NewService creates new Service instance.
@@ -48,6 +59,12 @@ func NewService(
 	sharedTagRepository repositories.SharedTagRepositoryProvider,
 	artifactStorageFactory storage.ArtifactStorageFactoryProvider,
 	artifactRepository repositories.ArtifactRepositoryProvider,
+	textRepository repositories.TextRepositoryProvider,
+	distributionRepository repositories.DistributionRepositoryProvider,
+	figureRepository repositories.FigureRepositoryProvider,
+	audioRepository repositories.AudioRepositoryProvider,
+	liveBroker *live.Broker,
+	liveUpdatesEnabled bool,
 ) *Service {
 	return &Service{
 		runRepository:          runRepository,
@@ -57,6 +74,12 @@ func NewService(
 		sharedTagRepository:    sharedTagRepository,
 		artifactStorageFactory: artifactStorageFactory,
 		artifactRepository:     artifactRepository,
+		textRepository:         textRepository,
+		distributionRepository: distributionRepository,
+		figureRepository:       figureRepository,
+		audioRepository:        audioRepository,
+		liveBroker:             liveBroker,
+		liveUpdatesEnabled:     liveUpdatesEnabled,
 	}
 }
 
@@ -92,6 +115,68 @@ func (s Service) GetRunLogs(
 	return rows, next, nil
 }
 
+// SubscribeLiveUpdates subscribes to the live updates of the runs matching the request.
+// Returned function reports whether the run of the received event matches the request.
+func (s Service) SubscribeLiveUpdates(
+	ctx context.Context, namespaceID uint, timeZoneOffset int, req *request.GetRunsLiveRequest,
+) (*live.Subscription, func(context.Context, string) (bool, error), error) {
+	if !s.liveUpdatesEnabled {
+		return nil, nil, api.NewBadRequestError("live updates are disabled")
+	}
+
+	for _, runID := range req.RunIDs {
+		run, err := s.runRepository.GetRunByNamespaceIDAndRunID(ctx, namespaceID, runID)
+		if err != nil {
+			return nil, nil, api.NewInternalError("error getting run by id %s: %s", runID, err)
+		}
+		if run == nil {
+			return nil, nil, api.NewResourceDoesNotExistError("run '%s' not found", runID)
+		}
+	}
+
+	// match the requested runs upfront, it also reports an invalid query before the stream starts.
+	runIDs, err := s.runRepository.GetRunIDsByQuery(ctx, namespaceID, timeZoneOffset, req.Query, req.RunIDs)
+	if err != nil {
+		return nil, nil, api.NewInternalError("error matching runs: %s", err)
+	}
+
+	requested := make(map[string]struct{}, len(req.RunIDs))
+	for _, runID := range req.RunIDs {
+		requested[runID] = struct{}{}
+	}
+	matched := make(map[string]struct{}, len(runIDs))
+	for _, runID := range runIDs {
+		matched[runID] = struct{}{}
+	}
+	// runs, which didn't match the query, are checked again after a while,
+	// as new params and tags could make them match.
+	unmatched := make(map[string]time.Time)
+	match := func(ctx context.Context, runID string) (bool, error) {
+		if _, ok := matched[runID]; ok {
+			return true, nil
+		}
+		if _, ok := requested[runID]; len(requested) > 0 && !ok {
+			return false, nil
+		}
+		if checkedAt, ok := unmatched[runID]; ok && time.Since(checkedAt) < liveUpdatesRecheckInterval {
+			return false, nil
+		}
+		runIDs, err := s.runRepository.GetRunIDsByQuery(ctx, namespaceID, timeZoneOffset, req.Query, []string{runID})
+		if err != nil {
+			return false, eris.Wrapf(err, "error matching run '%s'", runID)
+		}
+		if len(runIDs) == 0 {
+			unmatched[runID] = time.Now()
+			return false, nil
+		}
+		delete(unmatched, runID)
+		matched[runID] = struct{}{}
+		return true, nil
+	}
+
+	return s.liveBroker.Subscribe(namespaceID), match, nil
+}
+
 // GetRunMetrics returns run metrics.
 func (s Service) GetRunMetrics(
 	ctx context.Context, namespaceID uint, runID string, req *request.GetRunMetricsRequest,
@@ -172,21 +257,124 @@ func (s Service) GetRunImages(
 	return images, nil
 }
 
+// GetRunTexts returns run texts.
+func (s Service) GetRunTexts(
+	ctx context.Context, namespaceID uint, runID string, req *request.GetRunTextsRequest,
+) ([]models.Text, error) {
+	run, err := s.runRepository.GetRunByNamespaceIDAndRunID(ctx, namespaceID, runID)
+	if err != nil {
+		return nil, api.NewInternalError("error getting run by id %s: %s", runID, err)
+	}
+	if run == nil {
+		return nil, api.NewResourceDoesNotExistError("run '%s' not found", runID)
+	}
+
+	texts, err := s.textRepository.GetRunTexts(ctx, runID, *req)
+	if err != nil {
+		return nil, api.NewInternalError("error getting run texts by id %s: %s", runID, err)
+	}
+	return texts, nil
+}
+
+// GetRunDistributions returns run distributions.
+func (s Service) GetRunDistributions(
+	ctx context.Context, namespaceID uint, runID string, req *request.GetRunDistributionsRequest,
+) ([]models.Distribution, error) {
+	run, err := s.runRepository.GetRunByNamespaceIDAndRunID(ctx, namespaceID, runID)
+	if err != nil {
+		return nil, api.NewInternalError("error getting run by id %s: %s", runID, err)
+	}
+	if run == nil {
+		return nil, api.NewResourceDoesNotExistError("run '%s' not found", runID)
+	}
+
+	distributions, err := s.distributionRepository.GetRunDistributions(ctx, runID, *req)
+	if err != nil {
+		return nil, api.NewInternalError("error getting run distributions by id %s: %s", runID, err)
+	}
+	return distributions, nil
+}
+
+// GetRunFigures returns run figures.
+func (s Service) GetRunFigures(
+	ctx context.Context, namespaceID uint, runID string, req *request.GetRunFiguresRequest,
+) ([]models.Figure, error) {
+	run, err := s.runRepository.GetRunByNamespaceIDAndRunID(ctx, namespaceID, runID)
+	if err != nil {
+		return nil, api.NewInternalError("error getting run by id %s: %s", runID, err)
+	}
+	if run == nil {
+		return nil, api.NewResourceDoesNotExistError("run '%s' not found", runID)
+	}
+
+	figures, err := s.figureRepository.GetRunFigures(ctx, runID, *req)
+	if err != nil {
+		return nil, api.NewInternalError("error getting run figures by id %s: %s", runID, err)
+	}
+	return figures, nil
+}
+
+// GetRunAudios returns run audios.
+func (s Service) GetRunAudios(
+	ctx context.Context, namespaceID uint, runID string, req *request.GetRunAudiosRequest,
+) ([]models.Audio, error) {
+	run, err := s.runRepository.GetRunByNamespaceIDAndRunID(ctx, namespaceID, runID)
+	if err != nil {
+		return nil, api.NewInternalError("error getting run by id %s: %s", runID, err)
+	}
+	if run == nil {
+		return nil, api.NewResourceDoesNotExistError("run '%s' not found", runID)
+	}
+
+	audios, err := s.audioRepository.GetRunAudios(ctx, runID, *req)
+	if err != nil {
+		return nil, api.NewInternalError("error getting run audios by id %s: %s", runID, err)
+	}
+	return audios, nil
+}
+
 // GetRunImagesBatch returns run images.
 func (s Service) GetRunImagesBatch(
 	ctx context.Context, req *request.GetRunImagesBatchRequest,
 ) ([]io.ReadCloser, error) {
-	readers := make([]io.ReadCloser, len(*req))
-	for i, image := range *req {
-		artifactStorage, err := s.artifactStorageFactory.GetStorage(ctx, image)
+	return s.getArtifactReaders(ctx, *req)
+}
+
+// GetRunAudiosBatch returns run audios.
+func (s Service) GetRunAudiosBatch(
+	ctx context.Context, req *request.GetRunAudiosBatchRequest,
+) ([]io.ReadCloser, error) {
+	return s.getArtifactReaders(ctx, *req)
+}
+
+// GetFigureBlob returns the content of the figure blob stored under provided URI.
+func (s Service) GetFigureBlob(ctx context.Context, blobURI string) ([]byte, error) {
+	readers, err := s.getArtifactReaders(ctx, []string{blobURI})
+	if err != nil {
+		return nil, err
+	}
+	//nolint:errcheck
+	defer readers[0].Close()
+	data, err := io.ReadAll(readers[0])
+	if err != nil {
+		return nil, api.NewInternalError("error reading artifact object for URI: %s", blobURI)
+	}
+	return data, nil
+}
+
+// getArtifactReaders returns readers of the artifact objects stored under provided URIs.
+func (s Service) getArtifactReaders(ctx context.Context, uris []string) ([]io.ReadCloser, error) {
+	readers := make([]io.ReadCloser, len(uris))
+	for i, uri := range uris {
+		artifactStorage, err := s.artifactStorageFactory.GetStorage(ctx, uri)
 		if err != nil {
 			return nil, api.NewInternalError("Unsupported artifact storage")
 		}
 		artifactReader, err := artifactStorage.Get(
-			ctx, image, "",
+			ctx, uri, "",
 		)
 		if err != nil {
-			msg := fmt.Sprintf("error getting artifact object for URI: %s", image)
+			msg := fmt.Sprintf("error getting artifact object for URI: %s", uri)
 			if errors.Is(err, fs.ErrNotExist) {
 				return nil, api.NewResourceDoesNotExistError(msg)
 			}
@@ -230,6 +418,21 @@ func (s Service) SearchMetrics(
 	return rows, total, searchResult, nil
 }
 
+// AggregateMetrics returns metrics of the runs matching search criteria aggregated by requested groups.
+func (s Service) AggregateMetrics(
+	ctx context.Context, namespaceID uint, timeZoneOffset int, req *request.SearchAggregatedMetricsRequest,
+) ([]models.MetricAggregation, error) {
+	req = NormaliseSearchAggregatedMetricsRequest(req)
+	if err := ValidateSearchAggregatedMetricsRequest(req); err != nil {
+		return nil, err
+	}
+	aggregations, err := s.metricRepository.AggregateMetrics(ctx, namespaceID, timeZoneOffset, *req)
+	if err != nil {
+		return nil, api.NewInternalError("error aggregating metrics: %s", err)
+	}
+	return aggregations, nil
+}
+
 // SearchArtifacts returns the list of artifacts (images) by provided search criteria.
 func (s Service) SearchArtifacts(
 	ctx context.Context, namespaceID uint, timeZoneOffset int, req request.SearchArtifactsRequest,
@@ -241,6 +444,50 @@ func (s Service) SearchArtifacts(
 	return rows, runs, result, nil
 }
 
+// SearchTexts returns the list of texts by provided search criteria.
+func (s Service) SearchTexts(
+	ctx context.Context, namespaceID uint, timeZoneOffset int, req request.SearchArtifactsRequest,
+) (*sql.Rows, map[string]models.Run, repositories.ArtifactSearchSummary, error) {
+	rows, runs, result, err := s.textRepository.Search(ctx, namespaceID, timeZoneOffset, req)
+	if err != nil {
+		return nil, nil, nil, api.NewInternalError("error searching texts: %s", err)
+	}
+	return rows, runs, result, nil
+}
+
+// SearchDistributions returns the list of distributions by provided search criteria.
+func (s Service) SearchDistributions(
+	ctx context.Context, namespaceID uint, timeZoneOffset int, req request.SearchArtifactsRequest,
+) (*sql.Rows, map[string]models.Run, repositories.ArtifactSearchSummary, error) {
+	rows, runs, result, err := s.distributionRepository.Search(ctx, namespaceID, timeZoneOffset, req)
+	if err != nil {
+		return nil, nil, nil, api.NewInternalError("error searching distributions: %s", err)
+	}
+	return rows, runs, result, nil
+}
+
+// SearchFigures returns the list of figures by provided search criteria.
+func (s Service) SearchFigures(
+	ctx context.Context, namespaceID uint, timeZoneOffset int, req request.SearchArtifactsRequest,
+) (*sql.Rows, map[string]models.Run, repositories.ArtifactSearchSummary, error) {
+	rows, runs, result, err := s.figureRepository.Search(ctx, namespaceID, timeZoneOffset, req)
+	if err != nil {
+		return nil, nil, nil, api.NewInternalError("error searching figures: %s", err)
+	}
+	return rows, runs, result, nil
+}
+
+// SearchAudios returns the list of audios by provided search criteria.
+func (s Service) SearchAudios(
+	ctx context.Context, namespaceID uint, timeZoneOffset int, req request.SearchArtifactsRequest,
+) (*sql.Rows, map[string]models.Run, repositories.ArtifactSearchSummary, error) {
+	rows, runs, result, err := s.audioRepository.Search(ctx, namespaceID, timeZoneOffset, req)
+	if err != nil {
+		return nil, nil, nil, api.NewInternalError("error searching audios: %s", err)
+	}
+	return rows, runs, result, nil
+}
+
 // SearchAlignedMetrics returns the list of aligned metrics.
 func (s Service) SearchAlignedMetrics(
 	ctx context.Context, namespaceID uint, req *request.SearchAlignedMetricsRequest,
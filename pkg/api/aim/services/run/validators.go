@@ -2,8 +2,10 @@ package run
 
 import (
 	"slices"
+	"strings"
 
 	"github.com/G-Research/fasttrackml/pkg/api/aim/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/dao/models"
 	"github.com/G-Research/fasttrackml/pkg/common/api"
 )
 
@@ -28,3 +30,22 @@ func ValidateGetRunInfoRequest(req *request.GetRunInfoRequest) error {
 	}
 	return nil
 }
+
+// ValidateSearchAggregatedMetricsRequest validates `POST /runs/search/metric/aggregate` request.
+func ValidateSearchAggregatedMetricsRequest(req *request.SearchAggregatedMetricsRequest) error {
+	if len(req.Metrics) == 0 {
+		return api.NewInvalidParameterValueError("No metrics are selected")
+	}
+	for _, field := range req.GroupBy {
+		switch {
+		case field == models.MetricAggregationGroupByExperiment:
+		case strings.HasPrefix(field, models.MetricAggregationGroupByTagPrefix) &&
+			len(field) > len(models.MetricAggregationGroupByTagPrefix):
+		case strings.HasPrefix(field, models.MetricAggregationGroupByParamPrefix) &&
+			len(field) > len(models.MetricAggregationGroupByParamPrefix):
+		default:
+			return api.NewInvalidParameterValueError("%q is not a valid group by field", field)
+		}
+	}
+	return nil
+}
@@ -87,3 +87,74 @@ type LogArtifactRequest struct {
 	Format  string `json:"format"`
 	BlobURI string `json:"blob_uri"`
 }
+
+// LogTextRequest is a request object for `POST mlflow/runs/log-text` endpoint.
+type LogTextRequest struct {
+	RunID   string         `json:"run_id"`
+	Name    string         `json:"name"`
+	Text    string         `json:"text"`
+	Step    int64          `json:"step"`
+	Index   int64          `json:"index"`
+	Context map[string]any `json:"context"`
+}
+
+// LogDistributionRequest is a request object for `POST mlflow/runs/log-distribution` endpoint.
+type LogDistributionRequest struct {
+	RunID    string         `json:"run_id"`
+	Name     string         `json:"name"`
+	Step     int64          `json:"step"`
+	BinEdges []float64      `json:"bin_edges"`
+	Counts   []float64      `json:"counts"`
+	Context  map[string]any `json:"context"`
+}
+
+// LogFigureRequest is a request object for `POST mlflow/runs/log-figure` endpoint.
+type LogFigureRequest struct {
+	RunID   string         `json:"run_id"`
+	Name    string         `json:"name"`
+	Step    int64          `json:"step"`
+	Caption string         `json:"caption"`
+	BlobURI string         `json:"blob_uri"`
+	Context map[string]any `json:"context"`
+}
+
+// LogAudioRequest is a request object for `POST mlflow/runs/log-audio` endpoint.
+type LogAudioRequest struct {
+	RunID      string         `json:"run_id"`
+	Name       string         `json:"name"`
+	Step       int64          `json:"step"`
+	Index      int64          `json:"index"`
+	Caption    string         `json:"caption"`
+	Format     string         `json:"format"`
+	SampleRate int64          `json:"sample_rate"`
+	BlobURI    string         `json:"blob_uri"`
+	Context    map[string]any `json:"context"`
+}
+
+// DatasetPartialRequest is a partial request object for different requests.
+type DatasetPartialRequest struct {
+	Name       string `json:"name"`
+	Digest     string `json:"digest"`
+	SourceType string `json:"source_type"`
+	Source     string `json:"source"`
+	Schema     string `json:"schema"`
+	Profile    string `json:"profile"`
+}
+
+// InputTagPartialRequest is a partial request object for different requests.
+type InputTagPartialRequest struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// DatasetInputPartialRequest is a partial request object for different requests.
+type DatasetInputPartialRequest struct {
+	Tags    []InputTagPartialRequest `json:"tags"`
+	Dataset DatasetPartialRequest    `json:"dataset"`
+}
+
+// LogInputsRequest is a request object for `POST mlflow/runs/log-inputs` endpoint.
+type LogInputsRequest struct {
+	RunID    string                       `json:"run_id"`
+	Datasets []DatasetInputPartialRequest `json:"datasets"`
+}
@@ -1,10 +1,33 @@
 package request
 
+// DownsamplingMethod represents the method used to downsample metric history.
+type DownsamplingMethod string
+
+// Supported downsampling methods.
+const (
+	DownsamplingMethodLTTB   DownsamplingMethod = "lttb"
+	DownsamplingMethodMinMax DownsamplingMethod = "minmax"
+)
+
+// MetricDownsamplingPartialRequest is a partial request object with FastTrackML extension
+// which allows to restrict metric history to a step range and to downsample each series.
+type MetricDownsamplingPartialRequest struct {
+	Downsampling DownsamplingMethod `json:"downsampling" query:"downsampling"`
+	Points       int32              `json:"points"       query:"points"`
+	StartStep    *int64             `json:"start_step"   query:"start_step"`
+	EndStep      *int64             `json:"end_step"     query:"end_step"`
+}
+
 // GetMetricHistoryRequest is a request object for `GET /mlflow/metrics/get-history` endpoint.
+// Context is a JSON object which selects metrics with matching context, e.g. `{"subset": "val"}`.
 type GetMetricHistoryRequest struct {
-	RunID     string `query:"run_id"`
-	RunUUID   string `query:"run_uuid"`
-	MetricKey string `query:"metric_key"`
+	MetricDownsamplingPartialRequest
+	RunID      string `query:"run_id"`
+	RunUUID    string `query:"run_uuid"`
+	MetricKey  string `query:"metric_key"`
+	Context    string `query:"context"`
+	MaxResults int32  `query:"max_results"`
+	PageToken  string `query:"page_token"`
 }
 
 // GetRunID returns Run RunID.
@@ -16,18 +39,24 @@ func (r GetMetricHistoryRequest) GetRunID() string {
 }
 
 // GetMetricHistoryBulkRequest is a request object for `GET /mlflow/metrics/get-history-bulk` endpoint.
+// Context is a JSON object which selects metrics with matching context, e.g. `{"subset": "val"}`.
 type GetMetricHistoryBulkRequest struct {
+	MetricDownsamplingPartialRequest
 	RunIDs     []string `query:"run_id"`
 	MetricKey  string   `query:"metric_key"`
+	Context    string   `query:"context"`
 	MaxResults int      `query:"max_results"`
+	PageToken  string   `query:"page_token"`
 }
 
 // GetMetricHistoriesRequest is a request object for `POST /mlflow/metrics/get-histories` endpoint.
 type GetMetricHistoriesRequest struct {
+	MetricDownsamplingPartialRequest
 	ExperimentIDs []string          `json:"experiment_ids"`
 	RunIDs        []string          `json:"run_ids"`
 	MetricKeys    []string          `json:"metric_keys"`
 	ViewType      ViewType          `json:"run_view_type"`
 	MaxResults    int32             `json:"max_results"`
+	PageToken     string            `json:"page_token"`
 	Context       map[string]string `json:"context"`
 }
@@ -0,0 +1,97 @@
+package request
+
+// TraceTagPartialRequest is a partial request object for different requests.
+type TraceTagPartialRequest struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// TraceRequestMetadataPartialRequest is a partial request object for different requests.
+type TraceRequestMetadataPartialRequest struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// TraceSpanContextPartialRequest is a partial request object for different requests.
+type TraceSpanContextPartialRequest struct {
+	SpanID  string `json:"span_id"`
+	TraceID string `json:"trace_id"`
+}
+
+// TraceSpanPartialRequest is a partial request object for different requests.
+// It follows the format of span which mlflow client keeps in the trace data.
+type TraceSpanPartialRequest struct {
+	Name          string                         `json:"name"`
+	Context       TraceSpanContextPartialRequest `json:"context"`
+	ParentID      string                         `json:"parent_id"`
+	StartTime     int64                          `json:"start_time"`
+	EndTime       int64                          `json:"end_time"`
+	StatusCode    string                         `json:"status_code"`
+	StatusMessage string                         `json:"status_message"`
+	Attributes    map[string]any                 `json:"attributes"`
+	Events        []any                          `json:"events"`
+}
+
+// TraceDataPartialRequest is a partial request object for different requests.
+type TraceDataPartialRequest struct {
+	Spans []TraceSpanPartialRequest `json:"spans"`
+}
+
+// StartTraceRequest is a request object for `POST /mlflow/traces` endpoint.
+type StartTraceRequest struct {
+	ExperimentID    string                               `json:"experiment_id"`
+	TimestampMs     int64                                `json:"timestamp_ms"`
+	RequestMetadata []TraceRequestMetadataPartialRequest `json:"request_metadata"`
+	Tags            []TraceTagPartialRequest             `json:"tags"`
+}
+
+// EndTraceRequest is a request object for `PATCH /mlflow/traces/{request_id}` endpoint.
+// Spans are optional and, if provided, they are stored together with the trace data.
+type EndTraceRequest struct {
+	RequestID       string                               `json:"request_id"`
+	TimestampMs     int64                                `json:"timestamp_ms"`
+	Status          string                               `json:"status"`
+	RequestMetadata []TraceRequestMetadataPartialRequest `json:"request_metadata"`
+	Tags            []TraceTagPartialRequest             `json:"tags"`
+	Spans           []TraceSpanPartialRequest            `json:"spans"`
+}
+
+// GetTraceInfoRequest is a request object for `GET /mlflow/traces/{request_id}/info` endpoint.
+type GetTraceInfoRequest struct {
+	RequestID string `query:"request_id"`
+}
+
+// GetTraceRequest is a request object for `GET /mlflow/traces/{request_id}` endpoint.
+type GetTraceRequest struct {
+	RequestID string `query:"request_id"`
+}
+
+// SearchTracesRequest is a request object for `GET /mlflow/traces` endpoint.
+type SearchTracesRequest struct {
+	ExperimentIDs []string `json:"experiment_ids" query:"experiment_ids"`
+	Filter        string   `json:"filter"         query:"filter"`
+	MaxResults    int32    `json:"max_results"    query:"max_results"`
+	OrderBy       []string `json:"order_by"       query:"order_by"`
+	PageToken     string   `json:"page_token"     query:"page_token"`
+}
+
+// DeleteTracesRequest is a request object for `POST /mlflow/traces/delete-traces` endpoint.
+type DeleteTracesRequest struct {
+	ExperimentID       string   `json:"experiment_id"`
+	MaxTimestampMillis int64    `json:"max_timestamp_millis"`
+	MaxTraces          int32    `json:"max_traces"`
+	RequestIDs         []string `json:"request_ids"`
+}
+
+// SetTraceTagRequest is a request object for `PATCH /mlflow/traces/{request_id}/tags` endpoint.
+type SetTraceTagRequest struct {
+	RequestID string `json:"request_id"`
+	Key       string `json:"key"`
+	Value     string `json:"value"`
+}
+
+// DeleteTraceTagRequest is a request object for `DELETE /mlflow/traces/{request_id}/tags` endpoint.
+type DeleteTraceTagRequest struct {
+	RequestID string `json:"request_id" query:"request_id"`
+	Key       string `json:"key"        query:"key"`
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/rotisserie/eris"
 
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 )
@@ -30,13 +31,21 @@ type MetricPartialResponse struct {
 
 // GetMetricHistoryResponse is a response object for `GET mlflow/metrics/get-history` endpoint.
 type GetMetricHistoryResponse struct {
-	Metrics []MetricPartialResponse `json:"metrics"`
+	Metrics       []MetricPartialResponse `json:"metrics"`
+	NextPageToken string                  `json:"next_page_token,omitempty"`
 }
 
 // NewMetricHistoryResponse creates new GetMetricHistoryResponse object.
-func NewMetricHistoryResponse(metrics []models.Metric) (*GetMetricHistoryResponse, error) {
+// Zero limit means that metrics were not paginated.
+func NewMetricHistoryResponse(metrics []models.Metric, limit, offset int) (*GetMetricHistoryResponse, error) {
+	token, metrics, err := newMetricHistoryNextPageToken(metrics, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
 	resp := GetMetricHistoryResponse{
-		Metrics: make([]MetricPartialResponse, len(metrics)),
+		Metrics:       make([]MetricPartialResponse, len(metrics)),
+		NextPageToken: token,
 	}
 
 	mappedContext := map[string]map[string]any{}
@@ -69,13 +78,20 @@ func NewMetricHistoryResponse(metrics []models.Metric) (*GetMetricHistoryRespons
 
 // GetMetricHistoryBulkResponse is a response object for `GET mlflow/metrics/get-history-bulk` endpoint.
 type GetMetricHistoryBulkResponse struct {
-	Metrics []MetricPartialResponseBulk `json:"metrics"`
+	Metrics       []MetricPartialResponseBulk `json:"metrics"`
+	NextPageToken string                      `json:"next_page_token,omitempty"`
 }
 
 // NewMetricHistoryBulkResponse creates new GetMetricHistoryBulkResponse object.
-func NewMetricHistoryBulkResponse(metrics []models.Metric) *GetMetricHistoryBulkResponse {
+func NewMetricHistoryBulkResponse(metrics []models.Metric, limit, offset int) (*GetMetricHistoryBulkResponse, error) {
+	token, metrics, err := newMetricHistoryNextPageToken(metrics, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
 	resp := GetMetricHistoryBulkResponse{
-		Metrics: make([]MetricPartialResponseBulk, len(metrics)),
+		Metrics:       make([]MetricPartialResponseBulk, len(metrics)),
+		NextPageToken: token,
 	}
 
 	for n, m := range metrics {
@@ -90,5 +106,32 @@ func NewMetricHistoryBulkResponse(metrics []models.Metric) *GetMetricHistoryBulk
 			resp.Metrics[n].Value = common.NANValue
 		}
 	}
-	return &resp
+	return &resp, nil
+}
+
+// NewMetricHistoriesNextPageToken encodes `next_page_token` value of `POST mlflow/metrics/get-histories`
+// endpoint, which is returned in the header of the Arrow stream.
+func NewMetricHistoriesNextPageToken(token *request.PageToken) (string, error) {
+	if token == nil {
+		return "", nil
+	}
+	return newModelNextPageToken(1, 0, int(token.Offset))
+}
+
+// newMetricHistoryNextPageToken encodes `next_page_token` value of metric history and trims
+// extra metric which was requested to detect the next page.
+func newMetricHistoryNextPageToken(
+	metrics []models.Metric, limit, offset int,
+) (string, []models.Metric, error) {
+	if limit == 0 {
+		return "", metrics, nil
+	}
+	token, err := newModelNextPageToken(len(metrics), limit, offset)
+	if err != nil {
+		return "", nil, err
+	}
+	if len(metrics) > limit {
+		metrics = metrics[:limit]
+	}
+	return token, metrics, nil
 }
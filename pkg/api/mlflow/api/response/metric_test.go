@@ -103,7 +103,7 @@ func TestNewMetricHistoryResponse_Ok(t *testing.T) {
 
 	for _, tt := range testData {
 		t.Run(tt.name, func(t *testing.T) {
-			actualResponse, err := NewMetricHistoryResponse(tt.metrics)
+			actualResponse, err := NewMetricHistoryResponse(tt.metrics, 0, 0)
 			require.Nil(t, err)
 			assert.Equal(t, tt.expectedResponse, actualResponse)
 		})
@@ -170,8 +170,24 @@ func TestNewMetricHistoryBulkResponse_Ok(t *testing.T) {
 
 	for _, tt := range testData {
 		t.Run(tt.name, func(t *testing.T) {
-			actualResponse := NewMetricHistoryBulkResponse(tt.metrics)
+			actualResponse, err := NewMetricHistoryBulkResponse(tt.metrics, 10, 0)
+			require.Nil(t, err)
 			assert.Equal(t, tt.expectedResponse, actualResponse)
 		})
 	}
 }
+
+func TestNewMetricHistoryBulkResponse_WithNextPageToken(t *testing.T) {
+	actualResponse, err := NewMetricHistoryBulkResponse([]models.Metric{
+		{Key: "key", Value: 1.1, Timestamp: 1234567890, RunID: "run_id", Step: 1},
+		{Key: "key", Value: 2.2, Timestamp: 1234567891, RunID: "run_id", Step: 2},
+	}, 1, 2)
+	require.Nil(t, err)
+	assert.Equal(t, &GetMetricHistoryBulkResponse{
+		Metrics: []MetricPartialResponseBulk{
+			{RunID: "run_id", Key: "key", Value: 1.1, Timestamp: 1234567890, Step: 1},
+		},
+		// base64 encoded `{"offset":3}`.
+		NextPageToken: "eyJvZmZzZXQiOjN9Cg==",
+	}, actualResponse)
+}
@@ -0,0 +1,263 @@
+package response
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/rotisserie/eris"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+)
+
+// RegisteredModelTagPartialResponse is a partial response object for different responses.
+type RegisteredModelTagPartialResponse struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// RegisteredModelAliasPartialResponse is a partial response object for different responses.
+type RegisteredModelAliasPartialResponse struct {
+	Alias   string `json:"alias"`
+	Version string `json:"version"`
+}
+
+// ModelVersionTagPartialResponse is a partial response object for different responses.
+type ModelVersionTagPartialResponse struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// ModelVersionPartialResponse is a partial response object for different responses.
+type ModelVersionPartialResponse struct {
+	Name                 string                           `json:"name"`
+	Version              string                           `json:"version"`
+	CreationTimestamp    int64                            `json:"creation_timestamp"`
+	LastUpdatedTimestamp int64                            `json:"last_updated_timestamp"`
+	UserID               string                           `json:"user_id,omitempty"`
+	CurrentStage         string                           `json:"current_stage"`
+	Description          string                           `json:"description,omitempty"`
+	Source               string                           `json:"source,omitempty"`
+	RunID                string                           `json:"run_id,omitempty"`
+	Status               string                           `json:"status"`
+	StatusMessage        string                           `json:"status_message,omitempty"`
+	RunLink              string                           `json:"run_link,omitempty"`
+	Tags                 []ModelVersionTagPartialResponse `json:"tags,omitempty"`
+	Aliases              []string                         `json:"aliases,omitempty"`
+}
+
+// RegisteredModelPartialResponse is a partial response object for different responses.
+type RegisteredModelPartialResponse struct {
+	Name                 string                                `json:"name"`
+	CreationTimestamp    int64                                 `json:"creation_timestamp"`
+	LastUpdatedTimestamp int64                                 `json:"last_updated_timestamp"`
+	Description          string                                `json:"description,omitempty"`
+	LatestVersions       []ModelVersionPartialResponse         `json:"latest_versions,omitempty"`
+	Tags                 []RegisteredModelTagPartialResponse   `json:"tags,omitempty"`
+	Aliases              []RegisteredModelAliasPartialResponse `json:"aliases,omitempty"`
+}
+
+// RegisteredModelResponse is a response object for `POST /mlflow/registered-models/create`,
+// `GET /mlflow/registered-models/get`, `POST /mlflow/registered-models/rename` and
+// `PATCH /mlflow/registered-models/update` endpoints.
+type RegisteredModelResponse struct {
+	RegisteredModel *RegisteredModelPartialResponse `json:"registered_model"`
+}
+
+// NewRegisteredModelResponse creates new RegisteredModelResponse object.
+func NewRegisteredModelResponse(model *models.RegisteredModel) *RegisteredModelResponse {
+	return &RegisteredModelResponse{
+		RegisteredModel: NewRegisteredModelPartialResponse(model),
+	}
+}
+
+// SearchRegisteredModelsResponse is a response object for `GET /mlflow/registered-models/search` endpoint.
+type SearchRegisteredModelsResponse struct {
+	RegisteredModels []*RegisteredModelPartialResponse `json:"registered_models"`
+	NextPageToken    string                            `json:"next_page_token,omitempty"`
+}
+
+// NewSearchRegisteredModelsResponse creates new SearchRegisteredModelsResponse object.
+func NewSearchRegisteredModelsResponse(
+	registeredModels []models.RegisteredModel, limit, offset int,
+) (*SearchRegisteredModelsResponse, error) {
+	token, err := newModelNextPageToken(len(registeredModels), limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	if len(registeredModels) > limit {
+		registeredModels = registeredModels[:limit]
+	}
+
+	resp := SearchRegisteredModelsResponse{
+		RegisteredModels: make([]*RegisteredModelPartialResponse, 0, len(registeredModels)),
+		NextPageToken:    token,
+	}
+	for _, registeredModel := range registeredModels {
+		//nolint:gosec
+		resp.RegisteredModels = append(resp.RegisteredModels, NewRegisteredModelPartialResponse(&registeredModel))
+	}
+	return &resp, nil
+}
+
+// ModelVersionResponse is a response object for `POST /mlflow/model-versions/create`,
+// `GET /mlflow/model-versions/get`, `PATCH /mlflow/model-versions/update`,
+// `POST /mlflow/model-versions/transition-stage` and `GET /mlflow/registered-models/alias` endpoints.
+type ModelVersionResponse struct {
+	ModelVersion *ModelVersionPartialResponse `json:"model_version"`
+}
+
+// NewModelVersionResponse creates new ModelVersionResponse object.
+func NewModelVersionResponse(
+	registeredModel *models.RegisteredModel, modelVersion *models.ModelVersion,
+) *ModelVersionResponse {
+	return &ModelVersionResponse{
+		ModelVersion: NewModelVersionPartialResponse(registeredModel, modelVersion),
+	}
+}
+
+// GetLatestVersionsResponse is a response object for `POST /mlflow/registered-models/get-latest-versions` endpoint.
+type GetLatestVersionsResponse struct {
+	ModelVersions []*ModelVersionPartialResponse `json:"model_versions"`
+}
+
+// NewGetLatestVersionsResponse creates new GetLatestVersionsResponse object.
+func NewGetLatestVersionsResponse(
+	registeredModel *models.RegisteredModel, modelVersions []models.ModelVersion,
+) *GetLatestVersionsResponse {
+	resp := GetLatestVersionsResponse{
+		ModelVersions: make([]*ModelVersionPartialResponse, 0, len(modelVersions)),
+	}
+	for _, modelVersion := range modelVersions {
+		//nolint:gosec
+		resp.ModelVersions = append(resp.ModelVersions, NewModelVersionPartialResponse(registeredModel, &modelVersion))
+	}
+	return &resp
+}
+
+// SearchModelVersionsResponse is a response object for `GET /mlflow/model-versions/search` endpoint.
+type SearchModelVersionsResponse struct {
+	ModelVersions []*ModelVersionPartialResponse `json:"model_versions"`
+	NextPageToken string                         `json:"next_page_token,omitempty"`
+}
+
+// NewSearchModelVersionsResponse creates new SearchModelVersionsResponse object.
+func NewSearchModelVersionsResponse(
+	modelVersions []models.ModelVersion, limit, offset int,
+) (*SearchModelVersionsResponse, error) {
+	token, err := newModelNextPageToken(len(modelVersions), limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	if len(modelVersions) > limit {
+		modelVersions = modelVersions[:limit]
+	}
+
+	resp := SearchModelVersionsResponse{
+		ModelVersions: make([]*ModelVersionPartialResponse, 0, len(modelVersions)),
+		NextPageToken: token,
+	}
+	for _, modelVersion := range modelVersions {
+		resp.ModelVersions = append(
+			resp.ModelVersions,
+			//nolint:gosec
+			NewModelVersionPartialResponse(&modelVersion.RegisteredModel, &modelVersion),
+		)
+	}
+	return &resp, nil
+}
+
+// GetModelVersionDownloadURIResponse is a response object for
+// `GET /mlflow/model-versions/get-download-uri` endpoint.
+type GetModelVersionDownloadURIResponse struct {
+	ArtifactURI string `json:"artifact_uri"`
+}
+
+// NewGetModelVersionDownloadURIResponse creates new GetModelVersionDownloadURIResponse object.
+func NewGetModelVersionDownloadURIResponse(modelVersion *models.ModelVersion) *GetModelVersionDownloadURIResponse {
+	uri := modelVersion.StorageLocation
+	if uri == "" {
+		uri = modelVersion.Source
+	}
+	return &GetModelVersionDownloadURIResponse{
+		ArtifactURI: uri,
+	}
+}
+
+// NewRegisteredModelPartialResponse is a helper function to convert models.RegisteredModel
+// into RegisteredModelPartialResponse object.
+func NewRegisteredModelPartialResponse(registeredModel *models.RegisteredModel) *RegisteredModelPartialResponse {
+	resp := RegisteredModelPartialResponse{
+		Name:                 registeredModel.Name,
+		CreationTimestamp:    registeredModel.CreationTime,
+		LastUpdatedTimestamp: registeredModel.LastUpdatedTime,
+		Description:          registeredModel.Description,
+	}
+	for _, version := range registeredModel.LatestVersions() {
+		//nolint:gosec
+		resp.LatestVersions = append(resp.LatestVersions, *NewModelVersionPartialResponse(registeredModel, &version))
+	}
+	for _, tag := range registeredModel.Tags {
+		resp.Tags = append(resp.Tags, RegisteredModelTagPartialResponse{
+			Key:   tag.Key,
+			Value: tag.Value,
+		})
+	}
+	for _, alias := range registeredModel.Aliases {
+		resp.Aliases = append(resp.Aliases, RegisteredModelAliasPartialResponse{
+			Alias:   alias.Alias,
+			Version: fmt.Sprint(alias.Version),
+		})
+	}
+	return &resp
+}
+
+// NewModelVersionPartialResponse is a helper function to convert models.ModelVersion
+// into ModelVersionPartialResponse object.
+func NewModelVersionPartialResponse(
+	registeredModel *models.RegisteredModel, modelVersion *models.ModelVersion,
+) *ModelVersionPartialResponse {
+	resp := ModelVersionPartialResponse{
+		Name:                 registeredModel.Name,
+		Version:              fmt.Sprint(modelVersion.Version),
+		CreationTimestamp:    modelVersion.CreationTime,
+		LastUpdatedTimestamp: modelVersion.LastUpdatedTime,
+		UserID:               modelVersion.UserID,
+		CurrentStage:         string(modelVersion.CurrentStage),
+		Description:          modelVersion.Description,
+		Source:               modelVersion.Source,
+		RunID:                modelVersion.RunID,
+		Status:               string(modelVersion.Status),
+		StatusMessage:        modelVersion.StatusMessage,
+		RunLink:              modelVersion.RunLink,
+	}
+	for _, tag := range modelVersion.Tags {
+		resp.Tags = append(resp.Tags, ModelVersionTagPartialResponse{
+			Key:   tag.Key,
+			Value: tag.Value,
+		})
+	}
+	if aliases := modelVersion.Aliases(registeredModel.Aliases); len(aliases) > 0 {
+		resp.Aliases = aliases
+	}
+	return &resp
+}
+
+// newModelNextPageToken encodes `next_page_token` value when there are more results than requested.
+func newModelNextPageToken(count, limit, offset int) (string, error) {
+	var token strings.Builder
+	if count > limit {
+		encoder := base64.NewEncoder(base64.StdEncoding, &token)
+		if err := json.NewEncoder(encoder).Encode(request.PageToken{
+			Offset: int32(offset + limit),
+		}); err != nil {
+			return "", eris.Wrap(err, "error encoding 'nextPageToken' value")
+		}
+		if err := encoder.Close(); err != nil {
+			return "", eris.Wrap(err, "error flushing 'nextPageToken' value")
+		}
+	}
+	return token.String(), nil
+}
@@ -26,11 +26,13 @@ type RunParamPartialResponse struct {
 }
 
 // RunMetricPartialResponse is a partial response object for different responses.
+// Context is omitted for metrics logged with the default context.
 type RunMetricPartialResponse struct {
-	Key       string `json:"key"`
-	Value     any    `json:"value"`
-	Timestamp int64  `json:"timestamp"`
-	Step      int64  `json:"step"`
+	Key       string         `json:"key"`
+	Value     any            `json:"value"`
+	Timestamp int64          `json:"timestamp"`
+	Step      int64          `json:"step"`
+	Context   map[string]any `json:"context,omitempty"`
 }
 
 // RunDataPartialResponse is a partial response object for different responses.
@@ -54,10 +56,38 @@ type RunInfoPartialResponse struct {
 	LifecycleStage string `json:"lifecycle_stage"`
 }
 
+// InputTagPartialResponse is a partial response object for different responses.
+type InputTagPartialResponse struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// DatasetPartialResponse is a partial response object for different responses.
+type DatasetPartialResponse struct {
+	Name       string `json:"name"`
+	Digest     string `json:"digest"`
+	SourceType string `json:"source_type"`
+	Source     string `json:"source"`
+	Schema     string `json:"schema,omitempty"`
+	Profile    string `json:"profile,omitempty"`
+}
+
+// DatasetInputPartialResponse is a partial response object for different responses.
+type DatasetInputPartialResponse struct {
+	Tags    []InputTagPartialResponse `json:"tags,omitempty"`
+	Dataset DatasetPartialResponse    `json:"dataset"`
+}
+
+// RunInputsPartialResponse is a partial response object for different responses.
+type RunInputsPartialResponse struct {
+	DatasetInputs []DatasetInputPartialResponse `json:"dataset_inputs,omitempty"`
+}
+
 // RunPartialResponse is a partial response object for different responses.
 type RunPartialResponse struct {
-	Info RunInfoPartialResponse `json:"info"`
-	Data RunDataPartialResponse `json:"data"`
+	Info   RunInfoPartialResponse   `json:"info"`
+	Data   RunDataPartialResponse   `json:"data"`
+	Inputs RunInputsPartialResponse `json:"inputs"`
 }
 
 // CreateRunResponse is a response object for `POST mlflow/runs/create` endpoint.
@@ -178,6 +208,11 @@ func NewRunPartialResponse(run *models.Run) *RunPartialResponse {
 		if m.IsNan {
 			metrics[n].Value = common.NANValue
 		}
+		// context is always stored as a valid JSON object, so it is safe to skip broken values.
+		if len(m.Context.Json) > 0 {
+			//nolint:errcheck,gosec
+			json.Unmarshal(m.Context.Json, &metrics[n].Context)
+		}
 	}
 
 	params := make([]RunParamPartialResponse, len(run.Params))
@@ -202,6 +237,30 @@ func NewRunPartialResponse(run *models.Run) *RunPartialResponse {
 		}
 	}
 
+	var datasetInputs []DatasetInputPartialResponse
+	for _, input := range run.Inputs {
+		if input.SourceType != models.InputSourceTypeDataset {
+			continue
+		}
+		datasetInput := DatasetInputPartialResponse{
+			Dataset: DatasetPartialResponse{
+				Name:       input.Dataset.Name,
+				Digest:     input.Dataset.Digest,
+				SourceType: input.Dataset.SourceType,
+				Source:     input.Dataset.Source,
+				Schema:     input.Dataset.Schema,
+				Profile:    input.Dataset.Profile,
+			},
+		}
+		for _, tag := range input.Tags {
+			datasetInput.Tags = append(datasetInput.Tags, InputTagPartialResponse{
+				Key:   tag.Name,
+				Value: tag.Value,
+			})
+		}
+		datasetInputs = append(datasetInputs, datasetInput)
+	}
+
 	return &RunPartialResponse{
 		Info: RunInfoPartialResponse{
 			ID:             run.ID,
@@ -220,5 +279,8 @@ func NewRunPartialResponse(run *models.Run) *RunPartialResponse {
 			Params:  params,
 			Tags:    tags,
 		},
+		Inputs: RunInputsPartialResponse{
+			DatasetInputs: datasetInputs,
+		},
 	}
 }
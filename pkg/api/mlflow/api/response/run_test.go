@@ -8,6 +8,7 @@ import (
 
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/types"
 )
 
 func TestNewRunPartialResponse(t *testing.T) {
@@ -208,6 +209,107 @@ func TestNewRunPartialResponse(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "WithDatasetInputs",
+			run: &models.Run{
+				Params:        []models.Param{},
+				Tags:          []models.Tag{},
+				LatestMetrics: []models.LatestMetric{},
+				Inputs: []models.Input{
+					{
+						SourceType:      models.InputSourceTypeDataset,
+						DestinationType: models.InputDestinationTypeRun,
+						DestinationID:   "ID",
+						Dataset: models.Dataset{
+							Name:       "Name",
+							Digest:     "Digest",
+							SourceType: "SourceType",
+							Source:     "Source",
+							Schema:     "Schema",
+						},
+						Tags: []models.InputTag{
+							{
+								Name:  models.InputTagContext,
+								Value: "training",
+							},
+						},
+					},
+				},
+			},
+			expectedResponse: &RunPartialResponse{
+				Info: RunInfoPartialResponse{
+					ExperimentID: "0",
+				},
+				Data: RunDataPartialResponse{
+					Tags:    []RunTagPartialResponse{},
+					Params:  []RunParamPartialResponse{},
+					Metrics: []RunMetricPartialResponse{},
+				},
+				Inputs: RunInputsPartialResponse{
+					DatasetInputs: []DatasetInputPartialResponse{{
+						Tags: []InputTagPartialResponse{{
+							Key:   models.InputTagContext,
+							Value: "training",
+						}},
+						Dataset: DatasetPartialResponse{
+							Name:       "Name",
+							Digest:     "Digest",
+							SourceType: "SourceType",
+							Source:     "Source",
+							Schema:     "Schema",
+						},
+					}},
+				},
+			},
+		},
+		{
+			name: "WithMetricContext",
+			run: &models.Run{
+				Params: []models.Param{},
+				Tags:   []models.Tag{},
+				LatestMetrics: []models.LatestMetric{
+					{
+						Key:       "loss",
+						Value:     0.1,
+						Timestamp: 1234567890,
+						Step:      1,
+						Context:   models.Context{Json: types.JSONB(`{"subset": "val"}`)},
+					},
+					{
+						Key:       "loss",
+						Value:     0.2,
+						Timestamp: 1234567890,
+						Step:      1,
+						Context:   models.DefaultContext,
+					},
+				},
+			},
+			expectedResponse: &RunPartialResponse{
+				Info: RunInfoPartialResponse{
+					ExperimentID: "0",
+				},
+				Data: RunDataPartialResponse{
+					Tags:   []RunTagPartialResponse{},
+					Params: []RunParamPartialResponse{},
+					Metrics: []RunMetricPartialResponse{
+						{
+							Key:       "loss",
+							Value:     0.1,
+							Timestamp: 1234567890,
+							Step:      1,
+							Context:   map[string]any{"subset": "val"},
+						},
+						{
+							Key:       "loss",
+							Value:     0.2,
+							Timestamp: 1234567890,
+							Step:      1,
+							Context:   map[string]any{},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "WithTagKeyName",
 			run: &models.Run{
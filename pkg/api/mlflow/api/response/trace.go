@@ -0,0 +1,176 @@
+package response
+
+import (
+	"fmt"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/types"
+)
+
+// TraceTagPartialResponse is a partial response object for different responses.
+type TraceTagPartialResponse struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// TraceRequestMetadataPartialResponse is a partial response object for different responses.
+type TraceRequestMetadataPartialResponse struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// TraceInfoPartialResponse is a partial response object for different responses.
+type TraceInfoPartialResponse struct {
+	RequestID       string                                `json:"request_id"`
+	ExperimentID    string                                `json:"experiment_id"`
+	TimestampMs     int64                                 `json:"timestamp_ms"`
+	ExecutionTimeMs *int64                                `json:"execution_time_ms,omitempty"`
+	Status          string                                `json:"status"`
+	RequestMetadata []TraceRequestMetadataPartialResponse `json:"request_metadata"`
+	Tags            []TraceTagPartialResponse             `json:"tags"`
+}
+
+// TraceSpanContextPartialResponse is a partial response object for different responses.
+type TraceSpanContextPartialResponse struct {
+	SpanID  string `json:"span_id"`
+	TraceID string `json:"trace_id"`
+}
+
+// TraceSpanPartialResponse is a partial response object for different responses.
+type TraceSpanPartialResponse struct {
+	Name          string                          `json:"name"`
+	Context       TraceSpanContextPartialResponse `json:"context"`
+	ParentID      string                          `json:"parent_id,omitempty"`
+	StartTime     int64                           `json:"start_time"`
+	EndTime       int64                           `json:"end_time"`
+	StatusCode    string                          `json:"status_code"`
+	StatusMessage string                          `json:"status_message"`
+	Attributes    types.JSONB                     `json:"attributes"`
+	Events        types.JSONB                     `json:"events"`
+}
+
+// TraceDataPartialResponse is a partial response object for different responses.
+type TraceDataPartialResponse struct {
+	Spans []TraceSpanPartialResponse `json:"spans"`
+}
+
+// TracePartialResponse is a partial response object for different responses.
+type TracePartialResponse struct {
+	Info *TraceInfoPartialResponse `json:"info"`
+	Data TraceDataPartialResponse  `json:"data"`
+}
+
+// TraceInfoResponse is a response object for `POST /mlflow/traces`, `PATCH /mlflow/traces/{request_id}`
+// and `GET /mlflow/traces/{request_id}/info` endpoints.
+type TraceInfoResponse struct {
+	TraceInfo *TraceInfoPartialResponse `json:"trace_info"`
+}
+
+// NewTraceInfoResponse creates new TraceInfoResponse object.
+func NewTraceInfoResponse(trace *models.Trace) *TraceInfoResponse {
+	return &TraceInfoResponse{
+		TraceInfo: NewTraceInfoPartialResponse(trace),
+	}
+}
+
+// GetTraceResponse is a response object for `GET /mlflow/traces/{request_id}` endpoint.
+type GetTraceResponse struct {
+	Trace TracePartialResponse `json:"trace"`
+}
+
+// NewGetTraceResponse creates new GetTraceResponse object.
+func NewGetTraceResponse(trace *models.Trace, spans []models.TraceSpan) *GetTraceResponse {
+	resp := GetTraceResponse{
+		Trace: TracePartialResponse{
+			Info: NewTraceInfoPartialResponse(trace),
+			Data: TraceDataPartialResponse{
+				Spans: make([]TraceSpanPartialResponse, 0, len(spans)),
+			},
+		},
+	}
+	for _, span := range spans {
+		resp.Trace.Data.Spans = append(resp.Trace.Data.Spans, TraceSpanPartialResponse{
+			Name: span.Name,
+			Context: TraceSpanContextPartialResponse{
+				SpanID:  span.SpanID,
+				TraceID: span.TraceID,
+			},
+			ParentID:      span.ParentID,
+			StartTime:     span.StartTimeNs,
+			EndTime:       span.EndTimeNs,
+			StatusCode:    span.StatusCode,
+			StatusMessage: span.StatusMessage,
+			Attributes:    span.Attributes,
+			Events:        span.Events,
+		})
+	}
+	return &resp
+}
+
+// SearchTracesResponse is a response object for `GET /mlflow/traces` endpoint.
+type SearchTracesResponse struct {
+	Traces        []*TraceInfoPartialResponse `json:"traces"`
+	NextPageToken string                      `json:"next_page_token,omitempty"`
+}
+
+// NewSearchTracesResponse creates new SearchTracesResponse object.
+func NewSearchTracesResponse(traces []models.Trace, limit, offset int) (*SearchTracesResponse, error) {
+	token, err := newModelNextPageToken(len(traces), limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	if len(traces) > limit {
+		traces = traces[:limit]
+	}
+
+	resp := SearchTracesResponse{
+		Traces:        make([]*TraceInfoPartialResponse, 0, len(traces)),
+		NextPageToken: token,
+	}
+	for _, trace := range traces {
+		//nolint:gosec
+		resp.Traces = append(resp.Traces, NewTraceInfoPartialResponse(&trace))
+	}
+	return &resp, nil
+}
+
+// DeleteTracesResponse is a response object for `POST /mlflow/traces/delete-traces` endpoint.
+type DeleteTracesResponse struct {
+	TracesDeleted int64 `json:"traces_deleted"`
+}
+
+// NewDeleteTracesResponse creates new DeleteTracesResponse object.
+func NewDeleteTracesResponse(deleted int64) *DeleteTracesResponse {
+	return &DeleteTracesResponse{
+		TracesDeleted: deleted,
+	}
+}
+
+// NewTraceInfoPartialResponse is a helper function to convert models.Trace
+// into TraceInfoPartialResponse object.
+func NewTraceInfoPartialResponse(trace *models.Trace) *TraceInfoPartialResponse {
+	resp := TraceInfoPartialResponse{
+		RequestID:       trace.ID,
+		ExperimentID:    fmt.Sprint(trace.ExperimentID),
+		TimestampMs:     trace.TimestampMs,
+		Status:          string(trace.Status),
+		RequestMetadata: make([]TraceRequestMetadataPartialResponse, 0, len(trace.RequestMetadata)),
+		Tags:            make([]TraceTagPartialResponse, 0, len(trace.Tags)),
+	}
+	if trace.ExecutionTimeMs.Valid {
+		resp.ExecutionTimeMs = &trace.ExecutionTimeMs.Int64
+	}
+	for _, metadata := range trace.RequestMetadata {
+		resp.RequestMetadata = append(resp.RequestMetadata, TraceRequestMetadataPartialResponse{
+			Key:   metadata.Key,
+			Value: metadata.Value,
+		})
+	}
+	for _, tag := range trace.Tags {
+		resp.Tags = append(resp.Tags, TraceTagPartialResponse{
+			Key:   tag.Key,
+			Value: tag.Value,
+		})
+	}
+	return &resp
+}
@@ -2,8 +2,11 @@ package common
 
 import (
 	"mime"
+	"net/url"
 	"path"
 	"slices"
+	"strings"
+	"unicode"
 )
 
 // textTypes used by GetContentType.
@@ -52,3 +55,16 @@ func GetContentType(filename string) string {
 	}
 	return "application/octet-stream"
 }
+
+// GetContentDisposition returns `attachment` Content-Disposition header value for provided file name.
+// The name is quoted and escaped, and non-ASCII name is additionally encoded according to RFC 6266.
+func GetContentDisposition(filename string) string {
+	value := `attachment; filename="` +
+		strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\r", "", "\n", "").Replace(filename) + `"`
+	for _, r := range filename {
+		if r > unicode.MaxASCII {
+			return value + `; filename*=UTF-8''` + strings.ReplaceAll(url.QueryEscape(filename), "+", "%20")
+		}
+	}
+	return value
+}
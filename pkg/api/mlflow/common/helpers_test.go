@@ -39,3 +39,37 @@ func TestGetContentType(t *testing.T) {
 		assert.Equal(t, tt.expected, result, "Unexpected content type for filename: %s", tt.filename)
 	}
 }
+
+func TestGetContentDisposition(t *testing.T) {
+	tests := []struct {
+		name     string
+		filename string
+		expected string
+	}{
+		{
+			name:     "SimpleName",
+			filename: "checkpoint.zip",
+			expected: `attachment; filename="checkpoint.zip"`,
+		},
+		{
+			name:     "NameWithSpecialCharacters",
+			filename: `my "best"; model\v1.tar.gz`,
+			expected: `attachment; filename="my \"best\"; model\\v1.tar.gz"`,
+		},
+		{
+			name:     "NameWithLineBreaks",
+			filename: "model\r\nSet-Cookie: a=b.zip",
+			expected: `attachment; filename="modelSet-Cookie: a=b.zip"`,
+		},
+		{
+			name:     "NonASCIIName",
+			filename: "modèle 1.zip",
+			expected: `attachment; filename="modèle 1.zip"; filename*=UTF-8''mod%C3%A8le%201.zip`,
+		},
+	}
+
+	for _, tt := range tests {
+		result := GetContentDisposition(tt.filename)
+		assert.Equal(t, tt.expected, result, "Unexpected content disposition for filename: %s", tt.filename)
+	}
+}
@@ -92,3 +92,49 @@ func (c Controller) GetArtifact(ctx *fiber.Ctx) error {
 	})
 	return nil
 }
+
+// DownloadArtifacts handles `GET /artifacts/download` endpoint.
+func (c Controller) DownloadArtifacts(ctx *fiber.Ctx) error {
+	req := request.DownloadArtifactsRequest{}
+	if err := ctx.QueryParser(&req); err != nil {
+		return api.NewBadRequestError(err.Error())
+	}
+	log.Debugf("downloadArtifacts request: %#v", req)
+
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("downloadArtifacts namespace: %s", ns.Code)
+
+	archive, err := c.artifactService.DownloadArtifacts(ctx.Context(), ns, &req)
+	if err != nil {
+		return err
+	}
+
+	ctx.Set("Content-Type", archive.GetContentType())
+	ctx.Set("Content-Disposition", common.GetContentDisposition(archive.Name))
+	ctx.Set("X-Content-Type-Options", "nosniff")
+	requestCtx := ctx.Context()
+	ctx.Context().Response.SetBodyStreamWriter(func(w *bufio.Writer) {
+		start := time.Now()
+		if err := func() error {
+			if err := archive.Write(requestCtx, w); err != nil {
+				return eris.Wrap(err, "error writing artifact archive to output stream")
+			}
+			if err := w.Flush(); err != nil {
+				return eris.Wrap(err, "error flushing output stream")
+			}
+			return nil
+		}(); err != nil {
+			log.Errorf(
+				"error encountered in %s %s: error streaming artifact archive: %s",
+				ctx.Method(),
+				ctx.Path(),
+				err,
+			)
+		}
+		log.Infof("body - %s %s %s", time.Since(start), ctx.Method(), ctx.Path())
+	})
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/services/metric"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/services/model"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/services/run"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/services/trace"
 	"github.com/G-Research/fasttrackml/pkg/common/services/artifact"
 )
 
@@ -15,6 +16,7 @@ type Controller struct {
 	metricService     *metric.Service
 	artifactService   *artifact.Service
 	experimentService *experiment.Service
+	traceService      *trace.Service
 }
 
 // NewController creates new Controller instance.
@@ -24,6 +26,7 @@ func NewController(
 	metricService *metric.Service,
 	artifactService *artifact.Service,
 	experimentService *experiment.Service,
+	traceService *trace.Service,
 ) *Controller {
 	return &Controller{
 		runService:        runService,
@@ -31,5 +34,6 @@ func NewController(
 		metricService:     metricService,
 		artifactService:   artifactService,
 		experimentService: experimentService,
+		traceService:      traceService,
 	}
 }
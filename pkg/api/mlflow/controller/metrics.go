@@ -3,6 +3,7 @@ package controller
 import (
 	"bufio"
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/apache/arrow/go/v14/arrow"
@@ -15,11 +16,14 @@ import (
 
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 	"github.com/G-Research/fasttrackml/pkg/common/api"
 	"github.com/G-Research/fasttrackml/pkg/common/middleware"
-	"github.com/G-Research/fasttrackml/pkg/database"
 )
 
+// NextPageTokenHeader is the header which contains `next_page_token` value of the streamed metric histories.
+const NextPageTokenHeader = "X-Next-Page-Token"
+
 // GetMetricHistory handles `GET /metrics/get-history` endpoint.
 func (c Controller) GetMetricHistory(ctx *fiber.Ctx) error {
 	req := request.GetMetricHistoryRequest{}
@@ -32,12 +36,12 @@ func (c Controller) GetMetricHistory(ctx *fiber.Ctx) error {
 		return api.NewInternalError("error getting namespace from context")
 	}
 	log.Debugf("getMetricHistory namespace: %s", ns.Code)
-	metrics, err := c.metricService.GetMetricHistory(ctx.Context(), ns, &req)
+	metrics, limit, offset, err := c.metricService.GetMetricHistory(ctx.Context(), ns, &req)
 	if err != nil {
 		return err
 	}
 
-	resp, err := response.NewMetricHistoryResponse(metrics)
+	resp, err := response.NewMetricHistoryResponse(metrics, limit, offset)
 	if err != nil {
 		return err
 	}
@@ -60,12 +64,15 @@ func (c Controller) GetMetricHistoryBulk(ctx *fiber.Ctx) error {
 	}
 	log.Debugf("getMetricHistoryBulk namespace: %s", ns.Code)
 
-	metrics, err := c.metricService.GetMetricHistoryBulk(ctx.Context(), ns, &req)
+	metrics, limit, offset, err := c.metricService.GetMetricHistoryBulk(ctx.Context(), ns, &req)
 	if err != nil {
 		return err
 	}
 
-	resp := response.NewMetricHistoryBulkResponse(metrics)
+	resp, err := response.NewMetricHistoryBulkResponse(metrics, limit, offset)
+	if err != nil {
+		return err
+	}
 	log.Debugf("getMetricHistoryBulk response: %#v", resp)
 
 	return ctx.JSON(resp)
@@ -85,7 +92,31 @@ func (c Controller) GetMetricHistories(ctx *fiber.Ctx) error {
 	}
 	log.Debugf("getMetricHistories namespace: %s", ns.Code)
 
-	rows, iterator, err := c.metricService.GetMetricHistories(ctx.Context(), ns, &req)
+	if req.Downsampling != "" {
+		metrics, err := c.metricService.GetDownsampledMetricHistories(ctx.Context(), ns, &req)
+		if err != nil {
+			return err
+		}
+
+		ctx.Set("Content-Type", "application/octet-stream")
+		ctx.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+			start := time.Now()
+			if err := writeMetricHistories(w, func() (*models.Metric, error) {
+				if len(metrics) == 0 {
+					return nil, nil
+				}
+				metric := metrics[0]
+				metrics = metrics[1:]
+				return &metric, nil
+			}); err != nil {
+				log.Errorf("error encountered in %s %s: error streaming metrics: %s", ctx.Method(), ctx.Path(), err)
+			}
+			log.Infof("body - %s %s %s", time.Since(start), ctx.Method(), ctx.Path())
+		})
+		return nil
+	}
+
+	rows, iterator, nextPageToken, err := c.metricService.GetMetricHistories(ctx.Context(), ns, &req)
 	if err != nil {
 		return err
 	}
@@ -93,64 +124,90 @@ func (c Controller) GetMetricHistories(ctx *fiber.Ctx) error {
 		return api.NewInternalError("error getting query result: %s", err)
 	}
 
+	token, err := response.NewMetricHistoriesNextPageToken(nextPageToken)
+	if err != nil {
+		//nolint:errcheck,gosec
+		rows.Close()
+		return err
+	}
+	if token != "" {
+		ctx.Set(NextPageTokenHeader, token)
+	}
+
 	ctx.Set("Content-Type", "application/octet-stream")
 	ctx.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
 		//nolint:errcheck
 		defer rows.Close()
 
 		start := time.Now()
-		if err := func() error {
-			pool := memory.NewGoAllocator()
-			schema := arrow.NewSchema(
-				[]arrow.Field{
-					{Name: "run_id", Type: arrow.BinaryTypes.String},
-					{Name: "key", Type: arrow.BinaryTypes.String},
-					{Name: "step", Type: arrow.PrimitiveTypes.Int64},
-					{Name: "timestamp", Type: arrow.PrimitiveTypes.Int64},
-					{Name: "value", Type: arrow.PrimitiveTypes.Float64},
-					{Name: "context", Type: arrow.BinaryTypes.String},
-				},
-				nil,
-			)
-			writer := ipc.NewWriter(w, ipc.WithAllocator(pool), ipc.WithSchema(schema))
-			//nolint:errcheck
-			defer writer.Close()
-
-			b := array.NewRecordBuilder(pool, schema)
-			defer b.Release()
-
-			for i := 0; rows.Next(); i++ {
-				var m database.Metric
-				if err := iterator(rows, &m); err != nil {
-					return eris.Wrap(err, "error reading metric from iterator")
-				}
-				b.Field(0).(*array.StringBuilder).Append(m.RunID)
-				b.Field(1).(*array.StringBuilder).Append(m.Key)
-				b.Field(2).(*array.Int64Builder).Append(m.Step)
-				b.Field(3).(*array.Int64Builder).Append(m.Timestamp)
-				if m.IsNan {
-					b.Field(4).(*array.Float64Builder).AppendNull()
-				} else {
-					b.Field(4).(*array.Float64Builder).Append(m.Value)
-				}
-				b.Field(5).(*array.StringBuilder).Append(string(m.Context.Json))
-				if (i+1)%100000 == 0 {
-					if err := WriteStreamingRecord(writer, b.NewRecord()); err != nil {
-						return fmt.Errorf("unable to write Arrow record batch: %w", err)
-					}
-				}
+		if err := writeMetricHistories(w, func() (*models.Metric, error) {
+			if !rows.Next() {
+				return nil, nil
 			}
-			if b.Field(0).Len() > 0 {
-				if err := WriteStreamingRecord(writer, b.NewRecord()); err != nil {
-					return fmt.Errorf("unable to write Arrow record batch: %w", err)
-				}
+			var m models.Metric
+			if err := iterator(rows, &m); err != nil {
+				return nil, eris.Wrap(err, "error reading metric from iterator")
 			}
-
-			return nil
-		}(); err != nil {
+			return &m, nil
+		}); err != nil {
 			log.Errorf("error encountered in %s %s: error streaming metrics: %s", ctx.Method(), ctx.Path(), err)
 		}
 		log.Infof("body - %s %s %s", time.Since(start), ctx.Method(), ctx.Path())
 	})
 	return nil
 }
+
+// writeMetricHistories writes metrics into the Arrow stream. Metrics are read by the next function
+// until it returns nil.
+func writeMetricHistories(w io.Writer, next func() (*models.Metric, error)) error {
+	pool := memory.NewGoAllocator()
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "run_id", Type: arrow.BinaryTypes.String},
+			{Name: "key", Type: arrow.BinaryTypes.String},
+			{Name: "step", Type: arrow.PrimitiveTypes.Int64},
+			{Name: "timestamp", Type: arrow.PrimitiveTypes.Int64},
+			{Name: "value", Type: arrow.PrimitiveTypes.Float64},
+			{Name: "context", Type: arrow.BinaryTypes.String},
+		},
+		nil,
+	)
+	writer := ipc.NewWriter(w, ipc.WithAllocator(pool), ipc.WithSchema(schema))
+	//nolint:errcheck
+	defer writer.Close()
+
+	b := array.NewRecordBuilder(pool, schema)
+	defer b.Release()
+
+	for i := 0; ; i++ {
+		m, err := next()
+		if err != nil {
+			return err
+		}
+		if m == nil {
+			break
+		}
+		b.Field(0).(*array.StringBuilder).Append(m.RunID)
+		b.Field(1).(*array.StringBuilder).Append(m.Key)
+		b.Field(2).(*array.Int64Builder).Append(m.Step)
+		b.Field(3).(*array.Int64Builder).Append(m.Timestamp)
+		if m.IsNan {
+			b.Field(4).(*array.Float64Builder).AppendNull()
+		} else {
+			b.Field(4).(*array.Float64Builder).Append(m.Value)
+		}
+		b.Field(5).(*array.StringBuilder).Append(string(m.Context.Json))
+		if (i+1)%100000 == 0 {
+			if err := WriteStreamingRecord(writer, b.NewRecord()); err != nil {
+				return fmt.Errorf("unable to write Arrow record batch: %w", err)
+			}
+		}
+	}
+	if b.Field(0).Len() > 0 {
+		if err := WriteStreamingRecord(writer, b.NewRecord()); err != nil {
+			return fmt.Errorf("unable to write Arrow record batch: %w", err)
+		}
+	}
+
+	return nil
+}
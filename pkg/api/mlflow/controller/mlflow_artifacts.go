@@ -0,0 +1,290 @@
+package controller
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rotisserie/eris"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/api/request"
+	"github.com/G-Research/fasttrackml/pkg/common/api/response"
+	"github.com/G-Research/fasttrackml/pkg/common/middleware"
+)
+
+// ListProxyArtifacts handles `GET /mlflow-artifacts/artifacts` endpoint.
+func (c Controller) ListProxyArtifacts(ctx *fiber.Ctx) error {
+	req := request.ListProxyArtifactsRequest{}
+	if err := ctx.QueryParser(&req); err != nil {
+		return api.NewBadRequestError(err.Error())
+	}
+	log.Debugf("listProxyArtifacts request: %#v", req)
+
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("listProxyArtifacts namespace: %s", ns.Code)
+
+	artifacts, err := c.artifactService.ListProxyArtifacts(ctx.Context(), ns, &req)
+	if err != nil {
+		return err
+	}
+
+	resp := response.NewListProxyArtifactsResponse(artifacts)
+	log.Debugf("listProxyArtifacts response: %#v", resp)
+	return ctx.JSON(resp)
+}
+
+// DownloadProxyArtifact handles `GET /mlflow-artifacts/artifacts/*` endpoint.
+func (c Controller) DownloadProxyArtifact(ctx *fiber.Ctx) error {
+	path, err := getProxyArtifactPath(ctx)
+	if err != nil {
+		return err
+	}
+	req := request.ProxyArtifactRequest{Path: path}
+	log.Debugf("downloadProxyArtifact request: %#v", req)
+
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("downloadProxyArtifact namespace: %s", ns.Code)
+
+	artifact, err := c.artifactService.GetProxyArtifact(ctx.Context(), ns, &req)
+	if err != nil {
+		return err
+	}
+
+	filename := filepath.Base(req.Path)
+	ctx.Set("Content-Type", common.GetContentType(filename))
+	ctx.Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s", filename))
+	ctx.Set("X-Content-Type-Options", "nosniff")
+	ctx.Context().Response.SetBodyStreamWriter(func(w *bufio.Writer) {
+		//nolint:errcheck
+		defer artifact.Close()
+
+		start := time.Now()
+		if err := func() error {
+			bytesWritten, err := io.CopyBuffer(w, artifact, make([]byte, 4096))
+			if err != nil {
+				return eris.Wrap(err, "error copying artifact Reader to output stream")
+			}
+			if err := w.Flush(); err != nil {
+				return eris.Wrap(err, "error flushing output stream")
+			}
+			log.Debugf("DownloadProxyArtifact wrote bytes to output stream: %d", bytesWritten)
+			return nil
+		}(); err != nil {
+			log.Errorf(
+				"error encountered in %s %s: error streaming artifact: %s",
+				ctx.Method(),
+				ctx.Path(),
+				err,
+			)
+		}
+		log.Infof("body - %s %s %s", time.Since(start), ctx.Method(), ctx.Path())
+	})
+	return nil
+}
+
+// UploadProxyArtifact handles `PUT /mlflow-artifacts/artifacts/*` endpoint.
+func (c Controller) UploadProxyArtifact(ctx *fiber.Ctx) error {
+	path, err := getProxyArtifactPath(ctx)
+	if err != nil {
+		return err
+	}
+	req := request.ProxyArtifactRequest{Path: path}
+	log.Debugf("uploadProxyArtifact request: %#v", req)
+
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("uploadProxyArtifact namespace: %s", ns.Code)
+
+	body, err := getProxyArtifactBody(ctx)
+	if err != nil {
+		return err
+	}
+	if err := c.artifactService.PutProxyArtifact(ctx.Context(), ns, &req, body); err != nil {
+		return err
+	}
+
+	return ctx.JSON(fiber.Map{})
+}
+
+// DeleteProxyArtifact handles `DELETE /mlflow-artifacts/artifacts/*` endpoint.
+func (c Controller) DeleteProxyArtifact(ctx *fiber.Ctx) error {
+	path, err := getProxyArtifactPath(ctx)
+	if err != nil {
+		return err
+	}
+	req := request.ProxyArtifactRequest{Path: path}
+	log.Debugf("deleteProxyArtifact request: %#v", req)
+
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("deleteProxyArtifact namespace: %s", ns.Code)
+
+	if err := c.artifactService.DeleteProxyArtifact(ctx.Context(), ns, &req); err != nil {
+		return err
+	}
+
+	return ctx.JSON(fiber.Map{})
+}
+
+// CreateMultipartUpload handles `POST /mlflow-artifacts/mpu/create/*` endpoint.
+func (c Controller) CreateMultipartUpload(ctx *fiber.Ctx) error {
+	var req request.CreateMultipartUploadRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	}
+	path, err := getProxyArtifactPath(ctx)
+	if err != nil {
+		return err
+	}
+	req.Path = path
+	log.Debugf("createMultipartUpload request: %#v", req)
+
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("createMultipartUpload namespace: %s", ns.Code)
+
+	uploadID, err := c.artifactService.CreateMultipartUpload(ctx.Context(), ns, &req)
+	if err != nil {
+		return err
+	}
+
+	// parts are uploaded through `PUT /mlflow-artifacts/mpu/upload/*` endpoint of the same server.
+	uploadURL := ctx.BaseURL() + strings.Replace(
+		strings.SplitN(ctx.OriginalURL(), "?", 2)[0], "/mpu/create/", "/mpu/upload/", 1,
+	)
+	resp := response.NewCreateMultipartUploadResponse(uploadURL, uploadID, req.NumParts)
+	log.Debugf("createMultipartUpload response: %#v", resp)
+	return ctx.JSON(resp)
+}
+
+// UploadMultipartPart handles `PUT /mlflow-artifacts/mpu/upload/*` endpoint.
+func (c Controller) UploadMultipartPart(ctx *fiber.Ctx) error {
+	req := request.UploadMultipartPartRequest{}
+	if err := ctx.QueryParser(&req); err != nil {
+		return api.NewBadRequestError(err.Error())
+	}
+	path, err := getProxyArtifactPath(ctx)
+	if err != nil {
+		return err
+	}
+	req.Path = path
+	log.Debugf("uploadMultipartPart request: %#v", req)
+
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("uploadMultipartPart namespace: %s", ns.Code)
+
+	body, err := getProxyArtifactBody(ctx)
+	if err != nil {
+		return err
+	}
+	etag, err := c.artifactService.UploadMultipartPart(ctx.Context(), ns, &req, body)
+	if err != nil {
+		return err
+	}
+
+	ctx.Set(fiber.HeaderETag, etag)
+	return ctx.JSON(fiber.Map{})
+}
+
+// CompleteMultipartUpload handles `POST /mlflow-artifacts/mpu/complete/*` endpoint.
+func (c Controller) CompleteMultipartUpload(ctx *fiber.Ctx) error {
+	var req request.CompleteMultipartUploadRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	}
+	path, err := getProxyArtifactPath(ctx)
+	if err != nil {
+		return err
+	}
+	req.Path = path
+	log.Debugf("completeMultipartUpload request: %#v", req)
+
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("completeMultipartUpload namespace: %s", ns.Code)
+
+	if err := c.artifactService.CompleteMultipartUpload(ctx.Context(), ns, &req); err != nil {
+		return err
+	}
+
+	return ctx.JSON(fiber.Map{})
+}
+
+// AbortMultipartUpload handles `POST /mlflow-artifacts/mpu/abort/*` endpoint.
+func (c Controller) AbortMultipartUpload(ctx *fiber.Ctx) error {
+	var req request.AbortMultipartUploadRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	}
+	path, err := getProxyArtifactPath(ctx)
+	if err != nil {
+		return err
+	}
+	req.Path = path
+	log.Debugf("abortMultipartUpload request: %#v", req)
+
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("abortMultipartUpload namespace: %s", ns.Code)
+
+	if err := c.artifactService.AbortMultipartUpload(ctx.Context(), ns, &req); err != nil {
+		return err
+	}
+
+	return ctx.JSON(fiber.Map{})
+}
+
+// getProxyArtifactBody returns the uploaded artifact content. The request body is streamed,
+// so the artifact is neither buffered in memory nor limited by the server body limit.
+func getProxyArtifactBody(ctx *fiber.Ctx) (io.Reader, error) {
+	// server read timeout is intended for the request headers and small bodies,
+	// so the upload gets as much time to be read as the download gets to be written.
+	var deadline time.Time
+	if timeout := ctx.App().Config().WriteTimeout; timeout > 0 {
+		deadline = time.Now().Add(timeout)
+	}
+	if err := ctx.Context().Conn().SetReadDeadline(deadline); err != nil {
+		return nil, api.NewInternalError("error setting request read deadline: %s", err)
+	}
+	if stream := ctx.Request().BodyStream(); stream != nil {
+		return stream, nil
+	}
+	return bytes.NewReader(ctx.Body()), nil
+}
+
+// getProxyArtifactPath returns unescaped artifact path from the wildcard route parameter.
+func getProxyArtifactPath(ctx *fiber.Ctx) (string, error) {
+	path, err := url.PathUnescape(ctx.Params("*"))
+	if err != nil {
+		return "", api.NewInvalidParameterValueError("Invalid path")
+	}
+	return path, nil
+}
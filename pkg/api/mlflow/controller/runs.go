@@ -288,6 +288,34 @@ func (c Controller) LogBatch(ctx *fiber.Ctx) error {
 	return ctx.JSON(fiber.Map{})
 }
 
+// LogInputs handles `POST /runs/log-inputs` endpoint.
+func (c Controller) LogInputs(ctx *fiber.Ctx) error {
+	var req request.LogInputsRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		if err, ok := err.(*json.UnmarshalTypeError); ok {
+			return api.NewInvalidParameterValueError(
+				`Invalid value for log inputs field '%s'. Hint: Value was of type '%s'. `+
+					`See the API docs for more information about request parameters.`,
+				err.Field, err.Value,
+			)
+		}
+		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	}
+	log.Debugf("LogInputs request: %#v", req)
+
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("LogInputs namespace: %s", ns.Code)
+
+	if err := c.runService.LogInputs(ctx.Context(), ns, &req); err != nil {
+		return err
+	}
+
+	return ctx.JSON(fiber.Map{})
+}
+
 // LogOutput handles `POST /runs/log-output` endpoint.
 func (c Controller) LogOutput(ctx *fiber.Ctx) error {
 	var req request.LogOutputRequest
@@ -335,3 +363,115 @@ func (c Controller) LogArtifact(ctx *fiber.Ctx) error {
 
 	return ctx.SendStatus(http.StatusCreated)
 }
+
+// LogText handles `POST /runs/log-text` endpoint.
+func (c Controller) LogText(ctx *fiber.Ctx) error {
+	var req request.LogTextRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		if err, ok := err.(*json.UnmarshalTypeError); ok {
+			return api.NewInvalidParameterValueError(
+				`Invalid value for log text field '%s'. Hint: Value was of type '%s'. `+
+					`See the API docs for more information about request parameters.`,
+				err.Field, err.Value,
+			)
+		}
+		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	}
+	log.Debugf("LogText request: %#v", req)
+
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("LogText namespace: %s", ns.Code)
+
+	if err := c.runService.LogText(ctx.Context(), ns, &req); err != nil {
+		return err
+	}
+
+	return ctx.SendStatus(http.StatusCreated)
+}
+
+// LogDistribution handles `POST /runs/log-distribution` endpoint.
+func (c Controller) LogDistribution(ctx *fiber.Ctx) error {
+	var req request.LogDistributionRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		if err, ok := err.(*json.UnmarshalTypeError); ok {
+			return api.NewInvalidParameterValueError(
+				`Invalid value for log distribution field '%s'. Hint: Value was of type '%s'. `+
+					`See the API docs for more information about request parameters.`,
+				err.Field, err.Value,
+			)
+		}
+		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	}
+	log.Debugf("LogDistribution request: %#v", req)
+
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("LogDistribution namespace: %s", ns.Code)
+
+	if err := c.runService.LogDistribution(ctx.Context(), ns, &req); err != nil {
+		return err
+	}
+
+	return ctx.SendStatus(http.StatusCreated)
+}
+
+// LogFigure handles `POST /runs/log-figure` endpoint.
+func (c Controller) LogFigure(ctx *fiber.Ctx) error {
+	var req request.LogFigureRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		if err, ok := err.(*json.UnmarshalTypeError); ok {
+			return api.NewInvalidParameterValueError(
+				`Invalid value for log figure field '%s'. Hint: Value was of type '%s'. `+
+					`See the API docs for more information about request parameters.`,
+				err.Field, err.Value,
+			)
+		}
+		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	}
+	log.Debugf("LogFigure request: %#v", req)
+
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("LogFigure namespace: %s", ns.Code)
+
+	if err := c.runService.LogFigure(ctx.Context(), ns, &req); err != nil {
+		return err
+	}
+
+	return ctx.SendStatus(http.StatusCreated)
+}
+
+// LogAudio handles `POST /runs/log-audio` endpoint.
+func (c Controller) LogAudio(ctx *fiber.Ctx) error {
+	var req request.LogAudioRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		if err, ok := err.(*json.UnmarshalTypeError); ok {
+			return api.NewInvalidParameterValueError(
+				`Invalid value for log audio field '%s'. Hint: Value was of type '%s'. `+
+					`See the API docs for more information about request parameters.`,
+				err.Field, err.Value,
+			)
+		}
+		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	}
+	log.Debugf("LogAudio request: %#v", req)
+
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("LogAudio namespace: %s", ns.Code)
+
+	if err := c.runService.LogAudio(ctx.Context(), ns, &req); err != nil {
+		return err
+	}
+
+	return ctx.SendStatus(http.StatusCreated)
+}
@@ -0,0 +1,198 @@
+package controller
+
+import (
+	"github.com/gofiber/fiber/v2"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/middleware"
+)
+
+// StartTrace handles `POST /traces` endpoint.
+func (c Controller) StartTrace(ctx *fiber.Ctx) error {
+	var req request.StartTraceRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	}
+	log.Debugf("startTrace request: %#v", req)
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("startTrace namespace: %s", ns.Code)
+
+	trace, err := c.traceService.StartTrace(ctx.Context(), ns, &req)
+	if err != nil {
+		return err
+	}
+
+	resp := response.NewTraceInfoResponse(trace)
+	log.Debugf("startTrace response: %#v", resp)
+	return ctx.JSON(resp)
+}
+
+// EndTrace handles `PATCH /traces/:request_id` endpoint.
+func (c Controller) EndTrace(ctx *fiber.Ctx) error {
+	var req request.EndTraceRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	}
+	req.RequestID = ctx.Params("request_id")
+	log.Debugf("endTrace request: %#v", req)
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("endTrace namespace: %s", ns.Code)
+
+	trace, err := c.traceService.EndTrace(ctx.Context(), ns, &req)
+	if err != nil {
+		return err
+	}
+
+	resp := response.NewTraceInfoResponse(trace)
+	log.Debugf("endTrace response: %#v", resp)
+	return ctx.JSON(resp)
+}
+
+// GetTraceInfo handles `GET /traces/:request_id/info` endpoint.
+func (c Controller) GetTraceInfo(ctx *fiber.Ctx) error {
+	req := request.GetTraceInfoRequest{
+		RequestID: ctx.Params("request_id"),
+	}
+	log.Debugf("getTraceInfo request: %#v", req)
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("getTraceInfo namespace: %s", ns.Code)
+
+	trace, err := c.traceService.GetTraceInfo(ctx.Context(), ns, &req)
+	if err != nil {
+		return err
+	}
+
+	resp := response.NewTraceInfoResponse(trace)
+	log.Debugf("getTraceInfo response: %#v", resp)
+	return ctx.JSON(resp)
+}
+
+// GetTrace handles `GET /traces/:request_id` endpoint.
+func (c Controller) GetTrace(ctx *fiber.Ctx) error {
+	req := request.GetTraceRequest{
+		RequestID: ctx.Params("request_id"),
+	}
+	log.Debugf("getTrace request: %#v", req)
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("getTrace namespace: %s", ns.Code)
+
+	trace, spans, err := c.traceService.GetTrace(ctx.Context(), ns, &req)
+	if err != nil {
+		return err
+	}
+
+	resp := response.NewGetTraceResponse(trace, spans)
+	log.Debugf("getTrace response: %#v", resp)
+	return ctx.JSON(resp)
+}
+
+// SearchTraces handles `GET /traces` and `POST /traces/search` endpoints.
+func (c Controller) SearchTraces(ctx *fiber.Ctx) error {
+	var req request.SearchTracesRequest
+	switch ctx.Method() {
+	case fiber.MethodPost:
+		if err := ctx.BodyParser(&req); err != nil {
+			return api.NewBadRequestError("Unable to decode request body: %s", err)
+		}
+	case fiber.MethodGet:
+		if err := ctx.QueryParser(&req); err != nil {
+			return api.NewBadRequestError(err.Error())
+		}
+	}
+	log.Debugf("searchTraces request: %#v", req)
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("searchTraces namespace: %s", ns.Code)
+
+	traces, limit, offset, err := c.traceService.SearchTraces(ctx.Context(), ns, &req)
+	if err != nil {
+		return err
+	}
+
+	resp, err := response.NewSearchTracesResponse(traces, limit, offset)
+	if err != nil {
+		return api.NewInternalError("unable to build next_page_token: %s", err)
+	}
+	log.Debugf("searchTraces response: %#v", resp)
+	return ctx.JSON(resp)
+}
+
+// DeleteTraces handles `POST /traces/delete-traces` endpoint.
+func (c Controller) DeleteTraces(ctx *fiber.Ctx) error {
+	var req request.DeleteTracesRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	}
+	log.Debugf("deleteTraces request: %#v", req)
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("deleteTraces namespace: %s", ns.Code)
+
+	deleted, err := c.traceService.DeleteTraces(ctx.Context(), ns, &req)
+	if err != nil {
+		return err
+	}
+
+	resp := response.NewDeleteTracesResponse(deleted)
+	log.Debugf("deleteTraces response: %#v", resp)
+	return ctx.JSON(resp)
+}
+
+// SetTraceTag handles `PATCH /traces/:request_id/tags` endpoint.
+func (c Controller) SetTraceTag(ctx *fiber.Ctx) error {
+	var req request.SetTraceTagRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	}
+	req.RequestID = ctx.Params("request_id")
+	log.Debugf("setTraceTag request: %#v", req)
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("setTraceTag namespace: %s", ns.Code)
+
+	if err := c.traceService.SetTraceTag(ctx.Context(), ns, &req); err != nil {
+		return err
+	}
+	return ctx.JSON(fiber.Map{})
+}
+
+// DeleteTraceTag handles `DELETE /traces/:request_id/tags` endpoint.
+func (c Controller) DeleteTraceTag(ctx *fiber.Ctx) error {
+	var req request.DeleteTraceTagRequest
+	if err := parseDeleteRequest(ctx, &req); err != nil {
+		return err
+	}
+	req.RequestID = ctx.Params("request_id")
+	log.Debugf("deleteTraceTag request: %#v", req)
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("deleteTraceTag namespace: %s", ns.Code)
+
+	if err := c.traceService.DeleteTraceTag(ctx.Context(), ns, &req); err != nil {
+		return err
+	}
+	return ctx.JSON(fiber.Map{})
+}
@@ -5,8 +5,10 @@ package convertors
 import (
 	"encoding/json"
 	"math"
+	"net/url"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/rotisserie/eris"
 
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
@@ -34,6 +36,115 @@ func ConvertLogOutputRequestToDBModel(runID string, req *request.LogOutputReques
 	}
 }
 
+// ConvertLogTextRequestToDBModel converts request.LogTextRequest into actual models.Text model.
+func ConvertLogTextRequestToDBModel(runID string, req *request.LogTextRequest) (*models.Text, error) {
+	context, err := convertSequenceContextToDBModel(req.Context)
+	if err != nil {
+		return nil, err
+	}
+	return &models.Text{
+		ID:      uuid.New(),
+		Name:    req.Name,
+		Step:    req.Step,
+		Index:   req.Index,
+		Value:   req.Text,
+		RunID:   runID,
+		Context: context,
+	}, nil
+}
+
+// ConvertLogDistributionRequestToDBModel converts request.LogDistributionRequest into actual models.Distribution model.
+func ConvertLogDistributionRequestToDBModel(
+	runID string, req *request.LogDistributionRequest,
+) (*models.Distribution, error) {
+	context, err := convertSequenceContextToDBModel(req.Context)
+	if err != nil {
+		return nil, err
+	}
+	binEdges, err := json.Marshal(req.BinEdges)
+	if err != nil {
+		return nil, eris.Wrap(err, "error marshalling bin edges")
+	}
+	counts, err := json.Marshal(req.Counts)
+	if err != nil {
+		return nil, eris.Wrap(err, "error marshalling counts")
+	}
+	return &models.Distribution{
+		ID:       uuid.New(),
+		Name:     req.Name,
+		Step:     req.Step,
+		BinEdges: binEdges,
+		Counts:   counts,
+		RunID:    runID,
+		Context:  context,
+	}, nil
+}
+
+// ConvertLogFigureRequestToDBModel converts request.LogFigureRequest into actual models.Figure model.
+// The blob uri of the request is relative to the run artifact root.
+func ConvertLogFigureRequestToDBModel(
+	run *models.Run, req *request.LogFigureRequest,
+) (*models.Figure, error) {
+	context, err := convertSequenceContextToDBModel(req.Context)
+	if err != nil {
+		return nil, err
+	}
+	blobURI, err := url.JoinPath(run.ArtifactURI, req.BlobURI)
+	if err != nil {
+		return nil, eris.Wrap(err, "error constructing blob uri")
+	}
+	return &models.Figure{
+		ID:      uuid.New(),
+		Name:    req.Name,
+		Step:    req.Step,
+		Caption: req.Caption,
+		BlobURI: blobURI,
+		RunID:   run.ID,
+		Context: context,
+	}, nil
+}
+
+// ConvertLogAudioRequestToDBModel converts request.LogAudioRequest into actual models.Audio model.
+// The blob uri of the request is relative to the run artifact root.
+func ConvertLogAudioRequestToDBModel(
+	run *models.Run, req *request.LogAudioRequest,
+) (*models.Audio, error) {
+	context, err := convertSequenceContextToDBModel(req.Context)
+	if err != nil {
+		return nil, err
+	}
+	blobURI, err := url.JoinPath(run.ArtifactURI, req.BlobURI)
+	if err != nil {
+		return nil, eris.Wrap(err, "error constructing blob uri")
+	}
+	return &models.Audio{
+		ID:         uuid.New(),
+		Name:       req.Name,
+		Step:       req.Step,
+		Index:      req.Index,
+		Caption:    req.Caption,
+		Format:     req.Format,
+		SampleRate: req.SampleRate,
+		BlobURI:    blobURI,
+		RunID:      run.ID,
+		Context:    context,
+	}, nil
+}
+
+// convertSequenceContextToDBModel converts context of the sequence request into actual models.Context model.
+func convertSequenceContextToDBModel(context map[string]any) (models.Context, error) {
+	if len(context) == 0 {
+		return models.DefaultContext, nil
+	}
+	contextJSON, err := json.Marshal(context)
+	if err != nil {
+		return models.Context{}, eris.Wrap(err, "error marshalling context")
+	}
+	return models.Context{
+		Json: contextJSON,
+	}, nil
+}
+
 // ConvertLogBatchRequestToDBModel converts request.LogBatchRequest into actual []models.Param, []models.Tag models.
 func ConvertLogBatchRequestToDBModel(
 	runID string, req *request.LogBatchRequest,
@@ -98,3 +209,31 @@ func ConvertLogBatchRequestToDBModel(
 	}
 	return metrics, params, tags, nil
 }
+
+// ConvertLogInputsRequestToDBModel converts request.LogInputsRequest into actual []models.Input models.
+func ConvertLogInputsRequestToDBModel(req *request.LogInputsRequest) []models.Input {
+	inputs := make([]models.Input, len(req.Datasets))
+	for i, datasetInput := range req.Datasets {
+		tags := make([]models.InputTag, len(datasetInput.Tags))
+		for n, tag := range datasetInput.Tags {
+			tags[n] = models.InputTag{
+				Name:  tag.Key,
+				Value: tag.Value,
+			}
+		}
+		inputs[i] = models.Input{
+			SourceType:      models.InputSourceTypeDataset,
+			DestinationType: models.InputDestinationTypeRun,
+			Dataset: models.Dataset{
+				Name:       datasetInput.Dataset.Name,
+				Digest:     datasetInput.Dataset.Digest,
+				SourceType: datasetInput.Dataset.SourceType,
+				Source:     datasetInput.Dataset.Source,
+				Schema:     datasetInput.Dataset.Schema,
+				Profile:    datasetInput.Dataset.Profile,
+			},
+			Tags: tags,
+		}
+	}
+	return inputs
+}
@@ -297,3 +297,39 @@ func TestConvertLogBatchRequestToDBModel_Error(t *testing.T) {
 		})
 	}
 }
+
+func TestConvertLogInputsRequestToDBModel_Ok(t *testing.T) {
+	result := ConvertLogInputsRequestToDBModel(&request.LogInputsRequest{
+		RunID: "run_id",
+		Datasets: []request.DatasetInputPartialRequest{{
+			Tags: []request.InputTagPartialRequest{{
+				Key:   models.InputTagContext,
+				Value: "training",
+			}},
+			Dataset: request.DatasetPartialRequest{
+				Name:       "name",
+				Digest:     "digest",
+				SourceType: "local",
+				Source:     `{"uri": "/tmp/data.csv"}`,
+				Schema:     "schema",
+				Profile:    "profile",
+			},
+		}},
+	})
+	assert.Equal(t, []models.Input{{
+		SourceType:      models.InputSourceTypeDataset,
+		DestinationType: models.InputDestinationTypeRun,
+		Dataset: models.Dataset{
+			Name:       "name",
+			Digest:     "digest",
+			SourceType: "local",
+			Source:     `{"uri": "/tmp/data.csv"}`,
+			Schema:     "schema",
+			Profile:    "profile",
+		},
+		Tags: []models.InputTag{{
+			Name:  models.InputTagContext,
+			Value: "training",
+		}},
+	}}, result)
+}
@@ -0,0 +1,59 @@
+package convertors
+
+import (
+	"time"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+)
+
+// ConvertCreateRegisteredModelRequestToDBModel converts request.CreateRegisteredModelRequest
+// into actual models.RegisteredModel model.
+func ConvertCreateRegisteredModelRequestToDBModel(
+	namespace *models.Namespace, req *request.CreateRegisteredModelRequest,
+) *models.RegisteredModel {
+	ts := time.Now().UTC().UnixMilli()
+	registeredModel := models.RegisteredModel{
+		Name:            req.Name,
+		Description:     req.Description,
+		CreationTime:    ts,
+		LastUpdatedTime: ts,
+		NamespaceID:     namespace.ID,
+		Tags:            make([]models.RegisteredModelTag, len(req.Tags)),
+	}
+	for n, tag := range req.Tags {
+		registeredModel.Tags[n] = models.RegisteredModelTag{
+			Key:   tag.Key,
+			Value: tag.Value,
+		}
+	}
+	return &registeredModel
+}
+
+// ConvertCreateModelVersionRequestToDBModel converts request.CreateModelVersionRequest
+// into actual models.ModelVersion model.
+func ConvertCreateModelVersionRequestToDBModel(
+	registeredModel *models.RegisteredModel, req *request.CreateModelVersionRequest,
+) *models.ModelVersion {
+	ts := time.Now().UTC().UnixMilli()
+	modelVersion := models.ModelVersion{
+		RegisteredModelID: registeredModel.ID,
+		Description:       req.Description,
+		CurrentStage:      models.ModelVersionStageNone,
+		Source:            req.Source,
+		StorageLocation:   req.Source,
+		RunID:             req.RunID,
+		RunLink:           req.RunLink,
+		Status:            models.ModelVersionStatusReady,
+		CreationTime:      ts,
+		LastUpdatedTime:   ts,
+		Tags:              make([]models.ModelVersionTag, len(req.Tags)),
+	}
+	for n, tag := range req.Tags {
+		modelVersion.Tags[n] = models.ModelVersionTag{
+			Key:   tag.Key,
+			Value: tag.Value,
+		}
+	}
+	return &modelVersion
+}
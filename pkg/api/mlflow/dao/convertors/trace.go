@@ -0,0 +1,161 @@
+package convertors
+
+import (
+	"encoding/json"
+	"net/url"
+
+	"github.com/rotisserie/eris"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/types"
+	"github.com/G-Research/fasttrackml/pkg/database"
+)
+
+// ConvertStartTraceRequestToDBModel converts request.StartTraceRequest into actual models.Trace model.
+func ConvertStartTraceRequestToDBModel(
+	experiment *models.Experiment, req *request.StartTraceRequest,
+) (*models.Trace, error) {
+	requestID := "tr-" + database.NewUUID()
+	artifactLocation, err := url.JoinPath(experiment.ArtifactLocation, "traces", requestID, "artifacts")
+	if err != nil {
+		return nil, eris.Wrap(err, "error constructing trace artifact location")
+	}
+	trace := models.Trace{
+		ID:              requestID,
+		ExperimentID:    *experiment.ID,
+		TimestampMs:     req.TimestampMs,
+		Status:          models.TraceStatusInProgress,
+		Tags:            make([]models.TraceTag, 0, len(req.Tags)+1),
+		RequestMetadata: make([]models.TraceRequestMetadata, len(req.RequestMetadata)),
+	}
+	for _, tag := range req.Tags {
+		if tag.Key == models.TraceTagArtifactLocation {
+			continue
+		}
+		trace.Tags = append(trace.Tags, models.TraceTag{
+			Key:     tag.Key,
+			Value:   tag.Value,
+			TraceID: requestID,
+		})
+	}
+	trace.Tags = append(trace.Tags, models.TraceTag{
+		Key:     models.TraceTagArtifactLocation,
+		Value:   artifactLocation,
+		TraceID: requestID,
+	})
+	for n, metadata := range req.RequestMetadata {
+		trace.RequestMetadata[n] = models.TraceRequestMetadata{
+			Key:     metadata.Key,
+			Value:   metadata.Value,
+			TraceID: requestID,
+		}
+	}
+	return &trace, nil
+}
+
+// ConvertEndTraceRequestToDBModel merges request.EndTraceRequest into existing models.Trace model.
+func ConvertEndTraceRequestToDBModel(trace *models.Trace, req *request.EndTraceRequest) (*models.Trace, error) {
+	trace.Status = models.TraceStatus(req.Status)
+	trace.ExecutionTimeMs.Int64 = req.TimestampMs - trace.TimestampMs
+	trace.ExecutionTimeMs.Valid = true
+	trace.Tags = make([]models.TraceTag, 0, len(req.Tags))
+	for _, tag := range req.Tags {
+		// location of the trace data is decided by the server, so it can't be overridden by the client.
+		if tag.Key == models.TraceTagArtifactLocation {
+			continue
+		}
+		trace.Tags = append(trace.Tags, models.TraceTag{
+			Key:     tag.Key,
+			Value:   tag.Value,
+			TraceID: trace.ID,
+		})
+	}
+	trace.RequestMetadata = make([]models.TraceRequestMetadata, len(req.RequestMetadata))
+	for n, metadata := range req.RequestMetadata {
+		trace.RequestMetadata[n] = models.TraceRequestMetadata{
+			Key:     metadata.Key,
+			Value:   metadata.Value,
+			TraceID: trace.ID,
+		}
+	}
+	spans, err := ConvertTraceSpansToDBModel(trace.ID, req.Spans)
+	if err != nil {
+		return nil, err
+	}
+	trace.Spans = spans
+	return trace, nil
+}
+
+// ConvertTraceSpansToDBModel converts list of request.TraceSpanPartialRequest into actual models.TraceSpan models.
+func ConvertTraceSpansToDBModel(requestID string, spans []request.TraceSpanPartialRequest) ([]models.TraceSpan, error) {
+	result := make([]models.TraceSpan, len(spans))
+	for n, span := range spans {
+		attributes, err := convertToJSONB(span.Attributes)
+		if err != nil {
+			return nil, eris.Wrapf(err, "error converting attributes of span '%s'", span.Context.SpanID)
+		}
+		events, err := convertToJSONB(span.Events)
+		if err != nil {
+			return nil, eris.Wrapf(err, "error converting events of span '%s'", span.Context.SpanID)
+		}
+		result[n] = models.TraceSpan{
+			TraceID:       requestID,
+			SpanID:        span.Context.SpanID,
+			ParentID:      span.ParentID,
+			Name:          span.Name,
+			StatusCode:    span.StatusCode,
+			StatusMessage: span.StatusMessage,
+			StartTimeNs:   span.StartTime,
+			EndTimeNs:     span.EndTime,
+			Attributes:    attributes,
+			Events:        events,
+		}
+	}
+	return result, nil
+}
+
+// ConvertTraceSpansToTraceData converts list of models.TraceSpan into request.TraceDataPartialRequest
+// object which is stored in the artifact storage.
+func ConvertTraceSpansToTraceData(spans []models.TraceSpan) (*request.TraceDataPartialRequest, error) {
+	data := request.TraceDataPartialRequest{
+		Spans: make([]request.TraceSpanPartialRequest, len(spans)),
+	}
+	for n, span := range spans {
+		data.Spans[n] = request.TraceSpanPartialRequest{
+			Name: span.Name,
+			Context: request.TraceSpanContextPartialRequest{
+				SpanID:  span.SpanID,
+				TraceID: span.TraceID,
+			},
+			ParentID:      span.ParentID,
+			StartTime:     span.StartTimeNs,
+			EndTime:       span.EndTimeNs,
+			StatusCode:    span.StatusCode,
+			StatusMessage: span.StatusMessage,
+		}
+		if len(span.Attributes) > 0 {
+			if err := json.Unmarshal(span.Attributes, &data.Spans[n].Attributes); err != nil {
+				return nil, eris.Wrapf(err, "error converting attributes of span '%s'", span.SpanID)
+			}
+		}
+		if len(span.Events) > 0 {
+			if err := json.Unmarshal(span.Events, &data.Spans[n].Events); err != nil {
+				return nil, eris.Wrapf(err, "error converting events of span '%s'", span.SpanID)
+			}
+		}
+	}
+	return &data, nil
+}
+
+// convertToJSONB marshals provided value into types.JSONB. Empty values are kept empty.
+func convertToJSONB[T map[string]any | []any](value T) (types.JSONB, error) {
+	if len(value) == 0 {
+		return nil, nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	return types.JSONB(data), nil
+}
@@ -0,0 +1,72 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// InputSourceType represents type of the Input source.
+type InputSourceType string
+
+// Supported list of Input source types.
+const (
+	InputSourceTypeDataset InputSourceType = "DATASET"
+)
+
+// InputDestinationType represents type of the Input destination.
+type InputDestinationType string
+
+// Supported list of Input destination types.
+const (
+	InputDestinationTypeRun InputDestinationType = "RUN"
+)
+
+// InputTagContext is the name of the Input tag which keeps dataset context, e.g. `training` or `validation`.
+const InputTagContext = "mlflow.data.context"
+
+// Dataset represents model to work with `datasets` table.
+type Dataset struct {
+	ID           uuid.UUID `gorm:"type:uuid;primaryKey"`
+	ExperimentID int32     `gorm:"not null;index:,unique,composite:dataset"`
+	Name         string    `gorm:"type:varchar(500);not null;index:,unique,composite:dataset"`
+	Digest       string    `gorm:"type:varchar(36);not null;index:,unique,composite:dataset"`
+	SourceType   string    `gorm:"type:varchar(36);not null"`
+	Source       string    `gorm:"type:text;not null"`
+	Schema       string    `gorm:"type:text"`
+	Profile      string    `gorm:"type:text"`
+}
+
+// BeforeCreate triggers by GORM before create.
+func (d *Dataset) BeforeCreate(tx *gorm.DB) error {
+	if d.ID == uuid.Nil {
+		d.ID = uuid.New()
+	}
+	return nil
+}
+
+// Input represents model to work with `inputs` table.
+// Right now the only supported case is a Dataset used as an input of a Run.
+type Input struct {
+	ID              uuid.UUID            `gorm:"type:uuid;primaryKey"`
+	SourceType      InputSourceType      `gorm:"type:varchar(36);not null;index:,unique,composite:input"`
+	SourceID        uuid.UUID            `gorm:"type:uuid;not null;index:,unique,composite:input"`
+	Dataset         Dataset              `gorm:"foreignKey:SourceID"`
+	DestinationType InputDestinationType `gorm:"type:varchar(36);not null;index:,unique,composite:input"`
+	DestinationID   string               `gorm:"type:varchar(32);not null;index:,unique,composite:input"`
+	Tags            []InputTag           `gorm:"constraint:OnDelete:CASCADE"`
+}
+
+// BeforeCreate triggers by GORM before create.
+func (i *Input) BeforeCreate(tx *gorm.DB) error {
+	if i.ID == uuid.Nil {
+		i.ID = uuid.New()
+	}
+	return nil
+}
+
+// InputTag represents model to work with `input_tags` table.
+type InputTag struct {
+	InputID uuid.UUID `gorm:"type:uuid;not null;primaryKey"`
+	Name    string    `gorm:"type:varchar(255);not null;primaryKey"`
+	Value   string    `gorm:"type:varchar(500);not null"`
+}
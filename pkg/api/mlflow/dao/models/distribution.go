@@ -0,0 +1,48 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/common/dao/types"
+)
+
+// Distribution represents the distribution (histogram) sequence model.
+type Distribution struct {
+	ID        uuid.UUID   `gorm:"type:uuid;primaryKey" json:"id"`
+	Name      string      `gorm:"not null;index"`
+	Iter      int64       `gorm:"index"`
+	Step      int64       `gorm:"default:0;not null"`
+	BinEdges  types.JSONB `gorm:"not null"`
+	Counts    types.JSONB `gorm:"not null"`
+	Run       Run
+	RunID     string `gorm:"column:run_uuid;not null;index;constraint:OnDelete:CASCADE"`
+	ContextID uint   `gorm:"not null"`
+	Context   Context
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// AfterSave will calculate the iter number for this step sequence based on creation time.
+func (u *Distribution) AfterSave(tx *gorm.DB) error {
+	if err := tx.Exec(
+		`UPDATE distributions
+	         SET iter = rows.new_iter
+                 FROM (
+                   SELECT id, ROW_NUMBER() OVER (ORDER BY created_at) as new_iter
+                   FROM distributions
+                   WHERE run_uuid = ?
+                   AND name = ?
+                   AND context_id = ?
+                   AND step = ?
+                 ) as rows
+	         WHERE distributions.id = rows.id`,
+		u.RunID, u.Name, u.ContextID, u.Step,
+	).Error; err != nil {
+		return eris.Wrap(err, "error updating distributions iter")
+	}
+	return nil
+}
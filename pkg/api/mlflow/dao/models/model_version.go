@@ -0,0 +1,96 @@
+package models
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ModelVersionStage represents ModelVersion stage.
+type ModelVersionStage string
+
+// Supported list of ModelVersion stages.
+const (
+	ModelVersionStageNone            ModelVersionStage = "None"
+	ModelVersionStageStaging         ModelVersionStage = "Staging"
+	ModelVersionStageProduction      ModelVersionStage = "Production"
+	ModelVersionStageArchived        ModelVersionStage = "Archived"
+	ModelVersionStageDeletedInternal ModelVersionStage = "Deleted_Internal"
+)
+
+// ModelVersionStages holds the list of user visible stages in the order MLflow reports them.
+var ModelVersionStages = []ModelVersionStage{
+	ModelVersionStageNone,
+	ModelVersionStageStaging,
+	ModelVersionStageProduction,
+	ModelVersionStageArchived,
+}
+
+// NewModelVersionStage returns the canonical ModelVersionStage for case-insensitive stage name.
+func NewModelVersionStage(stage string) (ModelVersionStage, bool) {
+	for _, s := range ModelVersionStages {
+		if strings.EqualFold(string(s), stage) {
+			return s, true
+		}
+	}
+	return "", false
+}
+
+// ModelVersionStatus represents ModelVersion status.
+type ModelVersionStatus string
+
+// Supported list of ModelVersion statuses.
+const (
+	ModelVersionStatusPendingRegistration ModelVersionStatus = "PENDING_REGISTRATION"
+	ModelVersionStatusFailedRegistration  ModelVersionStatus = "FAILED_REGISTRATION"
+	ModelVersionStatusReady               ModelVersionStatus = "READY"
+)
+
+// ModelVersion represents model to work with `model_versions` table.
+//
+//nolint:lll
+type ModelVersion struct {
+	ID                uuid.UUID `gorm:"type:uuid;primaryKey"`
+	RegisteredModelID uuid.UUID `gorm:"type:uuid;not null;index:,unique,composite:version"`
+	RegisteredModel   RegisteredModel
+	Version           int64              `gorm:"not null;index:,unique,composite:version"`
+	Description       string             `gorm:"type:varchar(5000)"`
+	UserID            string             `gorm:"type:varchar(256)"`
+	CurrentStage      ModelVersionStage  `gorm:"type:varchar(20);not null;index"`
+	Source            string             `gorm:"type:varchar(500)"`
+	StorageLocation   string             `gorm:"type:varchar(500)"`
+	RunID             string             `gorm:"type:varchar(32);index"`
+	RunLink           string             `gorm:"type:varchar(500)"`
+	Status            ModelVersionStatus `gorm:"type:varchar(20);check:status IN ('PENDING_REGISTRATION', 'FAILED_REGISTRATION', 'READY')"`
+	StatusMessage     string             `gorm:"type:varchar(500)"`
+	CreationTime      int64              `gorm:"type:bigint"`
+	LastUpdatedTime   int64              `gorm:"type:bigint"`
+	Tags              []ModelVersionTag  `gorm:"constraint:OnDelete:CASCADE"`
+}
+
+// BeforeCreate triggers by GORM before create.
+func (v *ModelVersion) BeforeCreate(tx *gorm.DB) error {
+	if v.ID == uuid.Nil {
+		v.ID = uuid.New()
+	}
+	return nil
+}
+
+// Aliases returns the list of RegisteredModel aliases which point to the current ModelVersion.
+func (v ModelVersion) Aliases(aliases []RegisteredModelAlias) []string {
+	result := make([]string, 0)
+	for _, alias := range aliases {
+		if alias.RegisteredModelID == v.RegisteredModelID && alias.Version == v.Version {
+			result = append(result, alias.Alias)
+		}
+	}
+	return result
+}
+
+// ModelVersionTag represents model to work with `model_version_tags` table.
+type ModelVersionTag struct {
+	Key            string    `gorm:"type:varchar(250);not null;primaryKey"`
+	Value          string    `gorm:"type:varchar(5000)"`
+	ModelVersionID uuid.UUID `gorm:"type:uuid;not null;primaryKey"`
+}
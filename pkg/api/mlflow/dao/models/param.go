@@ -1,14 +1,22 @@
 package models
 
-import "fmt"
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/G-Research/fasttrackml/pkg/common/dao/types"
+)
 
 // Param represents model to work with `params` table.
 type Param struct {
-	Key        string   `gorm:"type:varchar(250);not null;primaryKey"`
-	ValueStr   *string  `gorm:"type:varchar(500)"`
-	ValueInt   *int64   `gorm:"type:bigint"`
-	ValueFloat *float64 `gorm:"type:float"`
-	RunID      string   `gorm:"column:run_uuid;not null;primaryKey;index"`
+	Key        string      `gorm:"type:varchar(250);not null;primaryKey"`
+	ValueStr   *string     `gorm:"type:varchar(500)"`
+	ValueInt   *int64      `gorm:"type:bigint"`
+	ValueFloat *float64    `gorm:"type:float"`
+	ValueBool  *bool       `gorm:"type:boolean"`
+	ValueJSON  types.JSONB `gorm:"column:value_json"`
+	RunID      string      `gorm:"column:run_uuid;not null;primaryKey;index"`
 }
 
 // Value returns the value held by this Param as a string.
@@ -18,6 +26,14 @@ func (p Param) ValueString() string {
 		return fmt.Sprintf("%v", *p.ValueInt)
 	case p.ValueFloat != nil:
 		return fmt.Sprintf("%v", *p.ValueFloat)
+	case p.ValueBool != nil:
+		return strconv.FormatBool(*p.ValueBool)
+	case p.HasValueJSON():
+		value, err := p.ValueJSON.Normalize()
+		if err != nil {
+			return string(p.ValueJSON)
+		}
+		return value
 	case p.ValueStr != nil:
 		return *p.ValueStr
 	default:
@@ -32,9 +48,22 @@ func (p Param) ValueAny() any {
 		return *p.ValueInt
 	case p.ValueFloat != nil:
 		return *p.ValueFloat
+	case p.ValueBool != nil:
+		return *p.ValueBool
+	case p.HasValueJSON():
+		var value any
+		if err := json.Unmarshal(p.ValueJSON, &value); err != nil {
+			return string(p.ValueJSON)
+		}
+		return value
 	case p.ValueStr != nil:
 		return *p.ValueStr
 	default:
 		return nil
 	}
 }
+
+// HasValueJSON returns true when this Param holds nested (dict or list) value.
+func (p Param) HasValueJSON() bool {
+	return len(p.ValueJSON) != 0 && string(p.ValueJSON) != "null"
+}
@@ -0,0 +1,63 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// RegisteredModel represents model to work with `registered_models` table.
+type RegisteredModel struct {
+	ID              uuid.UUID              `gorm:"type:uuid;primaryKey"`
+	Name            string                 `gorm:"type:varchar(256);not null;index:,unique,composite:name"`
+	Description     string                 `gorm:"type:varchar(5000)"`
+	CreationTime    int64                  `gorm:"type:bigint"`
+	LastUpdatedTime int64                  `gorm:"type:bigint"`
+	NamespaceID     uint                   `gorm:"not null;index:,unique,composite:name"`
+	Namespace       Namespace              `gorm:"constraint:OnDelete:CASCADE"`
+	Tags            []RegisteredModelTag   `gorm:"constraint:OnDelete:CASCADE"`
+	Aliases         []RegisteredModelAlias `gorm:"constraint:OnDelete:CASCADE"`
+	Versions        []ModelVersion         `gorm:"constraint:OnDelete:CASCADE"`
+}
+
+// BeforeCreate triggers by GORM before create.
+func (m *RegisteredModel) BeforeCreate(tx *gorm.DB) error {
+	if m.ID == uuid.Nil {
+		m.ID = uuid.New()
+	}
+	return nil
+}
+
+// LatestVersions returns the latest models.ModelVersion for each stage.
+func (m RegisteredModel) LatestVersions() []ModelVersion {
+	latest := map[ModelVersionStage]ModelVersion{}
+	for _, version := range m.Versions {
+		if version.CurrentStage == ModelVersionStageDeletedInternal {
+			continue
+		}
+		if current, ok := latest[version.CurrentStage]; !ok || version.Version > current.Version {
+			latest[version.CurrentStage] = version
+		}
+	}
+
+	versions := make([]ModelVersion, 0, len(latest))
+	for _, stage := range ModelVersionStages {
+		if version, ok := latest[stage]; ok {
+			versions = append(versions, version)
+		}
+	}
+	return versions
+}
+
+// RegisteredModelTag represents model to work with `registered_model_tags` table.
+type RegisteredModelTag struct {
+	Key               string    `gorm:"type:varchar(250);not null;primaryKey"`
+	Value             string    `gorm:"type:varchar(5000)"`
+	RegisteredModelID uuid.UUID `gorm:"type:uuid;not null;primaryKey"`
+}
+
+// RegisteredModelAlias represents model to work with `registered_model_aliases` table.
+type RegisteredModelAlias struct {
+	Alias             string    `gorm:"type:varchar(256);not null;primaryKey"`
+	Version           int64     `gorm:"not null"`
+	RegisteredModelID uuid.UUID `gorm:"type:uuid;not null;primaryKey"`
+}
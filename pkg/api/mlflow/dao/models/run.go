@@ -45,6 +45,7 @@ type Run struct {
 	Logs           []Log          `gorm:"constraint:OnDelete:CASCADE"`
 	Metrics        []Metric       `gorm:"constraint:OnDelete:CASCADE"`
 	LatestMetrics  []LatestMetric `gorm:"constraint:OnDelete:CASCADE"`
+	Inputs         []Input        `gorm:"foreignKey:DestinationID"`
 }
 
 // RowNum represents custom data type.
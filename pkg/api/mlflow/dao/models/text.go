@@ -0,0 +1,46 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+)
+
+// Text represents the text sequence model.
+type Text struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey" json:"id"`
+	Name      string    `gorm:"not null;index"`
+	Iter      int64     `gorm:"index"`
+	Step      int64     `gorm:"default:0;not null"`
+	Index     int64     `gorm:"default:0;not null"`
+	Value     string    `gorm:"type:text;not null"`
+	Run       Run
+	RunID     string `gorm:"column:run_uuid;not null;index;constraint:OnDelete:CASCADE"`
+	ContextID uint   `gorm:"not null"`
+	Context   Context
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// AfterSave will calculate the iter number for this step sequence based on creation time.
+func (u *Text) AfterSave(tx *gorm.DB) error {
+	if err := tx.Exec(
+		`UPDATE texts
+	         SET iter = rows.new_iter
+                 FROM (
+                   SELECT id, ROW_NUMBER() OVER (ORDER BY created_at) as new_iter
+                   FROM texts
+                   WHERE run_uuid = ?
+                   AND name = ?
+                   AND context_id = ?
+                   AND step = ?
+                 ) as rows
+	         WHERE texts.id = rows.id`,
+		u.RunID, u.Name, u.ContextID, u.Step,
+	).Error; err != nil {
+		return eris.Wrap(err, "error updating texts iter")
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package models
+
+import (
+	"database/sql"
+
+	"github.com/G-Research/fasttrackml/pkg/common/dao/types"
+)
+
+// TraceStatus represents status of the Trace.
+type TraceStatus string
+
+// Supported list of Trace statuses.
+const (
+	TraceStatusUnspecified TraceStatus = "TRACE_STATUS_UNSPECIFIED"
+	TraceStatusOK          TraceStatus = "OK"
+	TraceStatusError       TraceStatus = "ERROR"
+	TraceStatusInProgress  TraceStatus = "IN_PROGRESS"
+)
+
+// TraceTagArtifactLocation is the name of the Trace tag which keeps location of the trace data.
+const TraceTagArtifactLocation = "mlflow.artifactLocation"
+
+// TraceDataFileName is the name of the file with trace data inside of the Trace artifact location.
+const TraceDataFileName = "traces.json"
+
+// Trace represents model to work with `traces` table.
+type Trace struct {
+	ID              string                 `gorm:"column:request_id;type:varchar(50);not null;primaryKey"`
+	ExperimentID    int32                  `gorm:"not null;index"`
+	TimestampMs     int64                  `gorm:"type:bigint;not null;index"`
+	ExecutionTimeMs sql.NullInt64          `gorm:"type:bigint"`
+	Status          TraceStatus            `gorm:"type:varchar(50);not null"`
+	Tags            []TraceTag             `gorm:"constraint:OnDelete:CASCADE"`
+	RequestMetadata []TraceRequestMetadata `gorm:"constraint:OnDelete:CASCADE"`
+	Spans           []TraceSpan            `gorm:"constraint:OnDelete:CASCADE"`
+}
+
+// GetTagValue returns value of the Trace tag by its key.
+func (t Trace) GetTagValue(key string) (string, bool) {
+	for _, tag := range t.Tags {
+		if tag.Key == key {
+			return tag.Value, true
+		}
+	}
+	return "", false
+}
+
+// TraceTag represents model to work with `trace_tags` table.
+type TraceTag struct {
+	Key     string `gorm:"type:varchar(250);not null;primaryKey"`
+	Value   string `gorm:"type:varchar(8000)"`
+	TraceID string `gorm:"column:request_id;type:varchar(50);not null;primaryKey"`
+}
+
+// TraceRequestMetadata represents model to work with `trace_request_metadata` table.
+type TraceRequestMetadata struct {
+	Key     string `gorm:"type:varchar(250);not null;primaryKey"`
+	Value   string `gorm:"type:varchar(8000)"`
+	TraceID string `gorm:"column:request_id;type:varchar(50);not null;primaryKey"`
+}
+
+// TableName returns actual table name.
+func (TraceRequestMetadata) TableName() string {
+	return "trace_request_metadata"
+}
+
+// TraceSpan represents model to work with `trace_spans` table.
+type TraceSpan struct {
+	TraceID       string      `gorm:"column:request_id;type:varchar(50);not null;primaryKey"`
+	SpanID        string      `gorm:"type:varchar(50);not null;primaryKey"`
+	ParentID      string      `gorm:"type:varchar(50)"`
+	Name          string      `gorm:"type:varchar(500)"`
+	StatusCode    string      `gorm:"type:varchar(50)"`
+	StatusMessage string      `gorm:"type:text"`
+	StartTimeNs   int64       `gorm:"type:bigint"`
+	EndTimeNs     int64       `gorm:"type:bigint"`
+	Attributes    types.JSONB `gorm:"type:text"`
+	Events        types.JSONB `gorm:"type:text"`
+}
@@ -0,0 +1,50 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/repositories"
+)
+
+// AudioRepositoryProvider provides an interface to work with `audio` entity.
+type AudioRepositoryProvider interface {
+	repositories.BaseRepositoryProvider
+	// Create creates a new models.Audio object together with its context.
+	Create(ctx context.Context, audio *models.Audio) error
+}
+
+// AudioRepository repository to work with `audio` entity.
+type AudioRepository struct {
+	repositories.BaseRepositoryProvider
+}
+
+// NewAudioRepository creates a repository to work with `audio` entity.
+func NewAudioRepository(db *gorm.DB) *AudioRepository {
+	return &AudioRepository{
+		repositories.NewBaseRepository(db),
+	}
+}
+
+// Create creates a new models.Audio object together with its context.
+func (r AudioRepository) Create(ctx context.Context, audio *models.Audio) error {
+	return r.GetDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(
+			clause.OnConflict{
+				Columns:   []clause.Column{{Name: "json"}},
+				UpdateAll: true,
+			},
+		).Create(&audio.Context).Error; err != nil {
+			return eris.Wrap(err, "error creating audio context")
+		}
+		audio.ContextID = audio.Context.ID
+		if err := tx.Omit("Context", "Run").Create(audio).Error; err != nil {
+			return eris.Wrap(err, "error creating audio entity")
+		}
+		return nil
+	})
+}
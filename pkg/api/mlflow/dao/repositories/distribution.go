@@ -0,0 +1,50 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/repositories"
+)
+
+// DistributionRepositoryProvider provides an interface to work with `distribution` entity.
+type DistributionRepositoryProvider interface {
+	repositories.BaseRepositoryProvider
+	// Create creates a new models.Distribution object together with its context.
+	Create(ctx context.Context, distribution *models.Distribution) error
+}
+
+// DistributionRepository repository to work with `distribution` entity.
+type DistributionRepository struct {
+	repositories.BaseRepositoryProvider
+}
+
+// NewDistributionRepository creates a repository to work with `distribution` entity.
+func NewDistributionRepository(db *gorm.DB) *DistributionRepository {
+	return &DistributionRepository{
+		repositories.NewBaseRepository(db),
+	}
+}
+
+// Create creates a new models.Distribution object together with its context.
+func (r DistributionRepository) Create(ctx context.Context, distribution *models.Distribution) error {
+	return r.GetDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(
+			clause.OnConflict{
+				Columns:   []clause.Column{{Name: "json"}},
+				UpdateAll: true,
+			},
+		).Create(&distribution.Context).Error; err != nil {
+			return eris.Wrap(err, "error creating distribution context")
+		}
+		distribution.ContextID = distribution.Context.ID
+		if err := tx.Omit("Context", "Run").Create(distribution).Error; err != nil {
+			return eris.Wrap(err, "error creating distribution entity")
+		}
+		return nil
+	})
+}
@@ -0,0 +1,245 @@
+package repositories
+
+import (
+	"math"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+)
+
+// metricSeriesStats represents statistics of the single metric series which are needed to downsample it.
+type metricSeriesStats struct {
+	RunID     string
+	Key       string
+	ContextID uint
+	Count     int
+	MinStep   int64
+	MaxStep   int64
+}
+
+// UniqueKey is a compound unique key for this metric series.
+func (s metricSeriesStats) UniqueKey() string {
+	return models.Metric{RunID: s.RunID, Key: s.Key, ContextID: s.ContextID}.UniqueKey()
+}
+
+// metricDownsampler reduces a single metric series, ordered by step, to the limited number of points.
+type metricDownsampler interface {
+	// Add adds the next metric of the series and returns metrics which have already been selected.
+	Add(metric models.Metric) []models.Metric
+	// Finish returns the rest of selected metrics.
+	Finish() []models.Metric
+}
+
+// newMetricDownsampler creates metricDownsampler for the series described by provided stats.
+func newMetricDownsampler(
+	method request.DownsamplingMethod, points int, stats metricSeriesStats,
+) metricDownsampler {
+	if points <= 0 || stats.Count <= points {
+		return &passthroughDownsampler{}
+	}
+	switch method {
+	case request.DownsamplingMethodMinMax:
+		return newMinMaxDownsampler(points, stats.MinStep, stats.MaxStep)
+	default:
+		return newLTTBDownsampler(points, stats.Count)
+	}
+}
+
+// passthroughDownsampler returns the series as is.
+type passthroughDownsampler struct{}
+
+// Add adds the next metric of the series and returns metrics which have already been selected.
+func (d *passthroughDownsampler) Add(metric models.Metric) []models.Metric {
+	return []models.Metric{metric}
+}
+
+// Finish returns the rest of selected metrics.
+func (d *passthroughDownsampler) Finish() []models.Metric {
+	return nil
+}
+
+// lttbDownsampler implements Largest-Triangle-Three-Buckets algorithm. The first and the last points
+// are always kept and the rest of the series is split into equal buckets by the number of points.
+// Only two buckets are kept in memory at any time.
+type lttbDownsampler struct {
+	count      int
+	buckets    int
+	bucketSize float64
+	index      int
+	bucket     int
+	previous   models.Metric
+	current    []models.Metric
+	next       []models.Metric
+}
+
+// newLTTBDownsampler creates new lttbDownsampler object.
+func newLTTBDownsampler(points, count int) *lttbDownsampler {
+	return &lttbDownsampler{
+		count:      count,
+		buckets:    points - 2,
+		bucketSize: float64(count-2) / float64(points-2),
+	}
+}
+
+// Add adds the next metric of the series and returns metrics which have already been selected.
+func (d *lttbDownsampler) Add(metric models.Metric) []models.Metric {
+	index := d.index
+	d.index++
+	if index == 0 {
+		d.previous = metric
+		return []models.Metric{metric}
+	}
+
+	var selected []models.Metric
+	if d.bucket < d.buckets && index >= d.bucketEnd(d.bucket) {
+		if d.current != nil {
+			selected = append(selected, d.selectPoint(d.next))
+		}
+		d.current, d.next = d.next, nil
+		d.bucket++
+	}
+	d.next = append(d.next, metric)
+	return selected
+}
+
+// Finish returns the rest of selected metrics.
+func (d *lttbDownsampler) Finish() []models.Metric {
+	if len(d.next) == 0 {
+		return nil
+	}
+	var selected []models.Metric
+	last := d.next[len(d.next)-1]
+	if d.current != nil {
+		selected = append(selected, d.selectPoint([]models.Metric{last}))
+	}
+	return append(selected, last)
+}
+
+// bucketEnd returns the exclusive end index of the bucket.
+func (d *lttbDownsampler) bucketEnd(bucket int) int {
+	end := int(float64(bucket+1)*d.bucketSize) + 1
+	if bucket == d.buckets-1 || end > d.count-1 {
+		end = d.count - 1
+	}
+	return end
+}
+
+// selectPoint selects the point from the current bucket which forms the largest triangle
+// together with the previously selected point and the average of the next bucket.
+func (d *lttbDownsampler) selectPoint(next []models.Metric) models.Metric {
+	var avgStep, avgValue float64
+	for _, metric := range next {
+		avgStep += float64(metric.Step)
+		avgValue += downsamplingValue(metric)
+	}
+	avgStep /= float64(len(next))
+	avgValue /= float64(len(next))
+
+	selected, maxArea := d.current[0], -1.0
+	previousStep, previousValue := float64(d.previous.Step), downsamplingValue(d.previous)
+	for _, metric := range d.current {
+		area := math.Abs(
+			(previousStep-avgStep)*(downsamplingValue(metric)-previousValue) -
+				(previousStep-float64(metric.Step))*(avgValue-previousValue),
+		)
+		if area > maxArea {
+			selected, maxArea = metric, area
+		}
+	}
+	d.previous = selected
+	return selected
+}
+
+// minMaxDownsampler splits the step range of the series into equal buckets and keeps
+// the minimal and the maximal value of each bucket in the step order.
+type minMaxDownsampler struct {
+	minStep   int64
+	buckets   int
+	width     float64
+	bucket    int
+	min       *models.Metric
+	max       *models.Metric
+	minIndex  int
+	maxIndex  int
+	index     int
+	nanMetric *models.Metric
+}
+
+// newMinMaxDownsampler creates new minMaxDownsampler object.
+func newMinMaxDownsampler(points int, minStep, maxStep int64) *minMaxDownsampler {
+	buckets := points / 2
+	return &minMaxDownsampler{
+		minStep: minStep,
+		buckets: buckets,
+		width:   float64(maxStep-minStep+1) / float64(buckets),
+		bucket:  -1,
+	}
+}
+
+// Add adds the next metric of the series and returns metrics which have already been selected.
+func (d *minMaxDownsampler) Add(metric models.Metric) []models.Metric {
+	var selected []models.Metric
+	if bucket := d.bucketOf(metric.Step); bucket != d.bucket {
+		selected = d.flush()
+		d.bucket = bucket
+	}
+
+	d.index++
+	if metric.IsNan {
+		if d.nanMetric == nil {
+			d.nanMetric = &metric
+		}
+		return selected
+	}
+	if d.min == nil || metric.Value < d.min.Value {
+		d.min, d.minIndex = &metric, d.index
+	}
+	if d.max == nil || metric.Value > d.max.Value {
+		d.max, d.maxIndex = &metric, d.index
+	}
+	return selected
+}
+
+// Finish returns the rest of selected metrics.
+func (d *minMaxDownsampler) Finish() []models.Metric {
+	return d.flush()
+}
+
+// bucketOf returns the bucket of the step.
+func (d *minMaxDownsampler) bucketOf(step int64) int {
+	bucket := int(float64(step-d.minStep) / d.width)
+	if bucket < 0 {
+		return 0
+	}
+	if bucket >= d.buckets {
+		return d.buckets - 1
+	}
+	return bucket
+}
+
+// flush returns selected metrics of the current bucket and resets the bucket state.
+// Bucket which has only NaN values is represented by its first NaN value.
+func (d *minMaxDownsampler) flush() []models.Metric {
+	var selected []models.Metric
+	switch {
+	case d.min == nil && d.nanMetric != nil:
+		selected = []models.Metric{*d.nanMetric}
+	case d.min == nil:
+	case d.minIndex == d.maxIndex:
+		selected = []models.Metric{*d.min}
+	case d.minIndex < d.maxIndex:
+		selected = []models.Metric{*d.min, *d.max}
+	default:
+		selected = []models.Metric{*d.max, *d.min}
+	}
+	d.min, d.max, d.nanMetric = nil, nil, nil
+	return selected
+}
+
+// downsamplingValue returns the value of the metric which is used by the downsampling algorithms.
+func downsamplingValue(metric models.Metric) float64 {
+	if metric.IsNan || math.IsNaN(metric.Value) || math.IsInf(metric.Value, 0) {
+		return 0
+	}
+	return metric.Value
+}
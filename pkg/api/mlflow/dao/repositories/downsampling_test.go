@@ -0,0 +1,101 @@
+package repositories
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+)
+
+func Test_newMetricDownsampler(t *testing.T) {
+	tests := []struct {
+		name           string
+		method         request.DownsamplingMethod
+		points         int
+		values         []float64
+		expectedSteps  []int64
+		expectedValues []float64
+	}{
+		{
+			name:           "LTTBWithLessMetricsThanPoints",
+			method:         request.DownsamplingMethodLTTB,
+			points:         10,
+			values:         []float64{1, 2, 3},
+			expectedSteps:  []int64{0, 1, 2},
+			expectedValues: []float64{1, 2, 3},
+		},
+		{
+			name:           "LTTBKeepsPeaks",
+			method:         request.DownsamplingMethodLTTB,
+			points:         4,
+			values:         []float64{0, 0, 10, 0, 0, 0, -10, 0, 0},
+			expectedSteps:  []int64{0, 2, 6, 8},
+			expectedValues: []float64{0, 10, -10, 0},
+		},
+		{
+			name:           "MinMaxKeepsStepOrder",
+			method:         request.DownsamplingMethodMinMax,
+			points:         4,
+			values:         []float64{5, 1, 3, 9, 7, 8, 2, 6},
+			expectedSteps:  []int64{1, 3, 5, 6},
+			expectedValues: []float64{1, 9, 8, 2},
+		},
+		{
+			name:           "MinMaxWithConstantBucket",
+			method:         request.DownsamplingMethodMinMax,
+			points:         4,
+			values:         []float64{1, 1, 1, 1, 2, 3, 4, 5},
+			expectedSteps:  []int64{0, 4, 7},
+			expectedValues: []float64{1, 2, 5},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			metrics := make([]models.Metric, len(tt.values))
+			for n, value := range tt.values {
+				metrics[n] = models.Metric{Step: int64(n), Value: value}
+			}
+			downsampler := newMetricDownsampler(tt.method, tt.points, metricSeriesStats{
+				Count:   len(metrics),
+				MinStep: metrics[0].Step,
+				MaxStep: metrics[len(metrics)-1].Step,
+			})
+
+			var result []models.Metric
+			for _, metric := range metrics {
+				result = append(result, downsampler.Add(metric)...)
+			}
+			result = append(result, downsampler.Finish()...)
+
+			steps, values := make([]int64, len(result)), make([]float64, len(result))
+			for n, metric := range result {
+				steps[n], values[n] = metric.Step, metric.Value
+			}
+			assert.Equal(t, tt.expectedSteps, steps)
+			assert.Equal(t, tt.expectedValues, values)
+		})
+	}
+}
+
+func Test_minMaxDownsampler_NaN(t *testing.T) {
+	downsampler := newMinMaxDownsampler(4, 0, 3)
+
+	var result []models.Metric
+	for _, metric := range []models.Metric{
+		{Step: 0, IsNan: true},
+		{Step: 1, IsNan: true},
+		{Step: 2, IsNan: true},
+		{Step: 3, Value: 1},
+	} {
+		result = append(result, downsampler.Add(metric)...)
+	}
+	result = append(result, downsampler.Finish()...)
+
+	assert.Equal(t, []models.Metric{
+		{Step: 0, IsNan: true},
+		{Step: 3, Value: 1},
+	}, result)
+}
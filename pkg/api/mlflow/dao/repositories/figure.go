@@ -0,0 +1,50 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/repositories"
+)
+
+// FigureRepositoryProvider provides an interface to work with `figure` entity.
+type FigureRepositoryProvider interface {
+	repositories.BaseRepositoryProvider
+	// Create creates a new models.Figure object together with its context.
+	Create(ctx context.Context, figure *models.Figure) error
+}
+
+// FigureRepository repository to work with `figure` entity.
+type FigureRepository struct {
+	repositories.BaseRepositoryProvider
+}
+
+// NewFigureRepository creates a repository to work with `figure` entity.
+func NewFigureRepository(db *gorm.DB) *FigureRepository {
+	return &FigureRepository{
+		repositories.NewBaseRepository(db),
+	}
+}
+
+// Create creates a new models.Figure object together with its context.
+func (r FigureRepository) Create(ctx context.Context, figure *models.Figure) error {
+	return r.GetDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(
+			clause.OnConflict{
+				Columns:   []clause.Column{{Name: "json"}},
+				UpdateAll: true,
+			},
+		).Create(&figure.Context).Error; err != nil {
+			return eris.Wrap(err, "error creating figure context")
+		}
+		figure.ContextID = figure.Context.ID
+		if err := tx.Omit("Context", "Run").Create(figure).Error; err != nil {
+			return eris.Wrap(err, "error creating figure entity")
+		}
+		return nil
+	})
+}
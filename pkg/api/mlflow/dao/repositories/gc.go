@@ -0,0 +1,266 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/repositories"
+)
+
+// GCRepositoryProvider provides an interface to permanently purge deleted `run` and `experiment` entities.
+type GCRepositoryProvider interface {
+	repositories.BaseRepositoryProvider
+	// GetDeletedRuns returns deleted runs of active experiments which were deleted not later than deletedBefore.
+	// Runs of all the namespaces are returned when namespaceID is nil.
+	GetDeletedRuns(ctx context.Context, namespaceID *uint, deletedBefore int64) ([]models.Run, error)
+	// GetDeletedExperiments returns deleted experiments which were deleted not later than deletedBefore.
+	// Experiments of all the namespaces are returned when namespaceID is nil.
+	GetDeletedExperiments(ctx context.Context, namespaceID *uint, deletedBefore int64) ([]models.Experiment, error)
+	// GetRunsByExperimentIDs returns all the runs of provided experiments.
+	GetRunsByExperimentIDs(ctx context.Context, experimentIDs []int32) ([]models.Run, error)
+	// GetTracesByExperimentIDs returns all the traces of provided experiments together with their tags.
+	GetTracesByExperimentIDs(ctx context.Context, experimentIDs []int32) ([]models.Trace, error)
+	// GetOtherExperimentsArtifactLocations returns artifact locations of all the experiments except provided one.
+	GetOtherExperimentsArtifactLocations(ctx context.Context, experimentID int32) ([]string, error)
+	// PurgeRuns permanently removes runs and all their related data.
+	PurgeRuns(ctx context.Context, ids []string) error
+	// PurgeExperiments permanently removes experiments, their runs and all their related data.
+	PurgeExperiments(ctx context.Context, ids []int32) error
+}
+
+// GCRepository repository to permanently purge deleted `run` and `experiment` entities.
+type GCRepository struct {
+	repositories.BaseRepositoryProvider
+}
+
+// NewGCRepository creates repository to permanently purge deleted `run` and `experiment` entities.
+func NewGCRepository(db *gorm.DB) *GCRepository {
+	return &GCRepository{
+		repositories.NewBaseRepository(db),
+	}
+}
+
+// GetDeletedRuns returns deleted runs of active experiments which were deleted not later than deletedBefore.
+// Runs of all the namespaces are returned when namespaceID is nil.
+func (r GCRepository) GetDeletedRuns(
+	ctx context.Context, namespaceID *uint, deletedBefore int64,
+) ([]models.Run, error) {
+	query := r.GetDB().WithContext(ctx).Joins(
+		"INNER JOIN experiments ON experiments.experiment_id = runs.experiment_id",
+	).Where(
+		"runs.lifecycle_stage = ?", models.LifecycleStageDeleted,
+	).Where(
+		"experiments.lifecycle_stage = ?", models.LifecycleStageActive,
+	).Where(
+		"runs.deleted_time <= ?", deletedBefore,
+	)
+	if namespaceID != nil {
+		query = query.Where("experiments.namespace_id = ?", *namespaceID)
+	}
+
+	var runs []models.Run
+	if err := query.Order("runs.row_num").Find(&runs).Error; err != nil {
+		return nil, eris.Wrap(err, "error getting deleted runs")
+	}
+	return runs, nil
+}
+
+// GetDeletedExperiments returns deleted experiments which were deleted not later than deletedBefore.
+// Experiments of all the namespaces are returned when namespaceID is nil.
+func (r GCRepository) GetDeletedExperiments(
+	ctx context.Context, namespaceID *uint, deletedBefore int64,
+) ([]models.Experiment, error) {
+	// default experiments can't be deleted, but never purge them even if they were marked as deleted directly.
+	query := r.GetDB().WithContext(ctx).Joins(
+		"INNER JOIN namespaces ON namespaces.id = experiments.namespace_id",
+	).Where(
+		"experiments.lifecycle_stage = ?", models.LifecycleStageDeleted,
+	).Where(
+		"experiments.last_update_time <= ?", deletedBefore,
+	).Where(
+		"experiments.experiment_id != namespaces.default_experiment_id",
+	)
+	if namespaceID != nil {
+		query = query.Where("experiments.namespace_id = ?", *namespaceID)
+	}
+
+	var experiments []models.Experiment
+	if err := query.Order("experiments.experiment_id").Find(&experiments).Error; err != nil {
+		return nil, eris.Wrap(err, "error getting deleted experiments")
+	}
+	return experiments, nil
+}
+
+// GetRunsByExperimentIDs returns all the runs of provided experiments.
+func (r GCRepository) GetRunsByExperimentIDs(ctx context.Context, experimentIDs []int32) ([]models.Run, error) {
+	var runs []models.Run
+	if err := r.GetDB().WithContext(ctx).Where(
+		"experiment_id IN ?", experimentIDs,
+	).Order("row_num").Find(&runs).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting runs of experiments: %v", experimentIDs)
+	}
+	return runs, nil
+}
+
+// GetTracesByExperimentIDs returns all the traces of provided experiments together with their tags.
+func (r GCRepository) GetTracesByExperimentIDs(
+	ctx context.Context, experimentIDs []int32,
+) ([]models.Trace, error) {
+	var traces []models.Trace
+	if err := r.GetDB().WithContext(ctx).Preload(
+		"Tags",
+	).Where(
+		"experiment_id IN ?", experimentIDs,
+	).Order("request_id").Find(&traces).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting traces of experiments: %v", experimentIDs)
+	}
+	return traces, nil
+}
+
+// GetOtherExperimentsArtifactLocations returns artifact locations of all the experiments except provided one.
+func (r GCRepository) GetOtherExperimentsArtifactLocations(
+	ctx context.Context, experimentID int32,
+) ([]string, error) {
+	var locations []string
+	if err := r.GetDB().WithContext(ctx).Model(
+		&models.Experiment{},
+	).Where(
+		"experiment_id != ?", experimentID,
+	).Pluck("artifact_location", &locations).Error; err != nil {
+		return nil, eris.Wrap(err, "error getting artifact locations of experiments")
+	}
+	return locations, nil
+}
+
+// PurgeRuns permanently removes runs and all their related data.
+func (r GCRepository) PurgeRuns(ctx context.Context, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	if err := r.GetDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var minRowNum sql.NullInt64
+		if err := tx.Model(
+			&models.Run{},
+		).Where(
+			"run_uuid IN ?", ids,
+		).Pluck("MIN(row_num)", &minRowNum).Error; err != nil {
+			return eris.Wrap(err, "error getting minimal row number of runs")
+		}
+
+		if err := purgeRuns(tx, tx.Model(&models.Run{}).Select("run_uuid").Where("run_uuid IN ?", ids)); err != nil {
+			return err
+		}
+
+		if minRowNum.Valid {
+			if err := NewRunRepository(tx).renumberRows(tx, models.RowNum(minRowNum.Int64)); err != nil {
+				return eris.Wrap(err, "error renumbering runs.row_num")
+			}
+		}
+		return nil
+	}); err != nil {
+		return eris.Wrapf(err, "error purging runs: %v", ids)
+	}
+	return nil
+}
+
+// PurgeExperiments permanently removes experiments, their runs and all their related data.
+func (r GCRepository) PurgeExperiments(ctx context.Context, ids []int32) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	if err := r.GetDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var minRowNum sql.NullInt64
+		if err := tx.Model(
+			&models.Run{},
+		).Where(
+			"experiment_id IN ?", ids,
+		).Pluck("MIN(row_num)", &minRowNum).Error; err != nil {
+			return eris.Wrap(err, "error getting minimal row number of runs")
+		}
+
+		if err := purgeRuns(
+			tx, tx.Model(&models.Run{}).Select("run_uuid").Where("experiment_id IN ?", ids),
+		); err != nil {
+			return err
+		}
+
+		if err := tx.Exec("DELETE FROM notes WHERE experiment_id IN ?", ids).Error; err != nil {
+			return eris.Wrap(err, "error deleting experiment notes")
+		}
+
+		traceIDs := tx.Model(&models.Trace{}).Select("request_id").Where("experiment_id IN ?", ids)
+		datasetIDs := tx.Model(&models.Dataset{}).Select("id").Where("experiment_id IN ?", ids)
+		inputIDs := tx.Model(&models.Input{}).Select("id").Where("source_id IN (?)", datasetIDs)
+		for _, item := range []struct {
+			model     any
+			condition string
+			value     any
+		}{
+			{model: &models.TraceTag{}, condition: "request_id IN (?)", value: traceIDs},
+			{model: &models.TraceRequestMetadata{}, condition: "request_id IN (?)", value: traceIDs},
+			{model: &models.TraceSpan{}, condition: "request_id IN (?)", value: traceIDs},
+			{model: &models.Trace{}, condition: "experiment_id IN ?", value: ids},
+			{model: &models.InputTag{}, condition: "input_id IN (?)", value: inputIDs},
+			{model: &models.Input{}, condition: "source_id IN (?)", value: datasetIDs},
+			{model: &models.Dataset{}, condition: "experiment_id IN ?", value: ids},
+			{model: &models.ExperimentTag{}, condition: "experiment_id IN ?", value: ids},
+			{model: &models.Experiment{}, condition: "experiment_id IN ?", value: ids},
+		} {
+			if err := tx.Where(item.condition, item.value).Delete(item.model).Error; err != nil {
+				return eris.Wrapf(err, "error deleting %T rows", item.model)
+			}
+		}
+
+		if minRowNum.Valid {
+			if err := NewRunRepository(tx).renumberRows(tx, models.RowNum(minRowNum.Int64)); err != nil {
+				return eris.Wrap(err, "error renumbering runs.row_num")
+			}
+		}
+		return nil
+	}); err != nil {
+		return eris.Wrapf(err, "error purging experiments: %v", ids)
+	}
+	return nil
+}
+
+// purgeRuns removes runs selected by provided sub query together with all their related data.
+// Related rows are removed explicitly, because not every table has `ON DELETE CASCADE` constraint.
+func purgeRuns(tx *gorm.DB, runIDs *gorm.DB) error {
+	inputIDs := tx.Model(&models.Input{}).Select("id").Where("destination_id IN (?)", runIDs)
+	for _, item := range []struct {
+		model     any
+		condition string
+		value     any
+	}{
+		{model: &models.Metric{}, condition: "run_uuid IN (?)", value: runIDs},
+		{model: &models.LatestMetric{}, condition: "run_uuid IN (?)", value: runIDs},
+		{model: &models.Param{}, condition: "run_uuid IN (?)", value: runIDs},
+		{model: &models.Tag{}, condition: "run_uuid IN (?)", value: runIDs},
+		{model: &models.Log{}, condition: "run_uuid IN (?)", value: runIDs},
+		{model: &models.Artifact{}, condition: "run_uuid IN (?)", value: runIDs},
+		{model: &models.Text{}, condition: "run_uuid IN (?)", value: runIDs},
+		{model: &models.Distribution{}, condition: "run_uuid IN (?)", value: runIDs},
+		{model: &models.Figure{}, condition: "run_uuid IN (?)", value: runIDs},
+		{model: &models.Audio{}, condition: "run_uuid IN (?)", value: runIDs},
+		{model: &models.InputTag{}, condition: "input_id IN (?)", value: inputIDs},
+		{model: &models.Input{}, condition: "destination_id IN (?)", value: runIDs},
+	} {
+		if err := tx.Where(item.condition, item.value).Delete(item.model).Error; err != nil {
+			return eris.Wrapf(err, "error deleting %T rows", item.model)
+		}
+	}
+	if err := tx.Exec("DELETE FROM run_shared_tags WHERE run_id IN (?)", runIDs).Error; err != nil {
+		return eris.Wrap(err, "error deleting run shared tags")
+	}
+	if err := tx.Exec("DELETE FROM notes WHERE run_uuid IN (?)", runIDs).Error; err != nil {
+		return eris.Wrap(err, "error deleting run notes")
+	}
+	if err := tx.Where("run_uuid IN (?)", runIDs).Delete(&models.Run{}).Error; err != nil {
+		return eris.Wrap(err, "error deleting runs")
+	}
+	return nil
+}
@@ -21,15 +21,15 @@ func makeSqlPlaceholders(numberInEachSet, numberOfSets int) string {
 // Key, Value, RunID from each input Param for use in sql values replacement
 func makeParamConflictPlaceholdersAndValues(params []models.Param, dialector string) (string, []interface{}) {
 	var placeholders string
-	// make place holders of 5 fields for each param
+	// make place holders of 7 fields for each param
 	if (sqlite.Dialector{}.Name() == dialector) {
-		placeholders = fmt.Sprintf("VALUES %s", makeSqlPlaceholders(5, len(params)))
+		placeholders = fmt.Sprintf("VALUES %s", makeSqlPlaceholders(7, len(params)))
 	} else {
-		set := "SELECT ?::text, ?::text, ?::int, ?::float, ?::text"
+		set := "SELECT ?::text, ?::text, ?::int, ?::float, ?::text, ?::boolean, ?::jsonb"
 		placeholders = strings.Repeat(set+"\nUNION ALL\n", len(params)-1) + set
 	}
-	// values array is params * 5 in length since using 5 fields from each
-	valuesArray := make([]interface{}, len(params)*5)
+	// values array is params * 7 in length since using 7 fields from each
+	valuesArray := make([]interface{}, len(params)*7)
 	index := 0
 	for _, param := range params {
 		valuesArray[index] = param.Key
@@ -40,8 +40,12 @@ func makeParamConflictPlaceholdersAndValues(params []models.Param, dialector str
 			valuesArray[index+3] = *param.ValueFloat
 		} else if param.ValueStr != nil {
 			valuesArray[index+4] = *param.ValueStr
+		} else if param.ValueBool != nil {
+			valuesArray[index+5] = *param.ValueBool
+		} else if param.HasValueJSON() {
+			valuesArray[index+6] = string(param.ValueJSON)
 		}
-		index = index + 5
+		index = index + 7
 	}
 	return placeholders, valuesArray
 }
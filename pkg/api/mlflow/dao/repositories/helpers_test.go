@@ -7,6 +7,7 @@ import (
 
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/types"
 	"github.com/G-Research/fasttrackml/pkg/database"
 )
 
@@ -40,8 +41,8 @@ func Test_makeParamConflictPlaceholdersAndValues(t *testing.T) {
 				{Key: "key1", ValueStr: common.GetPointer("value1"), RunID: "run1"},
 			},
 			dialector:            "postgres",
-			expectedPlaceholders: "SELECT ?::text, ?::text, ?::int, ?::float, ?::text",
-			expectedValues:       []interface{}{"key1", "run1", nil, nil, "value1"},
+			expectedPlaceholders: "SELECT ?::text, ?::text, ?::int, ?::float, ?::text, ?::boolean, ?::jsonb",
+			expectedValues:       []interface{}{"key1", "run1", nil, nil, "value1", nil, nil},
 		},
 		{
 			params: []models.Param{
@@ -49,18 +50,20 @@ func Test_makeParamConflictPlaceholdersAndValues(t *testing.T) {
 				{Key: "key2", ValueStr: common.GetPointer("value2"), RunID: "run2"},
 			},
 			dialector: "postgres",
-			expectedPlaceholders: "SELECT ?::text, ?::text, ?::int, ?::float, ?::text\n" +
+			expectedPlaceholders: "SELECT ?::text, ?::text, ?::int, ?::float, ?::text, ?::boolean, ?::jsonb\n" +
 				"UNION ALL\n" +
-				"SELECT ?::text, ?::text, ?::int, ?::float, ?::text",
-			expectedValues: []interface{}{"key1", "run1", nil, nil, "value1", "key2", "run2", nil, nil, "value2"},
+				"SELECT ?::text, ?::text, ?::int, ?::float, ?::text, ?::boolean, ?::jsonb",
+			expectedValues: []interface{}{
+				"key1", "run1", nil, nil, "value1", nil, nil, "key2", "run2", nil, nil, "value2", nil, nil,
+			},
 		},
 		{
 			params: []models.Param{
 				{Key: "key1", ValueStr: common.GetPointer("value1"), RunID: "run1"},
 			},
 			dialector:            "sqlite",
-			expectedPlaceholders: "VALUES (?,?,?,?,?)",
-			expectedValues:       []interface{}{"key1", "run1", nil, nil, "value1"},
+			expectedPlaceholders: "VALUES (?,?,?,?,?,?,?)",
+			expectedValues:       []interface{}{"key1", "run1", nil, nil, "value1", nil, nil},
 		},
 		{
 			params: []models.Param{
@@ -68,8 +71,21 @@ func Test_makeParamConflictPlaceholdersAndValues(t *testing.T) {
 				{Key: "key2", ValueStr: common.GetPointer("value2"), RunID: "run2"},
 			},
 			dialector:            "sqlite",
-			expectedPlaceholders: "VALUES (?,?,?,?,?),(?,?,?,?,?)",
-			expectedValues:       []interface{}{"key1", "run1", nil, nil, "value1", "key2", "run2", nil, nil, "value2"},
+			expectedPlaceholders: "VALUES (?,?,?,?,?,?,?),(?,?,?,?,?,?,?)",
+			expectedValues: []interface{}{
+				"key1", "run1", nil, nil, "value1", nil, nil, "key2", "run2", nil, nil, "value2", nil, nil,
+			},
+		},
+		{
+			params: []models.Param{
+				{Key: "key1", ValueBool: common.GetPointer(true), RunID: "run1"},
+				{Key: "key2", ValueJSON: types.JSONB(`{"lr":0.1}`), RunID: "run2"},
+			},
+			dialector:            "sqlite",
+			expectedPlaceholders: "VALUES (?,?,?,?,?,?,?),(?,?,?,?,?,?,?)",
+			expectedValues: []interface{}{
+				"key1", "run1", nil, nil, nil, true, nil, "key2", "run2", nil, nil, nil, nil, `{"lr":0.1}`,
+			},
 		},
 	}
 
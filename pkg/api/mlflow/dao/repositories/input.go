@@ -0,0 +1,96 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/repositories"
+)
+
+// InputRepositoryProvider provides an interface to work with models.Input entity.
+type InputRepositoryProvider interface {
+	repositories.BaseRepositoryProvider
+	// CreateRunInputs creates models.Dataset entities and connects them to models.Run as models.Input.
+	CreateRunInputs(ctx context.Context, run *models.Run, inputs []models.Input) error
+}
+
+// InputRepository repository to work with models.Input entity.
+type InputRepository struct {
+	repositories.BaseRepositoryProvider
+}
+
+// NewInputRepository creates repository to work with models.Input entity.
+func NewInputRepository(db *gorm.DB) *InputRepository {
+	return &InputRepository{
+		repositories.NewBaseRepository(db),
+	}
+}
+
+// CreateRunInputs creates models.Dataset entities and connects them to models.Run as models.Input.
+// Datasets are unique within the Experiment by name and digest, so already existing datasets,
+// inputs and input tags are reused as is.
+func (r InputRepository) CreateRunInputs(ctx context.Context, run *models.Run, inputs []models.Input) error {
+	if err := r.GetDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, input := range inputs {
+			newDataset := input.Dataset
+			newDataset.ExperimentID = run.ExperimentID
+			if err := tx.Clauses(
+				clause.OnConflict{DoNothing: true},
+			).Create(&newDataset).Error; err != nil {
+				return eris.Wrapf(err, "error creating dataset '%s'", newDataset.Name)
+			}
+			// dataset could already exist, so always use the stored one.
+			var dataset models.Dataset
+			if err := tx.Where(
+				"experiment_id = ? AND name = ? AND digest = ?",
+				newDataset.ExperimentID, newDataset.Name, newDataset.Digest,
+			).First(&dataset).Error; err != nil {
+				return eris.Wrapf(err, "error getting dataset '%s'", newDataset.Name)
+			}
+
+			newInput := models.Input{
+				SourceType:      models.InputSourceTypeDataset,
+				SourceID:        dataset.ID,
+				DestinationType: models.InputDestinationTypeRun,
+				DestinationID:   run.ID,
+			}
+			if err := tx.Omit(clause.Associations).Clauses(
+				clause.OnConflict{DoNothing: true},
+			).Create(&newInput).Error; err != nil {
+				return eris.Wrapf(err, "error creating input for dataset '%s'", dataset.Name)
+			}
+			var runInput models.Input
+			if err := tx.Where(
+				"source_type = ? AND source_id = ? AND destination_type = ? AND destination_id = ?",
+				newInput.SourceType, newInput.SourceID, newInput.DestinationType, newInput.DestinationID,
+			).First(&runInput).Error; err != nil {
+				return eris.Wrapf(err, "error getting input for dataset '%s'", dataset.Name)
+			}
+
+			if len(input.Tags) == 0 {
+				continue
+			}
+			tags := make([]models.InputTag, len(input.Tags))
+			for i, tag := range input.Tags {
+				tags[i] = models.InputTag{
+					InputID: runInput.ID,
+					Name:    tag.Name,
+					Value:   tag.Value,
+				}
+			}
+			if err := tx.Clauses(
+				clause.OnConflict{DoNothing: true},
+			).Create(&tags).Error; err != nil {
+				return eris.Wrapf(err, "error creating tags for input of dataset '%s'", dataset.Name)
+			}
+		}
+		return nil
+	}); err != nil {
+		return eris.Wrapf(err, "error creating inputs for run with id: %s", run.ID)
+	}
+	return nil
+}
@@ -19,6 +19,19 @@ const (
 	MetricHistoryBulkDefaultLimit = 25000
 )
 
+// MetricHistoryOptions represents pagination, context, step range and downsampling options of metric history.
+// Context maps JSON paths of the metric context to the expected values. Pagination is not applied
+// to downsampled history.
+type MetricHistoryOptions struct {
+	Limit        int
+	Offset       int
+	Context      map[string]string
+	StartStep    *int64
+	EndStep      *int64
+	Downsampling request.DownsamplingMethod
+	Points       int
+}
+
 // MetricRepositoryProvider provides an interface to work with models.Metric entity.
 type MetricRepositoryProvider interface {
 	repositories.BaseRepositoryProvider
@@ -30,15 +43,24 @@ type MetricRepositoryProvider interface {
 		namespaceID uint,
 		experimentIDs []string, runIDs []string, metricKeys []string,
 		viewType request.ViewType,
-		limit int32,
-		jsonPathValueMap map[string]string,
-	) (*sql.Rows, func(*sql.Rows, interface{}) error, error)
+		options MetricHistoryOptions,
+	) (*sql.Rows, func(*sql.Rows, interface{}) error, bool, error)
+	// GetDownsampledMetricHistories returns downsampled metric histories by request parameters.
+	GetDownsampledMetricHistories(
+		ctx context.Context,
+		namespaceID uint,
+		experimentIDs []string, runIDs []string, metricKeys []string,
+		viewType request.ViewType,
+		options MetricHistoryOptions,
+	) ([]models.Metric, error)
 	// GetMetricHistoryBulk returns metrics history bulk.
 	GetMetricHistoryBulk(
-		ctx context.Context, namespaceID uint, runIDs []string, key string, limit int,
+		ctx context.Context, namespaceID uint, runIDs []string, key string, options MetricHistoryOptions,
 	) ([]models.Metric, error)
 	// GetMetricHistoryByRunIDAndKey returns metrics history by RunID and Key.
-	GetMetricHistoryByRunIDAndKey(ctx context.Context, runID, key string) ([]models.Metric, error)
+	GetMetricHistoryByRunIDAndKey(
+		ctx context.Context, runID, key string, options MetricHistoryOptions,
+	) ([]models.Metric, error)
 }
 
 // MetricRepository repository to work with models.Metric entity.
@@ -169,16 +191,105 @@ func (r MetricRepository) CreateBatch(
 	return nil
 }
 
-// GetMetricHistories returns metric histories by request parameters.
+// GetMetricHistories returns metric histories by request parameters. Returned flag reports whether there are
+// more metrics after the requested page. It is checked only when the page is smaller than the default limit.
 // TODO think about to use interface instead of underlying type for -> func(*sql.Rows, interface{})
 func (r MetricRepository) GetMetricHistories(
 	ctx context.Context,
 	namespaceID uint,
 	experimentIDs []string, runIDs []string, metricKeys []string,
 	viewType request.ViewType,
-	limit int32,
-	jsonPathValueMap map[string]string,
-) (*sql.Rows, func(*sql.Rows, interface{}) error, error) {
+	options MetricHistoryOptions,
+) (*sql.Rows, func(*sql.Rows, interface{}) error, bool, error) {
+	query, err := r.getMetricHistoriesQuery(
+		ctx, namespaceID, experimentIDs, runIDs, metricKeys, viewType, options,
+	)
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	limit := options.Limit
+	if limit == 0 {
+		limit = MetricHistoriesDefaultLimit
+	}
+
+	hasMore := false
+	if limit < MetricHistoriesDefaultLimit {
+		var next []string
+		if err := query.Session(&gorm.Session{}).Offset(
+			options.Offset+limit,
+		).Limit(
+			1,
+		).Pluck("metrics.run_uuid", &next).Error; err != nil {
+			return nil, nil, false, eris.Wrap(err, "error checking for the next page of metric histories")
+		}
+		hasMore = len(next) > 0
+	}
+
+	rows, err := query.Joins(
+		"Context",
+	).Order(
+		"runs.start_time DESC",
+	).Order(
+		"metrics.run_uuid",
+	).Order(
+		"metrics.key",
+	).Order(
+		"metrics.step",
+	).Order(
+		"metrics.timestamp",
+	).Order(
+		"metrics.value",
+	).Offset(
+		options.Offset,
+	).Limit(
+		limit,
+	).Rows()
+	if err != nil {
+		return nil, nil, false, eris.Wrapf(
+			err, "error getting metrics by experimentIDs: %v, runIDs: %v, metricKeys: %v, viewType: %s",
+			experimentIDs,
+			runIDs,
+			metricKeys,
+			viewType,
+		)
+	}
+	return rows, r.GetDB().ScanRows, hasMore, nil
+}
+
+// GetDownsampledMetricHistories returns downsampled metric histories by request parameters.
+func (r MetricRepository) GetDownsampledMetricHistories(
+	ctx context.Context,
+	namespaceID uint,
+	experimentIDs []string, runIDs []string, metricKeys []string,
+	viewType request.ViewType,
+	options MetricHistoryOptions,
+) ([]models.Metric, error) {
+	metrics, err := r.getDownsampledMetrics(func() (*gorm.DB, error) {
+		return r.getMetricHistoriesQuery(
+			ctx, namespaceID, experimentIDs, runIDs, metricKeys, viewType, options,
+		)
+	}, options, "runs.start_time DESC")
+	if err != nil {
+		return nil, eris.Wrapf(
+			err, "error getting downsampled metrics by experimentIDs: %v, runIDs: %v, metricKeys: %v, viewType: %s",
+			experimentIDs,
+			runIDs,
+			metricKeys,
+			viewType,
+		)
+	}
+	return metrics, nil
+}
+
+// getMetricHistoriesQuery builds the query which selects metric histories by request parameters.
+func (r MetricRepository) getMetricHistoriesQuery(
+	ctx context.Context,
+	namespaceID uint,
+	experimentIDs []string, runIDs []string, metricKeys []string,
+	viewType request.ViewType,
+	options MetricHistoryOptions,
+) (*gorm.DB, error) {
 	// if experimentIDs has been provided then firstly get the runs by provided experimentIDs.
 	if len(experimentIDs) > 0 {
 		query := r.GetDB().WithContext(ctx).Model(
@@ -206,7 +317,7 @@ func (r MetricRepository) GetMetricHistories(
 			})
 		}
 		if err := query.Pluck("run_uuid", &runIDs).Error; err != nil {
-			return nil, nil, eris.Wrapf(
+			return nil, eris.Wrapf(
 				err, "error getting runs by experimentIDs: %v, viewType: %s", experimentIDs, viewType,
 			)
 		}
@@ -223,48 +334,14 @@ func (r MetricRepository) GetMetricHistories(
 	).Joins(
 		"INNER JOIN experiments ON experiments.experiment_id = runs.experiment_id AND experiments.namespace_id = ?",
 		namespaceID,
-	).Joins(
-		"Context",
-	).Order(
-		"runs.start_time DESC",
-	).Order(
-		"metrics.run_uuid",
-	).Order(
-		"metrics.key",
-	).Order(
-		"metrics.step",
-	).Order(
-		"metrics.timestamp",
-	).Order(
-		"metrics.value",
 	)
 
-	if limit == 0 {
-		limit = MetricHistoriesDefaultLimit
-	}
-	query.Limit(int(limit))
-
 	if len(metricKeys) > 0 {
 		query.Where("metrics.key IN ?", metricKeys)
 	}
 
-	if len(jsonPathValueMap) > 0 {
-		query.Joins("LEFT JOIN contexts on metrics.context_id = contexts.id")
-		sql, args := BuildJsonCondition(query.Dialector.Name(), "contexts.json", jsonPathValueMap)
-		query.Where(sql, args...)
-	}
-
-	rows, err := query.Rows()
-	if err != nil {
-		return nil, nil, eris.Wrapf(
-			err, "error getting metrics by experimentIDs: %v, runIDs: %v, metricKeys: %v, viewType: %s",
-			experimentIDs,
-			runIDs,
-			metricKeys,
-			viewType,
-		)
-	}
-	return rows, r.GetDB().ScanRows, nil
+	applyMetricHistoryConditions(query, options)
+	return query, nil
 }
 
 // getLatestMetricsByRunIDAndKeys returns the latest metrics by requested Run ID and keys.
@@ -284,18 +361,46 @@ func (r MetricRepository) getLatestMetricsByRunIDAndKeys(
 
 // GetMetricHistoryByRunIDAndKey returns metrics history by RunID and Key.
 func (r MetricRepository) GetMetricHistoryByRunIDAndKey(
-	ctx context.Context, runID, key string,
+	ctx context.Context, runID, key string, options MetricHistoryOptions,
 ) ([]models.Metric, error) {
-	var metrics []models.Metric
-	if err := r.GetDB().WithContext(
-		ctx,
-	).Joins(
+	newQuery := func() (*gorm.DB, error) {
+		query := r.GetDB().WithContext(ctx).Model(
+			&database.Metric{},
+		).Where(
+			"metrics.run_uuid = ?", runID,
+		).Where(
+			"metrics.key = ?", key,
+		)
+		applyMetricHistoryConditions(query, options)
+		return query, nil
+	}
+
+	if options.Downsampling != "" {
+		metrics, err := r.getDownsampledMetrics(newQuery, options)
+		if err != nil {
+			return nil, eris.Wrapf(
+				err, "error getting downsampled metric history by run id: %s and key: %s", runID, key,
+			)
+		}
+		return metrics, nil
+	}
+
+	query, _ := newQuery()
+	query.Joins(
 		"Context",
-	).Where(
-		"run_uuid = ?", runID,
-	).Where(
-		"key = ?", key,
-	).Find(&metrics).Error; err != nil {
+	).Order(
+		"metrics.step",
+	).Order(
+		"metrics.timestamp",
+	).Order(
+		"metrics.value",
+	)
+	if options.Limit > 0 {
+		query.Offset(options.Offset).Limit(options.Limit + 1)
+	}
+
+	var metrics []models.Metric
+	if err := query.Find(&metrics).Error; err != nil {
 		return nil, eris.Wrapf(err, "error getting metric history by run id: %s and key: %s", runID, key)
 	}
 	return metrics, nil
@@ -303,19 +408,37 @@ func (r MetricRepository) GetMetricHistoryByRunIDAndKey(
 
 // GetMetricHistoryBulk returns metrics history bulk.
 func (r MetricRepository) GetMetricHistoryBulk(
-	ctx context.Context, namespaceID uint, runIDs []string, key string, limit int,
+	ctx context.Context, namespaceID uint, runIDs []string, key string, options MetricHistoryOptions,
 ) ([]models.Metric, error) {
-	var metrics []models.Metric
-	query := r.GetDB().WithContext(ctx).Where(
-		"runs.run_uuid IN ?", runIDs,
-	).Joins(
-		"LEFT JOIN runs ON runs.run_uuid = metrics.run_uuid",
-	).Joins(
-		"INNER JOIN experiments ON experiments.experiment_id = runs.experiment_id AND experiments.namespace_id = ?",
-		namespaceID,
-	).Where(
-		"key = ?", key,
-	).Order(
+	newQuery := func() (*gorm.DB, error) {
+		query := r.GetDB().WithContext(ctx).Model(
+			&database.Metric{},
+		).Where(
+			"runs.run_uuid IN ?", runIDs,
+		).Joins(
+			"LEFT JOIN runs ON runs.run_uuid = metrics.run_uuid",
+		).Joins(
+			"INNER JOIN experiments ON experiments.experiment_id = runs.experiment_id AND experiments.namespace_id = ?",
+			namespaceID,
+		).Where(
+			"metrics.key = ?", key,
+		)
+		applyMetricHistoryConditions(query, options)
+		return query, nil
+	}
+
+	if options.Downsampling != "" {
+		metrics, err := r.getDownsampledMetrics(newQuery, options)
+		if err != nil {
+			return nil, eris.Wrapf(
+				err, "error getting downsampled metric history by run ids: %v and key: %s", runIDs, key,
+			)
+		}
+		return metrics, nil
+	}
+
+	query, _ := newQuery()
+	query.Order(
 		"metrics.run_uuid",
 	).Order(
 		"metrics.timestamp",
@@ -325,11 +448,13 @@ func (r MetricRepository) GetMetricHistoryBulk(
 		"metrics.value",
 	)
 
+	limit := options.Limit
 	if limit == 0 {
 		limit = MetricHistoryBulkDefaultLimit
 	}
-	query.Limit(limit)
+	query.Offset(options.Offset).Limit(limit + 1)
 
+	var metrics []models.Metric
 	if err := query.Find(
 		&metrics,
 	).Error; err != nil {
@@ -337,3 +462,96 @@ func (r MetricRepository) GetMetricHistoryBulk(
 	}
 	return metrics, nil
 }
+
+// getDownsampledMetrics reads every metric series selected by the query and downsamples it. Series are
+// identified by run, key and context and are processed one by one, so only the state of the current series
+// is kept in memory. Additional order is applied before the series order.
+func (r MetricRepository) getDownsampledMetrics(
+	newQuery func() (*gorm.DB, error), options MetricHistoryOptions, order ...string,
+) ([]models.Metric, error) {
+	query, err := newQuery()
+	if err != nil {
+		return nil, err
+	}
+	var stats []metricSeriesStats
+	if err := query.Select(
+		"metrics.run_uuid AS run_id, metrics.key AS key, metrics.context_id AS context_id, " +
+			"COUNT(*) AS count, MIN(metrics.step) AS min_step, MAX(metrics.step) AS max_step",
+	).Group(
+		"metrics.run_uuid, metrics.key, metrics.context_id",
+	).Scan(&stats).Error; err != nil {
+		return nil, eris.Wrap(err, "error getting metric series statistics")
+	}
+	statsMap := make(map[string]metricSeriesStats, len(stats))
+	for _, s := range stats {
+		statsMap[s.UniqueKey()] = s
+	}
+
+	query, err = newQuery()
+	if err != nil {
+		return nil, err
+	}
+	query.Joins("Context")
+	for _, o := range order {
+		query.Order(o)
+	}
+	rows, err := query.Order(
+		"metrics.run_uuid",
+	).Order(
+		"metrics.key",
+	).Order(
+		"metrics.context_id",
+	).Order(
+		"metrics.step",
+	).Order(
+		"metrics.timestamp",
+	).Order(
+		"metrics.value",
+	).Rows()
+	if err != nil {
+		return nil, eris.Wrap(err, "error getting metrics")
+	}
+	defer rows.Close()
+
+	var (
+		series      string
+		downsampler metricDownsampler
+	)
+	metrics := []models.Metric{}
+	for rows.Next() {
+		var metric models.Metric
+		if err := r.GetDB().ScanRows(rows, &metric); err != nil {
+			return nil, eris.Wrap(err, "error scanning metric")
+		}
+		if key := metric.UniqueKey(); downsampler == nil || key != series {
+			if downsampler != nil {
+				metrics = append(metrics, downsampler.Finish()...)
+			}
+			series = key
+			downsampler = newMetricDownsampler(options.Downsampling, options.Points, statsMap[key])
+		}
+		metrics = append(metrics, downsampler.Add(metric)...)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, eris.Wrap(err, "error reading metrics")
+	}
+	if downsampler != nil {
+		metrics = append(metrics, downsampler.Finish()...)
+	}
+	return metrics, nil
+}
+
+// applyMetricHistoryConditions restricts the query to the context and the step range of provided options.
+func applyMetricHistoryConditions(query *gorm.DB, options MetricHistoryOptions) {
+	if len(options.Context) > 0 {
+		query.Joins("LEFT JOIN contexts on metrics.context_id = contexts.id")
+		sql, args := BuildJsonCondition(query.Dialector.Name(), "contexts.json", options.Context)
+		query.Where(sql, args...)
+	}
+	if options.StartStep != nil {
+		query.Where("metrics.step >= ?", *options.StartStep)
+	}
+	if options.EndStep != nil {
+		query.Where("metrics.step <= ?", *options.EndStep)
+	}
+}
@@ -51,60 +51,93 @@ func (_m *MockMetricRepositoryProvider) GetDB() *gorm.DB {
 	return r0
 }
 
-// GetMetricHistories provides a mock function with given fields: ctx, namespaceID, experimentIDs, runIDs, metricKeys, viewType, limit, jsonPathValueMap
-func (_m *MockMetricRepositoryProvider) GetMetricHistories(ctx context.Context, namespaceID uint, experimentIDs []string, runIDs []string, metricKeys []string, viewType request.ViewType, limit int32, jsonPathValueMap map[string]string) (*sql.Rows, func(*sql.Rows, interface{}) error, error) {
-	ret := _m.Called(ctx, namespaceID, experimentIDs, runIDs, metricKeys, viewType, limit, jsonPathValueMap)
+// GetDownsampledMetricHistories provides a mock function with given fields: ctx, namespaceID, experimentIDs, runIDs, metricKeys, viewType, options
+func (_m *MockMetricRepositoryProvider) GetDownsampledMetricHistories(ctx context.Context, namespaceID uint, experimentIDs []string, runIDs []string, metricKeys []string, viewType request.ViewType, options MetricHistoryOptions) ([]models.Metric, error) {
+	ret := _m.Called(ctx, namespaceID, experimentIDs, runIDs, metricKeys, viewType, options)
+
+	var r0 []models.Metric
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, []string, []string, []string, request.ViewType, MetricHistoryOptions) ([]models.Metric, error)); ok {
+		return rf(ctx, namespaceID, experimentIDs, runIDs, metricKeys, viewType, options)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, []string, []string, []string, request.ViewType, MetricHistoryOptions) []models.Metric); ok {
+		r0 = rf(ctx, namespaceID, experimentIDs, runIDs, metricKeys, viewType, options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Metric)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, []string, []string, []string, request.ViewType, MetricHistoryOptions) error); ok {
+		r1 = rf(ctx, namespaceID, experimentIDs, runIDs, metricKeys, viewType, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMetricHistories provides a mock function with given fields: ctx, namespaceID, experimentIDs, runIDs, metricKeys, viewType, options
+func (_m *MockMetricRepositoryProvider) GetMetricHistories(ctx context.Context, namespaceID uint, experimentIDs []string, runIDs []string, metricKeys []string, viewType request.ViewType, options MetricHistoryOptions) (*sql.Rows, func(*sql.Rows, interface{}) error, bool, error) {
+	ret := _m.Called(ctx, namespaceID, experimentIDs, runIDs, metricKeys, viewType, options)
 
 	var r0 *sql.Rows
 	var r1 func(*sql.Rows, interface{}) error
-	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint, []string, []string, []string, request.ViewType, int32, map[string]string) (*sql.Rows, func(*sql.Rows, interface{}) error, error)); ok {
-		return rf(ctx, namespaceID, experimentIDs, runIDs, metricKeys, viewType, limit, jsonPathValueMap)
+	var r2 bool
+	var r3 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, []string, []string, []string, request.ViewType, MetricHistoryOptions) (*sql.Rows, func(*sql.Rows, interface{}) error, bool, error)); ok {
+		return rf(ctx, namespaceID, experimentIDs, runIDs, metricKeys, viewType, options)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uint, []string, []string, []string, request.ViewType, int32, map[string]string) *sql.Rows); ok {
-		r0 = rf(ctx, namespaceID, experimentIDs, runIDs, metricKeys, viewType, limit, jsonPathValueMap)
+	if rf, ok := ret.Get(0).(func(context.Context, uint, []string, []string, []string, request.ViewType, MetricHistoryOptions) *sql.Rows); ok {
+		r0 = rf(ctx, namespaceID, experimentIDs, runIDs, metricKeys, viewType, options)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*sql.Rows)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uint, []string, []string, []string, request.ViewType, int32, map[string]string) func(*sql.Rows, interface{}) error); ok {
-		r1 = rf(ctx, namespaceID, experimentIDs, runIDs, metricKeys, viewType, limit, jsonPathValueMap)
+	if rf, ok := ret.Get(1).(func(context.Context, uint, []string, []string, []string, request.ViewType, MetricHistoryOptions) func(*sql.Rows, interface{}) error); ok {
+		r1 = rf(ctx, namespaceID, experimentIDs, runIDs, metricKeys, viewType, options)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(func(*sql.Rows, interface{}) error)
 		}
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, uint, []string, []string, []string, request.ViewType, int32, map[string]string) error); ok {
-		r2 = rf(ctx, namespaceID, experimentIDs, runIDs, metricKeys, viewType, limit, jsonPathValueMap)
+	if rf, ok := ret.Get(2).(func(context.Context, uint, []string, []string, []string, request.ViewType, MetricHistoryOptions) bool); ok {
+		r2 = rf(ctx, namespaceID, experimentIDs, runIDs, metricKeys, viewType, options)
+	} else {
+		r2 = ret.Get(2).(bool)
+	}
+
+	if rf, ok := ret.Get(3).(func(context.Context, uint, []string, []string, []string, request.ViewType, MetricHistoryOptions) error); ok {
+		r3 = rf(ctx, namespaceID, experimentIDs, runIDs, metricKeys, viewType, options)
 	} else {
-		r2 = ret.Error(2)
+		r3 = ret.Error(3)
 	}
 
-	return r0, r1, r2
+	return r0, r1, r2, r3
 }
 
-// GetMetricHistoryBulk provides a mock function with given fields: ctx, namespaceID, runIDs, key, limit
-func (_m *MockMetricRepositoryProvider) GetMetricHistoryBulk(ctx context.Context, namespaceID uint, runIDs []string, key string, limit int) ([]models.Metric, error) {
-	ret := _m.Called(ctx, namespaceID, runIDs, key, limit)
+// GetMetricHistoryBulk provides a mock function with given fields: ctx, namespaceID, runIDs, key, options
+func (_m *MockMetricRepositoryProvider) GetMetricHistoryBulk(ctx context.Context, namespaceID uint, runIDs []string, key string, options MetricHistoryOptions) ([]models.Metric, error) {
+	ret := _m.Called(ctx, namespaceID, runIDs, key, options)
 
 	var r0 []models.Metric
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint, []string, string, int) ([]models.Metric, error)); ok {
-		return rf(ctx, namespaceID, runIDs, key, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, uint, []string, string, MetricHistoryOptions) ([]models.Metric, error)); ok {
+		return rf(ctx, namespaceID, runIDs, key, options)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uint, []string, string, int) []models.Metric); ok {
-		r0 = rf(ctx, namespaceID, runIDs, key, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, uint, []string, string, MetricHistoryOptions) []models.Metric); ok {
+		r0 = rf(ctx, namespaceID, runIDs, key, options)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]models.Metric)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uint, []string, string, int) error); ok {
-		r1 = rf(ctx, namespaceID, runIDs, key, limit)
+	if rf, ok := ret.Get(1).(func(context.Context, uint, []string, string, MetricHistoryOptions) error); ok {
+		r1 = rf(ctx, namespaceID, runIDs, key, options)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -112,25 +145,25 @@ func (_m *MockMetricRepositoryProvider) GetMetricHistoryBulk(ctx context.Context
 	return r0, r1
 }
 
-// GetMetricHistoryByRunIDAndKey provides a mock function with given fields: ctx, runID, key
-func (_m *MockMetricRepositoryProvider) GetMetricHistoryByRunIDAndKey(ctx context.Context, runID string, key string) ([]models.Metric, error) {
-	ret := _m.Called(ctx, runID, key)
+// GetMetricHistoryByRunIDAndKey provides a mock function with given fields: ctx, runID, key, options
+func (_m *MockMetricRepositoryProvider) GetMetricHistoryByRunIDAndKey(ctx context.Context, runID string, key string, options MetricHistoryOptions) ([]models.Metric, error) {
+	ret := _m.Called(ctx, runID, key, options)
 
 	var r0 []models.Metric
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]models.Metric, error)); ok {
-		return rf(ctx, runID, key)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, MetricHistoryOptions) ([]models.Metric, error)); ok {
+		return rf(ctx, runID, key, options)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) []models.Metric); ok {
-		r0 = rf(ctx, runID, key)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, MetricHistoryOptions) []models.Metric); ok {
+		r0 = rf(ctx, runID, key, options)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]models.Metric)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = rf(ctx, runID, key)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, MetricHistoryOptions) error); ok {
+		r1 = rf(ctx, runID, key, options)
 	} else {
 		r1 = ret.Error(1)
 	}
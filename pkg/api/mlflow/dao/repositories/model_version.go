@@ -0,0 +1,213 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/repositories"
+)
+
+// ModelVersionRepositoryProvider provides an interface to work with models.ModelVersion entity.
+type ModelVersionRepositoryProvider interface {
+	repositories.BaseRepositoryProvider
+	// Create creates new models.ModelVersion entity using the next available version number.
+	Create(ctx context.Context, modelVersion *models.ModelVersion) error
+	// Update updates existing models.ModelVersion entity.
+	Update(ctx context.Context, modelVersion *models.ModelVersion) error
+	// Delete marks existing models.ModelVersion entity as deleted and removes aliases pointing to it.
+	Delete(ctx context.Context, modelVersion *models.ModelVersion) error
+	// TransitionStage moves models.ModelVersion entity to the new stage,
+	// optionally archiving the other versions in the same stage.
+	TransitionStage(
+		ctx context.Context,
+		modelVersion *models.ModelVersion,
+		stage models.ModelVersionStage,
+		archiveExistingVersions bool,
+	) error
+	// GetByRegisteredModelIDAndVersion returns models.ModelVersion entity by RegisteredModel ID and its version.
+	GetByRegisteredModelIDAndVersion(
+		ctx context.Context, registeredModelID uuid.UUID, version int64,
+	) (*models.ModelVersion, error)
+	// SetTag creates or updates models.ModelVersionTag entity.
+	SetTag(ctx context.Context, tag *models.ModelVersionTag) error
+	// DeleteTag removes existing models.ModelVersionTag entity.
+	DeleteTag(ctx context.Context, tag *models.ModelVersionTag) error
+}
+
+// ModelVersionRepository repository to work with models.ModelVersion entity.
+type ModelVersionRepository struct {
+	repositories.BaseRepositoryProvider
+}
+
+// NewModelVersionRepository creates repository to work with models.ModelVersion entity.
+func NewModelVersionRepository(db *gorm.DB) *ModelVersionRepository {
+	return &ModelVersionRepository{
+		repositories.NewBaseRepository(db),
+	}
+}
+
+// Create creates new models.ModelVersion entity using the next available version number.
+func (r ModelVersionRepository) Create(ctx context.Context, modelVersion *models.ModelVersion) error {
+	if err := r.GetDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// deleted versions are kept in the table, so version numbers are never reused.
+		var version int64
+		if err := tx.Model(
+			&models.ModelVersion{},
+		).Where(
+			"registered_model_id = ?", modelVersion.RegisteredModelID,
+		).Select(
+			"COALESCE(MAX(version), 0)",
+		).Scan(&version).Error; err != nil {
+			return eris.Wrap(err, "error getting latest model version")
+		}
+		modelVersion.Version = version + 1
+
+		if err := tx.Omit("RegisteredModel").Create(modelVersion).Error; err != nil {
+			return eris.Wrap(err, "error creating model version")
+		}
+
+		if err := tx.Model(
+			&models.RegisteredModel{ID: modelVersion.RegisteredModelID},
+		).Update(
+			"last_updated_time", modelVersion.CreationTime,
+		).Error; err != nil {
+			return eris.Wrap(err, "error updating registered model last_updated_time")
+		}
+		return nil
+	}); err != nil {
+		return eris.Wrapf(err, "error creating model version for registered model with id: %s",
+			modelVersion.RegisteredModelID,
+		)
+	}
+	return nil
+}
+
+// Update updates existing models.ModelVersion entity.
+func (r ModelVersionRepository) Update(ctx context.Context, modelVersion *models.ModelVersion) error {
+	if err := r.GetDB().WithContext(ctx).Model(
+		modelVersion,
+	).Select(
+		"Description", "LastUpdatedTime",
+	).Updates(modelVersion).Error; err != nil {
+		return eris.Wrapf(err, "error updating model version with id: %s", modelVersion.ID)
+	}
+	return nil
+}
+
+// Delete marks existing models.ModelVersion entity as deleted and removes aliases pointing to it.
+func (r ModelVersionRepository) Delete(ctx context.Context, modelVersion *models.ModelVersion) error {
+	if err := r.GetDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where(
+			"registered_model_id = ? AND version = ?", modelVersion.RegisteredModelID, modelVersion.Version,
+		).Delete(&models.RegisteredModelAlias{}).Error; err != nil {
+			return eris.Wrap(err, "error deleting model version aliases")
+		}
+		if err := tx.Where(
+			"model_version_id = ?", modelVersion.ID,
+		).Delete(&models.ModelVersionTag{}).Error; err != nil {
+			return eris.Wrap(err, "error deleting model version tags")
+		}
+		// MLflow keeps deleted model versions to never reuse their numbers, so do the same.
+		return tx.Model(
+			modelVersion,
+		).Updates(map[string]any{
+			"current_stage":     models.ModelVersionStageDeletedInternal,
+			"description":       "",
+			"user_id":           "",
+			"source":            "",
+			"storage_location":  "",
+			"run_id":            "",
+			"run_link":          "",
+			"status_message":    "",
+			"last_updated_time": modelVersion.LastUpdatedTime,
+		}).Error
+	}); err != nil {
+		return eris.Wrapf(err, "error deleting model version with id: %s", modelVersion.ID)
+	}
+	return nil
+}
+
+// TransitionStage moves models.ModelVersion entity to the new stage,
+// optionally archiving the other versions in the same stage.
+func (r ModelVersionRepository) TransitionStage(
+	ctx context.Context,
+	modelVersion *models.ModelVersion,
+	stage models.ModelVersionStage,
+	archiveExistingVersions bool,
+) error {
+	if err := r.GetDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if archiveExistingVersions {
+			if err := tx.Model(
+				&models.ModelVersion{},
+			).Where(
+				"registered_model_id = ?", modelVersion.RegisteredModelID,
+			).Where(
+				"id <> ?", modelVersion.ID,
+			).Where(
+				"current_stage = ?", stage,
+			).Updates(map[string]any{
+				"current_stage":     models.ModelVersionStageArchived,
+				"last_updated_time": modelVersion.LastUpdatedTime,
+			}).Error; err != nil {
+				return eris.Wrap(err, "error archiving existing model versions")
+			}
+		}
+		modelVersion.CurrentStage = stage
+		return tx.Model(
+			modelVersion,
+		).Select(
+			"CurrentStage", "LastUpdatedTime",
+		).Updates(modelVersion).Error
+	}); err != nil {
+		return eris.Wrapf(err, "error transitioning model version with id: %s to stage: %s", modelVersion.ID, stage)
+	}
+	return nil
+}
+
+// GetByRegisteredModelIDAndVersion returns models.ModelVersion entity by RegisteredModel ID and its version.
+func (r ModelVersionRepository) GetByRegisteredModelIDAndVersion(
+	ctx context.Context, registeredModelID uuid.UUID, version int64,
+) (*models.ModelVersion, error) {
+	var modelVersion models.ModelVersion
+	if err := r.GetDB().WithContext(ctx).Preload(
+		"Tags",
+	).Where(
+		"registered_model_id = ?", registeredModelID,
+	).Where(
+		"version = ?", version,
+	).Where(
+		"current_stage <> ?", models.ModelVersionStageDeletedInternal,
+	).First(&modelVersion).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, eris.Wrapf(
+			err, "error getting model version by registered model id: %s and version: %d", registeredModelID, version,
+		)
+	}
+	return &modelVersion, nil
+}
+
+// SetTag creates or updates models.ModelVersionTag entity.
+func (r ModelVersionRepository) SetTag(ctx context.Context, tag *models.ModelVersionTag) error {
+	if err := r.GetDB().WithContext(ctx).Clauses(clause.OnConflict{
+		UpdateAll: true,
+	}).Create(tag).Error; err != nil {
+		return eris.Wrapf(err, "error setting tag for model version with id: %s", tag.ModelVersionID)
+	}
+	return nil
+}
+
+// DeleteTag removes existing models.ModelVersionTag entity.
+func (r ModelVersionRepository) DeleteTag(ctx context.Context, tag *models.ModelVersionTag) error {
+	if err := r.GetDB().WithContext(ctx).Delete(tag).Error; err != nil {
+		return eris.Wrapf(err, "error deleting tag by model version id: %s and key: %s", tag.ModelVersionID, tag.Key)
+	}
+	return nil
+}
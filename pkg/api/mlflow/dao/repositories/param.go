@@ -92,16 +92,18 @@ func findConflictingParams(tx *gorm.DB, params []models.Param) ([]paramConflict,
 	if (tx.Dialector.Name() == postgres.Dialector{}.Name()) {
 		nullSafeEquality = "IS DISTINCT FROM"
 	}
-	sql := fmt.Sprintf(`WITH new(key, run_uuid, value_int, value_float, value_str) AS (%s)
+	sql := fmt.Sprintf(`WITH new(key, run_uuid, value_int, value_float, value_str, value_bool, value_json) AS (%s)
 		     SELECT current.run_uuid, current.key, CONCAT(current.value_int, 
-			   current.value_float, current.value_str) as old_value, CONCAT(new.value_int,
-			   new.value_float, new.value_str) as new_value
+			   current.value_float, current.value_str, current.value_bool, current.value_json) as old_value,
+			   CONCAT(new.value_int, new.value_float, new.value_str, new.value_bool, new.value_json) as new_value
 		     FROM params AS current
 		     INNER JOIN new USING (run_uuid, key)
 		     WHERE (new.value_int %s current.value_int)
 			 OR (new.value_float %s current.value_float)
-			 OR (new.value_str %s current.value_str)`,
-		placeholders, nullSafeEquality, nullSafeEquality, nullSafeEquality)
+			 OR (new.value_str %s current.value_str)
+			 OR (new.value_bool %s current.value_bool)
+			 OR (new.value_json %s current.value_json)`,
+		placeholders, nullSafeEquality, nullSafeEquality, nullSafeEquality, nullSafeEquality, nullSafeEquality)
 	if err := tx.Raw(sql, values...).
 		Find(&conflicts).Error; err != nil {
 		return nil, eris.Wrap(err, "error fetching params from db")
@@ -68,7 +68,7 @@ func (r RunRepository) GetByID(ctx context.Context, id string) (*models.Run, err
 	if err := r.GetDB().WithContext(
 		ctx,
 	).Preload(
-		"LatestMetrics",
+		"LatestMetrics.Context",
 	).Preload(
 		"Params",
 	).Preload(
@@ -108,11 +108,15 @@ func (r RunRepository) GetByNamespaceIDAndRunID(
 	if err := r.GetDB().WithContext(
 		ctx,
 	).Preload(
-		"LatestMetrics",
+		"LatestMetrics.Context",
 	).Preload(
 		"Params",
 	).Preload(
 		"Tags",
+	).Preload(
+		"Inputs.Dataset",
+	).Preload(
+		"Inputs.Tags",
 	).Joins(
 		"INNER JOIN experiments ON experiments.experiment_id = runs.experiment_id AND experiments.namespace_id = ?",
 		namespaceID,
@@ -0,0 +1,50 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/repositories"
+)
+
+// TextRepositoryProvider provides an interface to work with `text` entity.
+type TextRepositoryProvider interface {
+	repositories.BaseRepositoryProvider
+	// Create creates a new models.Text object together with its context.
+	Create(ctx context.Context, text *models.Text) error
+}
+
+// TextRepository repository to work with `text` entity.
+type TextRepository struct {
+	repositories.BaseRepositoryProvider
+}
+
+// NewTextRepository creates a repository to work with `text` entity.
+func NewTextRepository(db *gorm.DB) *TextRepository {
+	return &TextRepository{
+		repositories.NewBaseRepository(db),
+	}
+}
+
+// Create creates a new models.Text object together with its context.
+func (r TextRepository) Create(ctx context.Context, text *models.Text) error {
+	return r.GetDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(
+			clause.OnConflict{
+				Columns:   []clause.Column{{Name: "json"}},
+				UpdateAll: true,
+			},
+		).Create(&text.Context).Error; err != nil {
+			return eris.Wrap(err, "error creating text context")
+		}
+		text.ContextID = text.Context.ID
+		if err := tx.Omit("Context", "Run").Create(text).Error; err != nil {
+			return eris.Wrap(err, "error creating text entity")
+		}
+		return nil
+	})
+}
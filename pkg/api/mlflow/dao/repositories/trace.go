@@ -0,0 +1,225 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/repositories"
+)
+
+// TraceRepositoryProvider provides an interface to work with models.Trace entity.
+type TraceRepositoryProvider interface {
+	repositories.BaseRepositoryProvider
+	// Create creates new models.Trace entity together with its tags and request metadata.
+	Create(ctx context.Context, trace *models.Trace) error
+	// Update updates existing models.Trace entity and merges its tags, request metadata and spans.
+	Update(ctx context.Context, trace *models.Trace) error
+	// GetByNamespaceIDAndRequestID returns models.Trace entity by Namespace ID and its Request ID.
+	GetByNamespaceIDAndRequestID(ctx context.Context, namespaceID uint, requestID string) (*models.Trace, error)
+	// GetSpans returns models.TraceSpan entities of the Trace ordered by the start time.
+	GetSpans(ctx context.Context, requestID string) ([]models.TraceSpan, error)
+	// SetTag creates or updates models.TraceTag entity.
+	SetTag(ctx context.Context, tag *models.TraceTag) error
+	// DeleteTag removes existing models.TraceTag entity.
+	DeleteTag(ctx context.Context, tag *models.TraceTag) error
+	// GetBatch returns models.Trace entities of the Experiment matching provided conditions.
+	GetBatch(
+		ctx context.Context, experimentID int32, maxTimestampMs int64, maxTraces int32, requestIDs []string,
+	) ([]models.Trace, error)
+	// DeleteBatch removes models.Trace entities of the Experiment by their Request IDs.
+	DeleteBatch(ctx context.Context, experimentID int32, requestIDs []string) (int64, error)
+}
+
+// TraceRepository repository to work with models.Trace entity.
+type TraceRepository struct {
+	repositories.BaseRepositoryProvider
+}
+
+// NewTraceRepository creates repository to work with models.Trace entity.
+func NewTraceRepository(db *gorm.DB) *TraceRepository {
+	return &TraceRepository{
+		repositories.NewBaseRepository(db),
+	}
+}
+
+// Create creates new models.Trace entity together with its tags and request metadata.
+func (r TraceRepository) Create(ctx context.Context, trace *models.Trace) error {
+	if err := r.GetDB().WithContext(ctx).Create(trace).Error; err != nil {
+		return eris.Wrapf(err, "error creating trace with id: %s", trace.ID)
+	}
+	return nil
+}
+
+// Update updates existing models.Trace entity and merges its tags, request metadata and spans.
+func (r TraceRepository) Update(ctx context.Context, trace *models.Trace) error {
+	if err := r.GetDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(
+			trace,
+		).Omit(
+			clause.Associations,
+		).Select(
+			"Status", "ExecutionTimeMs",
+		).Updates(trace).Error; err != nil {
+			return eris.Wrap(err, "error updating trace")
+		}
+		if len(trace.Tags) > 0 {
+			if err := tx.Clauses(clause.OnConflict{
+				UpdateAll: true,
+			}).Create(&trace.Tags).Error; err != nil {
+				return eris.Wrap(err, "error updating trace tags")
+			}
+		}
+		if len(trace.RequestMetadata) > 0 {
+			if err := tx.Clauses(clause.OnConflict{
+				UpdateAll: true,
+			}).Create(&trace.RequestMetadata).Error; err != nil {
+				return eris.Wrap(err, "error updating trace request metadata")
+			}
+		}
+		if len(trace.Spans) > 0 {
+			if err := tx.Clauses(clause.OnConflict{
+				UpdateAll: true,
+			}).CreateInBatches(&trace.Spans, 100).Error; err != nil {
+				return eris.Wrap(err, "error updating trace spans")
+			}
+		}
+		return nil
+	}); err != nil {
+		return eris.Wrapf(err, "error updating trace with id: %s", trace.ID)
+	}
+	return nil
+}
+
+// GetByNamespaceIDAndRequestID returns models.Trace entity by Namespace ID and its Request ID.
+func (r TraceRepository) GetByNamespaceIDAndRequestID(
+	ctx context.Context, namespaceID uint, requestID string,
+) (*models.Trace, error) {
+	var trace models.Trace
+	if err := r.GetDB().WithContext(
+		ctx,
+	).Preload(
+		"Tags",
+	).Preload(
+		"RequestMetadata",
+	).Joins(
+		"INNER JOIN experiments ON experiments.experiment_id = traces.experiment_id AND experiments.namespace_id = ?",
+		namespaceID,
+	).Where(
+		"traces.request_id = ?", requestID,
+	).First(&trace).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, eris.Wrapf(err, "error getting trace by id: %s", requestID)
+	}
+	return &trace, nil
+}
+
+// GetSpans returns models.TraceSpan entities of the Trace ordered by the start time.
+func (r TraceRepository) GetSpans(ctx context.Context, requestID string) ([]models.TraceSpan, error) {
+	var spans []models.TraceSpan
+	if err := r.GetDB().WithContext(
+		ctx,
+	).Where(
+		"request_id = ?", requestID,
+	).Order(
+		"start_time_ns",
+	).Order(
+		"span_id",
+	).Find(&spans).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting spans of trace with id: %s", requestID)
+	}
+	return spans, nil
+}
+
+// SetTag creates or updates models.TraceTag entity.
+func (r TraceRepository) SetTag(ctx context.Context, tag *models.TraceTag) error {
+	if err := r.GetDB().WithContext(ctx).Clauses(clause.OnConflict{
+		UpdateAll: true,
+	}).Create(tag).Error; err != nil {
+		return eris.Wrapf(err, "error setting tag for trace with id: %s", tag.TraceID)
+	}
+	return nil
+}
+
+// DeleteTag removes existing models.TraceTag entity.
+func (r TraceRepository) DeleteTag(ctx context.Context, tag *models.TraceTag) error {
+	if err := r.GetDB().WithContext(ctx).Delete(tag).Error; err != nil {
+		return eris.Wrapf(err, "error deleting tag by trace id: %s and key: %s", tag.TraceID, tag.Key)
+	}
+	return nil
+}
+
+// GetBatch returns models.Trace entities of the Experiment matching provided conditions.
+// Either `requestIDs` or `maxTimestampMs` has to be provided. In the last case the oldest traces
+// are returned first and `maxTraces` limits the number of returned traces.
+func (r TraceRepository) GetBatch(
+	ctx context.Context, experimentID int32, maxTimestampMs int64, maxTraces int32, requestIDs []string,
+) ([]models.Trace, error) {
+	query := r.GetDB().WithContext(
+		ctx,
+	).Preload(
+		"Tags",
+	).Where(
+		"experiment_id = ?", experimentID,
+	)
+	if len(requestIDs) > 0 {
+		query = query.Where("request_id IN (?)", requestIDs)
+	} else {
+		query = query.Where("timestamp_ms <= ?", maxTimestampMs).Order("timestamp_ms")
+		if maxTraces > 0 {
+			query = query.Limit(int(maxTraces))
+		}
+	}
+
+	var traces []models.Trace
+	if err := query.Find(&traces).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting traces of experiment with id: %d", experimentID)
+	}
+	return traces, nil
+}
+
+// DeleteBatch removes models.Trace entities of the Experiment by their Request IDs.
+func (r TraceRepository) DeleteBatch(ctx context.Context, experimentID int32, requestIDs []string) (int64, error) {
+	if len(requestIDs) == 0 {
+		return 0, nil
+	}
+	var deleted int64
+	if err := r.GetDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var ids []string
+		if err := tx.Model(
+			&models.Trace{},
+		).Where(
+			"experiment_id = ? AND request_id IN (?)", experimentID, requestIDs,
+		).Pluck("request_id", &ids).Error; err != nil {
+			return eris.Wrap(err, "error getting traces to delete")
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+
+		for _, table := range []any{
+			&models.TraceTag{},
+			&models.TraceRequestMetadata{},
+			&models.TraceSpan{},
+		} {
+			if err := tx.Where("request_id IN (?)", ids).Delete(table).Error; err != nil {
+				return eris.Wrap(err, "error deleting trace relations")
+			}
+		}
+		result := tx.Where("request_id IN (?)", ids).Delete(&models.Trace{})
+		if result.Error != nil {
+			return eris.Wrap(result.Error, "error deleting traces")
+		}
+		deleted = result.RowsAffected
+		return nil
+	}); err != nil {
+		return 0, eris.Wrapf(err, "error deleting traces of experiment with id: %d", experimentID)
+	}
+	return deleted, nil
+}
@@ -0,0 +1,150 @@
+package query
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// supported list of comparison operators.
+const (
+	OperatorEqual          = "="
+	OperatorNotEqual       = "!="
+	OperatorLess           = "<"
+	OperatorLessOrEqual    = "<="
+	OperatorGreater        = ">"
+	OperatorGreaterOrEqual = ">="
+	OperatorLike           = "LIKE"
+	OperatorNotLike        = "NOT LIKE"
+	OperatorILike          = "ILIKE"
+	OperatorNotILike       = "NOT ILIKE"
+	OperatorIn             = "IN"
+	OperatorNotIn          = "NOT IN"
+	OperatorIsNull         = "IS NULL"
+	OperatorIsNotNull      = "IS NOT NULL"
+)
+
+// ValueKind represents kind of the literal value.
+type ValueKind int
+
+// supported list of value kinds.
+const (
+	ValueKindString ValueKind = iota
+	ValueKindNumber
+	ValueKindIdentifier
+	ValueKindList
+)
+
+// Node represents a node of the filter syntax tree.
+type Node interface {
+	fmt.Stringer
+}
+
+// And represents logical conjunction of two nodes.
+type And struct {
+	Left  Node
+	Right Node
+}
+
+// String implements fmt.Stringer interface.
+func (n And) String() string {
+	return fmt.Sprintf("(%s AND %s)", n.Left, n.Right)
+}
+
+// Or represents logical disjunction of two nodes.
+type Or struct {
+	Left  Node
+	Right Node
+}
+
+// String implements fmt.Stringer interface.
+func (n Or) String() string {
+	return fmt.Sprintf("(%s OR %s)", n.Left, n.Right)
+}
+
+// Not represents logical negation of the node.
+type Not struct {
+	Node Node
+}
+
+// String implements fmt.Stringer interface.
+func (n Not) String() string {
+	return fmt.Sprintf("NOT %s", n.Node)
+}
+
+// Comparison represents single `entity.key <operator> value` condition.
+type Comparison struct {
+	Entity string
+	Key    string
+	// Context contains `{path="value", ...}` selector of the metric context, if provided.
+	Context  map[string]string
+	Operator string
+	// Value is nil for `IS NULL` and `IS NOT NULL` operators.
+	Value  *Value
+	Offset int
+}
+
+// String implements fmt.Stringer interface.
+func (n Comparison) String() string {
+	identifier := n.Key
+	if n.Entity != "" {
+		identifier = n.Entity + "." + n.Key
+	}
+	if n.Context != nil {
+		paths := make([]string, 0, len(n.Context))
+		for path := range n.Context {
+			paths = append(paths, path)
+		}
+		sort.Strings(paths)
+		selectors := make([]string, len(paths))
+		for i, path := range paths {
+			selectors[i] = fmt.Sprintf("%s=%q", path, n.Context[path])
+		}
+		identifier += "{" + strings.Join(selectors, ", ") + "}"
+	}
+	if n.Value == nil {
+		return fmt.Sprintf("%s %s", identifier, n.Operator)
+	}
+	return fmt.Sprintf("%s %s %s", identifier, n.Operator, n.Value.Raw)
+}
+
+// Value represents literal value of the comparison.
+type Value struct {
+	Kind ValueKind
+	// Text contains value without quotes.
+	Text string
+	// Raw contains value as it has been written in the filter.
+	Raw string
+	// Items contains list items for ValueKindList kind.
+	Items []Value
+}
+
+// IsList checks that value is a list.
+func (v Value) IsList() bool {
+	return v.Kind == ValueKindList
+}
+
+// Strings returns list item values as strings or value itself for a scalar value.
+func (v Value) Strings() []string {
+	if !v.IsList() {
+		return []string{v.Text}
+	}
+	values := make([]string, len(v.Items))
+	for i, item := range v.Items {
+		values[i] = item.Text
+	}
+	return values
+}
+
+// newListValue creates new list value from the provided items.
+func newListValue(items []Value) *Value {
+	raw := make([]string, len(items))
+	for i, item := range items {
+		raw[i] = item.Raw
+	}
+	return &Value{
+		Kind:  ValueKindList,
+		Raw:   "(" + strings.Join(raw, ", ") + ")",
+		Items: items,
+	}
+}
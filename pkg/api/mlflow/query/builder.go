@@ -0,0 +1,131 @@
+package query
+
+import (
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm/clause"
+
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+)
+
+// ConditionBuilder converts a single comparison into SQL expression.
+// Its errors are returned by Build function as is.
+type ConditionBuilder func(comparison *Comparison) (clause.Expr, error)
+
+// Build converts syntax tree into SQL expression using provided builder for each comparison.
+func Build(node Node, builder ConditionBuilder) (clause.Expr, error) {
+	switch node := node.(type) {
+	case And:
+		return buildBinary(node.Left, node.Right, "AND", builder)
+	case Or:
+		return buildBinary(node.Left, node.Right, "OR", builder)
+	case Not:
+		expr, err := Build(node.Node, builder)
+		if err != nil {
+			return clause.Expr{}, err
+		}
+		return clause.Expr{SQL: fmt.Sprintf("NOT (%s)", expr.SQL), Vars: expr.Vars}, nil
+	case Comparison:
+		expr, err := builder(&node)
+		if err != nil {
+			return clause.Expr{}, err
+		}
+		return clause.Expr{SQL: fmt.Sprintf("(%s)", expr.SQL), Vars: expr.Vars}, nil
+	default:
+		return clause.Expr{}, fmt.Errorf("unsupported filter node %T", node)
+	}
+}
+
+// buildBinary builds SQL expression of logical operator.
+func buildBinary(left, right Node, operator string, builder ConditionBuilder) (clause.Expr, error) {
+	leftExpr, err := Build(left, builder)
+	if err != nil {
+		return clause.Expr{}, err
+	}
+	rightExpr, err := Build(right, builder)
+	if err != nil {
+		return clause.Expr{}, err
+	}
+	return clause.Expr{
+		SQL:  fmt.Sprintf("(%s %s %s)", leftExpr.SQL, operator, rightExpr.SQL),
+		Vars: append(leftExpr.Vars, rightExpr.Vars...),
+	}, nil
+}
+
+// StringCondition builds condition for string column. As sqlite doesn't support ILIKE operator
+// it is emulated by LIKE over lower-cased values.
+func StringCondition(dialector, column, operator string, value *Value) clause.Expr {
+	switch operator {
+	case OperatorIsNull, OperatorIsNotNull:
+		return clause.Expr{SQL: fmt.Sprintf("%s %s", column, operator)}
+	case OperatorIn, OperatorNotIn:
+		return clause.Expr{SQL: fmt.Sprintf("%s %s ?", column, operator), Vars: []any{value.Strings()}}
+	case OperatorILike, OperatorNotILike:
+		if dialector == "sqlite" {
+			return clause.Expr{
+				SQL:  fmt.Sprintf("LOWER(%s) %s ?", column, strings.Replace(operator, "ILIKE", "LIKE", 1)),
+				Vars: []any{strings.ToLower(value.Text)},
+			}
+		}
+	}
+	return clause.Expr{SQL: fmt.Sprintf("%s %s ?", column, operator), Vars: []any{value.Text}}
+}
+
+// ValidateStringComparison validates operator and value of string comparison.
+// `operatorError` is the message format of the error returned for unsupported operator.
+func ValidateStringComparison(operator string, value *Value, operatorError string) error {
+	switch {
+	case IsNullOperator(operator):
+		return nil
+	case IsListOperator(operator):
+		if !value.IsList() {
+			return api.NewInvalidParameterValueError("invalid list definition '%s'", value.Raw)
+		}
+		return nil
+	case IsStringOperator(operator):
+		if value.IsList() {
+			return api.NewInvalidParameterValueError("invalid string value '%s'", value.Raw)
+		}
+		return nil
+	default:
+		return api.NewInvalidParameterValueError(operatorError, operator)
+	}
+}
+
+// ValueCondition builds condition for non-string column with already converted value.
+func ValueCondition(column, operator string, value any) clause.Expr {
+	switch operator {
+	case OperatorIsNull, OperatorIsNotNull:
+		return clause.Expr{SQL: fmt.Sprintf("%s %s", column, operator)}
+	}
+	return clause.Expr{SQL: fmt.Sprintf("%s %s ?", column, operator), Vars: []any{value}}
+}
+
+// IsStringOperator checks that operator could be applied to string values.
+func IsStringOperator(operator string) bool {
+	switch operator {
+	case OperatorEqual, OperatorNotEqual, OperatorLike, OperatorNotLike, OperatorILike, OperatorNotILike:
+		return true
+	}
+	return false
+}
+
+// IsNumericOperator checks that operator could be applied to numeric values.
+func IsNumericOperator(operator string) bool {
+	switch operator {
+	case OperatorEqual, OperatorNotEqual, OperatorLess, OperatorLessOrEqual, OperatorGreater, OperatorGreaterOrEqual:
+		return true
+	}
+	return false
+}
+
+// IsListOperator checks that operator expects list value.
+func IsListOperator(operator string) bool {
+	return operator == OperatorIn || operator == OperatorNotIn
+}
+
+// IsNullOperator checks that operator doesn't expect any value.
+func IsNullOperator(operator string) bool {
+	return operator == OperatorIsNull || operator == OperatorIsNotNull
+}
@@ -0,0 +1,75 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm/clause"
+
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+)
+
+func TestBuild_Ok(t *testing.T) {
+	node, err := Parse(`NOT (tags.a = 'x' OR tags.b ILIKE 'Y%') AND run_id IN ('1', '2') AND end_time IS NULL`)
+	require.Nil(t, err)
+
+	expr, err := Build(node, func(comparison *Comparison) (clause.Expr, error) {
+		return StringCondition("sqlite", comparison.Key, comparison.Operator, comparison.Value), nil
+	})
+	require.Nil(t, err)
+	assert.Equal(
+		t,
+		`((NOT (((a = ?) OR (LOWER(b) LIKE ?))) AND (run_id IN ?)) AND (end_time IS NULL))`,
+		expr.SQL,
+	)
+	assert.Equal(t, []any{"x", "y%", []string{"1", "2"}}, expr.Vars)
+}
+
+func TestBuild_Error(t *testing.T) {
+	node, err := Parse(`tags.a = 'x' OR tags.b = 'y'`)
+	require.Nil(t, err)
+
+	_, err = Build(node, func(comparison *Comparison) (clause.Expr, error) {
+		if comparison.Key == "b" {
+			return clause.Expr{}, assert.AnError
+		}
+		return StringCondition("postgres", comparison.Key, comparison.Operator, comparison.Value), nil
+	})
+	assert.Equal(t, assert.AnError, err)
+}
+
+func TestValidateStringComparison_Error(t *testing.T) {
+	tests := []struct {
+		name     string
+		operator string
+		value    *Value
+		error    *api.ErrorResponse
+	}{
+		{
+			name:     "ListOperatorWithString",
+			operator: OperatorIn,
+			value:    &Value{Kind: ValueKindString, Text: "x", Raw: "'x'"},
+			error:    api.NewInvalidParameterValueError("invalid list definition ''x''"),
+		},
+		{
+			name:     "StringOperatorWithList",
+			operator: OperatorLike,
+			value:    &Value{Kind: ValueKindList, Raw: "('x')"},
+			error:    api.NewInvalidParameterValueError("invalid string value '('x')'"),
+		},
+		{
+			name:     "UnsupportedOperator",
+			operator: OperatorGreater,
+			value:    &Value{Kind: ValueKindString, Text: "x", Raw: "'x'"},
+			error:    api.NewInvalidParameterValueError("invalid tag comparison operator '>'"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateStringComparison(tt.operator, tt.value, "invalid tag comparison operator '%s'")
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package query
+
+import (
+	"strings"
+	"unicode"
+)
+
+// tokenKind represents kind of the lexical token.
+type tokenKind int
+
+// supported list of token kinds.
+const (
+	tokenEOF tokenKind = iota
+	tokenIdentifier
+	tokenQuotedIdentifier
+	tokenString
+	tokenNumber
+	tokenOperator
+	tokenComma
+	tokenLeftParen
+	tokenRightParen
+	tokenLeftBrace
+	tokenRightBrace
+)
+
+// token represents single lexical token of the filter.
+type token struct {
+	kind   tokenKind
+	text   string // token value, for quoted tokens without quotes.
+	offset int    // offset of the first token character in the filter.
+	end    int    // offset right after the last token character in the filter.
+}
+
+// isKeyword checks that token is provided (case-insensitive) keyword.
+func (t token) isKeyword(keyword string) bool {
+	return t.kind == tokenIdentifier && strings.EqualFold(t.text, keyword)
+}
+
+// describe returns human-readable token description for error messages.
+func (t token) describe() string {
+	if t.kind == tokenEOF {
+		return "end of filter"
+	}
+	return "'" + t.text + "'"
+}
+
+// tokenize splits filter into the list of lexical tokens.
+func tokenize(filter string) ([]token, error) {
+	var tokens []token
+	runes := []rune(filter)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, token{kind: tokenLeftParen, text: "(", offset: i, end: i + 1})
+			i++
+		case r == ')':
+			tokens = append(tokens, token{kind: tokenRightParen, text: ")", offset: i, end: i + 1})
+			i++
+		case r == '{':
+			tokens = append(tokens, token{kind: tokenLeftBrace, text: "{", offset: i, end: i + 1})
+			i++
+		case r == '}':
+			tokens = append(tokens, token{kind: tokenRightBrace, text: "}", offset: i, end: i + 1})
+			i++
+		case r == ',':
+			tokens = append(tokens, token{kind: tokenComma, text: ",", offset: i, end: i + 1})
+			i++
+		case r == '\'' || r == '"' || r == '`':
+			end := i + 1
+			var text strings.Builder
+			for ; end < len(runes); end++ {
+				if runes[end] == r {
+					// doubled quote character is an escaped quote.
+					if end+1 < len(runes) && runes[end+1] == r {
+						text.WriteRune(r)
+						end++
+						continue
+					}
+					break
+				}
+				text.WriteRune(runes[end])
+			}
+			if end >= len(runes) {
+				return nil, newSyntaxError(filter, i, "unterminated quoted string")
+			}
+			kind := tokenString
+			if r == '`' {
+				kind = tokenQuotedIdentifier
+			}
+			tokens = append(tokens, token{kind: kind, text: text.String(), offset: i, end: end + 1})
+			i = end + 1
+		case isNumberStart(runes, i):
+			kind, end := tokenNumber, scanNumber(runes, i)
+			// unquoted values like `3f2a` are handled as identifiers.
+			for end < len(runes) && (isIdentifierCharacter(runes[end]) || runes[end] == '.') {
+				kind = tokenIdentifier
+				end++
+			}
+			tokens = append(tokens, token{kind: kind, text: string(runes[i:end]), offset: i, end: end})
+			i = end
+		case isIdentifierCharacter(r) && !unicode.IsDigit(r):
+			// dotted identifiers like `tags.mlflow.runName` are handled as a single token.
+			end := i + 1
+			for end < len(runes) && (isIdentifierCharacter(runes[end]) || runes[end] == '.') {
+				end++
+			}
+			tokens = append(tokens, token{kind: tokenIdentifier, text: string(runes[i:end]), offset: i, end: end})
+			i = end
+		case r == '=' || r == '!' || r == '<' || r == '>':
+			end := i + 1
+			if end < len(runes) && (runes[end] == '=' || (r == '<' && runes[end] == '>')) {
+				end++
+			}
+			operator := string(runes[i:end])
+			switch operator {
+			case "=", "==":
+				operator = "="
+			case "!=", "<>":
+				operator = "!="
+			case "<", "<=", ">", ">=":
+			default:
+				return nil, newSyntaxError(filter, i, "unexpected character '%s'", operator)
+			}
+			tokens = append(tokens, token{kind: tokenOperator, text: operator, offset: i, end: end})
+			i = end
+		default:
+			return nil, newSyntaxError(filter, i, "unexpected character '%c'", r)
+		}
+	}
+	return append(tokens, token{kind: tokenEOF, offset: len(runes), end: len(runes)}), nil
+}
+
+// isIdentifierCharacter checks that provided character could be a part of identifier.
+func isIdentifierCharacter(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// isNumberStart checks that a number literal starts at the provided position.
+func isNumberStart(runes []rune, i int) bool {
+	if runes[i] == '-' || runes[i] == '+' {
+		i++
+	}
+	if i < len(runes) && runes[i] == '.' {
+		i++
+	}
+	return i < len(runes) && unicode.IsDigit(runes[i])
+}
+
+// scanNumber returns the position right after the number literal started at provided position.
+func scanNumber(runes []rune, i int) int {
+	if runes[i] == '-' || runes[i] == '+' {
+		i++
+	}
+	for i < len(runes) && unicode.IsDigit(runes[i]) {
+		i++
+	}
+	if i < len(runes) && runes[i] == '.' {
+		i++
+		for i < len(runes) && unicode.IsDigit(runes[i]) {
+			i++
+		}
+	}
+	if i < len(runes) && (runes[i] == 'e' || runes[i] == 'E') {
+		j := i + 1
+		if j < len(runes) && (runes[j] == '-' || runes[j] == '+') {
+			j++
+		}
+		if j < len(runes) && unicode.IsDigit(runes[j]) {
+			i = j
+			for i < len(runes) && unicode.IsDigit(runes[i]) {
+				i++
+			}
+		}
+	}
+	return i
+}
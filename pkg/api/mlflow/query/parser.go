@@ -0,0 +1,303 @@
+package query
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SyntaxError represents an error of filter parsing with the position where it happened.
+type SyntaxError struct {
+	Filter  string
+	Offset  int
+	Message string
+}
+
+// newSyntaxError creates new SyntaxError instance.
+func newSyntaxError(filter string, offset int, message string, args ...any) *SyntaxError {
+	return &SyntaxError{
+		Filter:  filter,
+		Offset:  offset,
+		Message: fmt.Sprintf(message, args...),
+	}
+}
+
+// Error implements error interface.
+func (e SyntaxError) Error() string {
+	return fmt.Sprintf("%s at position %d", e.Message, e.Offset)
+}
+
+// parser represents recursive descent parser of the filter.
+//
+//	expression := or
+//	or         := and ( OR and )*
+//	and        := not ( AND not )*
+//	not        := NOT not | '(' expression ')' | comparison
+//	comparison := identifier [context] ( operator value | [NOT] LIKE value | [NOT] ILIKE value
+//	              | [NOT] IN list | IS [NOT] NULL )
+//	context    := '{' identifier '=' value ( ',' identifier '=' value )* '}'
+//	list       := '(' value ( ',' value )* ')'
+type parser struct {
+	filter   string
+	tokens   []token
+	position int
+}
+
+// Parse parses provided filter into the syntax tree.
+func Parse(filter string) (Node, error) {
+	tokens, err := tokenize(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	p := parser{
+		filter: filter,
+		tokens: tokens,
+	}
+	node, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if next := p.peek(); next.kind != tokenEOF {
+		return nil, p.errorf(next, "unexpected %s, expected AND or OR", next.describe())
+	}
+	return node, nil
+}
+
+// parseOr parses `and ( OR and )*` rule.
+func (p *parser) parseOr() (Node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().isKeyword("OR") {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = Or{Left: left, Right: right}
+	}
+	return left, nil
+}
+
+// parseAnd parses `not ( AND not )*` rule.
+func (p *parser) parseAnd() (Node, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().isKeyword("AND") {
+		p.next()
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = And{Left: left, Right: right}
+	}
+	return left, nil
+}
+
+// parseNot parses `NOT not | '(' expression ')' | comparison` rule.
+func (p *parser) parseNot() (Node, error) {
+	next := p.peek()
+	switch {
+	case next.isKeyword("NOT"):
+		p.next()
+		node, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return Not{Node: node}, nil
+	case next.kind == tokenLeftParen:
+		p.next()
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.next(); closing.kind != tokenRightParen {
+			return nil, p.errorf(closing, "unexpected %s, expected ')'", closing.describe())
+		}
+		return node, nil
+	default:
+		return p.parseComparison()
+	}
+}
+
+// parseComparison parses single comparison rule.
+func (p *parser) parseComparison() (Node, error) {
+	start := p.peek()
+	entity, key, err := p.parseIdentifier()
+	if err != nil {
+		return nil, err
+	}
+	comparison := Comparison{
+		Entity: entity,
+		Key:    key,
+		Offset: start.offset,
+	}
+	if p.peek().kind == tokenLeftBrace {
+		if comparison.Context, err = p.parseContext(); err != nil {
+			return nil, err
+		}
+	}
+
+	operator := p.next()
+	switch {
+	case operator.kind == tokenOperator:
+		comparison.Operator = operator.text
+		comparison.Value, err = p.parseScalar()
+	case operator.isKeyword("LIKE"), operator.isKeyword("ILIKE"):
+		comparison.Operator = strings.ToUpper(operator.text)
+		comparison.Value, err = p.parseScalar()
+	case operator.isKeyword("IN"):
+		comparison.Operator = OperatorIn
+		comparison.Value, err = p.parseList()
+	case operator.isKeyword("NOT"):
+		negated := p.next()
+		switch {
+		case negated.isKeyword("LIKE"), negated.isKeyword("ILIKE"):
+			comparison.Operator = "NOT " + strings.ToUpper(negated.text)
+			comparison.Value, err = p.parseScalar()
+		case negated.isKeyword("IN"):
+			comparison.Operator = OperatorNotIn
+			comparison.Value, err = p.parseList()
+		default:
+			return nil, p.errorf(negated, "unexpected %s, expected LIKE, ILIKE or IN", negated.describe())
+		}
+	case operator.isKeyword("IS"):
+		comparison.Operator = OperatorIsNull
+		next := p.next()
+		if next.isKeyword("NOT") {
+			comparison.Operator = OperatorIsNotNull
+			next = p.next()
+		}
+		if !next.isKeyword("NULL") {
+			return nil, p.errorf(next, "unexpected %s, expected NULL", next.describe())
+		}
+	default:
+		return nil, p.errorf(operator, "unexpected %s, expected comparison operator", operator.describe())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return comparison, nil
+}
+
+// parseIdentifier parses `entity.key` identifier. Key could be quoted with backticks or double quotes,
+// e.g. tags.`my key` or params."my key", otherwise the first dot separates the entity from the key.
+func (p *parser) parseIdentifier() (string, string, error) {
+	identifier := p.next()
+	switch identifier.kind {
+	case tokenIdentifier:
+		if identifier.isKeyword("AND") || identifier.isKeyword("OR") || identifier.isKeyword("NOT") {
+			return "", "", p.errorf(identifier, "unexpected %s, expected identifier", identifier.describe())
+		}
+		if strings.HasSuffix(identifier.text, ".") {
+			quoted := p.peek()
+			if (quoted.kind == tokenQuotedIdentifier || quoted.kind == tokenString) && quoted.offset == identifier.end {
+				p.next()
+				return strings.TrimSuffix(identifier.text, "."), quoted.text, nil
+			}
+			return "", "", p.errorf(identifier, "missing key in identifier %s", identifier.describe())
+		}
+		if entity, key, ok := strings.Cut(identifier.text, "."); ok {
+			return entity, key, nil
+		}
+		return "", identifier.text, nil
+	case tokenQuotedIdentifier, tokenString:
+		return "", identifier.text, nil
+	default:
+		return "", "", p.errorf(identifier, "unexpected %s, expected identifier", identifier.describe())
+	}
+}
+
+// parseContext parses `'{' identifier '=' value ( ',' identifier '=' value )* '}'` rule.
+func (p *parser) parseContext() (map[string]string, error) {
+	p.next()
+	context := map[string]string{}
+	for {
+		path := p.next()
+		if path.kind != tokenIdentifier && path.kind != tokenQuotedIdentifier && path.kind != tokenString {
+			return nil, p.errorf(path, "unexpected %s, expected context key", path.describe())
+		}
+		if operator := p.next(); operator.kind != tokenOperator || operator.text != OperatorEqual {
+			return nil, p.errorf(operator, "unexpected %s, expected '='", operator.describe())
+		}
+		value, err := p.parseScalar()
+		if err != nil {
+			return nil, err
+		}
+		context[path.text] = value.Text
+		switch separator := p.next(); separator.kind {
+		case tokenComma:
+			continue
+		case tokenRightBrace:
+			return context, nil
+		default:
+			return nil, p.errorf(separator, "unexpected %s, expected ',' or '}'", separator.describe())
+		}
+	}
+}
+
+// parseScalar parses single literal value.
+func (p *parser) parseScalar() (*Value, error) {
+	value := p.next()
+	raw := p.filter[p.byteOffset(value.offset):p.byteOffset(value.end)]
+	switch value.kind {
+	case tokenString:
+		return &Value{Kind: ValueKindString, Text: value.text, Raw: raw}, nil
+	case tokenNumber:
+		return &Value{Kind: ValueKindNumber, Text: value.text, Raw: raw}, nil
+	case tokenIdentifier:
+		return &Value{Kind: ValueKindIdentifier, Text: value.text, Raw: raw}, nil
+	default:
+		return nil, p.errorf(value, "unexpected %s, expected value", value.describe())
+	}
+}
+
+// parseList parses `'(' value ( ',' value )* ')'` rule.
+func (p *parser) parseList() (*Value, error) {
+	if opening := p.next(); opening.kind != tokenLeftParen {
+		return nil, p.errorf(opening, "unexpected %s, expected '('", opening.describe())
+	}
+	var items []Value
+	for {
+		item, err := p.parseScalar()
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, *item)
+		switch separator := p.next(); separator.kind {
+		case tokenComma:
+			continue
+		case tokenRightParen:
+			return newListValue(items), nil
+		default:
+			return nil, p.errorf(separator, "unexpected %s, expected ',' or ')'", separator.describe())
+		}
+	}
+}
+
+// peek returns the current token without moving forward.
+func (p *parser) peek() token {
+	return p.tokens[p.position]
+}
+
+// next returns the current token and moves forward.
+func (p *parser) next() token {
+	t := p.tokens[p.position]
+	if t.kind != tokenEOF {
+		p.position++
+	}
+	return t
+}
+
+// byteOffset converts rune offset into byte offset of the filter.
+func (p *parser) byteOffset(offset int) int {
+	return len(string([]rune(p.filter)[:offset]))
+}
+
+// errorf creates new SyntaxError at the position of provided token.
+func (p *parser) errorf(t token, message string, args ...any) error {
+	return newSyntaxError(p.filter, t.offset, message, args...)
+}
@@ -0,0 +1,172 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse_Ok(t *testing.T) {
+	tests := []struct {
+		name     string
+		filter   string
+		expected string
+	}{
+		{
+			name:     "SimpleComparison",
+			filter:   `attributes.status = 'RUNNING'`,
+			expected: `attributes.status = 'RUNNING'`,
+		},
+		{
+			name:     "ComparisonWithoutEntity",
+			filter:   `start_time >= 123`,
+			expected: `start_time >= 123`,
+		},
+		{
+			name:     "DottedKey",
+			filter:   `tags.mlflow.runName != "run"`,
+			expected: `tags.mlflow.runName != "run"`,
+		},
+		{
+			name:     "QuotedKey",
+			filter:   "params.`my param` = 'value' AND tags.\"my tag\" = 'value'",
+			expected: `(params.my param = 'value' AND tags.my tag = 'value')`,
+		},
+		{
+			name:     "OperatorPrecedence",
+			filter:   `metrics.a > 1 or metrics.b < -1.5e3 AND NOT metrics.c = .5`,
+			expected: `(metrics.a > 1 OR (metrics.b < -1.5e3 AND NOT metrics.c = .5))`,
+		},
+		{
+			name:     "Grouping",
+			filter:   `(metrics.a > 1 OR metrics.b < 1) AND (tags.t LIKE 'x%')`,
+			expected: `((metrics.a > 1 OR metrics.b < 1) AND tags.t LIKE 'x%')`,
+		},
+		{
+			name:     "InAndNotIn",
+			filter:   `run_id IN ('a', 'b') AND run_id not in ("c")`,
+			expected: `(run_id IN ('a', 'b') AND run_id NOT IN ("c"))`,
+		},
+		{
+			name:     "LikeAndILike",
+			filter:   `tags.t ilike '%X%' AND tags.t NOT LIKE 'y%'`,
+			expected: `(tags.t ILIKE '%X%' AND tags.t NOT LIKE 'y%')`,
+		},
+		{
+			name:     "IsNull",
+			filter:   `end_time IS NULL OR params.p is not null`,
+			expected: `(end_time IS NULL OR params.p IS NOT NULL)`,
+		},
+		{
+			name:     "AlternativeOperators",
+			filter:   `metrics.a == 1 AND metrics.b <> 2`,
+			expected: `(metrics.a = 1 AND metrics.b != 2)`,
+		},
+		{
+			name:     "MetricContext",
+			filter:   `metrics.loss{subset="val", "nested.fold" = 1} < 0.2`,
+			expected: `metrics.loss{nested.fold="1", subset="val"} < 0.2`,
+		},
+		{
+			name:     "EscapedQuote",
+			filter:   `tags.t = 'it''s'`,
+			expected: `tags.t = 'it''s'`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node, err := Parse(tt.filter)
+			require.Nil(t, err)
+			assert.Equal(t, tt.expected, node.String())
+		})
+	}
+}
+
+func TestParse_Values(t *testing.T) {
+	node, err := Parse(`params.p IN ('it''s', 1.5, value)`)
+	require.Nil(t, err)
+	comparison, ok := node.(Comparison)
+	require.True(t, ok)
+	assert.Equal(t, "params", comparison.Entity)
+	assert.Equal(t, "p", comparison.Key)
+	assert.Equal(t, OperatorIn, comparison.Operator)
+	require.True(t, comparison.Value.IsList())
+	assert.Equal(t, []string{"it's", "1.5", "value"}, comparison.Value.Strings())
+	assert.Equal(t, ValueKindString, comparison.Value.Items[0].Kind)
+	assert.Equal(t, ValueKindNumber, comparison.Value.Items[1].Kind)
+	assert.Equal(t, ValueKindIdentifier, comparison.Value.Items[2].Kind)
+}
+
+func TestParse_Error(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter string
+		error  string
+	}{
+		{
+			name:   "MissingOperator",
+			filter: `invalid_filter`,
+			error:  `unexpected end of filter, expected comparison operator at position 14`,
+		},
+		{
+			name:   "MissingValue",
+			filter: `metrics.a > `,
+			error:  `unexpected end of filter, expected value at position 12`,
+		},
+		{
+			name:   "UnterminatedString",
+			filter: `tags.t = 'value`,
+			error:  `unterminated quoted string at position 9`,
+		},
+		{
+			name:   "UnbalancedParentheses",
+			filter: `(metrics.a > 1 OR metrics.b > 1`,
+			error:  `unexpected end of filter, expected ')' at position 31`,
+		},
+		{
+			name:   "MissingLogicalOperator",
+			filter: `metrics.a > 1 metrics.b > 1`,
+			error:  `unexpected 'metrics.b', expected AND or OR at position 14`,
+		},
+		{
+			name:   "IncorrectList",
+			filter: `run_id IN 'a'`,
+			error:  `unexpected 'a', expected '(' at position 10`,
+		},
+		{
+			name:   "IncorrectNull",
+			filter: `end_time IS 1`,
+			error:  `unexpected '1', expected NULL at position 12`,
+		},
+		{
+			name:   "UnexpectedCharacter",
+			filter: `metrics.a ~ 1`,
+			error:  `unexpected character '~' at position 10`,
+		},
+		{
+			name:   "UnterminatedContext",
+			filter: `metrics.loss{subset="val" < 1`,
+			error:  `unexpected '<', expected ',' or '}' at position 26`,
+		},
+		{
+			name:   "IncorrectContextOperator",
+			filter: `metrics.loss{subset > "val"} < 1`,
+			error:  `unexpected '>', expected '=' at position 20`,
+		},
+		{
+			name:   "DanglingAnd",
+			filter: `metrics.a > 1 AND`,
+			error:  `unexpected end of filter, expected identifier at position 17`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node, err := Parse(tt.filter)
+			assert.Nil(t, node)
+			assert.EqualError(t, err, tt.error)
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package mlflow
 
 import (
+	"strings"
+
 	"github.com/gofiber/fiber/v2"
 
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/controller"
@@ -9,16 +11,40 @@ import (
 
 // List of route prefixes.
 const (
-	RunsRoutePrefix        = "/runs"
-	MetricsRoutePrefix     = "/metrics"
-	ArtifactsRoutePrefix   = "/artifacts"
-	ExperimentsRoutePrefix = "/experiments"
+	RunsRoutePrefix             = "/runs"
+	MetricsRoutePrefix          = "/metrics"
+	ArtifactsRoutePrefix        = "/artifacts"
+	ExperimentsRoutePrefix      = "/experiments"
+	ModelVersionsRoutePrefix    = "/model-versions"
+	RegisteredModelsRoutePrefix = "/registered-models"
+	TracesRoutePrefix           = "/traces"
+)
+
+// List of `mlflow-artifacts` proxy route prefixes.
+const (
+	ProxyArtifactsRoutePrefix = "/artifacts"
+	ProxyMultipartRoutePrefix = "/mpu"
+)
+
+// List of `mlflow-artifacts` proxy `/artifacts/*` routes.
+const (
+	ProxyArtifactsListRoute = "/"
+	ProxyArtifactsPathRoute = "/*"
+)
+
+// List of `mlflow-artifacts` proxy `/mpu/*` routes.
+const (
+	ProxyMultipartAbortRoute    = "/abort/*"
+	ProxyMultipartCreateRoute   = "/create/*"
+	ProxyMultipartUploadRoute   = "/upload/*"
+	ProxyMultipartCompleteRoute = "/complete/*"
 )
 
 // List of `/artifact/*` routes.
 const (
-	ArtifactsGetRoute  = "/get"
-	ArtifactsListRoute = "/list"
+	ArtifactsGetRoute      = "/get"
+	ArtifactsListRoute     = "/list"
+	ArtifactsDownloadRoute = "/download"
 )
 
 // List of `/experiments/*` routes.
@@ -41,28 +67,93 @@ const (
 	MetricsGetHistoryBulkRoute = "/get-history-bulk"
 )
 
+// List of `/model-versions/*` routes.
+const (
+	ModelVersionsGetRoute             = "/get"
+	ModelVersionsCreateRoute          = "/create"
+	ModelVersionsDeleteRoute          = "/delete"
+	ModelVersionsSearchRoute          = "/search"
+	ModelVersionsUpdateRoute          = "/update"
+	ModelVersionsSetTagRoute          = "/set-tag"
+	ModelVersionsDeleteTagRoute       = "/delete-tag"
+	ModelVersionsGetDownloadURIRoute  = "/get-download-uri"
+	ModelVersionsTransitionStageRoute = "/transition-stage"
+)
+
+// List of `/registered-models/*` routes.
+const (
+	RegisteredModelsGetRoute               = "/get"
+	RegisteredModelsAliasRoute             = "/alias"
+	RegisteredModelsCreateRoute            = "/create"
+	RegisteredModelsDeleteRoute            = "/delete"
+	RegisteredModelsRenameRoute            = "/rename"
+	RegisteredModelsSearchRoute            = "/search"
+	RegisteredModelsUpdateRoute            = "/update"
+	RegisteredModelsSetTagRoute            = "/set-tag"
+	RegisteredModelsDeleteTagRoute         = "/delete-tag"
+	RegisteredModelsGetLatestVersionsRoute = "/get-latest-versions"
+)
+
 // List of `/runs/*` routes.
 const (
-	RunsGetRoute          = "/get"
-	RunsCreateRoute       = "/create"
-	RunsDeleteRoute       = "/delete"
-	RunsSearchRoute       = "/search"
-	RunsSetTagRoute       = "/set-tag"
-	RunsUpdateRoute       = "/update"
-	RunsRestoreRoute      = "/restore"
-	RunsDeleteTagRoute    = "/delete-tag"
-	RunsLogBatchRoute     = "/log-batch"
-	RunsLogMetricRoute    = "/log-metric"
-	RunsLogParameterRoute = "/log-parameter"
-	RunsLogOutputRoute    = "/log-output"
-	RunsLogArtifactRoute  = "/log-artifact"
+	RunsGetRoute             = "/get"
+	RunsCreateRoute          = "/create"
+	RunsDeleteRoute          = "/delete"
+	RunsSearchRoute          = "/search"
+	RunsSetTagRoute          = "/set-tag"
+	RunsUpdateRoute          = "/update"
+	RunsRestoreRoute         = "/restore"
+	RunsDeleteTagRoute       = "/delete-tag"
+	RunsLogBatchRoute        = "/log-batch"
+	RunsLogMetricRoute       = "/log-metric"
+	RunsLogParameterRoute    = "/log-parameter"
+	RunsLogOutputRoute       = "/log-output"
+	RunsLogArtifactRoute     = "/log-artifact"
+	RunsLogTextRoute         = "/log-text"
+	RunsLogDistributionRoute = "/log-distribution"
+	RunsLogFigureRoute       = "/log-figure"
+	RunsLogAudioRoute        = "/log-audio"
+	RunsLogInputsRoute       = "/log-inputs"
 )
 
+// List of `/traces/*` routes.
+const (
+	TracesRoute             = ""
+	TracesGetRoute          = "/:request_id"
+	TracesEndRoute          = "/:request_id"
+	TracesTagsRoute         = "/:request_id/tags"
+	TracesSearchRoute       = "/search"
+	TracesGetInfoRoute      = "/:request_id/info"
+	TracesDeleteTracesRoute = "/delete-traces"
+)
+
+// proxyPrefixList is the list of `mlflow-artifacts` proxy prefixes.
+var proxyPrefixList = []string{
+	"/api/2.0/mlflow-artifacts/",
+	"/ajax-api/2.0/mlflow-artifacts/",
+}
+
+// StreamedRoutes returns templates of `mlflow-artifacts` proxy routes, which read the request body as a stream.
+func StreamedRoutes() []string {
+	routes := make([]string, 0, 2*len(proxyPrefixList))
+	for _, prefix := range proxyPrefixList {
+		prefix = strings.TrimSuffix(prefix, "/")
+		routes = append(
+			routes,
+			prefix+ProxyArtifactsRoutePrefix+ProxyArtifactsPathRoute,
+			prefix+ProxyMultipartRoutePrefix+ProxyMultipartUploadRoute,
+		)
+	}
+	return routes
+}
+
 // Router represents `mlflow` router.
 type Router struct {
 	prefixList        []string
+	proxyPrefixList   []string
 	controller        *controller.Controller
 	globalMiddlewares []fiber.Handler
+	artifactsProxy    bool
 }
 
 // NewRouter creates new instance of `mlflow` router.
@@ -72,6 +163,7 @@ func NewRouter(controller *controller.Controller) *Router {
 			"/api/2.0/mlflow/",
 			"/ajax-api/2.0/mlflow/",
 		},
+		proxyPrefixList:   proxyPrefixList,
 		controller:        controller,
 		globalMiddlewares: make([]fiber.Handler, 0),
 	}
@@ -79,6 +171,10 @@ func NewRouter(controller *controller.Controller) *Router {
 
 // Init makes initialization of all `mlflow` routes.
 func (r *Router) Init(router fiber.Router) {
+	if r.artifactsProxy {
+		r.initArtifactsProxy(router)
+	}
+
 	for _, prefix := range r.prefixList {
 		mainGroup := router.Group(prefix)
 		// apply global middlewares.
@@ -90,6 +186,7 @@ func (r *Router) Init(router fiber.Router) {
 		artifacts := mainGroup.Group(ArtifactsRoutePrefix)
 		artifacts.Get(ArtifactsGetRoute, r.controller.GetArtifact)
 		artifacts.Get(ArtifactsListRoute, r.controller.ListArtifacts)
+		artifacts.Get(ArtifactsDownloadRoute, r.controller.DownloadArtifacts)
 
 		experiments := mainGroup.Group(ExperimentsRoutePrefix)
 		experiments.Post(ExperimentsCreateRoute, r.controller.CreateExperiment)
@@ -114,6 +211,7 @@ func (r *Router) Init(router fiber.Router) {
 		runs.Post(RunsDeleteTagRoute, r.controller.DeleteRunTag)
 		runs.Get(RunsGetRoute, r.controller.GetRun)
 		runs.Post(RunsLogBatchRoute, r.controller.LogBatch)
+		runs.Post(RunsLogInputsRoute, r.controller.LogInputs)
 		runs.Post(RunsLogMetricRoute, r.controller.LogMetric)
 		runs.Post(RunsLogParameterRoute, r.controller.LogParam)
 		runs.Post(RunsRestoreRoute, r.controller.RestoreRun)
@@ -122,9 +220,47 @@ func (r *Router) Init(router fiber.Router) {
 		runs.Post(RunsUpdateRoute, r.controller.UpdateRun)
 		runs.Post(RunsLogOutputRoute, r.controller.LogOutput)
 		runs.Post(RunsLogArtifactRoute, r.controller.LogArtifact)
+		runs.Post(RunsLogTextRoute, r.controller.LogText)
+		runs.Post(RunsLogDistributionRoute, r.controller.LogDistribution)
+		runs.Post(RunsLogFigureRoute, r.controller.LogFigure)
+		runs.Post(RunsLogAudioRoute, r.controller.LogAudio)
 
-		mainGroup.Get("/model-versions/search", r.controller.SearchModelVersions)
-		mainGroup.Get("/registered-models/search", r.controller.SearchRegisteredModels)
+		modelVersions := mainGroup.Group(ModelVersionsRoutePrefix)
+		modelVersions.Post(ModelVersionsCreateRoute, r.controller.CreateModelVersion)
+		modelVersions.Delete(ModelVersionsDeleteRoute, r.controller.DeleteModelVersion)
+		modelVersions.Delete(ModelVersionsDeleteTagRoute, r.controller.DeleteModelVersionTag)
+		modelVersions.Get(ModelVersionsGetRoute, r.controller.GetModelVersion)
+		modelVersions.Get(ModelVersionsGetDownloadURIRoute, r.controller.GetModelVersionDownloadURI)
+		modelVersions.Get(ModelVersionsSearchRoute, r.controller.SearchModelVersions)
+		modelVersions.Post(ModelVersionsSetTagRoute, r.controller.SetModelVersionTag)
+		modelVersions.Post(ModelVersionsTransitionStageRoute, r.controller.TransitionModelVersionStage)
+		modelVersions.Patch(ModelVersionsUpdateRoute, r.controller.UpdateModelVersion)
+
+		registeredModels := mainGroup.Group(RegisteredModelsRoutePrefix)
+		registeredModels.Delete(RegisteredModelsAliasRoute, r.controller.DeleteRegisteredModelAlias)
+		registeredModels.Get(RegisteredModelsAliasRoute, r.controller.GetModelVersionByAlias)
+		registeredModels.Post(RegisteredModelsAliasRoute, r.controller.SetRegisteredModelAlias)
+		registeredModels.Post(RegisteredModelsCreateRoute, r.controller.CreateRegisteredModel)
+		registeredModels.Delete(RegisteredModelsDeleteRoute, r.controller.DeleteRegisteredModel)
+		registeredModels.Delete(RegisteredModelsDeleteTagRoute, r.controller.DeleteRegisteredModelTag)
+		registeredModels.Get(RegisteredModelsGetRoute, r.controller.GetRegisteredModel)
+		registeredModels.Get(RegisteredModelsGetLatestVersionsRoute, r.controller.GetLatestVersions)
+		registeredModels.Post(RegisteredModelsGetLatestVersionsRoute, r.controller.GetLatestVersions)
+		registeredModels.Post(RegisteredModelsRenameRoute, r.controller.RenameRegisteredModel)
+		registeredModels.Get(RegisteredModelsSearchRoute, r.controller.SearchRegisteredModels)
+		registeredModels.Post(RegisteredModelsSetTagRoute, r.controller.SetRegisteredModelTag)
+		registeredModels.Patch(RegisteredModelsUpdateRoute, r.controller.UpdateRegisteredModel)
+
+		traces := mainGroup.Group(TracesRoutePrefix)
+		traces.Get(TracesRoute, r.controller.SearchTraces)
+		traces.Post(TracesRoute, r.controller.StartTrace)
+		traces.Post(TracesSearchRoute, r.controller.SearchTraces)
+		traces.Post(TracesDeleteTracesRoute, r.controller.DeleteTraces)
+		traces.Get(TracesGetInfoRoute, r.controller.GetTraceInfo)
+		traces.Delete(TracesTagsRoute, r.controller.DeleteTraceTag)
+		traces.Patch(TracesTagsRoute, r.controller.SetTraceTag)
+		traces.Get(TracesGetRoute, r.controller.GetTrace)
+		traces.Patch(TracesEndRoute, r.controller.EndTrace)
 
 		mainGroup.Use(func(c *fiber.Ctx) error {
 			return api.NewEndpointNotFound("Not found")
@@ -137,3 +273,37 @@ func (r *Router) AddGlobalMiddleware(middleware fiber.Handler) *Router {
 	r.globalMiddlewares = append(r.globalMiddlewares, middleware)
 	return r
 }
+
+// EnableArtifactsProxy enables `mlflow-artifacts` proxy routes.
+func (r *Router) EnableArtifactsProxy() *Router {
+	r.artifactsProxy = true
+	return r
+}
+
+// initArtifactsProxy makes initialization of `mlflow-artifacts` proxy routes.
+func (r *Router) initArtifactsProxy(router fiber.Router) {
+	for _, prefix := range r.proxyPrefixList {
+		mainGroup := router.Group(prefix)
+		// apply global middlewares.
+		for _, globalMiddleware := range r.globalMiddlewares {
+			mainGroup.Use(globalMiddleware)
+		}
+
+		// setup related routes.
+		artifacts := mainGroup.Group(ProxyArtifactsRoutePrefix)
+		artifacts.Get(ProxyArtifactsListRoute, r.controller.ListProxyArtifacts)
+		artifacts.Get(ProxyArtifactsPathRoute, r.controller.DownloadProxyArtifact)
+		artifacts.Put(ProxyArtifactsPathRoute, r.controller.UploadProxyArtifact)
+		artifacts.Delete(ProxyArtifactsPathRoute, r.controller.DeleteProxyArtifact)
+
+		multipart := mainGroup.Group(ProxyMultipartRoutePrefix)
+		multipart.Post(ProxyMultipartAbortRoute, r.controller.AbortMultipartUpload)
+		multipart.Post(ProxyMultipartCreateRoute, r.controller.CreateMultipartUpload)
+		multipart.Put(ProxyMultipartUploadRoute, r.controller.UploadMultipartPart)
+		multipart.Post(ProxyMultipartCompleteRoute, r.controller.CompleteMultipartUpload)
+
+		mainGroup.Use(func(c *fiber.Ctx) error {
+			return api.NewEndpointNotFound("Not found")
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package experiment
+
+import (
+	"strconv"
+
+	"gorm.io/gorm/clause"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/query"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/database"
+)
+
+// buildFilterCondition converts a single comparison of `search experiments` filter into SQL expression.
+func buildFilterCondition(comparison *query.Comparison) (clause.Expr, error) {
+	dialector := database.DB.Dialector.Name()
+	operator, value := comparison.Operator, comparison.Value
+	if comparison.Context != nil {
+		return clause.Expr{}, api.NewInvalidParameterValueError(
+			"context selector is not supported in '%s'", comparison,
+		)
+	}
+	switch comparison.Entity {
+	case "", "attribute", "attributes", "attr":
+		switch comparison.Key {
+		case "creation_time", "last_update_time":
+			if !query.IsNumericOperator(operator) {
+				return clause.Expr{}, api.NewInvalidParameterValueError(
+					"invalid numeric attribute comparison operator '%s'", operator,
+				)
+			}
+			v, err := strconv.Atoi(value.Text)
+			if err != nil {
+				return clause.Expr{}, api.NewInvalidParameterValueError("invalid numeric value '%s'", value.Raw)
+			}
+			return query.ValueCondition("experiments."+comparison.Key, operator, v), nil
+		case "name":
+			if err := query.ValidateStringComparison(
+				operator, value, "invalid string attribute comparison operator '%s'",
+			); err != nil {
+				return clause.Expr{}, err
+			}
+			return query.StringCondition(dialector, "experiments.name", operator, value), nil
+		default:
+			return clause.Expr{}, api.NewInvalidParameterValueError(
+				"invalid attribute '%s'. Valid values are ['name', 'creation_time', 'last_update_time']",
+				comparison.Key,
+			)
+		}
+	case "tag", "tags":
+		if err := query.ValidateStringComparison(operator, value, "invalid tag comparison operator '%s'"); err != nil {
+			return clause.Expr{}, err
+		}
+		tx := database.DB.Select("1").Model(
+			&database.ExperimentTag{},
+		).Where(
+			"experiment_id = experiments.experiment_id",
+		).Where(
+			"key = ?", comparison.Key,
+		)
+		switch operator {
+		case query.OperatorIsNull:
+			return clause.Expr{SQL: "NOT EXISTS (?)", Vars: []any{tx}}, nil
+		case query.OperatorIsNotNull:
+			return clause.Expr{SQL: "EXISTS (?)", Vars: []any{tx}}, nil
+		default:
+			return clause.Expr{
+				SQL:  "EXISTS (?)",
+				Vars: []any{tx.Where(query.StringCondition(dialector, "value", operator, value))},
+			}, nil
+		}
+	default:
+		return clause.Expr{}, api.NewInvalidParameterValueError(
+			"invalid entity type '%s'. Valid values are ['tag', 'attribute']", comparison.Entity,
+		)
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/convertors"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/query"
 	"github.com/G-Research/fasttrackml/pkg/common/api"
 	"github.com/G-Research/fasttrackml/pkg/common/config"
 	"github.com/G-Research/fasttrackml/pkg/database"
@@ -25,25 +26,9 @@ import (
 
 //nolint:lll
 var (
-	filterAnd       = regexp.MustCompile(`(?i)\s+AND\s+`)
-	filterCond      = regexp.MustCompile(`^(?:(\w+)\.)?("[^"]+"|` + "`[^`]+`" + `|[\w\.]+)\s+(<|<=|>|>=|=|!=|(?i:I?LIKE)|(?i:(?:NOT )?IN))\s+(\((?:'[^']+'(?:,\s*)?)+\)|"[^"]+"|'[^']+'|[\w\.]+)$`)
 	experimentOrder = regexp.MustCompile(`^(?:attr(?:ibutes?)?\.)?(\w+)(?i:\s+(ASC|DESC))?$`)
 )
 
-// supported expression list.
-const (
-	InExpression            = "IN"
-	NotInExpression         = "NOT IN"
-	LikeExpression          = "LIKE"
-	ILikeExpression         = "ILIKE"
-	EqualExpression         = "="
-	NotEqualExpression      = "!="
-	LessExpression          = "<"
-	LessOrEqualExpression   = "<="
-	GraterExpression        = ">"
-	GraterOrEqualExpression = ">="
-)
-
 // Service provides service layer to work with `metric` business logic.
 type Service struct {
 	config               *config.Config
@@ -275,7 +260,7 @@ func (s Service) SearchExperiments(
 		return nil, 0, 0, err
 	}
 
-	query := database.DB.Where(
+	tx := database.DB.Where(
 		"experiments.namespace_id = ?", ns.ID,
 	)
 
@@ -296,14 +281,14 @@ func (s Service) SearchExperiments(
 			database.LifecycleStageDeleted,
 		}
 	}
-	query.Where("lifecycle_stage IN ?", lifecyleStages)
+	tx.Where("lifecycle_stage IN ?", lifecyleStages)
 
 	// MaxResults
 	limit := int(req.MaxResults)
 	if limit == 0 {
 		limit = 1000
 	}
-	query.Limit(limit + 1)
+	tx.Limit(limit + 1)
 
 	// PageToken
 	var offset int
@@ -319,89 +304,19 @@ func (s Service) SearchExperiments(
 		}
 		offset = int(token.Offset)
 	}
-	query.Offset(offset)
+	tx.Offset(offset)
 
 	// Filter
 	if req.Filter != "" {
-		for n, f := range filterAnd.Split(req.Filter, -1) {
-			components := filterCond.FindStringSubmatch(f)
-			if len(components) != 5 {
-				return nil, 0, 0, api.NewInvalidParameterValueError("malformed filter '%s'", f)
-			}
-
-			entity := components[1]
-			key := strings.Trim(components[2], "\"`")
-			comparison := components[3]
-			var value any = components[4]
-
-			switch entity {
-			case "", "attribute", "attributes", "attr":
-				switch key {
-				case "creation_time", "last_update_time":
-					switch comparison {
-					case GraterExpression, GraterOrEqualExpression, NotEqualExpression,
-						EqualExpression, LessExpression, LessOrEqualExpression:
-						v, err := strconv.Atoi(value.(string))
-						if err != nil {
-							return nil, 0, 0, api.NewInvalidParameterValueError("invalid numeric value '%s'", value)
-						}
-						value = v
-					default:
-						return nil, 0, 0, api.NewInvalidParameterValueError(
-							"invalid numeric attribute comparison operator '%s'", comparison,
-						)
-					}
-				case "name":
-					switch strings.ToUpper(comparison) {
-					case NotEqualExpression, EqualExpression, LikeExpression, ILikeExpression:
-						if strings.HasPrefix(value.(string), "(") {
-							return nil, 0, 0, api.NewInvalidParameterValueError("invalid string value '%s'", value)
-						}
-						value = strings.Trim(value.(string), `"'`)
-						if database.DB.Dialector.Name() == "sqlite" && strings.ToUpper(comparison) == ILikeExpression {
-							key = fmt.Sprintf("LOWER(%s)", key)
-							comparison = LikeExpression
-							value = strings.ToLower(value.(string))
-						}
-					default:
-						return nil, 0, 0, api.NewInvalidParameterValueError(
-							"invalid string attribute comparison operator '%s'", comparison,
-						)
-					}
-				default:
-					return nil, 0, 0, api.NewInvalidParameterValueError(
-						"invalid attribute '%s'. Valid values are ['name', 'creation_time', 'last_update_time']", key,
-					)
-				}
-				query.Where(fmt.Sprintf("%s %s ?", key, comparison), value)
-			case "tag", "tags":
-				switch strings.ToUpper(comparison) {
-				case NotEqualExpression, EqualExpression, LikeExpression, ILikeExpression:
-					if strings.HasPrefix(value.(string), "(") {
-						return nil, 0, 0, api.NewInvalidParameterValueError("invalid string value '%s'", value)
-					}
-					value = strings.Trim(value.(string), `"'`)
-				default:
-					return nil, 0, 0, api.NewInvalidParameterValueError("invalid tag comparison operator '%s'", comparison)
-				}
-				table := fmt.Sprintf("filter_%d", n)
-				where := fmt.Sprintf("value %s ?", comparison)
-				if database.DB.Dialector.Name() == "sqlite" && strings.ToUpper(comparison) == ILikeExpression {
-					where = "LOWER(value) LIKE ?"
-					value = strings.ToLower(value.(string))
-				}
-				query.Joins(
-					fmt.Sprintf("JOIN (?) AS %s ON experiments.experiment_id = %s.experiment_id", table, table),
-					database.DB.Select(
-						"experiment_id", "value",
-					).Where("key = ?", key).Where(where, value).Model(&database.ExperimentTag{}),
-				)
-			default:
-				return nil, 0, 0, api.NewInvalidParameterValueError(
-					"invalid entity type '%s'. Valid values are ['tag', 'attribute']", entity,
-				)
-			}
+		node, err := query.Parse(req.Filter)
+		if err != nil {
+			return nil, 0, 0, api.NewInvalidParameterValueError("malformed filter '%s': %s", req.Filter, err)
+		}
+		condition, err := query.Build(node, buildFilterCondition)
+		if err != nil {
+			return nil, 0, 0, err
 		}
+		tx.Where(condition)
 	}
 
 	// OrderBy
@@ -424,22 +339,22 @@ func (s Service) SearchExperiments(
 				column,
 			)
 		}
-		query.Order(clause.OrderByColumn{
+		tx.Order(clause.OrderByColumn{
 			Column: clause.Column{Name: column},
 			Desc:   len(components) == 3 && strings.ToUpper(components[2]) == "DESC",
 		})
 
 	}
 	if len(req.OrderBy) == 0 {
-		query.Order("experiments.creation_time DESC")
+		tx.Order("experiments.creation_time DESC")
 	}
 	if !expOrder {
-		query.Order("experiments.experiment_id ASC")
+		tx.Order("experiments.experiment_id ASC")
 	}
 
 	// Actual query
 	var exps []models.Experiment
-	if err := query.Preload("Tags").Find(&exps).Error; err != nil {
+	if err := tx.Preload("Tags").Find(&exps).Error; err != nil {
 		return nil, 0, 0, api.NewInternalError("unable to search runs: %s", err)
 	}
 
@@ -0,0 +1,54 @@
+package gc
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/fasttrackml/pkg/common/config"
+)
+
+// Collector represents a background job which periodically purges deleted runs and experiments.
+type Collector struct {
+	ctx     context.Context
+	config  *config.Config
+	service *Service
+}
+
+// NewCollector creates a new instance of Collector.
+func NewCollector(ctx context.Context, config *config.Config, service *Service) *Collector {
+	return &Collector{
+		ctx:     ctx,
+		config:  config,
+		service: service,
+	}
+}
+
+// Run runs garbage collector background job.
+func (c Collector) Run() {
+	if c.config.GCInterval <= 0 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(c.config.GCInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-c.ctx.Done():
+				log.Debug("garbage collector finished. exiting.")
+				return
+			case <-ticker.C:
+				result, err := c.service.Purge(c.ctx, PurgeOptions{OlderThan: c.config.GCOlderThan})
+				if err != nil {
+					log.Errorf("error purging deleted runs and experiments: %+v", err)
+				} else {
+					log.Infof(
+						"%d deleted runs and %d deleted experiments were successfully purged",
+						len(result.Runs), len(result.Experiments),
+					)
+				}
+			}
+		}
+	}()
+}
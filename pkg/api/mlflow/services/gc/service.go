@@ -0,0 +1,192 @@
+package gc
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/rotisserie/eris"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/common/services/artifact/storage"
+)
+
+// PurgeOptions represents options of the purge operation.
+type PurgeOptions struct {
+	// NamespaceCode limits the purge to a single namespace. All the namespaces are processed when empty.
+	NamespaceCode string
+	// OlderThan is the minimal age of the deletion of the run or experiment.
+	OlderThan time.Duration
+	// DryRun only collects what would have been purged without deleting anything.
+	DryRun bool
+}
+
+// PurgeResult represents the result of the purge operation.
+type PurgeResult struct {
+	Runs        []models.Run
+	Experiments []models.Experiment
+}
+
+// Service provides service layer to permanently purge deleted runs and experiments.
+type Service struct {
+	gcRepository           repositories.GCRepositoryProvider
+	namespaceRepository    repositories.NamespaceRepositoryProvider
+	artifactStorageFactory storage.ArtifactStorageFactoryProvider
+}
+
+// NewService creates new Service instance.
+func NewService(
+	gcRepository repositories.GCRepositoryProvider,
+	namespaceRepository repositories.NamespaceRepositoryProvider,
+	artifactStorageFactory storage.ArtifactStorageFactoryProvider,
+) *Service {
+	return &Service{
+		gcRepository:           gcRepository,
+		namespaceRepository:    namespaceRepository,
+		artifactStorageFactory: artifactStorageFactory,
+	}
+}
+
+// Purge permanently removes runs and experiments which were deleted earlier than provided age
+// together with all their related data and artifacts, including artifact locations of experiments
+// and artifacts of their traces. Runs or experiments which artifacts
+// can't be deleted are skipped, so that they will be processed again by the next purge.
+func (s Service) Purge(ctx context.Context, options PurgeOptions) (*PurgeResult, error) {
+	var namespaceID *uint
+	if options.NamespaceCode != "" {
+		namespace, err := s.namespaceRepository.GetByCode(ctx, options.NamespaceCode)
+		if err != nil {
+			return nil, eris.Wrapf(err, "error getting namespace with code: %s", options.NamespaceCode)
+		}
+		if namespace == nil {
+			return nil, eris.Errorf("namespace with code '%s' not found", options.NamespaceCode)
+		}
+		namespaceID = &namespace.ID
+	}
+	deletedBefore := time.Now().UTC().Add(-options.OlderThan).UnixMilli()
+
+	runs, err := s.gcRepository.GetDeletedRuns(ctx, namespaceID, deletedBefore)
+	if err != nil {
+		return nil, eris.Wrap(err, "error getting deleted runs")
+	}
+	experiments, err := s.gcRepository.GetDeletedExperiments(ctx, namespaceID, deletedBefore)
+	if err != nil {
+		return nil, eris.Wrap(err, "error getting deleted experiments")
+	}
+
+	result := PurgeResult{}
+	if options.DryRun {
+		result.Runs, result.Experiments = runs, experiments
+		return &result, nil
+	}
+
+	runIDs := make([]string, 0, len(runs))
+	for _, run := range runs {
+		if err := s.deleteRunArtifacts(ctx, run); err != nil {
+			log.Errorf("error deleting artifacts of run '%s', skipping it: %+v", run.ID, err)
+			continue
+		}
+		runIDs = append(runIDs, run.ID)
+		result.Runs = append(result.Runs, run)
+	}
+	if err := s.gcRepository.PurgeRuns(ctx, runIDs); err != nil {
+		return nil, eris.Wrap(err, "error purging deleted runs")
+	}
+
+	experimentIDs := make([]int32, 0, len(experiments))
+	for _, experiment := range experiments {
+		experimentRuns, err := s.gcRepository.GetRunsByExperimentIDs(ctx, []int32{*experiment.ID})
+		if err != nil {
+			return nil, eris.Wrapf(err, "error getting runs of experiment '%d'", *experiment.ID)
+		}
+		if err := s.deleteRunsArtifacts(ctx, experimentRuns); err != nil {
+			log.Errorf("error deleting artifacts of experiment '%d', skipping it: %+v", *experiment.ID, err)
+			continue
+		}
+		if err := s.deleteExperimentArtifacts(ctx, experiment); err != nil {
+			log.Errorf("error deleting artifacts of experiment '%d', skipping it: %+v", *experiment.ID, err)
+			continue
+		}
+		experimentIDs = append(experimentIDs, *experiment.ID)
+		result.Experiments = append(result.Experiments, experiment)
+	}
+	if err := s.gcRepository.PurgeExperiments(ctx, experimentIDs); err != nil {
+		return nil, eris.Wrap(err, "error purging deleted experiments")
+	}
+
+	return &result, nil
+}
+
+// deleteRunsArtifacts deletes artifacts of all the provided runs.
+func (s Service) deleteRunsArtifacts(ctx context.Context, runs []models.Run) error {
+	for _, run := range runs {
+		if err := s.deleteRunArtifacts(ctx, run); err != nil {
+			return eris.Wrapf(err, "error deleting artifacts of run '%s'", run.ID)
+		}
+	}
+	return nil
+}
+
+// deleteExperimentArtifacts deletes artifacts of the experiment traces and the experiment artifact location.
+// Artifact location which is shared with other experiments, for example the default artifact root
+// used as artifact location, is kept, because it contains artifacts of those experiments as well.
+func (s Service) deleteExperimentArtifacts(ctx context.Context, experiment models.Experiment) error {
+	traces, err := s.gcRepository.GetTracesByExperimentIDs(ctx, []int32{*experiment.ID})
+	if err != nil {
+		return eris.Wrap(err, "error getting traces")
+	}
+	for _, trace := range traces {
+		location, ok := trace.GetTagValue(models.TraceTagArtifactLocation)
+		if !ok {
+			continue
+		}
+		if err := s.deleteArtifacts(ctx, location); err != nil {
+			return eris.Wrapf(err, "error deleting artifacts of trace '%s'", trace.ID)
+		}
+	}
+
+	if experiment.ArtifactLocation == "" {
+		return nil
+	}
+	locations, err := s.gcRepository.GetOtherExperimentsArtifactLocations(ctx, *experiment.ID)
+	if err != nil {
+		return eris.Wrap(err, "error getting artifact locations of other experiments")
+	}
+	location := strings.TrimRight(experiment.ArtifactLocation, "/")
+	for _, other := range locations {
+		other = strings.TrimRight(other, "/")
+		if other == location || strings.HasPrefix(other, location+"/") {
+			log.Warnf(
+				"artifact location '%s' of experiment '%d' is shared with other experiments, keeping it",
+				experiment.ArtifactLocation, *experiment.ID,
+			)
+			return nil
+		}
+	}
+	if err := s.deleteArtifacts(ctx, experiment.ArtifactLocation); err != nil {
+		return eris.Wrap(err, "error deleting experiment artifact location")
+	}
+	return nil
+}
+
+// deleteRunArtifacts deletes all the artifacts stored under the run artifact location.
+func (s Service) deleteRunArtifacts(ctx context.Context, run models.Run) error {
+	if err := s.deleteArtifacts(ctx, run.ArtifactURI); err != nil {
+		return eris.Wrap(err, "error deleting run artifacts")
+	}
+	return nil
+}
+
+// deleteArtifacts deletes all the artifacts stored under the artifact location.
+func (s Service) deleteArtifacts(ctx context.Context, artifactURI string) error {
+	if artifactURI == "" {
+		return nil
+	}
+	artifactStorage, err := s.artifactStorageFactory.GetStorage(ctx, artifactURI)
+	if err != nil {
+		return eris.Wrap(err, "error getting artifact storage")
+	}
+	return artifactStorage.Delete(ctx, artifactURI, "")
+}
@@ -1,10 +1,13 @@
 package metric
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
 )
 
 // adjustGetMetricHistoriesRequestForNamespace preprocesses the GetMetricHistoriesRequest for the given namespace.
@@ -15,3 +18,62 @@ func adjustGetMetricHistoriesRequestForNamespace(ns *models.Namespace, req *requ
 		}
 	}
 }
+
+// convertMetricContextParameter converts JSON encoded `context` parameter into the map
+// of JSON paths and values. Nested objects are converted into dotted paths.
+func convertMetricContextParameter(context string) (map[string]string, error) {
+	if context == "" {
+		return nil, nil
+	}
+	var value map[string]any
+	if err := json.Unmarshal([]byte(context), &value); err != nil {
+		return nil, api.NewInvalidParameterValueError("invalid context '%s': %s", context, err)
+	}
+	result := map[string]string{}
+	if err := flattenMetricContext("", value, result); err != nil {
+		return nil, api.NewInvalidParameterValueError("invalid context '%s': %s", context, err)
+	}
+	return result, nil
+}
+
+// flattenMetricContext puts scalar values of the context object into the result map by their dotted paths.
+func flattenMetricContext(prefix string, context map[string]any, result map[string]string) error {
+	for key, value := range context {
+		path := key
+		if prefix != "" {
+			path = prefix + "." + key
+		}
+		switch value := value.(type) {
+		case map[string]any:
+			if err := flattenMetricContext(path, value, result); err != nil {
+				return err
+			}
+		case string:
+			result[path] = value
+		case float64, bool:
+			result[path] = fmt.Sprint(value)
+		default:
+			return fmt.Errorf("unsupported value of '%s'", path)
+		}
+	}
+	return nil
+}
+
+// newMetricHistoryOptions converts request parameters into repositories.MetricHistoryOptions.
+func newMetricHistoryOptions(
+	req request.MetricDownsamplingPartialRequest, context map[string]string, limit, offset int,
+) repositories.MetricHistoryOptions {
+	options := repositories.MetricHistoryOptions{
+		Limit:        limit,
+		Offset:       offset,
+		Context:      context,
+		StartStep:    req.StartStep,
+		EndStep:      req.EndStep,
+		Downsampling: req.Downsampling,
+		Points:       int(req.Points),
+	}
+	if options.Downsampling != "" && options.Points == 0 {
+		options.Points = DefaultDownsamplingPoints
+	}
+	return options
+}
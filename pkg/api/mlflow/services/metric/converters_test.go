@@ -4,10 +4,12 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
 )
 
 func Test_adjustGetMetricHistoriesRequestForNamespace_Ok(t *testing.T) {
@@ -50,3 +52,45 @@ func Test_adjustGetMetricHistoriesRequestForNamespace_Ok(t *testing.T) {
 		})
 	}
 }
+
+func Test_convertMetricContextParameter_Ok(t *testing.T) {
+	testData := []struct {
+		name     string
+		context  string
+		expected map[string]string
+	}{
+		{
+			name:     "EmptyContext",
+			context:  "",
+			expected: nil,
+		},
+		{
+			name:    "NestedContext",
+			context: `{"subset": "val", "fold": 1, "nested": {"key": "value"}}`,
+			expected: map[string]string{
+				"subset":     "val",
+				"fold":       "1",
+				"nested.key": "value",
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			context, err := convertMetricContextParameter(tt.context)
+			require.Nil(t, err)
+			assert.Equal(t, tt.expected, context)
+		})
+	}
+}
+
+func Test_convertMetricContextParameter_Error(t *testing.T) {
+	_, err := convertMetricContextParameter(`{"subset": ["train", "val"]}`)
+	assert.Equal(
+		t,
+		api.NewInvalidParameterValueError(
+			`invalid context '{"subset": ["train", "val"]}': unsupported value of 'subset'`,
+		),
+		err,
+	)
+}
@@ -3,6 +3,9 @@ package metric
 import (
 	"context"
 	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
 
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
@@ -27,73 +30,160 @@ func NewService(
 	}
 }
 
+// GetMetricHistory returns metric history of the run. Together with metrics it returns the page limit and offset.
 func (s Service) GetMetricHistory(
 	ctx context.Context, namespace *models.Namespace, req *request.GetMetricHistoryRequest,
-) ([]models.Metric, error) {
+) ([]models.Metric, int, int, error) {
 	if err := ValidateGetMetricHistoryRequest(req); err != nil {
-		return nil, err
+		return nil, 0, 0, err
+	}
+
+	offset, err := decodePageToken(req.PageToken)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	metricContext, err := convertMetricContextParameter(req.Context)
+	if err != nil {
+		return nil, 0, 0, err
 	}
 
 	run, err := s.runRepository.GetByNamespaceIDAndRunID(ctx, namespace.ID, req.GetRunID())
 	if err != nil {
-		return nil, api.NewInternalError("unable to find run '%s': %s", req.GetRunID(), err)
+		return nil, 0, 0, api.NewInternalError("unable to find run '%s': %s", req.GetRunID(), err)
 	}
 	if run == nil {
-		return nil, api.NewResourceDoesNotExistError("unable to find run '%s'", req.GetRunID())
+		return nil, 0, 0, api.NewResourceDoesNotExistError("unable to find run '%s'", req.GetRunID())
 	}
 
-	metrics, err := s.metricRepository.GetMetricHistoryByRunIDAndKey(ctx, run.ID, req.MetricKey)
+	options := newMetricHistoryOptions(
+		req.MetricDownsamplingPartialRequest, metricContext, int(req.MaxResults), offset,
+	)
+	metrics, err := s.metricRepository.GetMetricHistoryByRunIDAndKey(ctx, run.ID, req.MetricKey, options)
 	if err != nil {
-		return nil, api.NewInternalError(
+		return nil, 0, 0, api.NewInternalError(
 			"unable to get metric history for metric '%s' of run '%s'", req.MetricKey, req.GetRunID(),
 		)
 	}
 
-	return metrics, nil
+	return metrics, options.Limit, options.Offset, nil
 }
 
+// GetMetricHistoryBulk returns metric history of several runs. Together with metrics it returns
+// the page limit and offset.
 func (s Service) GetMetricHistoryBulk(
 	ctx context.Context, namespace *models.Namespace, req *request.GetMetricHistoryBulkRequest,
-) ([]models.Metric, error) {
+) ([]models.Metric, int, int, error) {
 	if err := ValidateGetMetricHistoryBulkRequest(req); err != nil {
-		return nil, err
+		return nil, 0, 0, err
 	}
+
+	offset, err := decodePageToken(req.PageToken)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	metricContext, err := convertMetricContextParameter(req.Context)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	limit := req.MaxResults
+	if limit == 0 {
+		limit = repositories.MetricHistoryBulkDefaultLimit
+	}
+	options := newMetricHistoryOptions(req.MetricDownsamplingPartialRequest, metricContext, limit, offset)
 	metrics, err := s.metricRepository.GetMetricHistoryBulk(
 		ctx,
 		namespace.ID,
 		req.RunIDs,
 		req.MetricKey,
-		req.MaxResults,
+		options,
 	)
 	if err != nil {
-		return nil, api.NewInternalError(
+		return nil, 0, 0, api.NewInternalError(
 			"unable to get metric history in bulk for metric %q of runs %q", req.MetricKey, req.RunIDs,
 		)
 	}
-	return metrics, nil
+	return metrics, options.Limit, options.Offset, nil
 }
 
+// GetMetricHistories returns metric histories as rows to be streamed. Returned page token
+// points to the next page of metrics and is nil when there are no more metrics.
 func (s Service) GetMetricHistories(
 	ctx context.Context, namespace *models.Namespace, req *request.GetMetricHistoriesRequest,
-) (*sql.Rows, func(*sql.Rows, interface{}) error, error) {
+) (*sql.Rows, func(*sql.Rows, interface{}) error, *request.PageToken, error) {
 	adjustGetMetricHistoriesRequestForNamespace(namespace, req)
 	if err := ValidateGetMetricHistoriesRequest(req); err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
+	}
+
+	offset, err := decodePageToken(req.PageToken)
+	if err != nil {
+		return nil, nil, nil, err
 	}
 
-	rows, iterator, err := s.metricRepository.GetMetricHistories(
+	options := newMetricHistoryOptions(
+		req.MetricDownsamplingPartialRequest, req.Context, int(req.MaxResults), offset,
+	)
+	rows, iterator, hasMore, err := s.metricRepository.GetMetricHistories(
 		ctx,
 		namespace.ID,
 		req.ExperimentIDs,
 		req.RunIDs,
 		req.MetricKeys,
 		req.ViewType,
-		req.MaxResults,
-		req.Context,
+		options,
 	)
 	if err != nil {
-		return nil, nil, api.NewInternalError("Unable to search runs: %s", err)
+		return nil, nil, nil, api.NewInternalError("Unable to search runs: %s", err)
 	}
 
-	return rows, iterator, nil
+	var nextPageToken *request.PageToken
+	if hasMore {
+		//nolint:gosec
+		nextPageToken = &request.PageToken{Offset: int32(options.Offset + options.Limit)}
+	}
+	return rows, iterator, nextPageToken, nil
+}
+
+// GetDownsampledMetricHistories returns downsampled metric histories.
+func (s Service) GetDownsampledMetricHistories(
+	ctx context.Context, namespace *models.Namespace, req *request.GetMetricHistoriesRequest,
+) ([]models.Metric, error) {
+	adjustGetMetricHistoriesRequestForNamespace(namespace, req)
+	if err := ValidateGetMetricHistoriesRequest(req); err != nil {
+		return nil, err
+	}
+
+	metrics, err := s.metricRepository.GetDownsampledMetricHistories(
+		ctx,
+		namespace.ID,
+		req.ExperimentIDs,
+		req.RunIDs,
+		req.MetricKeys,
+		req.ViewType,
+		newMetricHistoryOptions(req.MetricDownsamplingPartialRequest, req.Context, 0, 0),
+	)
+	if err != nil {
+		return nil, api.NewInternalError("Unable to search runs: %s", err)
+	}
+	return metrics, nil
+}
+
+// decodePageToken decodes `page_token` into offset value.
+func decodePageToken(pageToken string) (int, error) {
+	if pageToken == "" {
+		return 0, nil
+	}
+	var token request.PageToken
+	if err := json.NewDecoder(
+		base64.NewDecoder(
+			base64.StdEncoding,
+			strings.NewReader(pageToken),
+		),
+	).Decode(&token); err != nil {
+		return 0, api.NewInvalidParameterValueError("invalid page_token '%s': %s", pageToken, err)
+	}
+	return int(token.Offset), nil
 }
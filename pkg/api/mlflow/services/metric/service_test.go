@@ -35,6 +35,7 @@ func TestService_GetMetricHistory_Ok(t *testing.T) {
 		context.TODO(),
 		"1",
 		"key",
+		repositories.MetricHistoryOptions{},
 	).Return([]models.Metric{
 		{
 			Key:       "key",
@@ -46,7 +47,7 @@ func TestService_GetMetricHistory_Ok(t *testing.T) {
 
 	// call service under testing.
 	service := NewService(&runRepository, &metricRepository)
-	metrics, err := service.GetMetricHistory(
+	metrics, limit, offset, err := service.GetMetricHistory(
 		context.TODO(),
 		&models.Namespace{
 			ID: 1,
@@ -67,6 +68,8 @@ func TestService_GetMetricHistory_Ok(t *testing.T) {
 			Timestamp: 1234567890,
 		},
 	}, metrics)
+	assert.Equal(t, 0, limit)
+	assert.Equal(t, 0, offset)
 }
 
 func TestService_GetMetricHistory_Error(t *testing.T) {
@@ -129,6 +132,7 @@ func TestService_GetMetricHistory_Error(t *testing.T) {
 					context.TODO(),
 					"1",
 					"key",
+					repositories.MetricHistoryOptions{},
 				).Return(nil, errors.New("database error"))
 				return NewService(&runRepository, &metricRepository)
 			},
@@ -138,7 +142,7 @@ func TestService_GetMetricHistory_Error(t *testing.T) {
 	for _, tt := range testData {
 		t.Run(tt.name, func(t *testing.T) {
 			// call service under testing.
-			_, err := tt.service().GetMetricHistory(context.TODO(), &models.Namespace{ID: 1}, tt.request)
+			_, _, _, err := tt.service().GetMetricHistory(context.TODO(), &models.Namespace{ID: 1}, tt.request)
 			assert.Equal(t, tt.error, err)
 		})
 	}
@@ -155,7 +159,7 @@ func TestService_GetMetricHistoryBulk_Ok(t *testing.T) {
 		uint(1),
 		[]string{"1", "2"},
 		"key",
-		10,
+		repositories.MetricHistoryOptions{Limit: 10},
 	).Return([]models.Metric{
 		{
 			Key:       "key",
@@ -167,7 +171,7 @@ func TestService_GetMetricHistoryBulk_Ok(t *testing.T) {
 
 	// call service under testing.
 	service := NewService(&runRepository, &metricRepository)
-	metrics, err := service.GetMetricHistoryBulk(context.TODO(), &models.Namespace{
+	metrics, limit, offset, err := service.GetMetricHistoryBulk(context.TODO(), &models.Namespace{
 		ID: 1,
 	}, &request.GetMetricHistoryBulkRequest{
 		RunIDs:     []string{"1", "2"},
@@ -185,6 +189,8 @@ func TestService_GetMetricHistoryBulk_Ok(t *testing.T) {
 			Timestamp: 1234567890,
 		},
 	}, metrics)
+	assert.Equal(t, 10, limit)
+	assert.Equal(t, 0, offset)
 }
 
 func TestService_GetMetricHistoryBulk_Error(t *testing.T) {
@@ -251,7 +257,7 @@ func TestService_GetMetricHistoryBulk_Error(t *testing.T) {
 					uint(1),
 					[]string{"1"},
 					"key",
-					10,
+					repositories.MetricHistoryOptions{Limit: 10},
 				).Return(nil, errors.New("database error"))
 				return NewService(&runRepository, &metricRepository)
 			},
@@ -261,7 +267,7 @@ func TestService_GetMetricHistoryBulk_Error(t *testing.T) {
 	for _, tt := range testData {
 		t.Run(tt.name, func(t *testing.T) {
 			// call service under testing.
-			_, err := tt.service().GetMetricHistoryBulk(context.TODO(), &models.Namespace{ID: 1}, tt.request)
+			_, _, _, err := tt.service().GetMetricHistoryBulk(context.TODO(), &models.Namespace{ID: 1}, tt.request)
 			assert.Equal(t, tt.error, err)
 		})
 	}
@@ -334,19 +340,20 @@ func TestNewService_GetMetricHistories_Ok(t *testing.T) {
 				mock.Anything,
 				[]string{"key1", "key2"},
 				request.ViewTypeActiveOnly,
-				int32(1),
-				map[string]string(nil),
+				repositories.MetricHistoryOptions{Limit: 1},
 			).Return(
 				tt.expectedRows,
 				tt.expectedIter,
+				true,
 				nil,
 			)
 
 			// call service under testing.
 			service := NewService(&runRepository, &metricRepository)
 			//nolint:rowserrcheck,sqlclosecheck
-			rows, iterator, err := service.GetMetricHistories(context.TODO(), tt.namespace, tt.request)
+			rows, iterator, nextPageToken, err := service.GetMetricHistories(context.TODO(), tt.namespace, tt.request)
 			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, &request.PageToken{Offset: 1}, nextPageToken)
 			assert.Equal(t, tt.expectedRows, rows)
 			require.Nil(t, rows.Err())
 			assert.NotNil(t, iterator)
@@ -423,11 +430,11 @@ func TestNewService_GetMetricHistories_Error(t *testing.T) {
 					[]string{"1"},
 					[]string{"key1", "key2"},
 					request.ViewTypeAll,
-					int32(1),
-					map[string]string(nil),
+					repositories.MetricHistoryOptions{Limit: 1},
 				).Return(
 					nil,
 					nil,
+					false,
 					errors.New("database error"),
 				)
 				return NewService(&runRepository, &metricRepository)
@@ -439,7 +446,7 @@ func TestNewService_GetMetricHistories_Error(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			// call service under testing.
 			//nolint:rowserrcheck,sqlclosecheck
-			_, _, err := tt.service().GetMetricHistories(context.TODO(), &models.Namespace{ID: 1}, tt.request)
+			_, _, _, err := tt.service().GetMetricHistories(context.TODO(), &models.Namespace{ID: 1}, tt.request)
 			assert.Equal(t, tt.error, err)
 		})
 	}
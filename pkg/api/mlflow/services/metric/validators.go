@@ -8,9 +8,13 @@ import (
 const (
 	MaxResultsForMetricHistoriesRequest  = 1000000000
 	MaxRunIDsForMetricHistoryBulkRequest = 200
+	MinDownsamplingPoints                = 3
+	MaxDownsamplingPoints                = 100000
+	DefaultDownsamplingPoints            = 1000
 )
 
 // AllowedViewTypeList supported list of ViewType.
+// AllowedDownsamplingMethodList supported list of DownsamplingMethod.
 var (
 	AllowedViewTypeList = map[request.ViewType]struct{}{
 		"":                          {},
@@ -18,6 +22,10 @@ var (
 		request.ViewTypeActiveOnly:  {},
 		request.ViewTypeDeletedOnly: {},
 	}
+	AllowedDownsamplingMethodList = map[request.DownsamplingMethod]struct{}{
+		request.DownsamplingMethodLTTB:   {},
+		request.DownsamplingMethodMinMax: {},
+	}
 )
 
 // ValidateGetMetricHistoryRequest validates `GET /mlflow/metrics/get-history` request.
@@ -28,7 +36,10 @@ func ValidateGetMetricHistoryRequest(req *request.GetMetricHistoryRequest) error
 	if req.MetricKey == "" {
 		return api.NewInvalidParameterValueError("Missing value for required parameter 'metric_key'")
 	}
-	return nil
+	if req.MaxResults < 0 {
+		return api.NewInvalidParameterValueError("Invalid value for parameter 'max_results' supplied.")
+	}
+	return validateMetricDownsamplingPartialRequest(req.MetricDownsamplingPartialRequest, req.PageToken)
 }
 
 // ValidateGetMetricHistoryBulkRequest validates `GET /mlflow/metrics/get-history-bulk` request.
@@ -46,7 +57,11 @@ func ValidateGetMetricHistoryBulkRequest(req *request.GetMetricHistoryBulkReques
 	if req.MetricKey == "" {
 		return api.NewInvalidParameterValueError("GetMetricHistoryBulk request must specify a metric_key.")
 	}
-	return nil
+
+	if req.MaxResults < 0 {
+		return api.NewInvalidParameterValueError("Invalid value for parameter 'max_results' supplied.")
+	}
+	return validateMetricDownsamplingPartialRequest(req.MetricDownsamplingPartialRequest, req.PageToken)
 }
 
 // ValidateGetMetricHistoriesRequest validates `GET /mlflow/metrics/get-histories` request.
@@ -63,8 +78,32 @@ func ValidateGetMetricHistoriesRequest(req *request.GetMetricHistoriesRequest) e
 		}
 	}
 
-	if req.MaxResults > MaxResultsForMetricHistoriesRequest {
+	if req.MaxResults < 0 || req.MaxResults > MaxResultsForMetricHistoriesRequest {
 		return api.NewInvalidParameterValueError("Invalid value for parameter 'max_results' supplied.")
 	}
+	return validateMetricDownsamplingPartialRequest(req.MetricDownsamplingPartialRequest, req.PageToken)
+}
+
+// validateMetricDownsamplingPartialRequest validates step range and downsampling parameters.
+func validateMetricDownsamplingPartialRequest(req request.MetricDownsamplingPartialRequest, pageToken string) error {
+	if req.StartStep != nil && req.EndStep != nil && *req.StartStep > *req.EndStep {
+		return api.NewInvalidParameterValueError("'start_step' can not be greater than 'end_step'")
+	}
+
+	if req.Downsampling == "" {
+		return nil
+	}
+	if _, ok := AllowedDownsamplingMethodList[req.Downsampling]; !ok {
+		return api.NewInvalidParameterValueError("Invalid downsampling '%s'", req.Downsampling)
+	}
+	if req.Points != 0 && (req.Points < MinDownsamplingPoints || req.Points > MaxDownsamplingPoints) {
+		return api.NewInvalidParameterValueError(
+			"Invalid value for parameter 'points' supplied. It must be between %d and %d.",
+			MinDownsamplingPoints, MaxDownsamplingPoints,
+		)
+	}
+	if pageToken != "" {
+		return api.NewInvalidParameterValueError("'page_token' can not be used together with 'downsampling'")
+	}
 	return nil
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
 	"github.com/G-Research/fasttrackml/pkg/common/api"
 )
 
@@ -37,6 +38,55 @@ func TestValidateGetMetricHistoryRequest_Error(t *testing.T) {
 				RunID: "id",
 			},
 		},
+		{
+			name:  "IncorrectStepRange",
+			error: api.NewInvalidParameterValueError("'start_step' can not be greater than 'end_step'"),
+			request: &request.GetMetricHistoryRequest{
+				RunID:     "id",
+				MetricKey: "key",
+				MetricDownsamplingPartialRequest: request.MetricDownsamplingPartialRequest{
+					StartStep: common.GetPointer(int64(10)),
+					EndStep:   common.GetPointer(int64(1)),
+				},
+			},
+		},
+		{
+			name:  "IncorrectDownsampling",
+			error: api.NewInvalidParameterValueError("Invalid downsampling 'unknown'"),
+			request: &request.GetMetricHistoryRequest{
+				RunID:     "id",
+				MetricKey: "key",
+				MetricDownsamplingPartialRequest: request.MetricDownsamplingPartialRequest{
+					Downsampling: "unknown",
+				},
+			},
+		},
+		{
+			name: "IncorrectPoints",
+			error: api.NewInvalidParameterValueError(
+				"Invalid value for parameter 'points' supplied. It must be between 3 and 100000.",
+			),
+			request: &request.GetMetricHistoryRequest{
+				RunID:     "id",
+				MetricKey: "key",
+				MetricDownsamplingPartialRequest: request.MetricDownsamplingPartialRequest{
+					Downsampling: request.DownsamplingMethodLTTB,
+					Points:       2,
+				},
+			},
+		},
+		{
+			name:  "PageTokenWithDownsampling",
+			error: api.NewInvalidParameterValueError("'page_token' can not be used together with 'downsampling'"),
+			request: &request.GetMetricHistoryRequest{
+				RunID:     "id",
+				MetricKey: "key",
+				PageToken: "token",
+				MetricDownsamplingPartialRequest: request.MetricDownsamplingPartialRequest{
+					Downsampling: request.DownsamplingMethodMinMax,
+				},
+			},
+		},
 	}
 
 	for _, tt := range testData {
@@ -0,0 +1,134 @@
+package model
+
+import (
+	"strconv"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/query"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+)
+
+// newRegisteredModelsFilterBuilder creates builder, which converts a single comparison of
+// `search registered models` filter into SQL expression.
+func newRegisteredModelsFilterBuilder(db *gorm.DB) query.ConditionBuilder {
+	dialector := db.Dialector.Name()
+	return func(comparison *query.Comparison) (clause.Expr, error) {
+		operator, value := comparison.Operator, comparison.Value
+		if comparison.Context != nil {
+			return clause.Expr{}, api.NewInvalidParameterValueError(
+				"context selector is not supported in '%s'", comparison,
+			)
+		}
+		switch comparison.Entity {
+		case "", "attribute", "attributes", "attr":
+			if comparison.Key != "name" {
+				return clause.Expr{}, api.NewInvalidParameterValueError(
+					"invalid attribute '%s'. Valid values are ['name']", comparison.Key,
+				)
+			}
+			if err := query.ValidateStringComparison(
+				operator, value, "invalid string comparison operator '%s'",
+			); err != nil {
+				return clause.Expr{}, err
+			}
+			return query.StringCondition(dialector, "registered_models.name", operator, value), nil
+		case "tag", "tags":
+			return buildTagCondition(
+				db.Select("1").Model(
+					&models.RegisteredModelTag{},
+				).Where(
+					"registered_model_id = registered_models.id",
+				),
+				comparison,
+			)
+		default:
+			return clause.Expr{}, api.NewInvalidParameterValueError(
+				"invalid entity type '%s'. Valid values are ['tag', 'attribute']", comparison.Entity,
+			)
+		}
+	}
+}
+
+// newModelVersionsFilterBuilder creates builder, which converts a single comparison of
+// `search model versions` filter into SQL expression.
+func newModelVersionsFilterBuilder(db *gorm.DB) query.ConditionBuilder {
+	dialector := db.Dialector.Name()
+	return func(comparison *query.Comparison) (clause.Expr, error) {
+		operator, value := comparison.Operator, comparison.Value
+		if comparison.Context != nil {
+			return clause.Expr{}, api.NewInvalidParameterValueError(
+				"context selector is not supported in '%s'", comparison,
+			)
+		}
+		switch comparison.Entity {
+		case "", "attribute", "attributes", "attr":
+			var column string
+			switch comparison.Key {
+			case "name":
+				column = `"RegisteredModel".name`
+			case "source_path":
+				column = "model_versions.source"
+			case "run_id":
+				column = "model_versions.run_id"
+			case "version_number":
+				if !query.IsNumericOperator(operator) {
+					return clause.Expr{}, api.NewInvalidParameterValueError(
+						"invalid numeric attribute comparison operator '%s'", operator,
+					)
+				}
+				v, err := strconv.ParseInt(value.Text, 10, 64)
+				if err != nil {
+					return clause.Expr{}, api.NewInvalidParameterValueError("invalid numeric value '%s'", value.Raw)
+				}
+				return query.ValueCondition("model_versions.version", operator, v), nil
+			default:
+				return clause.Expr{}, api.NewInvalidParameterValueError(
+					"invalid attribute '%s'. Valid values are ['name', 'run_id', 'source_path', 'version_number']",
+					comparison.Key,
+				)
+			}
+			if err := query.ValidateStringComparison(
+				operator, value, "invalid string comparison operator '%s'",
+			); err != nil {
+				return clause.Expr{}, err
+			}
+			return query.StringCondition(dialector, column, operator, value), nil
+		case "tag", "tags":
+			return buildTagCondition(
+				db.Select("1").Model(
+					&models.ModelVersionTag{},
+				).Where(
+					"model_version_id = model_versions.id",
+				),
+				comparison,
+			)
+		default:
+			return clause.Expr{}, api.NewInvalidParameterValueError(
+				"invalid entity type '%s'. Valid values are ['tag', 'attribute']", comparison.Entity,
+			)
+		}
+	}
+}
+
+// buildTagCondition builds condition over tags selected by provided subquery.
+func buildTagCondition(tx *gorm.DB, comparison *query.Comparison) (clause.Expr, error) {
+	operator, value := comparison.Operator, comparison.Value
+	if err := query.ValidateStringComparison(operator, value, "invalid string comparison operator '%s'"); err != nil {
+		return clause.Expr{}, err
+	}
+	tx = tx.Where("key = ?", comparison.Key)
+	switch operator {
+	case query.OperatorIsNull:
+		return clause.Expr{SQL: "NOT EXISTS (?)", Vars: []any{tx}}, nil
+	case query.OperatorIsNotNull:
+		return clause.Expr{SQL: "EXISTS (?)", Vars: []any{tx}}, nil
+	default:
+		return clause.Expr{
+			SQL:  "EXISTS (?)",
+			Vars: []any{tx.Where(query.StringCondition(tx.Dialector.Name(), "value", operator, value))},
+		}, nil
+	}
+}
@@ -2,26 +2,749 @@ package model
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
-	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm/clause"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/convertors"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/query"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+)
+
+var (
+	registeredOrder   = regexp.MustCompile(`^(?:attr(?:ibutes?)?\.)?(\w+)(?i:\s+(ASC|DESC))?$`)
+	runsSourcePattern = regexp.MustCompile(`^runs:/([^/]+)(?:/(.*))?$`)
 )
 
 // Service provides service layer to work with `model` business logic.
-type Service struct{}
+type Service struct {
+	runRepository             repositories.RunRepositoryProvider
+	modelVersionRepository    repositories.ModelVersionRepositoryProvider
+	registeredModelRepository repositories.RegisteredModelRepositoryProvider
+}
 
 // NewService creates new Service instance.
-func NewService() *Service {
-	return &Service{}
+func NewService(
+	runRepository repositories.RunRepositoryProvider,
+	modelVersionRepository repositories.ModelVersionRepositoryProvider,
+	registeredModelRepository repositories.RegisteredModelRepositoryProvider,
+) *Service {
+	return &Service{
+		runRepository:             runRepository,
+		modelVersionRepository:    modelVersionRepository,
+		registeredModelRepository: registeredModelRepository,
+	}
+}
+
+// CreateRegisteredModel creates new models.RegisteredModel entity.
+func (s Service) CreateRegisteredModel(
+	ctx context.Context, namespace *models.Namespace, req *request.CreateRegisteredModelRequest,
+) (*models.RegisteredModel, error) {
+	if err := ValidateCreateRegisteredModelRequest(req); err != nil {
+		return nil, err
+	}
+
+	registeredModel, err := s.registeredModelRepository.GetByNamespaceIDAndName(ctx, namespace.ID, req.Name)
+	if err != nil {
+		return nil, api.NewInternalError("error getting registered model with name: '%s', error: %s", req.Name, err)
+	}
+	if registeredModel != nil {
+		return nil, api.NewResourceAlreadyExistsError("registered model(name=%s) already exists", req.Name)
+	}
+
+	registeredModel = convertors.ConvertCreateRegisteredModelRequestToDBModel(namespace, req)
+	if err := s.registeredModelRepository.Create(ctx, registeredModel); err != nil {
+		return nil, api.NewInternalError("error inserting registered model '%s': %s", req.Name, err)
+	}
+	return registeredModel, nil
+}
+
+// GetRegisteredModel returns existing models.RegisteredModel entity by its name.
+func (s Service) GetRegisteredModel(
+	ctx context.Context, namespace *models.Namespace, req *request.GetRegisteredModelRequest,
+) (*models.RegisteredModel, error) {
+	if err := ValidateGetRegisteredModelRequest(req); err != nil {
+		return nil, err
+	}
+	return s.getRegisteredModel(ctx, namespace, req.Name)
+}
+
+// RenameRegisteredModel renames existing models.RegisteredModel entity.
+func (s Service) RenameRegisteredModel(
+	ctx context.Context, namespace *models.Namespace, req *request.RenameRegisteredModelRequest,
+) (*models.RegisteredModel, error) {
+	if err := ValidateRenameRegisteredModelRequest(req); err != nil {
+		return nil, err
+	}
+
+	registeredModel, err := s.getRegisteredModel(ctx, namespace, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.registeredModelRepository.GetByNamespaceIDAndName(ctx, namespace.ID, req.NewName)
+	if err != nil {
+		return nil, api.NewInternalError("error getting registered model with name: '%s', error: %s", req.NewName, err)
+	}
+	if existing != nil {
+		return nil, api.NewResourceAlreadyExistsError("registered model(name=%s) already exists", req.NewName)
+	}
+
+	registeredModel.Name = req.NewName
+	registeredModel.LastUpdatedTime = time.Now().UTC().UnixMilli()
+	if err := s.registeredModelRepository.Update(ctx, registeredModel); err != nil {
+		return nil, api.NewInternalError("unable to rename registered model '%s': %s", req.Name, err)
+	}
+	return registeredModel, nil
+}
+
+// UpdateRegisteredModel updates existing models.RegisteredModel entity.
+func (s Service) UpdateRegisteredModel(
+	ctx context.Context, namespace *models.Namespace, req *request.UpdateRegisteredModelRequest,
+) (*models.RegisteredModel, error) {
+	if err := ValidateUpdateRegisteredModelRequest(req); err != nil {
+		return nil, err
+	}
+
+	registeredModel, err := s.getRegisteredModel(ctx, namespace, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Description != nil {
+		registeredModel.Description = *req.Description
+	}
+	registeredModel.LastUpdatedTime = time.Now().UTC().UnixMilli()
+	if err := s.registeredModelRepository.Update(ctx, registeredModel); err != nil {
+		return nil, api.NewInternalError("unable to update registered model '%s': %s", req.Name, err)
+	}
+	return registeredModel, nil
+}
+
+// DeleteRegisteredModel deletes existing models.RegisteredModel entity.
+func (s Service) DeleteRegisteredModel(
+	ctx context.Context, namespace *models.Namespace, req *request.DeleteRegisteredModelRequest,
+) error {
+	if err := ValidateDeleteRegisteredModelRequest(req); err != nil {
+		return err
+	}
+
+	registeredModel, err := s.getRegisteredModel(ctx, namespace, req.Name)
+	if err != nil {
+		return err
+	}
+
+	if err := s.registeredModelRepository.Delete(ctx, registeredModel); err != nil {
+		return api.NewInternalError("unable to delete registered model '%s': %s", req.Name, err)
+	}
+	return nil
+}
+
+// SearchRegisteredModels searches models.RegisteredModel entities by provided filter.
+func (s Service) SearchRegisteredModels(
+	ctx context.Context, namespace *models.Namespace, req *request.SearchRegisteredModelsRequest,
+) ([]models.RegisteredModel, int, int, error) {
+	if err := ValidateSearchRegisteredModelsRequest(req); err != nil {
+		return nil, 0, 0, err
+	}
+
+	db := s.registeredModelRepository.GetDB()
+	tx := db.WithContext(ctx).Where(
+		"registered_models.namespace_id = ?", namespace.ID,
+	)
+
+	// MaxResults
+	limit := int(req.MaxResults)
+	if limit == 0 {
+		limit = DefaultRegisteredModelsPerPage
+	}
+	tx.Limit(limit + 1)
+
+	// PageToken
+	offset, err := decodePageToken(req.PageToken)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	tx.Offset(offset)
+
+	// Filter
+	if req.Filter != "" {
+		node, err := query.Parse(req.Filter)
+		if err != nil {
+			return nil, 0, 0, api.NewInvalidParameterValueError("malformed filter '%s': %s", req.Filter, err)
+		}
+		condition, err := query.Build(node, newRegisteredModelsFilterBuilder(db))
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		tx.Where(condition)
+	}
+
+	// OrderBy
+	nameOrder := false
+	for _, o := range req.OrderBy {
+		components := registeredOrder.FindStringSubmatch(o)
+		if len(components) == 0 {
+			return nil, 0, 0, api.NewInvalidParameterValueError("invalid order_by clause '%s'", o)
+		}
+
+		var column string
+		switch components[1] {
+		case "name":
+			nameOrder = true
+			column = "registered_models.name"
+		case "timestamp", "last_updated_timestamp":
+			column = "registered_models.last_updated_time"
+		default:
+			return nil, 0, 0, api.NewInvalidParameterValueError(
+				`invalid attribute '%s'. Valid values are ['name', 'timestamp', 'last_updated_timestamp']`,
+				components[1],
+			)
+		}
+		tx.Order(clause.OrderByColumn{
+			Column: clause.Column{Name: column},
+			Desc:   len(components) == 3 && strings.ToUpper(components[2]) == "DESC",
+		})
+	}
+	if !nameOrder {
+		tx.Order("registered_models.name ASC")
+	}
+
+	var registeredModels []models.RegisteredModel
+	if err := tx.Preload(
+		"Tags",
+	).Preload(
+		"Aliases",
+	).Preload(
+		"Versions", "current_stage <> ?", models.ModelVersionStageDeletedInternal,
+	).Find(&registeredModels).Error; err != nil {
+		return nil, 0, 0, api.NewInternalError("unable to search registered models: %s", err)
+	}
+
+	return registeredModels, limit, offset, nil
+}
+
+// GetLatestVersions returns the latest models.ModelVersion for each requested stage.
+func (s Service) GetLatestVersions(
+	ctx context.Context, namespace *models.Namespace, req *request.GetLatestVersionsRequest,
+) (*models.RegisteredModel, []models.ModelVersion, error) {
+	if err := ValidateGetLatestVersionsRequest(req); err != nil {
+		return nil, nil, err
+	}
+
+	registeredModel, err := s.getRegisteredModel(ctx, namespace, req.Name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	latestVersions := registeredModel.LatestVersions()
+	if len(req.Stages) == 0 {
+		return registeredModel, latestVersions, nil
+	}
+
+	versions := make([]models.ModelVersion, 0, len(latestVersions))
+	for _, version := range latestVersions {
+		for _, s := range req.Stages {
+			if stage, _ := models.NewModelVersionStage(s); stage == version.CurrentStage {
+				versions = append(versions, version)
+				break
+			}
+		}
+	}
+	return registeredModel, versions, nil
+}
+
+// SetRegisteredModelTag sets tag on existing models.RegisteredModel entity.
+func (s Service) SetRegisteredModelTag(
+	ctx context.Context, namespace *models.Namespace, req *request.SetRegisteredModelTagRequest,
+) error {
+	if err := ValidateSetRegisteredModelTagRequest(req); err != nil {
+		return err
+	}
+
+	registeredModel, err := s.getRegisteredModel(ctx, namespace, req.Name)
+	if err != nil {
+		return err
+	}
+
+	if err := s.registeredModelRepository.SetTag(ctx, &models.RegisteredModelTag{
+		Key:               req.Key,
+		Value:             req.Value,
+		RegisteredModelID: registeredModel.ID,
+	}); err != nil {
+		return api.NewInternalError("unable to set tag for registered model '%s': %s", req.Name, err)
+	}
+	return nil
+}
+
+// DeleteRegisteredModelTag deletes tag from existing models.RegisteredModel entity.
+func (s Service) DeleteRegisteredModelTag(
+	ctx context.Context, namespace *models.Namespace, req *request.DeleteRegisteredModelTagRequest,
+) error {
+	if err := ValidateDeleteRegisteredModelTagRequest(req); err != nil {
+		return err
+	}
+
+	registeredModel, err := s.getRegisteredModel(ctx, namespace, req.Name)
+	if err != nil {
+		return err
+	}
+
+	for _, tag := range registeredModel.Tags {
+		if tag.Key == req.Key {
+			//nolint:gosec
+			if err := s.registeredModelRepository.DeleteTag(ctx, &tag); err != nil {
+				return api.NewInternalError("unable to delete tag for registered model '%s': %s", req.Name, err)
+			}
+			return nil
+		}
+	}
+	return api.NewResourceDoesNotExistError(
+		"unable to find tag '%s' for registered model '%s'", req.Key, req.Name,
+	)
+}
+
+// SetRegisteredModelAlias sets alias on existing models.RegisteredModel entity.
+func (s Service) SetRegisteredModelAlias(
+	ctx context.Context, namespace *models.Namespace, req *request.SetRegisteredModelAliasRequest,
+) error {
+	if err := ValidateSetRegisteredModelAliasRequest(req); err != nil {
+		return err
+	}
+
+	registeredModel, modelVersion, err := s.getModelVersion(ctx, namespace, req.Name, req.Version)
+	if err != nil {
+		return err
+	}
+
+	if err := s.registeredModelRepository.SetAlias(ctx, &models.RegisteredModelAlias{
+		Alias:             req.Alias,
+		Version:           modelVersion.Version,
+		RegisteredModelID: registeredModel.ID,
+	}); err != nil {
+		return api.NewInternalError("unable to set alias for registered model '%s': %s", req.Name, err)
+	}
+	return nil
+}
+
+// DeleteRegisteredModelAlias deletes alias from existing models.RegisteredModel entity.
+func (s Service) DeleteRegisteredModelAlias(
+	ctx context.Context, namespace *models.Namespace, req *request.DeleteRegisteredModelAliasRequest,
+) error {
+	if err := ValidateDeleteRegisteredModelAliasRequest(req); err != nil {
+		return err
+	}
+
+	registeredModel, err := s.getRegisteredModel(ctx, namespace, req.Name)
+	if err != nil {
+		return err
+	}
+
+	for _, alias := range registeredModel.Aliases {
+		if alias.Alias == req.Alias {
+			//nolint:gosec
+			if err := s.registeredModelRepository.DeleteAlias(ctx, &alias); err != nil {
+				return api.NewInternalError("unable to delete alias for registered model '%s': %s", req.Name, err)
+			}
+			return nil
+		}
+	}
+	return api.NewResourceDoesNotExistError(
+		"unable to find alias '%s' for registered model '%s'", req.Alias, req.Name,
+	)
+}
+
+// GetModelVersionByAlias returns models.ModelVersion entity which alias points to.
+func (s Service) GetModelVersionByAlias(
+	ctx context.Context, namespace *models.Namespace, req *request.GetModelVersionByAliasRequest,
+) (*models.RegisteredModel, *models.ModelVersion, error) {
+	if err := ValidateGetModelVersionByAliasRequest(req); err != nil {
+		return nil, nil, err
+	}
+
+	registeredModel, err := s.getRegisteredModel(ctx, namespace, req.Name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, alias := range registeredModel.Aliases {
+		if alias.Alias == req.Alias {
+			modelVersion, err := s.modelVersionRepository.GetByRegisteredModelIDAndVersion(
+				ctx, registeredModel.ID, alias.Version,
+			)
+			if err != nil {
+				return nil, nil, api.NewInternalError(
+					"unable to get model version by alias '%s' of registered model '%s': %s", req.Alias, req.Name, err,
+				)
+			}
+			if modelVersion == nil {
+				break
+			}
+			return registeredModel, modelVersion, nil
+		}
+	}
+	return nil, nil, api.NewResourceDoesNotExistError(
+		"unable to find alias '%s' for registered model '%s'", req.Alias, req.Name,
+	)
+}
+
+// CreateModelVersion creates new models.ModelVersion entity.
+func (s Service) CreateModelVersion(
+	ctx context.Context, namespace *models.Namespace, req *request.CreateModelVersionRequest,
+) (*models.RegisteredModel, *models.ModelVersion, error) {
+	if err := ValidateCreateModelVersionRequest(req); err != nil {
+		return nil, nil, err
+	}
+
+	registeredModel, err := s.getRegisteredModel(ctx, namespace, req.Name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	modelVersion := convertors.ConvertCreateModelVersionRequestToDBModel(registeredModel, req)
+	// `runs:/<run_id>/<path>` sources are resolved to the actual run artifact location.
+	if components := runsSourcePattern.FindStringSubmatch(req.Source); components != nil {
+		run, err := s.runRepository.GetByNamespaceIDAndRunID(ctx, namespace.ID, components[1])
+		if err != nil {
+			return nil, nil, api.NewInternalError("unable to find run '%s': %s", components[1], err)
+		}
+		if run == nil {
+			return nil, nil, api.NewResourceDoesNotExistError("unable to find run '%s'", components[1])
+		}
+		location, err := url.JoinPath(run.ArtifactURI, components[2])
+		if err != nil {
+			return nil, nil, api.NewInvalidParameterValueError("invalid value for parameter 'source': %s", err)
+		}
+		modelVersion.StorageLocation = location
+		if modelVersion.RunID == "" {
+			modelVersion.RunID = run.ID
+		}
+	}
+
+	if err := s.modelVersionRepository.Create(ctx, modelVersion); err != nil {
+		return nil, nil, api.NewInternalError(
+			"unable to create model version for registered model '%s': %s", req.Name, err,
+		)
+	}
+	return registeredModel, modelVersion, nil
+}
+
+// GetModelVersion returns existing models.ModelVersion entity.
+func (s Service) GetModelVersion(
+	ctx context.Context, namespace *models.Namespace, req *request.GetModelVersionRequest,
+) (*models.RegisteredModel, *models.ModelVersion, error) {
+	if err := ValidateGetModelVersionRequest(req); err != nil {
+		return nil, nil, err
+	}
+	return s.getModelVersion(ctx, namespace, req.Name, req.Version)
 }
 
-func (s Service) SearchModelVersions(ctx context.Context) (any, error) {
-	return fiber.Map{
-		"model_versions": []any{},
-	}, nil
+// UpdateModelVersion updates existing models.ModelVersion entity.
+func (s Service) UpdateModelVersion(
+	ctx context.Context, namespace *models.Namespace, req *request.UpdateModelVersionRequest,
+) (*models.RegisteredModel, *models.ModelVersion, error) {
+	if err := ValidateUpdateModelVersionRequest(req); err != nil {
+		return nil, nil, err
+	}
+
+	registeredModel, modelVersion, err := s.getModelVersion(ctx, namespace, req.Name, req.Version)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if req.Description != nil {
+		modelVersion.Description = *req.Description
+	}
+	modelVersion.LastUpdatedTime = time.Now().UTC().UnixMilli()
+	if err := s.modelVersionRepository.Update(ctx, modelVersion); err != nil {
+		return nil, nil, api.NewInternalError(
+			"unable to update model version '%s' of registered model '%s': %s", req.Version, req.Name, err,
+		)
+	}
+	return registeredModel, modelVersion, nil
+}
+
+// DeleteModelVersion deletes existing models.ModelVersion entity.
+func (s Service) DeleteModelVersion(
+	ctx context.Context, namespace *models.Namespace, req *request.DeleteModelVersionRequest,
+) error {
+	if err := ValidateDeleteModelVersionRequest(req); err != nil {
+		return err
+	}
+
+	_, modelVersion, err := s.getModelVersion(ctx, namespace, req.Name, req.Version)
+	if err != nil {
+		return err
+	}
+
+	modelVersion.LastUpdatedTime = time.Now().UTC().UnixMilli()
+	if err := s.modelVersionRepository.Delete(ctx, modelVersion); err != nil {
+		return api.NewInternalError(
+			"unable to delete model version '%s' of registered model '%s': %s", req.Version, req.Name, err,
+		)
+	}
+	return nil
+}
+
+// SearchModelVersions searches models.ModelVersion entities by provided filter.
+func (s Service) SearchModelVersions(
+	ctx context.Context, namespace *models.Namespace, req *request.SearchModelVersionsRequest,
+) ([]models.ModelVersion, int, int, error) {
+	if err := ValidateSearchModelVersionsRequest(req); err != nil {
+		return nil, 0, 0, err
+	}
+
+	db := s.modelVersionRepository.GetDB()
+	tx := db.WithContext(ctx).Joins(
+		"RegisteredModel",
+	).Where(
+		`"RegisteredModel".namespace_id = ?`, namespace.ID,
+	).Where(
+		"model_versions.current_stage <> ?", models.ModelVersionStageDeletedInternal,
+	)
+
+	// MaxResults
+	limit := int(req.MaxResults)
+	if limit == 0 {
+		limit = DefaultModelVersionsPerPage
+	}
+	tx.Limit(limit + 1)
+
+	// PageToken
+	offset, err := decodePageToken(req.PageToken)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	tx.Offset(offset)
+
+	// Filter
+	if req.Filter != "" {
+		node, err := query.Parse(req.Filter)
+		if err != nil {
+			return nil, 0, 0, api.NewInvalidParameterValueError("malformed filter '%s': %s", req.Filter, err)
+		}
+		condition, err := query.Build(node, newModelVersionsFilterBuilder(db))
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		tx.Where(condition)
+	}
+
+	// OrderBy
+	for _, o := range req.OrderBy {
+		components := registeredOrder.FindStringSubmatch(o)
+		if len(components) == 0 {
+			return nil, 0, 0, api.NewInvalidParameterValueError("invalid order_by clause '%s'", o)
+		}
+
+		var column string
+		switch components[1] {
+		case "name":
+			column = `"RegisteredModel".name`
+		case "version_number":
+			column = "model_versions.version"
+		case "creation_timestamp":
+			column = "model_versions.creation_time"
+		case "timestamp", "last_updated_timestamp":
+			column = "model_versions.last_updated_time"
+		default:
+			return nil, 0, 0, api.NewInvalidParameterValueError(
+				`invalid attribute '%s'. Valid values are `+
+					`['name', 'version_number', 'creation_timestamp', 'timestamp', 'last_updated_timestamp']`,
+				components[1],
+			)
+		}
+		tx.Order(clause.OrderByColumn{
+			Column: clause.Column{Name: column, Raw: true},
+			Desc:   len(components) == 3 && strings.ToUpper(components[2]) == "DESC",
+		})
+	}
+	tx.Order(
+		"model_versions.last_updated_time DESC",
+	).Order(
+		`"RegisteredModel".name ASC`,
+	).Order(
+		"model_versions.version DESC",
+	)
+
+	var modelVersions []models.ModelVersion
+	if err := tx.Preload(
+		"Tags",
+	).Preload(
+		"RegisteredModel.Aliases",
+	).Find(&modelVersions).Error; err != nil {
+		return nil, 0, 0, api.NewInternalError("unable to search model versions: %s", err)
+	}
+
+	return modelVersions, limit, offset, nil
+}
+
+// GetModelVersionDownloadURI returns existing models.ModelVersion entity to build its download uri.
+func (s Service) GetModelVersionDownloadURI(
+	ctx context.Context, namespace *models.Namespace, req *request.GetModelVersionDownloadURIRequest,
+) (*models.ModelVersion, error) {
+	if err := ValidateGetModelVersionDownloadURIRequest(req); err != nil {
+		return nil, err
+	}
+
+	_, modelVersion, err := s.getModelVersion(ctx, namespace, req.Name, req.Version)
+	if err != nil {
+		return nil, err
+	}
+	return modelVersion, nil
+}
+
+// TransitionModelVersionStage moves existing models.ModelVersion entity to the requested stage.
+func (s Service) TransitionModelVersionStage(
+	ctx context.Context, namespace *models.Namespace, req *request.TransitionModelVersionStageRequest,
+) (*models.RegisteredModel, *models.ModelVersion, error) {
+	if err := ValidateTransitionModelVersionStageRequest(req); err != nil {
+		return nil, nil, err
+	}
+
+	registeredModel, modelVersion, err := s.getModelVersion(ctx, namespace, req.Name, req.Version)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stage, _ := models.NewModelVersionStage(req.Stage)
+	archiveExistingVersions := req.ArchiveExistingVersions &&
+		(stage == models.ModelVersionStageStaging || stage == models.ModelVersionStageProduction)
+	modelVersion.LastUpdatedTime = time.Now().UTC().UnixMilli()
+	if err := s.modelVersionRepository.TransitionStage(
+		ctx, modelVersion, stage, archiveExistingVersions,
+	); err != nil {
+		return nil, nil, api.NewInternalError(
+			"unable to transition model version '%s' of registered model '%s': %s", req.Version, req.Name, err,
+		)
+	}
+	return registeredModel, modelVersion, nil
+}
+
+// SetModelVersionTag sets tag on existing models.ModelVersion entity.
+func (s Service) SetModelVersionTag(
+	ctx context.Context, namespace *models.Namespace, req *request.SetModelVersionTagRequest,
+) error {
+	if err := ValidateSetModelVersionTagRequest(req); err != nil {
+		return err
+	}
+
+	_, modelVersion, err := s.getModelVersion(ctx, namespace, req.Name, req.Version)
+	if err != nil {
+		return err
+	}
+
+	if err := s.modelVersionRepository.SetTag(ctx, &models.ModelVersionTag{
+		Key:            req.Key,
+		Value:          req.Value,
+		ModelVersionID: modelVersion.ID,
+	}); err != nil {
+		return api.NewInternalError(
+			"unable to set tag for model version '%s' of registered model '%s': %s", req.Version, req.Name, err,
+		)
+	}
+	return nil
+}
+
+// DeleteModelVersionTag deletes tag from existing models.ModelVersion entity.
+func (s Service) DeleteModelVersionTag(
+	ctx context.Context, namespace *models.Namespace, req *request.DeleteModelVersionTagRequest,
+) error {
+	if err := ValidateDeleteModelVersionTagRequest(req); err != nil {
+		return err
+	}
+
+	_, modelVersion, err := s.getModelVersion(ctx, namespace, req.Name, req.Version)
+	if err != nil {
+		return err
+	}
+
+	for _, tag := range modelVersion.Tags {
+		if tag.Key == req.Key {
+			//nolint:gosec
+			if err := s.modelVersionRepository.DeleteTag(ctx, &tag); err != nil {
+				return api.NewInternalError(
+					"unable to delete tag for model version '%s' of registered model '%s': %s",
+					req.Version, req.Name, err,
+				)
+			}
+			return nil
+		}
+	}
+	return api.NewResourceDoesNotExistError(
+		"unable to find tag '%s' for model version '%s' of registered model '%s'", req.Key, req.Version, req.Name,
+	)
+}
+
+// getRegisteredModel returns existing models.RegisteredModel entity or ResourceDoesNotExist error.
+func (s Service) getRegisteredModel(
+	ctx context.Context, namespace *models.Namespace, name string,
+) (*models.RegisteredModel, error) {
+	registeredModel, err := s.registeredModelRepository.GetByNamespaceIDAndName(ctx, namespace.ID, name)
+	if err != nil {
+		return nil, api.NewInternalError("unable to get registered model by name '%s': %s", name, err)
+	}
+	if registeredModel == nil {
+		return nil, api.NewResourceDoesNotExistError("unable to find registered model '%s'", name)
+	}
+	return registeredModel, nil
+}
+
+// getModelVersion returns existing models.ModelVersion entity together with its models.RegisteredModel
+// or ResourceDoesNotExist error.
+func (s Service) getModelVersion(
+	ctx context.Context, namespace *models.Namespace, name, version string,
+) (*models.RegisteredModel, *models.ModelVersion, error) {
+	parsedVersion, err := strconv.ParseInt(version, 10, 64)
+	if err != nil {
+		return nil, nil, api.NewInvalidParameterValueError(
+			"unable to parse model version '%s': %s", version, err,
+		)
+	}
+
+	registeredModel, err := s.getRegisteredModel(ctx, namespace, name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	modelVersion, err := s.modelVersionRepository.GetByRegisteredModelIDAndVersion(
+		ctx, registeredModel.ID, parsedVersion,
+	)
+	if err != nil {
+		return nil, nil, api.NewInternalError(
+			"unable to get model version '%d' of registered model '%s': %s", parsedVersion, name, err,
+		)
+	}
+	if modelVersion == nil {
+		return nil, nil, api.NewResourceDoesNotExistError(
+			"unable to find model version '%d' of registered model '%s'", parsedVersion, name,
+		)
+	}
+	return registeredModel, modelVersion, nil
 }
 
-func (s Service) SearchRegisteredModels(ctx context.Context) (any, error) {
-	return fiber.Map{
-		"registered_models": []any{},
-	}, nil
+// decodePageToken decodes `page_token` request parameter into offset.
+func decodePageToken(pageToken string) (int, error) {
+	if pageToken == "" {
+		return 0, nil
+	}
+	var token request.PageToken
+	if err := json.NewDecoder(
+		base64.NewDecoder(
+			base64.StdEncoding,
+			strings.NewReader(pageToken),
+		),
+	).Decode(&token); err != nil {
+		return 0, api.NewInvalidParameterValueError("invalid page_token '%s': %s", pageToken, err)
+	}
+	return int(token.Offset), nil
 }
@@ -0,0 +1,236 @@
+package model
+
+import (
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+)
+
+const (
+	MaxRegisteredModelsPerPage     = 1000
+	DefaultRegisteredModelsPerPage = 100
+	MaxModelVersionsPerPage        = 200000
+	DefaultModelVersionsPerPage    = 200000
+)
+
+// ValidateCreateRegisteredModelRequest validates `POST /mlflow/registered-models/create` request.
+func ValidateCreateRegisteredModelRequest(req *request.CreateRegisteredModelRequest) error {
+	if req.Name == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'name'")
+	}
+	for _, tag := range req.Tags {
+		if tag.Key == "" {
+			return api.NewInvalidParameterValueError("Missing value for required parameter 'tags.key'")
+		}
+	}
+	return nil
+}
+
+// ValidateGetRegisteredModelRequest validates `GET /mlflow/registered-models/get` request.
+func ValidateGetRegisteredModelRequest(req *request.GetRegisteredModelRequest) error {
+	if req.Name == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'name'")
+	}
+	return nil
+}
+
+// ValidateRenameRegisteredModelRequest validates `POST /mlflow/registered-models/rename` request.
+func ValidateRenameRegisteredModelRequest(req *request.RenameRegisteredModelRequest) error {
+	if req.Name == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'name'")
+	}
+	if req.NewName == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'new_name'")
+	}
+	return nil
+}
+
+// ValidateUpdateRegisteredModelRequest validates `PATCH /mlflow/registered-models/update` request.
+func ValidateUpdateRegisteredModelRequest(req *request.UpdateRegisteredModelRequest) error {
+	if req.Name == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'name'")
+	}
+	return nil
+}
+
+// ValidateDeleteRegisteredModelRequest validates `DELETE /mlflow/registered-models/delete` request.
+func ValidateDeleteRegisteredModelRequest(req *request.DeleteRegisteredModelRequest) error {
+	if req.Name == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'name'")
+	}
+	return nil
+}
+
+// ValidateSearchRegisteredModelsRequest validates `GET /mlflow/registered-models/search` request.
+func ValidateSearchRegisteredModelsRequest(req *request.SearchRegisteredModelsRequest) error {
+	if req.MaxResults < 0 || req.MaxResults > MaxRegisteredModelsPerPage {
+		return api.NewInvalidParameterValueError(
+			"Invalid value for parameter 'max_results' supplied. It must be at most %d", MaxRegisteredModelsPerPage,
+		)
+	}
+	return nil
+}
+
+// ValidateGetLatestVersionsRequest validates `POST /mlflow/registered-models/get-latest-versions` request.
+func ValidateGetLatestVersionsRequest(req *request.GetLatestVersionsRequest) error {
+	if req.Name == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'name'")
+	}
+	for _, stage := range req.Stages {
+		if _, ok := models.NewModelVersionStage(stage); !ok {
+			return api.NewInvalidParameterValueError("Invalid Model Version stage: %s", stage)
+		}
+	}
+	return nil
+}
+
+// ValidateSetRegisteredModelTagRequest validates `POST /mlflow/registered-models/set-tag` request.
+func ValidateSetRegisteredModelTagRequest(req *request.SetRegisteredModelTagRequest) error {
+	if req.Name == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'name'")
+	}
+	if req.Key == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'key'")
+	}
+	return nil
+}
+
+// ValidateDeleteRegisteredModelTagRequest validates `DELETE /mlflow/registered-models/delete-tag` request.
+func ValidateDeleteRegisteredModelTagRequest(req *request.DeleteRegisteredModelTagRequest) error {
+	if req.Name == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'name'")
+	}
+	if req.Key == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'key'")
+	}
+	return nil
+}
+
+// ValidateSetRegisteredModelAliasRequest validates `POST /mlflow/registered-models/alias` request.
+func ValidateSetRegisteredModelAliasRequest(req *request.SetRegisteredModelAliasRequest) error {
+	if req.Name == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'name'")
+	}
+	if req.Alias == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'alias'")
+	}
+	if req.Version == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'version'")
+	}
+	return nil
+}
+
+// ValidateDeleteRegisteredModelAliasRequest validates `DELETE /mlflow/registered-models/alias` request.
+func ValidateDeleteRegisteredModelAliasRequest(req *request.DeleteRegisteredModelAliasRequest) error {
+	if req.Name == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'name'")
+	}
+	if req.Alias == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'alias'")
+	}
+	return nil
+}
+
+// ValidateGetModelVersionByAliasRequest validates `GET /mlflow/registered-models/alias` request.
+func ValidateGetModelVersionByAliasRequest(req *request.GetModelVersionByAliasRequest) error {
+	if req.Name == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'name'")
+	}
+	if req.Alias == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'alias'")
+	}
+	return nil
+}
+
+// ValidateCreateModelVersionRequest validates `POST /mlflow/model-versions/create` request.
+func ValidateCreateModelVersionRequest(req *request.CreateModelVersionRequest) error {
+	if req.Name == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'name'")
+	}
+	if req.Source == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'source'")
+	}
+	for _, tag := range req.Tags {
+		if tag.Key == "" {
+			return api.NewInvalidParameterValueError("Missing value for required parameter 'tags.key'")
+		}
+	}
+	return nil
+}
+
+// ValidateGetModelVersionRequest validates `GET /mlflow/model-versions/get` request.
+func ValidateGetModelVersionRequest(req *request.GetModelVersionRequest) error {
+	return validateModelVersionIdentity(req.Name, req.Version)
+}
+
+// ValidateUpdateModelVersionRequest validates `PATCH /mlflow/model-versions/update` request.
+func ValidateUpdateModelVersionRequest(req *request.UpdateModelVersionRequest) error {
+	return validateModelVersionIdentity(req.Name, req.Version)
+}
+
+// ValidateDeleteModelVersionRequest validates `DELETE /mlflow/model-versions/delete` request.
+func ValidateDeleteModelVersionRequest(req *request.DeleteModelVersionRequest) error {
+	return validateModelVersionIdentity(req.Name, req.Version)
+}
+
+// ValidateSearchModelVersionsRequest validates `GET /mlflow/model-versions/search` request.
+func ValidateSearchModelVersionsRequest(req *request.SearchModelVersionsRequest) error {
+	if req.MaxResults < 0 || req.MaxResults > MaxModelVersionsPerPage {
+		return api.NewInvalidParameterValueError(
+			"Invalid value for parameter 'max_results' supplied. It must be at most %d", MaxModelVersionsPerPage,
+		)
+	}
+	return nil
+}
+
+// ValidateGetModelVersionDownloadURIRequest validates `GET /mlflow/model-versions/get-download-uri` request.
+func ValidateGetModelVersionDownloadURIRequest(req *request.GetModelVersionDownloadURIRequest) error {
+	return validateModelVersionIdentity(req.Name, req.Version)
+}
+
+// ValidateTransitionModelVersionStageRequest validates `POST /mlflow/model-versions/transition-stage` request.
+func ValidateTransitionModelVersionStageRequest(req *request.TransitionModelVersionStageRequest) error {
+	if err := validateModelVersionIdentity(req.Name, req.Version); err != nil {
+		return err
+	}
+	if req.Stage == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'stage'")
+	}
+	if _, ok := models.NewModelVersionStage(req.Stage); !ok {
+		return api.NewInvalidParameterValueError("Invalid Model Version stage: %s", req.Stage)
+	}
+	return nil
+}
+
+// ValidateSetModelVersionTagRequest validates `POST /mlflow/model-versions/set-tag` request.
+func ValidateSetModelVersionTagRequest(req *request.SetModelVersionTagRequest) error {
+	if err := validateModelVersionIdentity(req.Name, req.Version); err != nil {
+		return err
+	}
+	if req.Key == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'key'")
+	}
+	return nil
+}
+
+// ValidateDeleteModelVersionTagRequest validates `DELETE /mlflow/model-versions/delete-tag` request.
+func ValidateDeleteModelVersionTagRequest(req *request.DeleteModelVersionTagRequest) error {
+	if err := validateModelVersionIdentity(req.Name, req.Version); err != nil {
+		return err
+	}
+	if req.Key == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'key'")
+	}
+	return nil
+}
+
+// validateModelVersionIdentity validates the `name` and `version` parameters which identify model version.
+func validateModelVersionIdentity(name, version string) error {
+	if name == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'name'")
+	}
+	if version == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'version'")
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+)
+
+func TestValidateCreateRegisteredModelRequest_Ok(t *testing.T) {
+	err := ValidateCreateRegisteredModelRequest(&request.CreateRegisteredModelRequest{
+		Name: "name",
+	})
+	require.Nil(t, err)
+}
+
+func TestValidateCreateRegisteredModelRequest_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.CreateRegisteredModelRequest
+	}{
+		{
+			name:    "EmptyNameProperty",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'name'"),
+			request: &request.CreateRegisteredModelRequest{},
+		},
+		{
+			name:  "EmptyTagKeyProperty",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'tags.key'"),
+			request: &request.CreateRegisteredModelRequest{
+				Name: "name",
+				Tags: []request.RegisteredModelTagPartialRequest{{Value: "value"}},
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateCreateRegisteredModelRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
+func TestValidateSearchRegisteredModelsRequest_Error(t *testing.T) {
+	err := ValidateSearchRegisteredModelsRequest(&request.SearchRegisteredModelsRequest{
+		MaxResults: MaxRegisteredModelsPerPage + 1,
+	})
+	assert.Equal(t, api.NewInvalidParameterValueError(
+		"Invalid value for parameter 'max_results' supplied. It must be at most 1000",
+	), err)
+}
+
+func TestValidateTransitionModelVersionStageRequest_Ok(t *testing.T) {
+	err := ValidateTransitionModelVersionStageRequest(&request.TransitionModelVersionStageRequest{
+		Name:    "name",
+		Version: "1",
+		Stage:   "staging",
+	})
+	require.Nil(t, err)
+}
+
+func TestValidateTransitionModelVersionStageRequest_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.TransitionModelVersionStageRequest
+	}{
+		{
+			name:    "EmptyNameProperty",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'name'"),
+			request: &request.TransitionModelVersionStageRequest{},
+		},
+		{
+			name:  "EmptyVersionProperty",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'version'"),
+			request: &request.TransitionModelVersionStageRequest{
+				Name: "name",
+			},
+		},
+		{
+			name:  "EmptyStageProperty",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'stage'"),
+			request: &request.TransitionModelVersionStageRequest{
+				Name:    "name",
+				Version: "1",
+			},
+		},
+		{
+			name:  "IncorrectStageProperty",
+			error: api.NewInvalidParameterValueError("Invalid Model Version stage: unknown"),
+			request: &request.TransitionModelVersionStageRequest{
+				Name:    "name",
+				Version: "1",
+				Stage:   "unknown",
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateTransitionModelVersionStageRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
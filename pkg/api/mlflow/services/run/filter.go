@@ -0,0 +1,216 @@
+package run
+
+import (
+	"fmt"
+	"strconv"
+
+	"gorm.io/gorm/clause"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/query"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/database"
+)
+
+// buildFilterCondition converts a single comparison of `search runs` filter into SQL expression.
+func buildFilterCondition(comparison *query.Comparison) (clause.Expr, error) {
+	dialector := database.DB.Dialector.Name()
+	operator, value := comparison.Operator, comparison.Value
+	if comparison.Context != nil && comparison.Entity != "metric" && comparison.Entity != "metrics" {
+		return clause.Expr{}, api.NewInvalidParameterValueError(
+			"context selector is supported only for metrics, got '%s'", comparison,
+		)
+	}
+	switch comparison.Entity {
+	case "", "attribute", "attributes", "attr", "run":
+		key := comparison.Key
+		switch key {
+		case "start_time", "end_time":
+			if query.IsNullOperator(operator) {
+				return query.ValueCondition(fmt.Sprintf("runs.%s", key), operator, nil), nil
+			}
+			if !query.IsNumericOperator(operator) {
+				return clause.Expr{}, api.NewInvalidParameterValueError(
+					"invalid numeric attribute comparison operator '%s'", operator,
+				)
+			}
+			v, err := strconv.Atoi(value.Text)
+			if err != nil {
+				return clause.Expr{}, api.NewInvalidParameterValueError("invalid numeric value '%s'", value.Raw)
+			}
+			return query.ValueCondition(fmt.Sprintf("runs.%s", key), operator, v), nil
+		case "run_name":
+			return buildStringSubqueryCondition(
+				dialector, &database.Tag{}, "mlflow.runName", "value", operator, value,
+				"invalid string attribute comparison operator '%s'",
+			)
+		case "status", "user_id", "artifact_uri", "run_id":
+			if key == "run_id" {
+				key = "run_uuid"
+			}
+			if err := query.ValidateStringComparison(
+				operator, value, "invalid string attribute comparison operator '%s'",
+			); err != nil {
+				return clause.Expr{}, err
+			}
+			return query.StringCondition(dialector, fmt.Sprintf("runs.%s", key), operator, value), nil
+		default:
+			return clause.Expr{}, api.NewInvalidParameterValueError(
+				`invalid attribute '%s'. `+
+					`Valid values are ['run_name', 'start_time', 'end_time', 'status', 'user_id', 'artifact_uri', 'run_id']`,
+				key,
+			)
+		}
+	case "metric", "metrics":
+		if query.IsNullOperator(operator) {
+			return buildMetricSubqueryCondition(dialector, comparison, clause.Expr{}), nil
+		}
+		if !query.IsNumericOperator(operator) {
+			return clause.Expr{}, api.NewInvalidParameterValueError(
+				"invalid metric comparison operator '%s'", operator,
+			)
+		}
+		v, err := strconv.ParseFloat(value.Text, 64)
+		if err != nil {
+			return clause.Expr{}, api.NewInvalidParameterValueError("invalid numeric value '%s'", value.Raw)
+		}
+		return buildMetricSubqueryCondition(
+			dialector, comparison, query.ValueCondition("latest_metrics.value", operator, v),
+		), nil
+	case "parameter", "parameters", "param", "params":
+		// numeric values are compared with typed params, other values with string params.
+		if value != nil && value.Kind == query.ValueKindNumber {
+			if !query.IsNumericOperator(operator) {
+				return clause.Expr{}, api.NewInvalidParameterValueError(
+					"invalid value '%s' for comparison operator '%s'", value.Raw, operator,
+				)
+			}
+			v, err := strconv.ParseFloat(value.Text, 64)
+			if err != nil {
+				return clause.Expr{}, api.NewInvalidParameterValueError("invalid numeric value '%s'", value.Raw)
+			}
+			return buildSubqueryCondition(
+				&database.Param{}, comparison.Key, operator,
+				query.ValueCondition("COALESCE(value_int, value_float)", operator, v),
+			), nil
+		}
+		return buildStringSubqueryCondition(
+			dialector, &database.Param{}, comparison.Key, "value_str", operator, value,
+			"invalid param comparison operator '%s'",
+		)
+	case "tag", "tags":
+		return buildStringSubqueryCondition(
+			dialector, &database.Tag{}, comparison.Key, "value", operator, value,
+			"invalid tag comparison operator '%s'",
+		)
+	case "dataset", "datasets":
+		return buildDatasetCondition(dialector, comparison.Key, operator, value)
+	default:
+		return clause.Expr{}, api.NewInvalidParameterValueError(
+			"invalid entity type '%s'. Valid values are ['metric', 'parameter', 'tag', 'attribute', 'dataset']",
+			comparison.Entity,
+		)
+	}
+}
+
+// buildDatasetCondition builds `EXISTS` condition over the datasets used as the run inputs.
+// `dataset.context` is compared with the context tag of the input.
+func buildDatasetCondition(dialector, key, operator string, value *query.Value) (clause.Expr, error) {
+	if err := query.ValidateStringComparison(
+		operator, value, "invalid dataset comparison operator '%s'",
+	); err != nil {
+		return clause.Expr{}, err
+	}
+
+	tx := database.DB.Select(
+		"1",
+	).Model(
+		&database.Input{},
+	).Joins(
+		"INNER JOIN datasets ON datasets.id = inputs.source_id",
+	).Where(
+		"inputs.destination_type = ? AND inputs.destination_id = runs.run_uuid", models.InputDestinationTypeRun,
+	)
+	var column string
+	switch key {
+	case "name", "digest":
+		column = fmt.Sprintf("datasets.%s", key)
+	case "context":
+		column = "input_tags.value"
+		tx = tx.Joins(
+			"INNER JOIN input_tags ON input_tags.input_id = inputs.id AND input_tags.name = ?", models.InputTagContext,
+		)
+	default:
+		return clause.Expr{}, api.NewInvalidParameterValueError(
+			"invalid dataset attribute '%s'. Valid values are ['name', 'digest', 'context']", key,
+		)
+	}
+
+	switch operator {
+	case query.OperatorIsNull:
+		return clause.Expr{SQL: "NOT EXISTS (?)", Vars: []any{tx}}, nil
+	case query.OperatorIsNotNull:
+		return clause.Expr{SQL: "EXISTS (?)", Vars: []any{tx}}, nil
+	default:
+		return clause.Expr{
+			SQL:  "EXISTS (?)",
+			Vars: []any{tx.Where(query.StringCondition(dialector, column, operator, value))},
+		}, nil
+	}
+}
+
+// buildStringSubqueryCondition builds condition over string column of the key-value run entity.
+func buildStringSubqueryCondition(
+	dialector string, model any, key, column, operator string, value *query.Value, operatorError string,
+) (clause.Expr, error) {
+	if err := query.ValidateStringComparison(operator, value, operatorError); err != nil {
+		return clause.Expr{}, err
+	}
+	if query.IsNullOperator(operator) {
+		return buildSubqueryCondition(model, key, operator, clause.Expr{}), nil
+	}
+	return buildSubqueryCondition(
+		model, key, operator, query.StringCondition(dialector, column, operator, value),
+	), nil
+}
+
+// buildMetricSubqueryCondition builds `EXISTS` condition over the latest metrics of the run.
+// If the comparison has context selector, then only metrics with matching context are checked.
+func buildMetricSubqueryCondition(dialector string, comparison *query.Comparison, condition clause.Expr) clause.Expr {
+	tx := database.DB.Select(
+		"1",
+	).Model(
+		&database.LatestMetric{},
+	).Where(
+		"latest_metrics.run_uuid = runs.run_uuid",
+	).Where(
+		"latest_metrics.key = ?", comparison.Key,
+	)
+	if len(comparison.Context) > 0 {
+		sql, args := repositories.BuildJsonCondition(dialector, "contexts.json", comparison.Context)
+		tx = tx.Joins("INNER JOIN contexts ON contexts.id = latest_metrics.context_id").Where(sql, args...)
+	}
+	switch comparison.Operator {
+	case query.OperatorIsNull:
+		return clause.Expr{SQL: "NOT EXISTS (?)", Vars: []any{tx}}
+	case query.OperatorIsNotNull:
+		return clause.Expr{SQL: "EXISTS (?)", Vars: []any{tx}}
+	default:
+		return clause.Expr{SQL: "EXISTS (?)", Vars: []any{tx.Where(condition)}}
+	}
+}
+
+// buildSubqueryCondition builds `EXISTS` condition over the key-value run entity like params, tags or metrics.
+// `IS NULL` and `IS NOT NULL` operators check that the run has no or has such key.
+func buildSubqueryCondition(model any, key, operator string, condition clause.Expr) clause.Expr {
+	tx := database.DB.Select("1").Model(model).Where("run_uuid = runs.run_uuid").Where("key = ?", key)
+	switch operator {
+	case query.OperatorIsNull:
+		return clause.Expr{SQL: "NOT EXISTS (?)", Vars: []any{tx}}
+	case query.OperatorIsNotNull:
+		return clause.Expr{SQL: "EXISTS (?)", Vars: []any{tx}}
+	default:
+		return clause.Expr{SQL: "EXISTS (?)", Vars: []any{tx.Where(condition)}}
+	}
+}
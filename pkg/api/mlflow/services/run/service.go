@@ -19,41 +19,32 @@ import (
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/convertors"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/query"
 	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/services/live"
 	"github.com/G-Research/fasttrackml/pkg/database"
 )
 
 //nolint:lll
 var (
-	runOrder      = regexp.MustCompile(`^(attribute|metric|param|tag)s?\.("[^"]+"|` + "`[^`]+`" + `|[\w\.]+)(?i:\s+(ASC|DESC))?$`)
-	filterAnd     = regexp.MustCompile(`(?i)\s+AND\s+`)
-	filterCond    = regexp.MustCompile(`^(?:(\w+)\.)?("[^"]+"|` + "`[^`]+`" + `|[\w\.]+)\s+(<|<=|>|>=|=|!=|(?i:I?LIKE)|(?i:(?:NOT )?IN))\s+(\((?:'[^']+'(?:,\s*)?)+\)|"[^"]+"|'[^']+'|[\w\.]+)$`)
-	filterInGroup = regexp.MustCompile(`,\s*`)
-)
-
-// supported expression list.
-const (
-	InExpression            = "IN"
-	NotInExpression         = "NOT IN"
-	LikeExpression          = "LIKE"
-	ILikeExpression         = "ILIKE"
-	EqualExpression         = "="
-	NotEqualExpression      = "!="
-	LessExpression          = "<"
-	LessOrEqualExpression   = "<="
-	GraterExpression        = ">"
-	GraterOrEqualExpression = ">="
+	runOrder = regexp.MustCompile(`^(attribute|metric|param|tag)s?\.("[^"]+"|` + "`[^`]+`" + `|[\w\.]+)(?i:\s+(ASC|DESC))?$`)
 )
 
 // Service provides service layer to work with `run` business logic.
 type Service struct {
-	logRepository        repositories.LogRepositoryProvider
-	tagRepository        repositories.TagRepositoryProvider
-	runRepository        repositories.RunRepositoryProvider
-	paramRepository      repositories.ParamRepositoryProvider
-	metricRepository     repositories.MetricRepositoryProvider
-	experimentRepository repositories.ExperimentRepositoryProvider
-	artifactRepository   repositories.ArtifactRepositoryProvider
+	logRepository          repositories.LogRepositoryProvider
+	tagRepository          repositories.TagRepositoryProvider
+	runRepository          repositories.RunRepositoryProvider
+	paramRepository        repositories.ParamRepositoryProvider
+	metricRepository       repositories.MetricRepositoryProvider
+	experimentRepository   repositories.ExperimentRepositoryProvider
+	artifactRepository     repositories.ArtifactRepositoryProvider
+	inputRepository        repositories.InputRepositoryProvider
+	textRepository         repositories.TextRepositoryProvider
+	distributionRepository repositories.DistributionRepositoryProvider
+	figureRepository       repositories.FigureRepositoryProvider
+	audioRepository        repositories.AudioRepositoryProvider
+	livePublisher          live.PublisherProvider
 }
 
 // NewService creates new Service instance.
@@ -65,15 +56,27 @@ func NewService(
 	experimentRepository repositories.ExperimentRepositoryProvider,
 	logRepository repositories.LogRepositoryProvider,
 	artifactRepository repositories.ArtifactRepositoryProvider,
+	inputRepository repositories.InputRepositoryProvider,
+	textRepository repositories.TextRepositoryProvider,
+	distributionRepository repositories.DistributionRepositoryProvider,
+	figureRepository repositories.FigureRepositoryProvider,
+	audioRepository repositories.AudioRepositoryProvider,
+	livePublisher live.PublisherProvider,
 ) *Service {
 	return &Service{
-		logRepository:        logRepository,
-		tagRepository:        tagRepository,
-		runRepository:        runRepository,
-		paramRepository:      paramRepository,
-		metricRepository:     metricRepository,
-		experimentRepository: experimentRepository,
-		artifactRepository:   artifactRepository,
+		logRepository:          logRepository,
+		tagRepository:          tagRepository,
+		runRepository:          runRepository,
+		paramRepository:        paramRepository,
+		metricRepository:       metricRepository,
+		experimentRepository:   experimentRepository,
+		artifactRepository:     artifactRepository,
+		inputRepository:        inputRepository,
+		textRepository:         textRepository,
+		distributionRepository: distributionRepository,
+		figureRepository:       figureRepository,
+		audioRepository:        audioRepository,
+		livePublisher:          livePublisher,
 	}
 }
 
@@ -98,6 +101,7 @@ func (s Service) CreateRun(
 	if err := s.runRepository.Create(ctx, run); err != nil {
 		return nil, api.NewInternalError("error inserting run: %s", err)
 	}
+	s.livePublisher.PublishStatus(ctx, ns.ID, run)
 
 	return run, nil
 }
@@ -135,6 +139,9 @@ func (s Service) UpdateRun(
 	}); err != nil {
 		return nil, api.NewInternalError("unable to update run '%s': %s", run.ID, err)
 	}
+	if req.Status != "" {
+		s.livePublisher.PublishStatus(ctx, namespace.ID, run)
+	}
 
 	return run, nil
 }
@@ -222,186 +229,15 @@ func (s Service) SearchRuns(
 
 	// Filter
 	if req.Filter != "" {
-		for n, f := range filterAnd.Split(req.Filter, -1) {
-			components := filterCond.FindStringSubmatch(f)
-			if len(components) != 5 {
-				return nil, 0, 0, api.NewInvalidParameterValueError("malformed filter '%s'", f)
-			}
-
-			entity := components[1]
-			key := strings.Trim(components[2], "\"`")
-			comparison := components[3]
-			var value any = components[4]
-			valueCol := "value"
-
-			var kind any
-			switch entity {
-			case "", "attribute", "attributes", "attr", "run":
-				switch key {
-				case "start_time", "end_time":
-					switch comparison {
-					case GraterExpression, GraterOrEqualExpression, NotEqualExpression,
-						EqualExpression, LessExpression, LessOrEqualExpression:
-						v, err := strconv.Atoi(value.(string))
-						if err != nil {
-							return nil, 0, 0, api.NewInvalidParameterValueError("invalid numeric value '%s'", value)
-						}
-						value = v
-					default:
-						return nil, 0, 0, api.NewInvalidParameterValueError(
-							"invalid numeric attribute comparison operator '%s'", comparison,
-						)
-					}
-				case "run_name":
-					key = "mlflow.runName"
-					kind = &database.Tag{}
-					fallthrough
-				case "status", "user_id", "artifact_uri":
-					switch strings.ToUpper(comparison) {
-					case NotEqualExpression, EqualExpression, LikeExpression, ILikeExpression:
-						if strings.HasPrefix(value.(string), "(") {
-							return nil, 0, 0, api.NewInvalidParameterValueError("invalid string value '%s'", value)
-						}
-						value = strings.Trim(value.(string), `"'`)
-					default:
-						return nil, 0, 0, api.NewInvalidParameterValueError(
-							"invalid string attribute comparison operator '%s'", comparison,
-						)
-					}
-				case "run_id":
-					key = "run_uuid"
-					switch strings.ToUpper(comparison) {
-					case NotEqualExpression, EqualExpression, LikeExpression, ILikeExpression:
-						if strings.HasPrefix(value.(string), "(") {
-							return nil, 0, 0, api.NewInvalidParameterValueError("invalid string value '%s'", value)
-						}
-						value = strings.Trim(value.(string), `"'`)
-					case InExpression, NotInExpression:
-						if !strings.HasPrefix(value.(string), "(") {
-							return nil, 0, 0, api.NewInvalidParameterValueError("invalid list definition '%s'", value)
-						}
-						var values []string
-						for _, v := range filterInGroup.Split(value.(string)[1:len(value.(string))-1], -1) {
-							values = append(values, strings.Trim(v, "'"))
-						}
-						value = values
-					default:
-						return nil, 0, 0, api.NewInvalidParameterValueError(
-							"invalid string attribute comparison operator '%s'", comparison,
-						)
-					}
-				default:
-					return nil, 0, 0, api.NewInvalidParameterValueError(
-						`invalid attribute '%s'. `+
-							`Valid values are ['run_name', 'start_time', 'end_time', 'status', 'user_id', 'artifact_uri', 'run_id']`,
-						key,
-					)
-				}
-			case "metric", "metrics":
-				switch comparison {
-				case GraterExpression, GraterOrEqualExpression,
-					NotEqualExpression, EqualExpression, LessExpression, LessOrEqualExpression:
-					v, err := strconv.ParseFloat(value.(string), 64)
-					if err != nil {
-						return nil, 0, 0, api.NewInvalidParameterValueError("invalid numeric value '%s'", value)
-					}
-					value = v
-				default:
-					return nil, 0, 0, api.NewInvalidParameterValueError(
-						"invalid metric comparison operator '%s'", comparison,
-					)
-				}
-				kind = &database.LatestMetric{}
-			case "parameter", "parameters", "param", "params":
-				switch strings.ToUpper(comparison) {
-				case NotEqualExpression, EqualExpression:
-					switch v := value.(type) {
-					case string:
-						if strings.HasPrefix(v, "(") {
-							return nil, 0, 0, api.NewInvalidParameterValueError("invalid string value '%s'", value)
-						}
-						value = strings.Trim(v, `"'`)
-						valueCol = "value_str"
-					case int64, int32, int:
-						valueCol = "value_int"
-					case float64, float32:
-						valueCol = "value_float"
-					default:
-						return nil, 0, 0, api.NewInvalidParameterValueError(
-							"invalid value '%v' for comparison operator '%s'", v, comparison,
-						)
-					}
-				case LikeExpression, ILikeExpression:
-					switch v := value.(type) {
-					case string:
-						if strings.HasPrefix(v, "(") {
-							return nil, 0, 0, api.NewInvalidParameterValueError("invalid string value '%s'", value)
-						}
-						value = strings.Trim(v, `"'`)
-						valueCol = "value_str"
-					default:
-						return nil, 0, 0, api.NewInvalidParameterValueError(
-							"invalid value '%v' for comparison operator '%s'", v, comparison,
-						)
-					}
-				case GraterExpression, GraterOrEqualExpression, LessExpression, LessOrEqualExpression:
-					switch v := value.(type) {
-					case int64, int32, int:
-						valueCol = "value_int"
-					case float64, float32:
-						valueCol = "value_float"
-					default:
-						return nil, 0, 0, api.NewInvalidParameterValueError(
-							"invalid value '%v' for comparison operator '%s'", v, comparison,
-						)
-					}
-				default:
-					return nil, 0, 0, api.NewInvalidParameterValueError(
-						"invalid param comparison operator '%s'", comparison,
-					)
-				}
-				kind = &database.Param{}
-			case "tag", "tags":
-				switch strings.ToUpper(comparison) {
-				case NotEqualExpression, EqualExpression, LikeExpression, ILikeExpression:
-					if strings.HasPrefix(value.(string), "(") {
-						return nil, 0, 0, api.NewInvalidParameterValueError("invalid string value '%s'", value)
-					}
-					value = strings.Trim(value.(string), `"'`)
-				default:
-					return nil, 0, 0, api.NewInvalidParameterValueError(
-						"invalid tag comparison operator '%s'", comparison,
-					)
-				}
-				kind = &database.Tag{}
-			default:
-				return nil, 0, 0, api.NewInvalidParameterValueError(
-					"invalid entity type '%s'. Valid values are ['metric', 'parameter', 'tag', 'attribute']", entity,
-				)
-			}
-
-			if kind == nil {
-				if database.DB.Dialector.Name() == "sqlite" && strings.ToUpper(comparison) == ILikeExpression {
-					key = fmt.Sprintf("LOWER(runs.%s)", key)
-					comparison = LikeExpression
-					value = strings.ToLower(value.(string))
-					tx.Where(fmt.Sprintf("%s %s ?", key, comparison), value)
-				} else {
-					tx.Where(fmt.Sprintf("runs.%s %s ?", key, comparison), value)
-				}
-			} else {
-				table := fmt.Sprintf("filter_%d", n)
-				where := fmt.Sprintf("%s %s ?", valueCol, comparison)
-				if database.DB.Dialector.Name() == "sqlite" && strings.ToUpper(comparison) == ILikeExpression {
-					where = fmt.Sprintf("LOWER(%s) LIKE ?", valueCol)
-					value = strings.ToLower(fmt.Sprintf("%v", value))
-				}
-				tx.Joins(
-					fmt.Sprintf("JOIN (?) AS %s ON runs.run_uuid = %s.run_uuid", table, table),
-					database.DB.Select("run_uuid", valueCol).Where("key = ?", key).Where(where, value).Model(kind),
-				)
-			}
+		node, err := query.Parse(req.Filter)
+		if err != nil {
+			return nil, 0, 0, api.NewInvalidParameterValueError("malformed filter '%s': %s", req.Filter, err)
 		}
+		condition, err := query.Build(node, buildFilterCondition)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		tx.Where(condition)
 	}
 
 	// OrderBy
@@ -457,9 +293,11 @@ func (s Service) SearchRuns(
 
 	// Actual query
 	var runs []models.Run
-	tx.Preload("LatestMetrics").
+	tx.Preload("LatestMetrics.Context").
 		Preload("Params").
 		Preload("Tags").
+		Preload("Inputs.Dataset").
+		Preload("Inputs.Tags").
 		Find(&runs)
 	if tx.Error != nil {
 		return nil, 0, 0, api.NewInternalError("unable to search runs: %s", tx.Error)
@@ -538,9 +376,11 @@ func (s Service) LogMetric(
 	if err != nil {
 		return api.NewInvalidParameterValueError(err.Error())
 	}
-	if err := s.metricRepository.CreateBatch(ctx, run, 1, []models.Metric{*metric}); err != nil {
+	metrics := []models.Metric{*metric}
+	if err := s.metricRepository.CreateBatch(ctx, run, 1, metrics); err != nil {
 		return api.NewInternalError("unable to log metric '%s' for run '%s': %s", req.Key, req.GetRunID(), err)
 	}
+	s.livePublisher.PublishMetrics(ctx, namespace.ID, run.ID, metrics)
 
 	return nil
 }
@@ -667,6 +507,7 @@ func (s Service) LogBatch(
 	if err := s.metricRepository.CreateBatch(ctx, run, 100, metrics); err != nil {
 		return api.NewInternalError("unable to insert metrics for run '%s': %s", run.ID, err)
 	}
+	s.livePublisher.PublishMetrics(ctx, namespace.ID, run.ID, metrics)
 	if err := s.runRepository.SetRunTagsBatch(ctx, run, 100, tags); err != nil {
 		return api.NewInternalError("unable to insert tags for run '%s': %s", run.ID, err)
 	}
@@ -674,6 +515,33 @@ func (s Service) LogBatch(
 	return nil
 }
 
+func (s Service) LogInputs(
+	ctx context.Context,
+	namespace *models.Namespace,
+	req *request.LogInputsRequest,
+) error {
+	if err := ValidateLogInputsRequest(req); err != nil {
+		return err
+	}
+
+	run, err := s.runRepository.GetByNamespaceIDRunIDAndLifecycleStage(
+		ctx, namespace.ID, req.RunID, models.LifecycleStageActive,
+	)
+	if err != nil {
+		return api.NewInternalError("Unable to find run '%s': %s", req.RunID, err)
+	}
+	if run == nil {
+		return api.NewResourceDoesNotExistError("Run '%s' not found", req.RunID)
+	}
+
+	inputs := convertors.ConvertLogInputsRequestToDBModel(req)
+	if err := s.inputRepository.CreateRunInputs(ctx, run, inputs); err != nil {
+		return api.NewInternalError("unable to insert inputs for run '%s': %s", run.ID, err)
+	}
+
+	return nil
+}
+
 func (s Service) LogOutput(
 	ctx context.Context,
 	namespace *models.Namespace,
@@ -695,6 +563,119 @@ func (s Service) LogOutput(
 	if err := s.logRepository.Create(ctx, log); err != nil {
 		return api.NewInternalError("unable to save log for run '%s'", req.RunID)
 	}
+	s.livePublisher.PublishLog(ctx, namespace.ID, log)
+	return nil
+}
+
+// LogText logs a new text sequence value for the Run.
+func (s Service) LogText(
+	ctx context.Context,
+	namespace *models.Namespace,
+	req *request.LogTextRequest,
+) error {
+	if err := ValidateLogTextRequest(req); err != nil {
+		return err
+	}
+
+	run, err := s.runRepository.GetByNamespaceIDAndRunID(ctx, namespace.ID, req.RunID)
+	if err != nil {
+		return api.NewResourceDoesNotExistError("unable to find run '%s': %s", req.RunID, err)
+	}
+	if run == nil {
+		return api.NewResourceDoesNotExistError("unable to find run '%s'", req.RunID)
+	}
+
+	text, err := convertors.ConvertLogTextRequestToDBModel(run.ID, req)
+	if err != nil {
+		return api.NewInvalidParameterValueError(err.Error())
+	}
+	if err := s.textRepository.Create(ctx, text); err != nil {
+		return api.NewInternalError("unable to save text for run '%s': %s", req.RunID, err)
+	}
+	return nil
+}
+
+// LogDistribution logs a new distribution sequence value for the Run.
+func (s Service) LogDistribution(
+	ctx context.Context,
+	namespace *models.Namespace,
+	req *request.LogDistributionRequest,
+) error {
+	if err := ValidateLogDistributionRequest(req); err != nil {
+		return err
+	}
+
+	run, err := s.runRepository.GetByNamespaceIDAndRunID(ctx, namespace.ID, req.RunID)
+	if err != nil {
+		return api.NewResourceDoesNotExistError("unable to find run '%s': %s", req.RunID, err)
+	}
+	if run == nil {
+		return api.NewResourceDoesNotExistError("unable to find run '%s'", req.RunID)
+	}
+
+	distribution, err := convertors.ConvertLogDistributionRequestToDBModel(run.ID, req)
+	if err != nil {
+		return api.NewInvalidParameterValueError(err.Error())
+	}
+	if err := s.distributionRepository.Create(ctx, distribution); err != nil {
+		return api.NewInternalError("unable to save distribution for run '%s': %s", req.RunID, err)
+	}
+	return nil
+}
+
+// LogFigure logs a new figure sequence value for the Run.
+func (s Service) LogFigure(
+	ctx context.Context,
+	namespace *models.Namespace,
+	req *request.LogFigureRequest,
+) error {
+	if err := ValidateLogFigureRequest(req); err != nil {
+		return err
+	}
+
+	run, err := s.runRepository.GetByNamespaceIDAndRunID(ctx, namespace.ID, req.RunID)
+	if err != nil {
+		return api.NewResourceDoesNotExistError("unable to find run '%s': %s", req.RunID, err)
+	}
+	if run == nil {
+		return api.NewResourceDoesNotExistError("unable to find run '%s'", req.RunID)
+	}
+
+	figure, err := convertors.ConvertLogFigureRequestToDBModel(run, req)
+	if err != nil {
+		return api.NewInvalidParameterValueError(err.Error())
+	}
+	if err := s.figureRepository.Create(ctx, figure); err != nil {
+		return api.NewInternalError("unable to save figure for run '%s': %s", req.RunID, err)
+	}
+	return nil
+}
+
+// LogAudio logs a new audio sequence value for the Run.
+func (s Service) LogAudio(
+	ctx context.Context,
+	namespace *models.Namespace,
+	req *request.LogAudioRequest,
+) error {
+	if err := ValidateLogAudioRequest(req); err != nil {
+		return err
+	}
+
+	run, err := s.runRepository.GetByNamespaceIDAndRunID(ctx, namespace.ID, req.RunID)
+	if err != nil {
+		return api.NewResourceDoesNotExistError("unable to find run '%s': %s", req.RunID, err)
+	}
+	if run == nil {
+		return api.NewResourceDoesNotExistError("unable to find run '%s'", req.RunID)
+	}
+
+	audio, err := convertors.ConvertLogAudioRequestToDBModel(run, req)
+	if err != nil {
+		return api.NewInvalidParameterValueError(err.Error())
+	}
+	if err := s.audioRepository.Create(ctx, audio); err != nil {
+		return api.NewInternalError("unable to save audio for run '%s': %s", req.RunID, err)
+	}
 	return nil
 }
 
@@ -15,6 +15,7 @@ import (
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
 	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/services/live"
 )
 
 func TestService_CreateRun_Ok(t *testing.T) {
@@ -60,6 +61,17 @@ func TestService_CreateRun_Ok(t *testing.T) {
 		ArtifactLocation: "/artifact/location",
 	}, nil)
 
+	livePublisher := live.MockPublisherProvider{}
+	livePublisher.On(
+		"PublishStatus",
+		context.TODO(),
+		ns.ID,
+		mock.MatchedBy(func(run *models.Run) bool {
+			assert.Equal(t, models.StatusRunning, run.Status)
+			return true
+		}),
+	).Return()
+
 	// call service under testing.
 	service := NewService(
 		&repositories.MockTagRepositoryProvider{},
@@ -69,6 +81,12 @@ func TestService_CreateRun_Ok(t *testing.T) {
 		&experimentRepository,
 		&repositories.MockLogRepositoryProvider{},
 		&repositories.MockArtifactRepositoryProvider{},
+		&repositories.MockInputRepositoryProvider{},
+		&repositories.MockTextRepositoryProvider{},
+		&repositories.MockDistributionRepositoryProvider{},
+		&repositories.MockFigureRepositoryProvider{},
+		&repositories.MockAudioRepositoryProvider{},
+		&livePublisher,
 	)
 	run, err := service.CreateRun(context.TODO(), &ns, &request.CreateRunRequest{
 		ExperimentID: "0", // default experiment id provided by the client is "0"
@@ -126,6 +144,12 @@ func TestService_CreateRun_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockInputRepositoryProvider{},
+					&repositories.MockTextRepositoryProvider{},
+					&repositories.MockDistributionRepositoryProvider{},
+					&repositories.MockFigureRepositoryProvider{},
+					&repositories.MockAudioRepositoryProvider{},
+					&live.MockPublisherProvider{},
 				)
 			},
 		},
@@ -151,6 +175,12 @@ func TestService_CreateRun_Error(t *testing.T) {
 					&experimentRepository,
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockInputRepositoryProvider{},
+					&repositories.MockTextRepositoryProvider{},
+					&repositories.MockDistributionRepositoryProvider{},
+					&repositories.MockFigureRepositoryProvider{},
+					&repositories.MockAudioRepositoryProvider{},
+					&live.MockPublisherProvider{},
 				)
 			},
 		},
@@ -205,6 +235,12 @@ func TestService_CreateRun_Error(t *testing.T) {
 					&experimentRepository,
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockInputRepositoryProvider{},
+					&repositories.MockTextRepositoryProvider{},
+					&repositories.MockDistributionRepositoryProvider{},
+					&repositories.MockFigureRepositoryProvider{},
+					&repositories.MockAudioRepositoryProvider{},
+					&live.MockPublisherProvider{},
 				)
 			},
 		},
@@ -243,6 +279,12 @@ func TestService_UpdateRun_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockInputRepositoryProvider{},
+					&repositories.MockTextRepositoryProvider{},
+					&repositories.MockDistributionRepositoryProvider{},
+					&repositories.MockFigureRepositoryProvider{},
+					&repositories.MockAudioRepositoryProvider{},
+					&live.MockPublisherProvider{},
 				)
 			},
 		},
@@ -268,6 +310,12 @@ func TestService_UpdateRun_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockInputRepositoryProvider{},
+					&repositories.MockTextRepositoryProvider{},
+					&repositories.MockDistributionRepositoryProvider{},
+					&repositories.MockFigureRepositoryProvider{},
+					&repositories.MockAudioRepositoryProvider{},
+					&live.MockPublisherProvider{},
 				)
 			},
 		},
@@ -310,6 +358,12 @@ func TestService_RestoreRun_Ok(t *testing.T) {
 		&repositories.MockExperimentRepositoryProvider{},
 		&repositories.MockLogRepositoryProvider{},
 		&repositories.MockArtifactRepositoryProvider{},
+		&repositories.MockInputRepositoryProvider{},
+		&repositories.MockTextRepositoryProvider{},
+		&repositories.MockDistributionRepositoryProvider{},
+		&repositories.MockFigureRepositoryProvider{},
+		&repositories.MockAudioRepositoryProvider{},
+		&live.MockPublisherProvider{},
 	)
 	err := service.RestoreRun(context.TODO(), &models.Namespace{ID: 1}, &request.RestoreRunRequest{RunID: "1"})
 
@@ -337,6 +391,12 @@ func TestService_RestoreRun_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockInputRepositoryProvider{},
+					&repositories.MockTextRepositoryProvider{},
+					&repositories.MockDistributionRepositoryProvider{},
+					&repositories.MockFigureRepositoryProvider{},
+					&repositories.MockAudioRepositoryProvider{},
+					&live.MockPublisherProvider{},
 				)
 			},
 		},
@@ -362,6 +422,12 @@ func TestService_RestoreRun_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockInputRepositoryProvider{},
+					&repositories.MockTextRepositoryProvider{},
+					&repositories.MockDistributionRepositoryProvider{},
+					&repositories.MockFigureRepositoryProvider{},
+					&repositories.MockAudioRepositoryProvider{},
+					&live.MockPublisherProvider{},
 				)
 			},
 		},
@@ -399,6 +465,12 @@ func TestService_RestoreRun_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockInputRepositoryProvider{},
+					&repositories.MockTextRepositoryProvider{},
+					&repositories.MockDistributionRepositoryProvider{},
+					&repositories.MockFigureRepositoryProvider{},
+					&repositories.MockAudioRepositoryProvider{},
+					&live.MockPublisherProvider{},
 				)
 			},
 		},
@@ -442,6 +514,12 @@ func TestService_SetRunTag_Ok(t *testing.T) {
 		&repositories.MockExperimentRepositoryProvider{},
 		&repositories.MockLogRepositoryProvider{},
 		&repositories.MockArtifactRepositoryProvider{},
+		&repositories.MockInputRepositoryProvider{},
+		&repositories.MockTextRepositoryProvider{},
+		&repositories.MockDistributionRepositoryProvider{},
+		&repositories.MockFigureRepositoryProvider{},
+		&repositories.MockAudioRepositoryProvider{},
+		&live.MockPublisherProvider{},
 	)
 	err := service.SetRunTag(context.TODO(), &models.Namespace{
 		ID: 1,
@@ -480,6 +558,12 @@ func TestService_DeleteRun_Ok(t *testing.T) {
 		&repositories.MockExperimentRepositoryProvider{},
 		&repositories.MockLogRepositoryProvider{},
 		&repositories.MockArtifactRepositoryProvider{},
+		&repositories.MockInputRepositoryProvider{},
+		&repositories.MockTextRepositoryProvider{},
+		&repositories.MockDistributionRepositoryProvider{},
+		&repositories.MockFigureRepositoryProvider{},
+		&repositories.MockAudioRepositoryProvider{},
+		&live.MockPublisherProvider{},
 	)
 	err := service.DeleteRun(context.TODO(), &models.Namespace{ID: 1}, &request.DeleteRunRequest{RunID: "1"})
 
@@ -507,6 +591,12 @@ func TestService_DeleteRun_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockInputRepositoryProvider{},
+					&repositories.MockTextRepositoryProvider{},
+					&repositories.MockDistributionRepositoryProvider{},
+					&repositories.MockFigureRepositoryProvider{},
+					&repositories.MockAudioRepositoryProvider{},
+					&live.MockPublisherProvider{},
 				)
 			},
 		},
@@ -532,6 +622,12 @@ func TestService_DeleteRun_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockInputRepositoryProvider{},
+					&repositories.MockTextRepositoryProvider{},
+					&repositories.MockDistributionRepositoryProvider{},
+					&repositories.MockFigureRepositoryProvider{},
+					&repositories.MockAudioRepositoryProvider{},
+					&live.MockPublisherProvider{},
 				)
 			},
 		},
@@ -557,6 +653,12 @@ func TestService_DeleteRun_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockInputRepositoryProvider{},
+					&repositories.MockTextRepositoryProvider{},
+					&repositories.MockDistributionRepositoryProvider{},
+					&repositories.MockFigureRepositoryProvider{},
+					&repositories.MockAudioRepositoryProvider{},
+					&live.MockPublisherProvider{},
 				)
 			},
 		},
@@ -592,6 +694,12 @@ func TestService_DeleteRun_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockInputRepositoryProvider{},
+					&repositories.MockTextRepositoryProvider{},
+					&repositories.MockDistributionRepositoryProvider{},
+					&repositories.MockFigureRepositoryProvider{},
+					&repositories.MockAudioRepositoryProvider{},
+					&live.MockPublisherProvider{},
 				)
 			},
 		},
@@ -629,6 +737,12 @@ func TestService_DeleteRunTag_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockInputRepositoryProvider{},
+					&repositories.MockTextRepositoryProvider{},
+					&repositories.MockDistributionRepositoryProvider{},
+					&repositories.MockFigureRepositoryProvider{},
+					&repositories.MockAudioRepositoryProvider{},
+					&live.MockPublisherProvider{},
 				)
 			},
 		},
@@ -655,6 +769,12 @@ func TestService_DeleteRunTag_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockInputRepositoryProvider{},
+					&repositories.MockTextRepositoryProvider{},
+					&repositories.MockDistributionRepositoryProvider{},
+					&repositories.MockFigureRepositoryProvider{},
+					&repositories.MockAudioRepositoryProvider{},
+					&live.MockPublisherProvider{},
 				)
 			},
 		},
@@ -681,6 +801,12 @@ func TestService_DeleteRunTag_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockInputRepositoryProvider{},
+					&repositories.MockTextRepositoryProvider{},
+					&repositories.MockDistributionRepositoryProvider{},
+					&repositories.MockFigureRepositoryProvider{},
+					&repositories.MockAudioRepositoryProvider{},
+					&live.MockPublisherProvider{},
 				)
 			},
 		},
@@ -718,6 +844,12 @@ func TestService_DeleteRunTag_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockInputRepositoryProvider{},
+					&repositories.MockTextRepositoryProvider{},
+					&repositories.MockDistributionRepositoryProvider{},
+					&repositories.MockFigureRepositoryProvider{},
+					&repositories.MockAudioRepositoryProvider{},
+					&live.MockPublisherProvider{},
 				)
 			},
 		},
@@ -755,6 +887,12 @@ func TestService_DeleteRunTag_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockInputRepositoryProvider{},
+					&repositories.MockTextRepositoryProvider{},
+					&repositories.MockDistributionRepositoryProvider{},
+					&repositories.MockFigureRepositoryProvider{},
+					&repositories.MockAudioRepositoryProvider{},
+					&live.MockPublisherProvider{},
 				)
 			},
 		},
@@ -806,6 +944,12 @@ func TestService_DeleteRunTag_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockInputRepositoryProvider{},
+					&repositories.MockTextRepositoryProvider{},
+					&repositories.MockDistributionRepositoryProvider{},
+					&repositories.MockFigureRepositoryProvider{},
+					&repositories.MockAudioRepositoryProvider{},
+					&live.MockPublisherProvider{},
 				)
 			},
 		},
@@ -874,6 +1018,12 @@ func TestService_GetRun_Ok(t *testing.T) {
 		&repositories.MockExperimentRepositoryProvider{},
 		&repositories.MockLogRepositoryProvider{},
 		&repositories.MockArtifactRepositoryProvider{},
+		&repositories.MockInputRepositoryProvider{},
+		&repositories.MockTextRepositoryProvider{},
+		&repositories.MockDistributionRepositoryProvider{},
+		&repositories.MockFigureRepositoryProvider{},
+		&repositories.MockAudioRepositoryProvider{},
+		&live.MockPublisherProvider{},
 	)
 	run, err := service.GetRun(context.TODO(), &models.Namespace{
 		ID: 1,
@@ -937,6 +1087,12 @@ func TestService_GetRun_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockInputRepositoryProvider{},
+					&repositories.MockTextRepositoryProvider{},
+					&repositories.MockDistributionRepositoryProvider{},
+					&repositories.MockFigureRepositoryProvider{},
+					&repositories.MockAudioRepositoryProvider{},
+					&live.MockPublisherProvider{},
 				)
 			},
 		},
@@ -962,6 +1118,12 @@ func TestService_GetRun_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockInputRepositoryProvider{},
+					&repositories.MockTextRepositoryProvider{},
+					&repositories.MockDistributionRepositoryProvider{},
+					&repositories.MockFigureRepositoryProvider{},
+					&repositories.MockAudioRepositoryProvider{},
+					&live.MockPublisherProvider{},
 				)
 			},
 		},
@@ -1030,6 +1192,17 @@ func TestService_LogBatch_Ok(t *testing.T) {
 			return true
 		}),
 	).Return(nil)
+	livePublisher := live.MockPublisherProvider{}
+	livePublisher.On(
+		"PublishMetrics",
+		context.TODO(),
+		uint(1),
+		"1",
+		mock.MatchedBy(func(metrics []models.Metric) bool {
+			assert.Equal(t, "key3", metrics[0].Key)
+			return true
+		}),
+	).Return()
 
 	// call service under testing.
 	service := NewService(
@@ -1040,6 +1213,12 @@ func TestService_LogBatch_Ok(t *testing.T) {
 		&repositories.MockExperimentRepositoryProvider{},
 		&repositories.MockLogRepositoryProvider{},
 		&repositories.MockArtifactRepositoryProvider{},
+		&repositories.MockInputRepositoryProvider{},
+		&repositories.MockTextRepositoryProvider{},
+		&repositories.MockDistributionRepositoryProvider{},
+		&repositories.MockFigureRepositoryProvider{},
+		&repositories.MockAudioRepositoryProvider{},
+		&livePublisher,
 	)
 	err := service.LogBatch(context.TODO(), &models.Namespace{
 		ID: 1,
@@ -1091,6 +1270,12 @@ func TestService_LogBatch_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockInputRepositoryProvider{},
+					&repositories.MockTextRepositoryProvider{},
+					&repositories.MockDistributionRepositoryProvider{},
+					&repositories.MockFigureRepositoryProvider{},
+					&repositories.MockAudioRepositoryProvider{},
+					&live.MockPublisherProvider{},
 				)
 			},
 		},
@@ -1117,6 +1302,12 @@ func TestService_LogBatch_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockInputRepositoryProvider{},
+					&repositories.MockTextRepositoryProvider{},
+					&repositories.MockDistributionRepositoryProvider{},
+					&repositories.MockFigureRepositoryProvider{},
+					&repositories.MockAudioRepositoryProvider{},
+					&live.MockPublisherProvider{},
 				)
 			},
 		},
@@ -1143,6 +1334,12 @@ func TestService_LogBatch_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockInputRepositoryProvider{},
+					&repositories.MockTextRepositoryProvider{},
+					&repositories.MockDistributionRepositoryProvider{},
+					&repositories.MockFigureRepositoryProvider{},
+					&repositories.MockAudioRepositoryProvider{},
+					&live.MockPublisherProvider{},
 				)
 			},
 		},
@@ -1169,6 +1366,12 @@ func TestService_LogBatch_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockInputRepositoryProvider{},
+					&repositories.MockTextRepositoryProvider{},
+					&repositories.MockDistributionRepositoryProvider{},
+					&repositories.MockFigureRepositoryProvider{},
+					&repositories.MockAudioRepositoryProvider{},
+					&live.MockPublisherProvider{},
 				)
 			},
 		},
@@ -1204,6 +1407,12 @@ func TestService_LogBatch_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockInputRepositoryProvider{},
+					&repositories.MockTextRepositoryProvider{},
+					&repositories.MockDistributionRepositoryProvider{},
+					&repositories.MockFigureRepositoryProvider{},
+					&repositories.MockAudioRepositoryProvider{},
+					&live.MockPublisherProvider{},
 				)
 			},
 		},
@@ -1251,6 +1460,12 @@ func TestService_LogBatch_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockInputRepositoryProvider{},
+					&repositories.MockTextRepositoryProvider{},
+					&repositories.MockDistributionRepositoryProvider{},
+					&repositories.MockFigureRepositoryProvider{},
+					&repositories.MockAudioRepositoryProvider{},
+					&live.MockPublisherProvider{},
 				)
 			},
 		},
@@ -1298,6 +1513,12 @@ func TestService_LogBatch_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockInputRepositoryProvider{},
+					&repositories.MockTextRepositoryProvider{},
+					&repositories.MockDistributionRepositoryProvider{},
+					&repositories.MockFigureRepositoryProvider{},
+					&repositories.MockAudioRepositoryProvider{},
+					&live.MockPublisherProvider{},
 				)
 			},
 		},
@@ -1375,6 +1596,12 @@ func TestService_LogBatch_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockInputRepositoryProvider{},
+					&repositories.MockTextRepositoryProvider{},
+					&repositories.MockDistributionRepositoryProvider{},
+					&repositories.MockFigureRepositoryProvider{},
+					&repositories.MockAudioRepositoryProvider{},
+					&live.MockPublisherProvider{},
 				)
 			},
 		},
@@ -1466,6 +1693,10 @@ func TestService_LogBatch_Error(t *testing.T) {
 						},
 					},
 				).Return(nil)
+				livePublisher := live.MockPublisherProvider{}
+				livePublisher.On(
+					"PublishMetrics", context.TODO(), uint(1), "1", mock.Anything,
+				).Return()
 				return NewService(
 					&repositories.MockTagRepositoryProvider{},
 					&runRepository,
@@ -1474,6 +1705,12 @@ func TestService_LogBatch_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockInputRepositoryProvider{},
+					&repositories.MockTextRepositoryProvider{},
+					&repositories.MockDistributionRepositoryProvider{},
+					&repositories.MockFigureRepositoryProvider{},
+					&repositories.MockAudioRepositoryProvider{},
+					&livePublisher,
 				)
 			},
 		},
@@ -1515,6 +1752,18 @@ func TestService_LogMetric_Ok(t *testing.T) {
 			return true
 		}),
 	).Return(nil)
+	livePublisher := live.MockPublisherProvider{}
+	livePublisher.On(
+		"PublishMetrics",
+		context.TODO(),
+		uint(1),
+		"1",
+		mock.MatchedBy(func(metrics []models.Metric) bool {
+			assert.Equal(t, "key", metrics[0].Key)
+			assert.Equal(t, 1.1, metrics[0].Value)
+			return true
+		}),
+	).Return()
 
 	// call service under testing.
 	service := NewService(
@@ -1525,6 +1774,12 @@ func TestService_LogMetric_Ok(t *testing.T) {
 		&repositories.MockExperimentRepositoryProvider{},
 		&repositories.MockLogRepositoryProvider{},
 		&repositories.MockArtifactRepositoryProvider{},
+		&repositories.MockInputRepositoryProvider{},
+		&repositories.MockTextRepositoryProvider{},
+		&repositories.MockDistributionRepositoryProvider{},
+		&repositories.MockFigureRepositoryProvider{},
+		&repositories.MockAudioRepositoryProvider{},
+		&livePublisher,
 	)
 	err := service.LogMetric(context.TODO(), &models.Namespace{
 		ID: 1,
@@ -1560,6 +1815,12 @@ func TestService_LogMetric_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockInputRepositoryProvider{},
+					&repositories.MockTextRepositoryProvider{},
+					&repositories.MockDistributionRepositoryProvider{},
+					&repositories.MockFigureRepositoryProvider{},
+					&repositories.MockAudioRepositoryProvider{},
+					&live.MockPublisherProvider{},
 				)
 			},
 		},
@@ -1578,6 +1839,12 @@ func TestService_LogMetric_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockInputRepositoryProvider{},
+					&repositories.MockTextRepositoryProvider{},
+					&repositories.MockDistributionRepositoryProvider{},
+					&repositories.MockFigureRepositoryProvider{},
+					&repositories.MockAudioRepositoryProvider{},
+					&live.MockPublisherProvider{},
 				)
 			},
 		},
@@ -1597,6 +1864,12 @@ func TestService_LogMetric_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockInputRepositoryProvider{},
+					&repositories.MockTextRepositoryProvider{},
+					&repositories.MockDistributionRepositoryProvider{},
+					&repositories.MockFigureRepositoryProvider{},
+					&repositories.MockAudioRepositoryProvider{},
+					&live.MockPublisherProvider{},
 				)
 			},
 		},
@@ -1625,6 +1898,12 @@ func TestService_LogMetric_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockInputRepositoryProvider{},
+					&repositories.MockTextRepositoryProvider{},
+					&repositories.MockDistributionRepositoryProvider{},
+					&repositories.MockFigureRepositoryProvider{},
+					&repositories.MockAudioRepositoryProvider{},
+					&live.MockPublisherProvider{},
 				)
 			},
 		},
@@ -1655,6 +1934,12 @@ func TestService_LogMetric_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockInputRepositoryProvider{},
+					&repositories.MockTextRepositoryProvider{},
+					&repositories.MockDistributionRepositoryProvider{},
+					&repositories.MockFigureRepositoryProvider{},
+					&repositories.MockAudioRepositoryProvider{},
+					&live.MockPublisherProvider{},
 				)
 			},
 		},
@@ -1705,6 +1990,12 @@ func TestService_LogMetric_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockInputRepositoryProvider{},
+					&repositories.MockTextRepositoryProvider{},
+					&repositories.MockDistributionRepositoryProvider{},
+					&repositories.MockFigureRepositoryProvider{},
+					&repositories.MockAudioRepositoryProvider{},
+					&live.MockPublisherProvider{},
 				)
 			},
 		},
@@ -1754,6 +2045,12 @@ func TestService_LogParam_Ok(t *testing.T) {
 		&repositories.MockExperimentRepositoryProvider{},
 		&repositories.MockLogRepositoryProvider{},
 		&repositories.MockArtifactRepositoryProvider{},
+		&repositories.MockInputRepositoryProvider{},
+		&repositories.MockTextRepositoryProvider{},
+		&repositories.MockDistributionRepositoryProvider{},
+		&repositories.MockFigureRepositoryProvider{},
+		&repositories.MockAudioRepositoryProvider{},
+		&live.MockPublisherProvider{},
 	)
 	err := service.LogParam(context.TODO(), &models.Namespace{
 		ID: 1,
@@ -1787,6 +2084,12 @@ func TestService_LogParam_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockInputRepositoryProvider{},
+					&repositories.MockTextRepositoryProvider{},
+					&repositories.MockDistributionRepositoryProvider{},
+					&repositories.MockFigureRepositoryProvider{},
+					&repositories.MockAudioRepositoryProvider{},
+					&live.MockPublisherProvider{},
 				)
 			},
 		},
@@ -1805,6 +2108,12 @@ func TestService_LogParam_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockInputRepositoryProvider{},
+					&repositories.MockTextRepositoryProvider{},
+					&repositories.MockDistributionRepositoryProvider{},
+					&repositories.MockFigureRepositoryProvider{},
+					&repositories.MockAudioRepositoryProvider{},
+					&live.MockPublisherProvider{},
 				)
 			},
 		},
@@ -1833,6 +2142,12 @@ func TestService_LogParam_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockInputRepositoryProvider{},
+					&repositories.MockTextRepositoryProvider{},
+					&repositories.MockDistributionRepositoryProvider{},
+					&repositories.MockFigureRepositoryProvider{},
+					&repositories.MockAudioRepositoryProvider{},
+					&live.MockPublisherProvider{},
 				)
 			},
 		},
@@ -1861,6 +2176,12 @@ func TestService_LogParam_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockInputRepositoryProvider{},
+					&repositories.MockTextRepositoryProvider{},
+					&repositories.MockDistributionRepositoryProvider{},
+					&repositories.MockFigureRepositoryProvider{},
+					&repositories.MockAudioRepositoryProvider{},
+					&live.MockPublisherProvider{},
 				)
 			},
 		},
@@ -1905,6 +2226,12 @@ func TestService_LogParam_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockInputRepositoryProvider{},
+					&repositories.MockTextRepositoryProvider{},
+					&repositories.MockDistributionRepositoryProvider{},
+					&repositories.MockFigureRepositoryProvider{},
+					&repositories.MockAudioRepositoryProvider{},
+					&live.MockPublisherProvider{},
 				)
 			},
 		},
@@ -1949,6 +2276,12 @@ func TestService_LogParam_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockInputRepositoryProvider{},
+					&repositories.MockTextRepositoryProvider{},
+					&repositories.MockDistributionRepositoryProvider{},
+					&repositories.MockFigureRepositoryProvider{},
+					&repositories.MockAudioRepositoryProvider{},
+					&live.MockPublisherProvider{},
 				)
 			},
 		},
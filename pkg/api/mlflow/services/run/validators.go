@@ -1,12 +1,21 @@
 package run
 
 import (
+	"net/url"
+	"path"
+	"slices"
+	"strings"
+
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
 	"github.com/G-Research/fasttrackml/pkg/common/api"
 )
 
 const (
-	MaxResultsPerPage = 1000000
+	MaxResultsPerPage      = 1000000
+	MaxDatasetNameLength   = 500
+	MaxDatasetDigestLength = 36
+	MaxInputTagKeyLength   = 255
+	MaxInputTagValueLength = 500
 )
 
 // AllowedViewTypeList supported list of ViewType.
@@ -123,6 +132,41 @@ func ValidateLogBatchRequest(req *request.LogBatchRequest) error {
 	return nil
 }
 
+// ValidateLogInputsRequest validates `POST /mlflow/runs/log-inputs` request.
+func ValidateLogInputsRequest(req *request.LogInputsRequest) error {
+	if req.RunID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'")
+	}
+	for _, input := range req.Datasets {
+		dataset := input.Dataset
+		if dataset.Name == "" || dataset.Digest == "" || dataset.SourceType == "" || dataset.Source == "" {
+			return api.NewInvalidParameterValueError("Invalid value for parameter 'datasets' supplied")
+		}
+		if len(dataset.Name) > MaxDatasetNameLength {
+			return api.NewInvalidParameterValueError(
+				"Dataset name '%s' exceeds the maximum length of %d", dataset.Name, MaxDatasetNameLength,
+			)
+		}
+		if len(dataset.Digest) > MaxDatasetDigestLength {
+			return api.NewInvalidParameterValueError(
+				"Dataset digest '%s' exceeds the maximum length of %d", dataset.Digest, MaxDatasetDigestLength,
+			)
+		}
+		for _, tag := range input.Tags {
+			if tag.Key == "" {
+				return api.NewInvalidParameterValueError("Invalid value for parameter 'tags' supplied")
+			}
+			if len(tag.Key) > MaxInputTagKeyLength || len(tag.Value) > MaxInputTagValueLength {
+				return api.NewInvalidParameterValueError(
+					"Input tag '%s' exceeds the maximum length of key %d or value %d",
+					tag.Key, MaxInputTagKeyLength, MaxInputTagValueLength,
+				)
+			}
+		}
+	}
+	return nil
+}
+
 // ValidateSearchRunsRequest validates `POST /mlflow/runs/search` request.
 func ValidateSearchRunsRequest(req *request.SearchRunsRequest) error {
 	if _, ok := AllowedViewTypeList[req.ViewType]; !ok {
@@ -144,3 +188,98 @@ func ValidateLogOutputRequest(req *request.LogOutputRequest) error {
 	}
 	return nil
 }
+
+// ValidateLogTextRequest validates `POST /mlflow/runs/log-text` request.
+func ValidateLogTextRequest(req *request.LogTextRequest) error {
+	if req.RunID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'")
+	}
+	if req.Name == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'name'")
+	}
+	if req.Step < 0 {
+		return api.NewInvalidParameterValueError("Invalid value for parameter 'step': must be non-negative")
+	}
+	if req.Index < 0 {
+		return api.NewInvalidParameterValueError("Invalid value for parameter 'index': must be non-negative")
+	}
+	return nil
+}
+
+// ValidateLogDistributionRequest validates `POST /mlflow/runs/log-distribution` request.
+func ValidateLogDistributionRequest(req *request.LogDistributionRequest) error {
+	if req.RunID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'")
+	}
+	if req.Name == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'name'")
+	}
+	if req.Step < 0 {
+		return api.NewInvalidParameterValueError("Invalid value for parameter 'step': must be non-negative")
+	}
+	if len(req.Counts) == 0 {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'counts'")
+	}
+	if len(req.BinEdges) != len(req.Counts)+1 {
+		return api.NewInvalidParameterValueError(
+			"Invalid value for parameter 'bin_edges': expected %d edges for %d counts",
+			len(req.Counts)+1, len(req.Counts),
+		)
+	}
+	for i := 1; i < len(req.BinEdges); i++ {
+		if req.BinEdges[i] < req.BinEdges[i-1] {
+			return api.NewInvalidParameterValueError(
+				"Invalid value for parameter 'bin_edges': must be sorted in ascending order",
+			)
+		}
+	}
+	return nil
+}
+
+// ValidateLogFigureRequest validates `POST /mlflow/runs/log-figure` request.
+func ValidateLogFigureRequest(req *request.LogFigureRequest) error {
+	if req.RunID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'")
+	}
+	if req.Name == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'name'")
+	}
+	if req.Step < 0 {
+		return api.NewInvalidParameterValueError("Invalid value for parameter 'step': must be non-negative")
+	}
+	return validateSequenceBlobURI(req.BlobURI)
+}
+
+// ValidateLogAudioRequest validates `POST /mlflow/runs/log-audio` request.
+func ValidateLogAudioRequest(req *request.LogAudioRequest) error {
+	if req.RunID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'")
+	}
+	if req.Name == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'name'")
+	}
+	if req.Step < 0 {
+		return api.NewInvalidParameterValueError("Invalid value for parameter 'step': must be non-negative")
+	}
+	if req.Index < 0 {
+		return api.NewInvalidParameterValueError("Invalid value for parameter 'index': must be non-negative")
+	}
+	if req.SampleRate < 0 {
+		return api.NewInvalidParameterValueError("Invalid value for parameter 'sample_rate': must be non-negative")
+	}
+	return validateSequenceBlobURI(req.BlobURI)
+}
+
+// validateSequenceBlobURI validates that blob uri points to an object under the run artifact root.
+func validateSequenceBlobURI(blobURI string) error {
+	if blobURI == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'blob_uri'")
+	}
+	u, err := url.Parse(blobURI)
+	if err != nil || u.Scheme != "" || path.IsAbs(u.Path) || slices.Contains(strings.Split(u.Path, "/"), "..") {
+		return api.NewInvalidParameterValueError(
+			"Invalid value for parameter 'blob_uri': must be a path relative to the run artifact root",
+		)
+	}
+	return nil
+}
@@ -368,6 +368,92 @@ func TestValidateLogBatchRequest_Error(t *testing.T) {
 	}
 }
 
+func TestValidateLogInputsRequest_Ok(t *testing.T) {
+	err := ValidateLogInputsRequest(&request.LogInputsRequest{
+		RunID: "id",
+		Datasets: []request.DatasetInputPartialRequest{{
+			Tags: []request.InputTagPartialRequest{{
+				Key:   "mlflow.data.context",
+				Value: "training",
+			}},
+			Dataset: request.DatasetPartialRequest{
+				Name:       "name",
+				Digest:     "digest",
+				SourceType: "local",
+				Source:     "source",
+			},
+		}},
+	})
+	require.Nil(t, err)
+}
+
+func TestValidateLogInputsRequest_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.LogInputsRequest
+	}{
+		{
+			name:    "EmptyRunID",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'"),
+			request: &request.LogInputsRequest{},
+		},
+		{
+			name:  "EmptyDatasetName",
+			error: api.NewInvalidParameterValueError("Invalid value for parameter 'datasets' supplied"),
+			request: &request.LogInputsRequest{
+				RunID: "id",
+				Datasets: []request.DatasetInputPartialRequest{{
+					Dataset: request.DatasetPartialRequest{
+						Digest:     "digest",
+						SourceType: "local",
+						Source:     "source",
+					},
+				}},
+			},
+		},
+		{
+			name:  "EmptyDatasetSource",
+			error: api.NewInvalidParameterValueError("Invalid value for parameter 'datasets' supplied"),
+			request: &request.LogInputsRequest{
+				RunID: "id",
+				Datasets: []request.DatasetInputPartialRequest{{
+					Dataset: request.DatasetPartialRequest{
+						Name:       "name",
+						Digest:     "digest",
+						SourceType: "local",
+					},
+				}},
+			},
+		},
+		{
+			name:  "EmptyTagKey",
+			error: api.NewInvalidParameterValueError("Invalid value for parameter 'tags' supplied"),
+			request: &request.LogInputsRequest{
+				RunID: "id",
+				Datasets: []request.DatasetInputPartialRequest{{
+					Tags: []request.InputTagPartialRequest{{
+						Value: "training",
+					}},
+					Dataset: request.DatasetPartialRequest{
+						Name:       "name",
+						Digest:     "digest",
+						SourceType: "local",
+						Source:     "source",
+					},
+				}},
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateLogInputsRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
 func TestValidateSearchRunsRequest_Ok(t *testing.T) {
 	err := ValidateSearchRunsRequest(&request.SearchRunsRequest{
 		ViewType:   request.ViewTypeAll,
@@ -444,3 +530,295 @@ func TestValidateLogOutputRequest_Error(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateLogTextRequest_Ok(t *testing.T) {
+	err := ValidateLogTextRequest(&request.LogTextRequest{
+		RunID: "id",
+		Name:  "name",
+		Text:  "some text",
+		Step:  1,
+	})
+	require.Nil(t, err)
+}
+
+func TestValidateLogTextRequest_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.LogTextRequest
+	}{
+		{
+			name:  "EmptyRunID",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'"),
+			request: &request.LogTextRequest{
+				Name: "name",
+			},
+		},
+		{
+			name:  "EmptyName",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'name'"),
+			request: &request.LogTextRequest{
+				RunID: "id",
+			},
+		},
+		{
+			name:  "NegativeStep",
+			error: api.NewInvalidParameterValueError("Invalid value for parameter 'step': must be non-negative"),
+			request: &request.LogTextRequest{
+				RunID: "id",
+				Name:  "name",
+				Step:  -1,
+			},
+		},
+		{
+			name:  "NegativeIndex",
+			error: api.NewInvalidParameterValueError("Invalid value for parameter 'index': must be non-negative"),
+			request: &request.LogTextRequest{
+				RunID: "id",
+				Name:  "name",
+				Index: -1,
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateLogTextRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
+func TestValidateLogDistributionRequest_Ok(t *testing.T) {
+	err := ValidateLogDistributionRequest(&request.LogDistributionRequest{
+		RunID:    "id",
+		Name:     "name",
+		Step:     1,
+		BinEdges: []float64{-1, 0, 1},
+		Counts:   []float64{2, 3},
+	})
+	require.Nil(t, err)
+}
+
+func TestValidateLogDistributionRequest_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.LogDistributionRequest
+	}{
+		{
+			name:  "EmptyRunID",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'"),
+			request: &request.LogDistributionRequest{
+				Name: "name",
+			},
+		},
+		{
+			name:  "EmptyName",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'name'"),
+			request: &request.LogDistributionRequest{
+				RunID: "id",
+			},
+		},
+		{
+			name:  "NegativeStep",
+			error: api.NewInvalidParameterValueError("Invalid value for parameter 'step': must be non-negative"),
+			request: &request.LogDistributionRequest{
+				RunID: "id",
+				Name:  "name",
+				Step:  -1,
+			},
+		},
+		{
+			name:  "EmptyCounts",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'counts'"),
+			request: &request.LogDistributionRequest{
+				RunID: "id",
+				Name:  "name",
+			},
+		},
+		{
+			name: "IncorrectBinEdgesNumber",
+			error: api.NewInvalidParameterValueError(
+				"Invalid value for parameter 'bin_edges': expected 3 edges for 2 counts",
+			),
+			request: &request.LogDistributionRequest{
+				RunID:    "id",
+				Name:     "name",
+				BinEdges: []float64{0, 1, 2, 3},
+				Counts:   []float64{1, 2},
+			},
+		},
+		{
+			name: "UnsortedBinEdges",
+			error: api.NewInvalidParameterValueError(
+				"Invalid value for parameter 'bin_edges': must be sorted in ascending order",
+			),
+			request: &request.LogDistributionRequest{
+				RunID:    "id",
+				Name:     "name",
+				BinEdges: []float64{1, 0, 2},
+				Counts:   []float64{1, 2},
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateLogDistributionRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
+func TestValidateLogFigureRequest_Ok(t *testing.T) {
+	err := ValidateLogFigureRequest(&request.LogFigureRequest{
+		RunID:   "id",
+		Name:    "name",
+		Step:    1,
+		BlobURI: "figures/figure.json",
+	})
+	require.Nil(t, err)
+}
+
+func TestValidateLogFigureRequest_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.LogFigureRequest
+	}{
+		{
+			name:  "EmptyRunID",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'"),
+			request: &request.LogFigureRequest{
+				Name: "name",
+			},
+		},
+		{
+			name:  "EmptyName",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'name'"),
+			request: &request.LogFigureRequest{
+				RunID: "id",
+			},
+		},
+		{
+			name:  "NegativeStep",
+			error: api.NewInvalidParameterValueError("Invalid value for parameter 'step': must be non-negative"),
+			request: &request.LogFigureRequest{
+				RunID: "id",
+				Name:  "name",
+				Step:  -1,
+			},
+		},
+		{
+			name:  "EmptyBlobURI",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'blob_uri'"),
+			request: &request.LogFigureRequest{
+				RunID: "id",
+				Name:  "name",
+			},
+		},
+		{
+			name: "AbsoluteBlobURI",
+			error: api.NewInvalidParameterValueError(
+				"Invalid value for parameter 'blob_uri': must be a path relative to the run artifact root",
+			),
+			request: &request.LogFigureRequest{
+				RunID:   "id",
+				Name:    "name",
+				BlobURI: "s3://bucket/figure.json",
+			},
+		},
+		{
+			name: "EscapingBlobURI",
+			error: api.NewInvalidParameterValueError(
+				"Invalid value for parameter 'blob_uri': must be a path relative to the run artifact root",
+			),
+			request: &request.LogFigureRequest{
+				RunID:   "id",
+				Name:    "name",
+				BlobURI: "figures/../../figure.json",
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateLogFigureRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
+func TestValidateLogAudioRequest_Ok(t *testing.T) {
+	err := ValidateLogAudioRequest(&request.LogAudioRequest{
+		RunID:      "id",
+		Name:       "name",
+		Step:       1,
+		Format:     "wav",
+		SampleRate: 16000,
+		BlobURI:    "audios/audio.wav",
+	})
+	require.Nil(t, err)
+}
+
+func TestValidateLogAudioRequest_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.LogAudioRequest
+	}{
+		{
+			name:  "EmptyRunID",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'"),
+			request: &request.LogAudioRequest{
+				Name: "name",
+			},
+		},
+		{
+			name:  "EmptyName",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'name'"),
+			request: &request.LogAudioRequest{
+				RunID: "id",
+			},
+		},
+		{
+			name:  "NegativeIndex",
+			error: api.NewInvalidParameterValueError("Invalid value for parameter 'index': must be non-negative"),
+			request: &request.LogAudioRequest{
+				RunID: "id",
+				Name:  "name",
+				Index: -1,
+			},
+		},
+		{
+			name: "NegativeSampleRate",
+			error: api.NewInvalidParameterValueError(
+				"Invalid value for parameter 'sample_rate': must be non-negative",
+			),
+			request: &request.LogAudioRequest{
+				RunID:      "id",
+				Name:       "name",
+				SampleRate: -1,
+			},
+		},
+		{
+			name: "AbsoluteBlobURI",
+			error: api.NewInvalidParameterValueError(
+				"Invalid value for parameter 'blob_uri': must be a path relative to the run artifact root",
+			),
+			request: &request.LogAudioRequest{
+				RunID:   "id",
+				Name:    "name",
+				BlobURI: "/tmp/audio.wav",
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateLogAudioRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package trace
+
+import (
+	"strconv"
+
+	"gorm.io/gorm/clause"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/query"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/database"
+)
+
+// buildFilterCondition converts a single comparison of `search traces` filter into SQL expression.
+func buildFilterCondition(comparison *query.Comparison) (clause.Expr, error) {
+	dialector := database.DB.Dialector.Name()
+	operator, value := comparison.Operator, comparison.Value
+	if comparison.Context != nil {
+		return clause.Expr{}, api.NewInvalidParameterValueError(
+			"context selector is not supported in '%s'", comparison,
+		)
+	}
+	switch comparison.Entity {
+	case "", "attribute", "attributes", "attr":
+		switch comparison.Key {
+		case "timestamp_ms", "timestamp", "execution_time_ms":
+			if !query.IsNumericOperator(operator) {
+				return clause.Expr{}, api.NewInvalidParameterValueError(
+					"invalid numeric attribute comparison operator '%s'", operator,
+				)
+			}
+			v, err := strconv.ParseInt(value.Text, 10, 64)
+			if err != nil {
+				return clause.Expr{}, api.NewInvalidParameterValueError("invalid numeric value '%s'", value.Raw)
+			}
+			column := "traces." + comparison.Key
+			if comparison.Key == "timestamp" {
+				column = "traces.timestamp_ms"
+			}
+			return query.ValueCondition(column, operator, v), nil
+		case "status", "request_id":
+			if err := query.ValidateStringComparison(
+				operator, value, "invalid string attribute comparison operator '%s'",
+			); err != nil {
+				return clause.Expr{}, err
+			}
+			return query.StringCondition(dialector, "traces."+comparison.Key, operator, value), nil
+		default:
+			return clause.Expr{}, api.NewInvalidParameterValueError(
+				"invalid attribute '%s'. "+
+					"Valid values are ['request_id', 'timestamp_ms', 'timestamp', 'execution_time_ms', 'status']",
+				comparison.Key,
+			)
+		}
+	case "tag", "tags":
+		return buildKeyValueCondition(
+			&database.TraceTag{}, dialector, comparison, "invalid tag comparison operator '%s'",
+		)
+	case "request_metadata":
+		return buildKeyValueCondition(
+			&database.TraceRequestMetadata{}, dialector, comparison, "invalid request metadata comparison operator '%s'",
+		)
+	default:
+		return clause.Expr{}, api.NewInvalidParameterValueError(
+			"invalid entity type '%s'. Valid values are ['tag', 'attribute', 'request_metadata']", comparison.Entity,
+		)
+	}
+}
+
+// buildKeyValueCondition builds condition over key/value relation of the trace, like tags or request metadata.
+func buildKeyValueCondition(
+	model any, dialector string, comparison *query.Comparison, operatorError string,
+) (clause.Expr, error) {
+	operator, value := comparison.Operator, comparison.Value
+	if err := query.ValidateStringComparison(operator, value, operatorError); err != nil {
+		return clause.Expr{}, err
+	}
+	tx := database.DB.Select("1").Model(
+		model,
+	).Where(
+		"request_id = traces.request_id",
+	).Where(
+		"key = ?", comparison.Key,
+	)
+	switch operator {
+	case query.OperatorIsNull:
+		return clause.Expr{SQL: "NOT EXISTS (?)", Vars: []any{tx}}, nil
+	case query.OperatorIsNotNull:
+		return clause.Expr{SQL: "EXISTS (?)", Vars: []any{tx}}, nil
+	default:
+		return clause.Expr{
+			SQL:  "EXISTS (?)",
+			Vars: []any{tx.Where(query.StringCondition(dialector, "value", operator, value))},
+		}, nil
+	}
+}
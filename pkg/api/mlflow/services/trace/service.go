@@ -0,0 +1,429 @@
+package trace
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gorm.io/gorm/clause"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/convertors"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/query"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/services/artifact/storage"
+)
+
+var traceOrder = regexp.MustCompile(`^(?:attr(?:ibutes?)?\.)?(\w+)(?i:\s+(ASC|DESC))?$`)
+
+// Service provides service layer to work with `trace` business logic.
+type Service struct {
+	traceRepository        repositories.TraceRepositoryProvider
+	experimentRepository   repositories.ExperimentRepositoryProvider
+	artifactStorageFactory storage.ArtifactStorageFactoryProvider
+}
+
+// NewService creates new Service instance.
+func NewService(
+	traceRepository repositories.TraceRepositoryProvider,
+	experimentRepository repositories.ExperimentRepositoryProvider,
+	artifactStorageFactory storage.ArtifactStorageFactoryProvider,
+) *Service {
+	return &Service{
+		traceRepository:        traceRepository,
+		experimentRepository:   experimentRepository,
+		artifactStorageFactory: artifactStorageFactory,
+	}
+}
+
+// StartTrace creates new models.Trace entity.
+func (s Service) StartTrace(
+	ctx context.Context, namespace *models.Namespace, req *request.StartTraceRequest,
+) (*models.Trace, error) {
+	if err := ValidateStartTraceRequest(req); err != nil {
+		return nil, err
+	}
+
+	experimentID, err := strconv.ParseInt(req.ExperimentID, 10, 32)
+	if err != nil {
+		return nil, api.NewBadRequestError("unable to parse experiment id '%s': %s", req.ExperimentID, err)
+	}
+	experiment, err := s.experimentRepository.GetByNamespaceIDAndExperimentID(ctx, namespace.ID, int32(experimentID))
+	if err != nil {
+		return nil, api.NewResourceDoesNotExistError("unable to find experiment with id '%s': %s", req.ExperimentID, err)
+	}
+
+	trace, err := convertors.ConvertStartTraceRequestToDBModel(experiment, req)
+	if err != nil {
+		return nil, api.NewInternalError("error converting request to actual trace model: %s", err)
+	}
+	if err := s.traceRepository.Create(ctx, trace); err != nil {
+		return nil, api.NewInternalError("error inserting trace: %s", err)
+	}
+	return trace, nil
+}
+
+// EndTrace finishes existing models.Trace entity and stores its data.
+func (s Service) EndTrace(
+	ctx context.Context, namespace *models.Namespace, req *request.EndTraceRequest,
+) (*models.Trace, error) {
+	if err := ValidateEndTraceRequest(req); err != nil {
+		return nil, err
+	}
+
+	trace, err := s.getTrace(ctx, namespace, req.RequestID)
+	if err != nil {
+		return nil, err
+	}
+	if trace.Status != models.TraceStatusInProgress {
+		return nil, api.NewInvalidParameterValueError("trace '%s' has already been ended", req.RequestID)
+	}
+
+	trace, err = convertors.ConvertEndTraceRequestToDBModel(trace, req)
+	if err != nil {
+		return nil, api.NewInvalidParameterValueError("invalid spans: %s", err)
+	}
+	if err := s.traceRepository.Update(ctx, trace); err != nil {
+		return nil, api.NewInternalError("error updating trace '%s': %s", req.RequestID, err)
+	}
+
+	// reload the trace to get merged tags and request metadata.
+	trace, err = s.getTrace(ctx, namespace, req.RequestID)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Spans) > 0 {
+		if err := s.storeTraceData(ctx, trace); err != nil {
+			return nil, err
+		}
+	}
+	return trace, nil
+}
+
+// GetTraceInfo returns models.Trace entity.
+func (s Service) GetTraceInfo(
+	ctx context.Context, namespace *models.Namespace, req *request.GetTraceInfoRequest,
+) (*models.Trace, error) {
+	if err := ValidateGetTraceInfoRequest(req); err != nil {
+		return nil, err
+	}
+	return s.getTrace(ctx, namespace, req.RequestID)
+}
+
+// GetTrace returns models.Trace entity together with its spans. Spans which are not in the database
+// are read from the trace data stored in the artifact storage.
+func (s Service) GetTrace(
+	ctx context.Context, namespace *models.Namespace, req *request.GetTraceRequest,
+) (*models.Trace, []models.TraceSpan, error) {
+	if err := ValidateGetTraceRequest(req); err != nil {
+		return nil, nil, err
+	}
+
+	trace, err := s.getTrace(ctx, namespace, req.RequestID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	spans, err := s.traceRepository.GetSpans(ctx, trace.ID)
+	if err != nil {
+		return nil, nil, api.NewInternalError("error getting spans of trace '%s': %s", req.RequestID, err)
+	}
+	if len(spans) == 0 {
+		spans = s.loadTraceData(ctx, trace)
+	}
+	return trace, spans, nil
+}
+
+// SearchTraces searches models.Trace entities by provided filter.
+func (s Service) SearchTraces(
+	ctx context.Context, namespace *models.Namespace, req *request.SearchTracesRequest,
+) ([]models.Trace, int, int, error) {
+	if err := ValidateSearchTracesRequest(req); err != nil {
+		return nil, 0, 0, err
+	}
+
+	experimentIDs := make([]int32, len(req.ExperimentIDs))
+	for n, id := range req.ExperimentIDs {
+		experimentID, err := strconv.ParseInt(id, 10, 32)
+		if err != nil {
+			return nil, 0, 0, api.NewInvalidParameterValueError("invalid experiment id '%s': %s", id, err)
+		}
+		experimentIDs[n] = int32(experimentID)
+	}
+
+	tx := s.traceRepository.GetDB().WithContext(ctx).Joins(
+		"INNER JOIN experiments ON experiments.experiment_id = traces.experiment_id AND experiments.namespace_id = ?",
+		namespace.ID,
+	).Where(
+		"traces.experiment_id IN ?", experimentIDs,
+	)
+
+	// MaxResults
+	limit := int(req.MaxResults)
+	if limit == 0 {
+		limit = DefaultTracesPerPage
+	}
+	tx.Limit(limit + 1)
+
+	// PageToken
+	offset, err := decodePageToken(req.PageToken)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	tx.Offset(offset)
+
+	// Filter
+	if req.Filter != "" {
+		node, err := query.Parse(req.Filter)
+		if err != nil {
+			return nil, 0, 0, api.NewInvalidParameterValueError("malformed filter '%s': %s", req.Filter, err)
+		}
+		condition, err := query.Build(node, buildFilterCondition)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		tx.Where(condition)
+	}
+
+	// OrderBy
+	idOrder := false
+	for _, o := range req.OrderBy {
+		components := traceOrder.FindStringSubmatch(o)
+		if len(components) == 0 {
+			return nil, 0, 0, api.NewInvalidParameterValueError("invalid order_by clause '%s'", o)
+		}
+
+		column := components[1]
+		switch column {
+		case "request_id":
+			idOrder = true
+		case "timestamp":
+			column = "timestamp_ms"
+		case "timestamp_ms", "execution_time_ms", "status":
+		default:
+			return nil, 0, 0, api.NewInvalidParameterValueError(
+				`invalid attribute '%s'. `+
+					`Valid values are ['request_id', 'timestamp_ms', 'timestamp', 'execution_time_ms', 'status']`,
+				column,
+			)
+		}
+		tx.Order(clause.OrderByColumn{
+			Column: clause.Column{Table: "traces", Name: column},
+			Desc:   len(components) == 3 && strings.ToUpper(components[2]) == "DESC",
+		})
+	}
+	if len(req.OrderBy) == 0 {
+		tx.Order("traces.timestamp_ms DESC")
+	}
+	if !idOrder {
+		tx.Order("traces.request_id ASC")
+	}
+
+	var traces []models.Trace
+	if err := tx.Preload("Tags").Preload("RequestMetadata").Find(&traces).Error; err != nil {
+		return nil, 0, 0, api.NewInternalError("unable to search traces: %s", err)
+	}
+	return traces, limit, offset, nil
+}
+
+// DeleteTraces deletes models.Trace entities of the experiment.
+func (s Service) DeleteTraces(
+	ctx context.Context, namespace *models.Namespace, req *request.DeleteTracesRequest,
+) (int64, error) {
+	if err := ValidateDeleteTracesRequest(req); err != nil {
+		return 0, err
+	}
+
+	experimentID, err := strconv.ParseInt(req.ExperimentID, 10, 32)
+	if err != nil {
+		return 0, api.NewBadRequestError("unable to parse experiment id '%s': %s", req.ExperimentID, err)
+	}
+	experiment, err := s.experimentRepository.GetByNamespaceIDAndExperimentID(ctx, namespace.ID, int32(experimentID))
+	if err != nil {
+		return 0, api.NewResourceDoesNotExistError("unable to find experiment with id '%s': %s", req.ExperimentID, err)
+	}
+
+	traces, err := s.traceRepository.GetBatch(
+		ctx, *experiment.ID, req.MaxTimestampMillis, req.MaxTraces, req.RequestIDs,
+	)
+	if err != nil {
+		return 0, api.NewInternalError("unable to get traces to delete: %s", err)
+	}
+
+	// trace data is deleted first, so nothing is left behind in the artifact storage if it fails.
+	requestIDs := make([]string, len(traces))
+	for n, trace := range traces {
+		if err := s.deleteTraceData(ctx, &trace); err != nil {
+			return 0, err
+		}
+		requestIDs[n] = trace.ID
+	}
+
+	deleted, err := s.traceRepository.DeleteBatch(ctx, *experiment.ID, requestIDs)
+	if err != nil {
+		return 0, api.NewInternalError("unable to delete traces: %s", err)
+	}
+	return deleted, nil
+}
+
+// SetTraceTag sets a tag on the models.Trace entity.
+func (s Service) SetTraceTag(
+	ctx context.Context, namespace *models.Namespace, req *request.SetTraceTagRequest,
+) error {
+	if err := ValidateSetTraceTagRequest(req); err != nil {
+		return err
+	}
+
+	trace, err := s.getTrace(ctx, namespace, req.RequestID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.traceRepository.SetTag(ctx, &models.TraceTag{
+		Key:     req.Key,
+		Value:   req.Value,
+		TraceID: trace.ID,
+	}); err != nil {
+		return api.NewInternalError("unable to set tag '%s' for trace '%s': %s", req.Key, req.RequestID, err)
+	}
+	return nil
+}
+
+// DeleteTraceTag deletes a tag of the models.Trace entity.
+func (s Service) DeleteTraceTag(
+	ctx context.Context, namespace *models.Namespace, req *request.DeleteTraceTagRequest,
+) error {
+	if err := ValidateDeleteTraceTagRequest(req); err != nil {
+		return err
+	}
+
+	trace, err := s.getTrace(ctx, namespace, req.RequestID)
+	if err != nil {
+		return err
+	}
+	if _, ok := trace.GetTagValue(req.Key); !ok {
+		return api.NewResourceDoesNotExistError(
+			"no tag with name '%s' found for trace with request_id '%s'", req.Key, req.RequestID,
+		)
+	}
+
+	if err := s.traceRepository.DeleteTag(ctx, &models.TraceTag{
+		Key:     req.Key,
+		TraceID: trace.ID,
+	}); err != nil {
+		return api.NewInternalError("unable to delete tag '%s' of trace '%s': %s", req.Key, req.RequestID, err)
+	}
+	return nil
+}
+
+// getTrace returns models.Trace entity or api.ErrorResponse if the trace doesn't exist.
+func (s Service) getTrace(ctx context.Context, namespace *models.Namespace, requestID string) (*models.Trace, error) {
+	trace, err := s.traceRepository.GetByNamespaceIDAndRequestID(ctx, namespace.ID, requestID)
+	if err != nil {
+		return nil, api.NewInternalError("unable to get trace '%s': %s", requestID, err)
+	}
+	if trace == nil {
+		return nil, api.NewResourceDoesNotExistError("Trace with request_id '%s' not found", requestID)
+	}
+	return trace, nil
+}
+
+// storeTraceData writes all the spans of the trace into the artifact storage.
+func (s Service) storeTraceData(ctx context.Context, trace *models.Trace) error {
+	location, ok := trace.GetTagValue(models.TraceTagArtifactLocation)
+	if !ok {
+		return nil
+	}
+
+	spans, err := s.traceRepository.GetSpans(ctx, trace.ID)
+	if err != nil {
+		return api.NewInternalError("error getting spans of trace '%s': %s", trace.ID, err)
+	}
+	data, err := convertors.ConvertTraceSpansToTraceData(spans)
+	if err != nil {
+		return api.NewInternalError("error converting spans of trace '%s': %s", trace.ID, err)
+	}
+	content, err := json.Marshal(data)
+	if err != nil {
+		return api.NewInternalError("error encoding data of trace '%s': %s", trace.ID, err)
+	}
+
+	artifactStorage, err := s.artifactStorageFactory.GetStorage(ctx, location)
+	if err != nil {
+		return api.NewInternalError("unable to initialise artifact storage for trace '%s': %s", trace.ID, err)
+	}
+	if err := artifactStorage.Put(ctx, location, models.TraceDataFileName, bytes.NewReader(content)); err != nil {
+		return api.NewInternalError("unable to store data of trace '%s': %s", trace.ID, err)
+	}
+	return nil
+}
+
+// deleteTraceData deletes all the data of the trace stored in the artifact storage.
+func (s Service) deleteTraceData(ctx context.Context, trace *models.Trace) error {
+	location, ok := trace.GetTagValue(models.TraceTagArtifactLocation)
+	if !ok {
+		return nil
+	}
+
+	artifactStorage, err := s.artifactStorageFactory.GetStorage(ctx, location)
+	if err != nil {
+		return api.NewInternalError("unable to initialise artifact storage for trace '%s': %s", trace.ID, err)
+	}
+	if err := artifactStorage.Delete(ctx, location, ""); err != nil {
+		return api.NewInternalError("unable to delete data of trace '%s': %s", trace.ID, err)
+	}
+	return nil
+}
+
+// loadTraceData reads spans of the trace from the artifact storage. Trace data is optional,
+// so any error is treated as the absence of spans.
+func (s Service) loadTraceData(ctx context.Context, trace *models.Trace) []models.TraceSpan {
+	location, ok := trace.GetTagValue(models.TraceTagArtifactLocation)
+	if !ok {
+		return nil
+	}
+
+	artifactStorage, err := s.artifactStorageFactory.GetStorage(ctx, location)
+	if err != nil {
+		return nil
+	}
+	reader, err := artifactStorage.Get(ctx, location, models.TraceDataFileName)
+	if err != nil {
+		return nil
+	}
+	//nolint:errcheck
+	defer reader.Close()
+
+	var data request.TraceDataPartialRequest
+	if err := json.NewDecoder(reader).Decode(&data); err != nil {
+		return nil
+	}
+	spans, err := convertors.ConvertTraceSpansToDBModel(trace.ID, data.Spans)
+	if err != nil {
+		return nil
+	}
+	return spans
+}
+
+// decodePageToken decodes `page_token` into offset value.
+func decodePageToken(pageToken string) (int, error) {
+	if pageToken == "" {
+		return 0, nil
+	}
+	var token request.PageToken
+	if err := json.NewDecoder(
+		base64.NewDecoder(
+			base64.StdEncoding,
+			strings.NewReader(pageToken),
+		),
+	).Decode(&token); err != nil {
+		return 0, api.NewInvalidParameterValueError("invalid page_token '%s': %s", pageToken, err)
+	}
+	return int(token.Offset), nil
+}
@@ -0,0 +1,145 @@
+package trace
+
+import (
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+)
+
+const (
+	MaxTracesPerPage     = 500
+	DefaultTracesPerPage = 100
+)
+
+// ValidateStartTraceRequest validates `POST /mlflow/traces` request.
+func ValidateStartTraceRequest(req *request.StartTraceRequest) error {
+	if req.ExperimentID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'experiment_id'")
+	}
+	if req.TimestampMs <= 0 {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'timestamp_ms'")
+	}
+	for _, tag := range req.Tags {
+		if tag.Key == "" {
+			return api.NewInvalidParameterValueError("Missing value for required parameter 'tags.key'")
+		}
+	}
+	for _, metadata := range req.RequestMetadata {
+		if metadata.Key == "" {
+			return api.NewInvalidParameterValueError("Missing value for required parameter 'request_metadata.key'")
+		}
+	}
+	return nil
+}
+
+// ValidateEndTraceRequest validates `PATCH /mlflow/traces/{request_id}` request.
+func ValidateEndTraceRequest(req *request.EndTraceRequest) error {
+	if req.RequestID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'request_id'")
+	}
+	if req.TimestampMs <= 0 {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'timestamp_ms'")
+	}
+	switch models.TraceStatus(req.Status) {
+	case models.TraceStatusOK, models.TraceStatusError:
+	default:
+		return api.NewInvalidParameterValueError(
+			"Invalid value '%s' for parameter 'status'. Valid values are ['%s', '%s']",
+			req.Status, models.TraceStatusOK, models.TraceStatusError,
+		)
+	}
+	for _, tag := range req.Tags {
+		if tag.Key == "" {
+			return api.NewInvalidParameterValueError("Missing value for required parameter 'tags.key'")
+		}
+	}
+	for _, metadata := range req.RequestMetadata {
+		if metadata.Key == "" {
+			return api.NewInvalidParameterValueError("Missing value for required parameter 'request_metadata.key'")
+		}
+	}
+	for _, span := range req.Spans {
+		if span.Context.SpanID == "" {
+			return api.NewInvalidParameterValueError("Missing value for required parameter 'spans.context.span_id'")
+		}
+	}
+	return nil
+}
+
+// ValidateGetTraceInfoRequest validates `GET /mlflow/traces/{request_id}/info` request.
+func ValidateGetTraceInfoRequest(req *request.GetTraceInfoRequest) error {
+	if req.RequestID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'request_id'")
+	}
+	return nil
+}
+
+// ValidateGetTraceRequest validates `GET /mlflow/traces/{request_id}` request.
+func ValidateGetTraceRequest(req *request.GetTraceRequest) error {
+	if req.RequestID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'request_id'")
+	}
+	return nil
+}
+
+// ValidateSearchTracesRequest validates `GET /mlflow/traces` request.
+func ValidateSearchTracesRequest(req *request.SearchTracesRequest) error {
+	if len(req.ExperimentIDs) == 0 {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'experiment_ids'")
+	}
+	if req.MaxResults < 0 || req.MaxResults > MaxTracesPerPage {
+		return api.NewInvalidParameterValueError(
+			"Invalid value for parameter 'max_results' supplied. It must be at most %d", MaxTracesPerPage,
+		)
+	}
+	return nil
+}
+
+// ValidateDeleteTracesRequest validates `POST /mlflow/traces/delete-traces` request.
+func ValidateDeleteTracesRequest(req *request.DeleteTracesRequest) error {
+	if req.ExperimentID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'experiment_id'")
+	}
+	if (req.MaxTimestampMillis > 0) == (len(req.RequestIDs) > 0) {
+		return api.NewInvalidParameterValueError(
+			"Exactly one of 'max_timestamp_millis' and 'request_ids' must be specified",
+		)
+	}
+	if req.MaxTraces < 0 {
+		return api.NewInvalidParameterValueError("Invalid value for parameter 'max_traces' supplied")
+	}
+	if req.MaxTraces > 0 && len(req.RequestIDs) > 0 {
+		return api.NewInvalidParameterValueError(
+			"'max_traces' can't be specified if 'request_ids' is specified",
+		)
+	}
+	return nil
+}
+
+// ValidateSetTraceTagRequest validates `PATCH /mlflow/traces/{request_id}/tags` request.
+func ValidateSetTraceTagRequest(req *request.SetTraceTagRequest) error {
+	if req.RequestID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'request_id'")
+	}
+	if req.Key == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'key'")
+	}
+	if req.Key == models.TraceTagArtifactLocation {
+		return api.NewInvalidParameterValueError("Tag '%s' is managed by the server and can't be changed", req.Key)
+	}
+	return nil
+}
+
+// ValidateDeleteTraceTagRequest validates `DELETE /mlflow/traces/{request_id}/tags` request.
+func ValidateDeleteTraceTagRequest(req *request.DeleteTraceTagRequest) error {
+	if req.RequestID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'request_id'")
+	}
+	if req.Key == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'key'")
+	}
+	if req.Key == models.TraceTagArtifactLocation {
+		return api.NewInvalidParameterValueError("Tag '%s' is managed by the server and can't be changed", req.Key)
+	}
+	return nil
+}
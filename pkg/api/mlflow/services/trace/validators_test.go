@@ -0,0 +1,244 @@
+package trace
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+)
+
+func TestValidateStartTraceRequest_Ok(t *testing.T) {
+	err := ValidateStartTraceRequest(&request.StartTraceRequest{
+		ExperimentID: "1",
+		TimestampMs:  1234567890,
+	})
+	require.Nil(t, err)
+}
+
+func TestValidateStartTraceRequest_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.StartTraceRequest
+	}{
+		{
+			name:    "EmptyExperimentIDProperty",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'experiment_id'"),
+			request: &request.StartTraceRequest{},
+		},
+		{
+			name:  "EmptyTimestampMsProperty",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'timestamp_ms'"),
+			request: &request.StartTraceRequest{
+				ExperimentID: "1",
+			},
+		},
+		{
+			name:  "EmptyTagKeyProperty",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'tags.key'"),
+			request: &request.StartTraceRequest{
+				ExperimentID: "1",
+				TimestampMs:  1234567890,
+				Tags:         []request.TraceTagPartialRequest{{Value: "value"}},
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateStartTraceRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
+func TestValidateEndTraceRequest_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.EndTraceRequest
+	}{
+		{
+			name:    "EmptyRequestIDProperty",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'request_id'"),
+			request: &request.EndTraceRequest{},
+		},
+		{
+			name: "InvalidStatusProperty",
+			error: api.NewInvalidParameterValueError(
+				"Invalid value 'IN_PROGRESS' for parameter 'status'. Valid values are ['OK', 'ERROR']",
+			),
+			request: &request.EndTraceRequest{
+				RequestID:   "tr-id",
+				TimestampMs: 1234567890,
+				Status:      "IN_PROGRESS",
+			},
+		},
+		{
+			name:  "EmptySpanIDProperty",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'spans.context.span_id'"),
+			request: &request.EndTraceRequest{
+				RequestID:   "tr-id",
+				TimestampMs: 1234567890,
+				Status:      "OK",
+				Spans:       []request.TraceSpanPartialRequest{{Name: "span"}},
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateEndTraceRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
+func TestValidateDeleteTracesRequest_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.DeleteTracesRequest
+	}{
+		{
+			name:    "EmptyExperimentIDProperty",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'experiment_id'"),
+			request: &request.DeleteTracesRequest{},
+		},
+		{
+			name: "NeitherTimestampNorRequestIDs",
+			error: api.NewInvalidParameterValueError(
+				"Exactly one of 'max_timestamp_millis' and 'request_ids' must be specified",
+			),
+			request: &request.DeleteTracesRequest{
+				ExperimentID: "1",
+			},
+		},
+		{
+			name: "BothTimestampAndRequestIDs",
+			error: api.NewInvalidParameterValueError(
+				"Exactly one of 'max_timestamp_millis' and 'request_ids' must be specified",
+			),
+			request: &request.DeleteTracesRequest{
+				ExperimentID:       "1",
+				MaxTimestampMillis: 1234567890,
+				RequestIDs:         []string{"tr-id"},
+			},
+		},
+		{
+			name: "MaxTracesWithRequestIDs",
+			error: api.NewInvalidParameterValueError(
+				"'max_traces' can't be specified if 'request_ids' is specified",
+			),
+			request: &request.DeleteTracesRequest{
+				ExperimentID: "1",
+				MaxTraces:    1,
+				RequestIDs:   []string{"tr-id"},
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateDeleteTracesRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
+func TestValidateSearchTracesRequest_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.SearchTracesRequest
+	}{
+		{
+			name:    "EmptyExperimentIDsProperty",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'experiment_ids'"),
+			request: &request.SearchTracesRequest{},
+		},
+		{
+			name: "InvalidMaxResultsProperty",
+			error: api.NewInvalidParameterValueError(
+				"Invalid value for parameter 'max_results' supplied. It must be at most 500",
+			),
+			request: &request.SearchTracesRequest{
+				ExperimentIDs: []string{"1"},
+				MaxResults:    MaxTracesPerPage + 1,
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSearchTracesRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
+func TestValidateSetTraceTagRequest_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.SetTraceTagRequest
+	}{
+		{
+			name:    "EmptyKeyProperty",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'key'"),
+			request: &request.SetTraceTagRequest{RequestID: "tr-id"},
+		},
+		{
+			name: "ArtifactLocationKeyProperty",
+			error: api.NewInvalidParameterValueError(
+				"Tag 'mlflow.artifactLocation' is managed by the server and can't be changed",
+			),
+			request: &request.SetTraceTagRequest{
+				RequestID: "tr-id",
+				Key:       "mlflow.artifactLocation",
+				Value:     "file:///tmp",
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSetTraceTagRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
+func TestValidateDeleteTraceTagRequest_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.DeleteTraceTagRequest
+	}{
+		{
+			name:    "EmptyKeyProperty",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'key'"),
+			request: &request.DeleteTraceTagRequest{RequestID: "tr-id"},
+		},
+		{
+			name: "ArtifactLocationKeyProperty",
+			error: api.NewInvalidParameterValueError(
+				"Tag 'mlflow.artifactLocation' is managed by the server and can't be changed",
+			),
+			request: &request.DeleteTraceTagRequest{
+				RequestID: "tr-id",
+				Key:       "mlflow.artifactLocation",
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateDeleteTraceTagRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
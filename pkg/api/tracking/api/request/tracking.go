@@ -0,0 +1,17 @@
+package request
+
+// GetResourceRequest is a request object for `POST /tracking/:client_uri/get-resource/` endpoint.
+type GetResourceRequest struct {
+	ResourceType string `json:"resource_type"`
+	Args         string `json:"args"`
+}
+
+// InstructionRequest is a request object for `POST /tracking/:client_uri/read-instruction/` endpoint.
+type InstructionRequest struct {
+	ResourceHandler string `json:"resource_handler"`
+	MethodName      string `json:"method_name"`
+	Args            string `json:"args"`
+}
+
+// WriteInstructionsRequest is a request object for `POST /tracking/:client_uri/write-instruction/` endpoint.
+type WriteInstructionsRequest []InstructionRequest
@@ -0,0 +1,37 @@
+package response
+
+import (
+	"bytes"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rotisserie/eris"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/encoding"
+)
+
+// GetVersionResponse represents the response json for `GET /client/get-version/` endpoint.
+type GetVersionResponse struct {
+	Version string `json:"version"`
+}
+
+// GetResourceResponse represents the response json for `POST /tracking/:client_uri/get-resource/` endpoint.
+type GetResourceResponse struct {
+	Handler string `json:"handler"`
+}
+
+// NewGetResourceResponse creates new response object for `POST /tracking/:client_uri/get-resource/` endpoint.
+func NewGetResourceResponse(handler string) *GetResourceResponse {
+	return &GetResourceResponse{
+		Handler: handler,
+	}
+}
+
+// NewReadInstructionResponse sends the Aim encoded result of `POST /tracking/:client_uri/read-instruction/`.
+func NewReadInstructionResponse(ctx *fiber.Ctx, result any) error {
+	buf := new(bytes.Buffer)
+	if err := encoding.Encode(buf, result); err != nil {
+		return eris.Wrap(err, "error encoding instruction result")
+	}
+	ctx.Set("Content-Type", "application/octet-stream")
+	return ctx.Send(buf.Bytes())
+}
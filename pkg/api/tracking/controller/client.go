@@ -0,0 +1,77 @@
+package controller
+
+import (
+	"github.com/gofiber/fiber/v2"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/fasttrackml/pkg/api/tracking/api/response"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/middleware"
+)
+
+// GetVersion handles `GET /client/get-version/` endpoint.
+func (c Controller) GetVersion(ctx *fiber.Ctx) error {
+	return ctx.JSON(response.GetVersionResponse{
+		Version: c.trackingService.GetVersion(),
+	})
+}
+
+// Connect handles `GET /client/connect/:client_uri/` endpoint.
+func (c Controller) Connect(ctx *fiber.Ctx) error {
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("connect namespace: %s", ns.Code)
+
+	if err := c.trackingService.Connect(ctx.Context(), ns, ctx.Params("client_uri")); err != nil {
+		return err
+	}
+
+	return ctx.JSON(fiber.Map{})
+}
+
+// Reconnect handles `GET /client/reconnect/:client_uri/` endpoint.
+func (c Controller) Reconnect(ctx *fiber.Ctx) error {
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("reconnect namespace: %s", ns.Code)
+
+	if err := c.trackingService.Reconnect(ctx.Context(), ns, ctx.Params("client_uri")); err != nil {
+		return err
+	}
+
+	return ctx.JSON(fiber.Map{})
+}
+
+// Disconnect handles `GET /client/disconnect/:client_uri/` endpoint.
+func (c Controller) Disconnect(ctx *fiber.Ctx) error {
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("disconnect namespace: %s", ns.Code)
+
+	if err := c.trackingService.Disconnect(ctx.Context(), ns, ctx.Params("client_uri")); err != nil {
+		return err
+	}
+
+	return ctx.JSON(fiber.Map{})
+}
+
+// Heartbeat handles `GET /client/heartbeat/:client_uri/` endpoint.
+func (c Controller) Heartbeat(ctx *fiber.Ctx) error {
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("heartbeat namespace: %s", ns.Code)
+
+	if err := c.trackingService.Heartbeat(ctx.Context(), ns, ctx.Params("client_uri")); err != nil {
+		return err
+	}
+
+	return ctx.JSON(fiber.Map{})
+}
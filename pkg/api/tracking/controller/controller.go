@@ -0,0 +1,17 @@
+package controller
+
+import (
+	"github.com/G-Research/fasttrackml/pkg/api/tracking/services/tracking"
+)
+
+// Controller handles all the input HTTP requests.
+type Controller struct {
+	trackingService *tracking.Service
+}
+
+// NewController creates new Controller instance.
+func NewController(trackingService *tracking.Service) *Controller {
+	return &Controller{
+		trackingService: trackingService,
+	}
+}
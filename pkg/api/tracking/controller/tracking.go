@@ -0,0 +1,90 @@
+package controller
+
+import (
+	"github.com/gofiber/fiber/v2"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/fasttrackml/pkg/api/tracking/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/tracking/api/response"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/middleware"
+)
+
+// GetResource handles `POST /tracking/:client_uri/get-resource/` endpoint.
+func (c Controller) GetResource(ctx *fiber.Ctx) error {
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("getResource namespace: %s", ns.Code)
+
+	req := request.GetResourceRequest{}
+	if err := ctx.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	handler, err := c.trackingService.GetResource(ctx.Context(), ns, ctx.Params("client_uri"), &req)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(response.NewGetResourceResponse(handler))
+}
+
+// ReleaseResource handles `GET /tracking/:client_uri/release-resource/:handler/` endpoint.
+func (c Controller) ReleaseResource(ctx *fiber.Ctx) error {
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("releaseResource namespace: %s", ns.Code)
+
+	if err := c.trackingService.ReleaseResource(
+		ctx.Context(), ns, ctx.Params("client_uri"), ctx.Params("handler"),
+	); err != nil {
+		return err
+	}
+
+	return ctx.JSON(fiber.Map{})
+}
+
+// ReadInstruction handles `POST /tracking/:client_uri/read-instruction/` endpoint.
+func (c Controller) ReadInstruction(ctx *fiber.Ctx) error {
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("readInstruction namespace: %s", ns.Code)
+
+	req := request.InstructionRequest{}
+	if err := ctx.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	result, err := c.trackingService.RunReadInstruction(ctx.Context(), ns, ctx.Params("client_uri"), &req)
+	if err != nil {
+		return err
+	}
+
+	return response.NewReadInstructionResponse(ctx, result)
+}
+
+// WriteInstructions handles `POST /tracking/:client_uri/write-instruction/` endpoint.
+func (c Controller) WriteInstructions(ctx *fiber.Ctx) error {
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("writeInstructions namespace: %s", ns.Code)
+
+	req := request.WriteInstructionsRequest{}
+	if err := ctx.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	if err := c.trackingService.RunWriteInstructions(ctx.Context(), ns, ctx.Params("client_uri"), req); err != nil {
+		return err
+	}
+
+	return ctx.JSON(fiber.Map{})
+}
@@ -0,0 +1,56 @@
+package tracking
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/G-Research/fasttrackml/pkg/api/tracking/controller"
+)
+
+// List of route prefixes.
+const (
+	ClientRoutePrefix   = "/client"
+	TrackingRoutePrefix = "/tracking"
+)
+
+// Router represents Aim remote `tracking` router.
+type Router struct {
+	controller        *controller.Controller
+	globalMiddlewares []fiber.Handler
+}
+
+// NewRouter creates a new instance of Aim remote `tracking` router.
+func NewRouter(controller *controller.Controller) *Router {
+	return &Router{
+		controller:        controller,
+		globalMiddlewares: make([]fiber.Handler, 0),
+	}
+}
+
+// Init initialise routes.
+// Routes follow the Aim remote tracking server, so `aim.Run(repo='aim://host:port')` could write data directly.
+func (r *Router) Init(server fiber.Router) {
+	clientGroup := server.Group(ClientRoutePrefix)
+	trackingGroup := server.Group(TrackingRoutePrefix)
+	// apply global middlewares.
+	for _, globalMiddleware := range r.globalMiddlewares {
+		clientGroup.Use(globalMiddleware)
+		trackingGroup.Use(globalMiddleware)
+	}
+
+	clientGroup.Get("/get-version/", r.controller.GetVersion)
+	clientGroup.Get("/connect/:client_uri/", r.controller.Connect)
+	clientGroup.Get("/reconnect/:client_uri/", r.controller.Reconnect)
+	clientGroup.Get("/disconnect/:client_uri/", r.controller.Disconnect)
+	clientGroup.Get("/heartbeat/:client_uri/", r.controller.Heartbeat)
+
+	trackingGroup.Post("/:client_uri/get-resource/", r.controller.GetResource)
+	trackingGroup.Get("/:client_uri/release-resource/:handler/", r.controller.ReleaseResource)
+	trackingGroup.Post("/:client_uri/read-instruction/", r.controller.ReadInstruction)
+	trackingGroup.Post("/:client_uri/write-instruction/", r.controller.WriteInstructions)
+}
+
+// AddGlobalMiddleware adds a global middleware which will be applied for each route.
+func (r *Router) AddGlobalMiddleware(middleware fiber.Handler) *Router {
+	r.globalMiddlewares = append(r.globalMiddlewares, middleware)
+	return r
+}
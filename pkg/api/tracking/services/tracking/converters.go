@@ -0,0 +1,222 @@
+package tracking
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rotisserie/eris"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/encoding"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/convertors"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+)
+
+// unsafePathCharacters matches characters, which are not allowed in artifact paths built from tracked names.
+var unsafePathCharacters = regexp.MustCompile(`[^A-Za-z0-9_\-]`)
+
+// ConvertPath converts Aim tree path argument, which could be a single key or a tuple of keys, into []string.
+func ConvertPath(arg any) []string {
+	switch arg := arg.(type) {
+	case nil:
+		return []string{}
+	case []any:
+		path := make([]string, len(arg))
+		for i, key := range arg {
+			path[i] = fmt.Sprintf("%v", key)
+		}
+		return path
+	default:
+		return []string{fmt.Sprintf("%v", arg)}
+	}
+}
+
+// ConvertParams converts Aim run attributes into the list of models.Param, one param per top level attribute.
+// Nested values (dicts and lists) are stored structurally as JSON, so they could be queried by dotted path.
+func ConvertParams(runID string, values map[string]any) ([]models.Param, error) {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	params := make([]models.Param, 0, len(keys))
+	for _, key := range keys {
+		param := models.Param{
+			Key:   key,
+			RunID: runID,
+		}
+		switch value := values[key].(type) {
+		case nil:
+			continue
+		case int64:
+			param.ValueInt = &value
+		case float64:
+			param.ValueFloat = &value
+		case string:
+			param.ValueStr = &value
+		case bool:
+			param.ValueBool = &value
+		default:
+			data, err := json.Marshal(value)
+			if err != nil {
+				return nil, eris.Wrapf(err, "error marshaling value of param '%s'", key)
+			}
+			param.ValueJSON = data
+		}
+		params = append(params, param)
+	}
+	return params, nil
+}
+
+// ConvertMetricValue converts tracked Aim value into the metric value.
+// The last returned value is false when tracked value is not a number.
+func ConvertMetricValue(value any) (float64, bool, bool) {
+	var v float64
+	switch value := value.(type) {
+	case int64:
+		v = float64(value)
+	case float64:
+		v = value
+	default:
+		return 0, false, false
+	}
+	switch {
+	case math.IsNaN(v):
+		return 0, true, true
+	case math.IsInf(v, 1):
+		return math.MaxFloat64, false, true
+	case math.IsInf(v, -1):
+		return -math.MaxFloat64, false, true
+	}
+	return v, false, true
+}
+
+// ConvertContext converts Aim context dictionary into models.Context.
+func ConvertContext(context map[string]any) (models.Context, error) {
+	if len(context) == 0 {
+		return models.DefaultContext, nil
+	}
+	data, err := json.Marshal(context)
+	if err != nil {
+		return models.Context{}, eris.Wrap(err, "error marshaling context")
+	}
+	return models.Context{Json: data}, nil
+}
+
+// Image represents Aim image object tracked by the client.
+type Image struct {
+	Data    []byte
+	Caption string
+	Format  string
+	Width   int64
+	Height  int64
+}
+
+// ConvertImages extracts the list of images from tracked Aim value.
+// The value could be either a single image or a list of images.
+func ConvertImages(value any) ([]Image, bool) {
+	switch value := value.(type) {
+	case map[string]any:
+		if img, ok := convertImage(value); ok {
+			return []Image{img}, true
+		}
+	case []any:
+		images := make([]Image, 0, len(value))
+		for _, item := range value {
+			object, ok := item.(map[string]any)
+			if !ok {
+				return nil, false
+			}
+			img, ok := convertImage(object)
+			if !ok {
+				return nil, false
+			}
+			images = append(images, img)
+		}
+		return images, len(images) > 0
+	}
+	return nil, false
+}
+
+// convertImage converts Aim `aim.image` custom object into image.
+func convertImage(object map[string]any) (Image, bool) {
+	objectType, _ := object[encoding.CustomObjectTypeKey].(string)
+	data, ok := object["data"].([]byte)
+	if !ok {
+		data, ok = object["source"].([]byte)
+	}
+	if !ok || (objectType != "" && !strings.Contains(strings.ToLower(objectType), "image")) {
+		return Image{}, false
+	}
+	img := Image{
+		Data:   data,
+		Format: "png",
+	}
+	if caption, ok := object["caption"].(string); ok {
+		img.Caption = caption
+	}
+	if format, ok := object["format"].(string); ok && format != "" {
+		img.Format = strings.ToLower(format)
+	}
+	if width, ok := object["width"].(int64); ok {
+		img.Width = width
+	}
+	if height, ok := object["height"].(int64); ok {
+		img.Height = height
+	}
+	return img, true
+}
+
+// ConvertImagePath returns path of the image blob relative to the run artifact root.
+func ConvertImagePath(name string, step, index int64, format string) string {
+	return fmt.Sprintf(
+		"aim/images/%s/%d-%d.%s",
+		unsafePathCharacters.ReplaceAllString(name, "_"),
+		step,
+		index,
+		unsafePathCharacters.ReplaceAllString(format, "_"),
+	)
+}
+
+// ConvertRunToDBModel creates models.Run for Aim run with provided hash.
+func ConvertRunToDBModel(experiment *models.Experiment, runID string) (*models.Run, error) {
+	artifactURI, err := url.JoinPath(experiment.ArtifactLocation, runID, "artifacts")
+	if err != nil {
+		return nil, eris.Wrap(err, "error constructing artifact_uri")
+	}
+	name, err := convertors.GenerateRandomName()
+	if err != nil {
+		return nil, eris.Wrap(err, "error generating run name")
+	}
+	return &models.Run{
+		ID:     runID,
+		Name:   name,
+		Status: models.StatusRunning,
+		StartTime: sql.NullInt64{
+			Int64: time.Now().UTC().UnixMilli(),
+			Valid: true,
+		},
+		SourceType:     "UNKNOWN",
+		ArtifactURI:    artifactURI,
+		ExperimentID:   *experiment.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	}, nil
+}
+
+// ConvertTimestamp converts Aim timestamp in seconds into milliseconds.
+func ConvertTimestamp(value any) (int64, bool) {
+	switch value := value.(type) {
+	case int64:
+		return value * 1000, true
+	case float64:
+		return int64(value * 1000), true
+	}
+	return 0, false
+}
@@ -0,0 +1,94 @@
+package tracking
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/types"
+)
+
+func TestConvertParams_Ok(t *testing.T) {
+	params, err := ConvertParams("id", map[string]any{
+		"hparams": map[string]any{
+			"lr":     0.01,
+			"model":  map[string]any{"name": "resnet"},
+			"layers": []any{int64(1), int64(2)},
+		},
+		"epochs":  int64(10),
+		"lr":      0.01,
+		"name":    "resnet",
+		"shuffle": true,
+		"unset":   nil,
+	})
+	require.Nil(t, err)
+	assert.Equal(t, []models.Param{
+		{Key: "epochs", RunID: "id", ValueInt: common.GetPointer(int64(10))},
+		{
+			Key:       "hparams",
+			RunID:     "id",
+			ValueJSON: types.JSONB(`{"layers":[1,2],"lr":0.01,"model":{"name":"resnet"}}`),
+		},
+		{Key: "lr", RunID: "id", ValueFloat: common.GetPointer(0.01)},
+		{Key: "name", RunID: "id", ValueStr: common.GetPointer("resnet")},
+		{Key: "shuffle", RunID: "id", ValueBool: common.GetPointer(true)},
+	}, params)
+}
+
+func TestConvertMetricValue_Ok(t *testing.T) {
+	testData := []struct {
+		name   string
+		value  any
+		result float64
+		isNaN  bool
+		ok     bool
+	}{
+		{name: "Int", value: int64(2), result: 2, ok: true},
+		{name: "Float", value: 0.5, result: 0.5, ok: true},
+		{name: "NaN", value: math.NaN(), result: 0, isNaN: true, ok: true},
+		{name: "PositiveInfinity", value: math.Inf(1), result: math.MaxFloat64, ok: true},
+		{name: "NegativeInfinity", value: math.Inf(-1), result: -math.MaxFloat64, ok: true},
+		{name: "NotANumber", value: "value"},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			result, isNaN, ok := ConvertMetricValue(tt.value)
+			assert.Equal(t, tt.result, result)
+			assert.Equal(t, tt.isNaN, isNaN)
+			assert.Equal(t, tt.ok, ok)
+		})
+	}
+}
+
+func TestConvertImages_Ok(t *testing.T) {
+	images, ok := ConvertImages([]any{
+		map[string]any{
+			"$type":   "aim.image",
+			"data":    []byte("image"),
+			"caption": "caption",
+			"format":  "JPEG",
+			"width":   int64(4),
+			"height":  int64(2),
+		},
+		map[string]any{
+			"source": []byte("other"),
+		},
+	})
+	require.True(t, ok)
+	assert.Equal(t, []Image{
+		{Data: []byte("image"), Caption: "caption", Format: "jpeg", Width: 4, Height: 2},
+		{Data: []byte("other"), Format: "png"},
+	}, images)
+
+	_, ok = ConvertImages(map[string]any{"$type": "aim.audio", "data": []byte("audio")})
+	assert.False(t, ok)
+}
+
+func TestConvertImagePath_Ok(t *testing.T) {
+	assert.Equal(t, "aim/images/___images/1-0.png", ConvertImagePath("../images", 1, 0, "png"))
+}
@@ -0,0 +1,228 @@
+package tracking
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// List of Aim run tree names.
+const (
+	treeNameMeta  = "meta"
+	treeNameSeqs  = "seqs"
+	treeKeyChunks = "chunks"
+	treeKeyAttrs  = "attrs"
+	treeKeyCtxs   = "contexts"
+)
+
+// List of Aim `StructuredRun` properties which are mapped onto the run.
+const (
+	runPropName        = "name"
+	runPropExperiment  = "experiment"
+	runPropDescription = "description"
+	runPropArchived    = "archived"
+	runPropEndTime     = "end_time"
+)
+
+// List of Aim sequence value kinds.
+const (
+	sequenceKindValue = "val"
+	sequenceKindTime  = "time"
+)
+
+// sequencePoint represents a single tracked value of Aim sequence.
+type sequencePoint struct {
+	Name       string
+	ContextIdx string
+	Step       int64
+	Value      any
+	HasValue   bool
+	Time       any
+}
+
+// writeBatch accumulates the data of a single write request, so it could be stored with a few queries.
+type writeBatch struct {
+	runIDs []string
+	attrs  map[string]map[string]any
+	props  map[string]map[string]any
+	points map[string]map[string]*sequencePoint
+}
+
+// newWriteBatch creates new empty writeBatch.
+func newWriteBatch() *writeBatch {
+	return &writeBatch{
+		attrs:  map[string]map[string]any{},
+		props:  map[string]map[string]any{},
+		points: map[string]map[string]*sequencePoint{},
+	}
+}
+
+// touch remembers the run, so it is created or loaded when the batch is stored.
+func (b *writeBatch) touch(runID string) {
+	if _, ok := b.attrs[runID]; ok {
+		return
+	}
+	b.runIDs = append(b.runIDs, runID)
+	b.attrs[runID] = map[string]any{}
+	b.props[runID] = map[string]any{}
+	b.points[runID] = map[string]*sequencePoint{}
+}
+
+// setProp remembers new value of run property, e.g. `name` or `experiment`.
+func (b *writeBatch) setProp(runID, name string, value any) {
+	b.touch(runID)
+	b.props[runID][name] = value
+}
+
+// setAttr remembers new value of run attribute under provided path.
+func (b *writeBatch) setAttr(runID string, path []string, value any) {
+	b.touch(runID)
+	setTreeValue(b.attrs[runID], path, value)
+}
+
+// addSequenceValue remembers the tracked value of Aim sequence.
+func (b *writeBatch) addSequenceValue(runID, contextIdx, name, kind string, step int64, value any) {
+	b.touch(runID)
+	key := fmt.Sprintf("%s/%s/%d", contextIdx, name, step)
+	point, ok := b.points[runID][key]
+	if !ok {
+		point = &sequencePoint{
+			Name:       name,
+			ContextIdx: contextIdx,
+			Step:       step,
+		}
+		b.points[runID][key] = point
+	}
+	switch kind {
+	case sequenceKindValue:
+		point.Value, point.HasValue = value, true
+	case sequenceKindTime:
+		point.Time = value
+	}
+}
+
+// applyWriteInstruction applies a single write instruction to the shadow trees and maps it onto the batch.
+func (c *client) applyWriteInstruction(batch *writeBatch, r *resource, method string, args any) {
+	tree := c.tree(r)
+	switch r.Type {
+	case ResourceTypeTreeView:
+		path := ConvertPath(getArg(args, "path", 0))
+		switch method {
+		case "__setitem__", "set", "merge":
+			value := getArg(args, "value", 1)
+			if values, ok := value.(map[string]any); ok && method == "merge" {
+				for key, value := range values {
+					setTreeValue(tree, append(path[:len(path):len(path)], key), value)
+				}
+			} else {
+				setTreeValue(tree, path, value)
+			}
+			c.applyTreeWrite(batch, r, path, value)
+		case "__delitem__":
+			deleteTreeValue(tree, path)
+		}
+	case ResourceTypeStructuredRun:
+		attr, value := method, getArg(args, "value", 0)
+		if method == "__setattr__" {
+			attr, value = fmt.Sprintf("%v", getArg(args, "name", 0)), getArg(args, "value", 1)
+		}
+		if r.RunID == "" {
+			return
+		}
+		switch attr {
+		case runPropName, runPropExperiment, runPropDescription, runPropArchived, runPropEndTime:
+			tree[attr] = value
+			batch.setProp(r.RunID, attr, value)
+		}
+	}
+}
+
+// applyTreeWrite maps the value written into Aim run tree onto the batch.
+// Paths, which have no FastTrackML representation, are silently skipped.
+func (c *client) applyTreeWrite(batch *writeBatch, r *resource, path []string, value any) {
+	runID, suffix, chunk := r.RunID, path, false
+	for i := 0; i+1 < len(path); i++ {
+		if path[i] == treeKeyChunks {
+			runID, suffix, chunk = path[i+1], path[i+2:], true
+			break
+		}
+	}
+	if !chunk && len(suffix) > 0 && suffix[0] == r.Name {
+		suffix = suffix[1:]
+	}
+
+	// contexts are written both into the repository and the run trees, so they are collected separately.
+	if len(suffix) > 0 && suffix[0] == treeKeyCtxs {
+		c.applyContextsWrite(suffix[1:], value)
+		return
+	}
+	if runID == "" {
+		return
+	}
+
+	switch r.Name {
+	case treeNameMeta:
+		c.applyMetaWrite(batch, runID, suffix, value)
+	case treeNameSeqs:
+		c.applySequenceWrite(batch, runID, suffix, value)
+	}
+}
+
+// applyContextsWrite remembers the written contexts, so sequence values could be resolved to them.
+func (c *client) applyContextsWrite(path []string, value any) {
+	values, ok := value.(map[string]any)
+	if !ok {
+		return
+	}
+	if len(path) > 0 {
+		c.contexts[path[0]] = values
+		return
+	}
+	for idx, context := range values {
+		if context, ok := context.(map[string]any); ok {
+			c.contexts[idx] = context
+		}
+	}
+}
+
+// applyMetaWrite handles writes into the run meta tree, e.g. `attrs` and `end_time`.
+func (c *client) applyMetaWrite(batch *writeBatch, runID string, path []string, value any) {
+	if len(path) == 0 {
+		if values, ok := value.(map[string]any); ok {
+			for key, value := range values {
+				c.applyMetaWrite(batch, runID, []string{key}, value)
+			}
+		}
+		return
+	}
+	switch path[0] {
+	case treeKeyAttrs:
+		batch.setAttr(runID, path[1:], value)
+	case runPropEndTime:
+		batch.setProp(runID, runPropEndTime, value)
+	default:
+		batch.touch(runID)
+	}
+}
+
+// applySequenceWrite handles writes into the run sequence tree.
+// Sequence values are stored under `(context_idx, name, kind, step)` path.
+func (c *client) applySequenceWrite(batch *writeBatch, runID string, path []string, value any) {
+	if len(path) < 4 {
+		switch values := value.(type) {
+		case map[string]any:
+			for key, value := range values {
+				c.applySequenceWrite(batch, runID, append(path[:len(path):len(path)], key), value)
+			}
+		case []any:
+			for i, value := range values {
+				c.applySequenceWrite(batch, runID, append(path[:len(path):len(path)], strconv.Itoa(i)), value)
+			}
+		}
+		return
+	}
+	step, err := strconv.ParseInt(path[3], 10, 64)
+	if err != nil {
+		return
+	}
+	batch.addSequenceValue(runID, path[0], path[1], path[2], step, value)
+}
@@ -0,0 +1,554 @@
+package tracking
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/encoding"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/api/tracking/api/request"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/config"
+	"github.com/G-Research/fasttrackml/pkg/common/services/artifact/storage"
+	"github.com/G-Research/fasttrackml/pkg/common/services/live"
+)
+
+// AimVersion is the version of Aim SDK, which remote tracking protocol is served.
+const AimVersion = "3.17.5"
+
+// Service provides service layer to work with Aim remote tracking protocol.
+type Service struct {
+	config                 *config.Config
+	runRepository          repositories.RunRepositoryProvider
+	paramRepository        repositories.ParamRepositoryProvider
+	metricRepository       repositories.MetricRepositoryProvider
+	artifactRepository     repositories.ArtifactRepositoryProvider
+	experimentRepository   repositories.ExperimentRepositoryProvider
+	artifactStorageFactory storage.ArtifactStorageFactoryProvider
+	livePublisher          live.PublisherProvider
+	clients                *sync.Map
+}
+
+// NewService creates new Service instance.
+func NewService(
+	config *config.Config,
+	runRepository repositories.RunRepositoryProvider,
+	paramRepository repositories.ParamRepositoryProvider,
+	metricRepository repositories.MetricRepositoryProvider,
+	artifactRepository repositories.ArtifactRepositoryProvider,
+	experimentRepository repositories.ExperimentRepositoryProvider,
+	artifactStorageFactory storage.ArtifactStorageFactoryProvider,
+	livePublisher live.PublisherProvider,
+) *Service {
+	return &Service{
+		config:                 config,
+		runRepository:          runRepository,
+		paramRepository:        paramRepository,
+		metricRepository:       metricRepository,
+		artifactRepository:     artifactRepository,
+		experimentRepository:   experimentRepository,
+		artifactStorageFactory: artifactStorageFactory,
+		livePublisher:          livePublisher,
+		clients:                &sync.Map{},
+	}
+}
+
+// GetVersion returns the version of Aim SDK, which remote tracking protocol is served.
+func (s Service) GetVersion() string {
+	return AimVersion
+}
+
+// Connect registers a new Aim SDK client in the provided namespace.
+// Client URI, which is already connected and hasn't expired yet, is rejected.
+func (s Service) Connect(ctx context.Context, namespace *models.Namespace, clientURI string) error {
+	if err := ValidateClientURI(clientURI); err != nil {
+		return err
+	}
+
+	// drop clients, which went away without disconnecting.
+	s.clients.Range(func(key, value any) bool {
+		c := value.(*client)
+		c.Lock()
+		defer c.Unlock()
+		if time.Since(c.lastSeen) > clientTTL {
+			s.clients.Delete(key)
+		}
+		return true
+	})
+
+	// client URI could reference the request buffer, so it has to be copied before being kept.
+	// session of another client must never be replaced, so the same client URI could be connected only once.
+	if _, loaded := s.clients.LoadOrStore(strings.Clone(clientURI), newClient(namespace.ID)); loaded {
+		return api.NewResourceAlreadyExistsError("client '%s' is already connected", clientURI)
+	}
+	return nil
+}
+
+// Reconnect restores the session of the Aim SDK client, registering it again if it has expired.
+func (s Service) Reconnect(ctx context.Context, namespace *models.Namespace, clientURI string) error {
+	if _, err := s.getClient(namespace, clientURI); err == nil {
+		return nil
+	}
+	return s.Connect(ctx, namespace, clientURI)
+}
+
+// Disconnect releases the Aim SDK client with all its resources.
+func (s Service) Disconnect(ctx context.Context, namespace *models.Namespace, clientURI string) error {
+	if _, err := s.getClient(namespace, clientURI); err != nil {
+		return err
+	}
+	s.clients.Delete(clientURI)
+	return nil
+}
+
+// Heartbeat keeps the Aim SDK client session alive.
+func (s Service) Heartbeat(ctx context.Context, namespace *models.Namespace, clientURI string) error {
+	_, err := s.getClient(namespace, clientURI)
+	return err
+}
+
+// GetResource registers Aim storage resource requested by the client and returns its handler.
+func (s Service) GetResource(
+	ctx context.Context, namespace *models.Namespace, clientURI string, req *request.GetResourceRequest,
+) (string, error) {
+	if err := ValidateGetResourceRequest(req); err != nil {
+		return "", err
+	}
+
+	c, err := s.getClient(namespace, clientURI)
+	if err != nil {
+		return "", err
+	}
+
+	args, err := decodeArgs(req.Args)
+	if err != nil {
+		return "", err
+	}
+
+	r := &resource{
+		Type: req.ResourceType,
+	}
+	readOnly, _ := getArg(args, "read_only", -1).(bool)
+	switch req.ResourceType {
+	case ResourceTypeTreeView:
+		r.Name, _ = getArg(args, "name", 0).(string)
+		r.RunID, _ = getArg(args, "sub", 1).(string)
+	case ResourceTypeStructuredRun:
+		r.RunID, _ = getArg(args, "hash", 0).(string)
+	}
+
+	// writable run resources are requested right after `aim.Run` creation, so the run becomes visible at once.
+	if r.RunID != "" && !readOnly && (r.Type == ResourceTypeStructuredRun || r.Name == treeNameMeta) {
+		if _, err := s.getOrCreateRun(ctx, namespace, r.RunID); err != nil {
+			return "", err
+		}
+	}
+
+	c.Lock()
+	defer c.Unlock()
+	return c.addResource(r), nil
+}
+
+// ReleaseResource releases Aim storage resource previously requested by the client.
+func (s Service) ReleaseResource(
+	ctx context.Context, namespace *models.Namespace, clientURI, handler string,
+) error {
+	c, err := s.getClient(namespace, clientURI)
+	if err != nil {
+		return err
+	}
+
+	c.Lock()
+	defer c.Unlock()
+	if _, ok := c.resources[handler]; !ok {
+		return api.NewResourceDoesNotExistError("resource '%s' not found", handler)
+	}
+	delete(c.resources, handler)
+	return nil
+}
+
+// RunReadInstruction runs read instruction on Aim storage resource.
+// Values are read back from the data, written by the same client.
+func (s Service) RunReadInstruction(
+	ctx context.Context, namespace *models.Namespace, clientURI string, req *request.InstructionRequest,
+) (any, error) {
+	if err := ValidateInstructionRequest(req); err != nil {
+		return nil, err
+	}
+
+	c, err := s.getClient(namespace, clientURI)
+	if err != nil {
+		return nil, err
+	}
+
+	args, err := decodeArgs(req.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Lock()
+	defer c.Unlock()
+	r, ok := c.resources[req.ResourceHandler]
+	if !ok {
+		return nil, api.NewResourceDoesNotExistError("resource '%s' not found", req.ResourceHandler)
+	}
+
+	tree := c.tree(r)
+	switch r.Type {
+	case ResourceTypeTreeView:
+		path := ConvertPath(getArg(args, "path", 0))
+		value, ok := getTreeValue(tree, path)
+		switch req.MethodName {
+		case "__getitem__", "collect":
+			if !ok {
+				return nil, api.NewResourceDoesNotExistError("key '%s' not found", strings.Join(path, "."))
+			}
+			return value, nil
+		case "get":
+			if !ok {
+				return getArg(args, "default", 1), nil
+			}
+			return value, nil
+		case "__contains__":
+			return ok, nil
+		case "keys":
+			values, _ := value.(map[string]any)
+			keys := make([]string, 0, len(values))
+			for key := range values {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			return keys, nil
+		}
+	case ResourceTypeStructuredRun:
+		attr := req.MethodName
+		if attr == "__getattr__" {
+			attr = fmt.Sprintf("%v", getArg(args, "name", 0))
+		}
+		return tree[attr], nil
+	}
+	return nil, nil
+}
+
+// RunWriteInstructions runs the batch of write instructions and stores the written data.
+func (s Service) RunWriteInstructions(
+	ctx context.Context, namespace *models.Namespace, clientURI string, req request.WriteInstructionsRequest,
+) error {
+	c, err := s.getClient(namespace, clientURI)
+	if err != nil {
+		return err
+	}
+
+	c.Lock()
+	defer c.Unlock()
+	batch := newWriteBatch()
+	for i := range req {
+		if err := ValidateInstructionRequest(&req[i]); err != nil {
+			return err
+		}
+		r, ok := c.resources[req[i].ResourceHandler]
+		if !ok {
+			return api.NewResourceDoesNotExistError("resource '%s' not found", req[i].ResourceHandler)
+		}
+		args, err := decodeArgs(req[i].Args)
+		if err != nil {
+			return err
+		}
+		c.applyWriteInstruction(batch, r, req[i].MethodName, args)
+	}
+
+	for _, runID := range batch.runIDs {
+		run, err := s.getOrCreateRun(ctx, namespace, runID)
+		if err != nil {
+			return err
+		}
+		if err := s.storeRunProps(ctx, namespace, run, batch.props[runID]); err != nil {
+			return err
+		}
+		if err := s.storeRunParams(ctx, run, batch.attrs[runID]); err != nil {
+			return err
+		}
+		if err := s.storeRunSequences(ctx, c, run, batch.points[runID]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getClient returns connected client of the provided namespace.
+func (s Service) getClient(namespace *models.Namespace, clientURI string) (*client, error) {
+	value, ok := s.clients.Load(clientURI)
+	if ok {
+		c := value.(*client)
+		c.Lock()
+		defer c.Unlock()
+		if c.namespaceID == namespace.ID {
+			c.lastSeen = time.Now()
+			return c, nil
+		}
+	}
+	return nil, api.NewResourceDoesNotExistError("client '%s' is not connected", clientURI)
+}
+
+// getOrCreateRun returns existing run or creates a new one in the default experiment of the namespace.
+func (s Service) getOrCreateRun(ctx context.Context, namespace *models.Namespace, runID string) (*models.Run, error) {
+	if err := ValidateRunHash(runID); err != nil {
+		return nil, err
+	}
+
+	run, err := s.runRepository.GetByNamespaceIDAndRunID(ctx, namespace.ID, runID)
+	if err != nil {
+		return nil, api.NewInternalError("unable to find run '%s': %s", runID, err)
+	}
+	if run != nil {
+		return run, nil
+	}
+
+	experiment, err := s.experimentRepository.GetByNamespaceIDAndExperimentID(
+		ctx, namespace.ID, *namespace.DefaultExperimentID,
+	)
+	if err != nil {
+		return nil, api.NewInternalError("unable to find default experiment: %s", err)
+	}
+	run, err = ConvertRunToDBModel(experiment, runID)
+	if err != nil {
+		return nil, api.NewInternalError("error converting run '%s': %s", runID, err)
+	}
+	if err := s.runRepository.Create(ctx, run); err != nil {
+		return nil, api.NewInternalError("error inserting run '%s': %s", runID, err)
+	}
+	s.livePublisher.PublishStatus(ctx, namespace.ID, run)
+	return run, nil
+}
+
+// getOrCreateExperiment returns existing experiment or creates a new one with the provided name.
+func (s Service) getOrCreateExperiment(
+	ctx context.Context, namespace *models.Namespace, name string,
+) (*models.Experiment, error) {
+	experiment, err := s.experimentRepository.GetByNamespaceIDAndName(ctx, namespace.ID, name)
+	if err != nil {
+		return nil, api.NewInternalError("error getting experiment with name: '%s', error: %s", name, err)
+	}
+	if experiment != nil {
+		return experiment, nil
+	}
+
+	ts := time.Now().UTC().UnixMilli()
+	experiment = &models.Experiment{
+		Name:           name,
+		NamespaceID:    namespace.ID,
+		LifecycleStage: models.LifecycleStageActive,
+		CreationTime:   sql.NullInt64{Int64: ts, Valid: true},
+		LastUpdateTime: sql.NullInt64{Int64: ts, Valid: true},
+	}
+	if err := s.experimentRepository.Create(ctx, experiment); err != nil {
+		return nil, api.NewInternalError("error inserting experiment '%s': %s", name, err)
+	}
+	path, err := url.JoinPath(s.config.DefaultArtifactRoot, fmt.Sprintf("%d", *experiment.ID))
+	if err != nil {
+		return nil, api.NewInternalError("error creating artifact_location for experiment '%s': %s", name, err)
+	}
+	experiment.ArtifactLocation = path
+	if err := s.experimentRepository.Update(ctx, experiment); err != nil {
+		return nil, api.NewInternalError("error updating artifact_location for experiment '%s': %s", name, err)
+	}
+	return experiment, nil
+}
+
+// storeRunProps stores run properties, set through Aim `StructuredRun` or run meta tree.
+func (s Service) storeRunProps(
+	ctx context.Context, namespace *models.Namespace, run *models.Run, props map[string]any,
+) error {
+	if len(props) == 0 {
+		return nil
+	}
+
+	var tags []models.Tag
+	if name, ok := props[runPropName].(string); ok {
+		tags = append(tags, models.Tag{Key: "mlflow.runName", Value: name, RunID: run.ID})
+	}
+	if description, ok := props[runPropDescription].(string); ok {
+		tags = append(tags, models.Tag{Key: "mlflow.note.content", Value: description, RunID: run.ID})
+	}
+	if len(tags) > 0 {
+		if err := s.runRepository.SetRunTagsBatch(ctx, run, 100, tags); err != nil {
+			return api.NewInternalError("unable to insert tags for run '%s': %s", run.ID, err)
+		}
+	}
+
+	if name, ok := props[runPropExperiment].(string); ok && name != "" {
+		experiment, err := s.getOrCreateExperiment(ctx, namespace, name)
+		if err != nil {
+			return err
+		}
+		run.ExperimentID = *experiment.ID
+	}
+	endTime, finished := ConvertTimestamp(props[runPropEndTime])
+	if finished {
+		run.Status = models.StatusFinished
+		run.EndTime = sql.NullInt64{Int64: endTime, Valid: true}
+	}
+	if err := s.runRepository.UpdateWithTransaction(ctx, s.runRepository.GetDB(), run); err != nil {
+		return api.NewInternalError("unable to update run '%s': %s", run.ID, err)
+	}
+	if finished {
+		s.livePublisher.PublishStatus(ctx, namespace.ID, run)
+	}
+
+	if archived, ok := props[runPropArchived].(bool); ok {
+		switch {
+		case archived && run.LifecycleStage == models.LifecycleStageActive:
+			if err := s.runRepository.Archive(ctx, run); err != nil {
+				return api.NewInternalError("unable to archive run '%s': %s", run.ID, err)
+			}
+		case !archived && run.LifecycleStage == models.LifecycleStageDeleted:
+			if err := s.runRepository.Restore(ctx, run); err != nil {
+				return api.NewInternalError("unable to restore run '%s': %s", run.ID, err)
+			}
+		}
+	}
+	return nil
+}
+
+// storeRunParams stores Aim run attributes as run params.
+func (s Service) storeRunParams(ctx context.Context, run *models.Run, attrs map[string]any) error {
+	params, err := ConvertParams(run.ID, attrs)
+	if err != nil {
+		return api.NewInvalidParameterValueError(err.Error())
+	}
+	if len(params) == 0 {
+		return nil
+	}
+	if err := s.paramRepository.CreateBatch(ctx, 100, params); err != nil {
+		if errors.As(err, &repositories.ParamConflictError{}) {
+			return api.NewInvalidParameterValueError("unable to insert params for run '%s': %s", run.ID, err)
+		}
+		return api.NewInternalError("unable to insert params for run '%s': %s", run.ID, err)
+	}
+	return nil
+}
+
+// storeRunSequences stores tracked numbers as metrics and tracked images as run artifacts.
+// Values of other types have no representation yet, so they are skipped.
+func (s Service) storeRunSequences(
+	ctx context.Context, c *client, run *models.Run, points map[string]*sequencePoint,
+) error {
+	keys := make([]string, 0, len(points))
+	for key := range points {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var metrics []models.Metric
+	for _, key := range keys {
+		point := points[key]
+		if !point.HasValue {
+			continue
+		}
+		if value, isNan, ok := ConvertMetricValue(point.Value); ok {
+			timestamp, ok := ConvertTimestamp(point.Time)
+			if !ok {
+				timestamp = time.Now().UTC().UnixMilli()
+			}
+			context, err := ConvertContext(c.contexts[point.ContextIdx])
+			if err != nil {
+				return api.NewInvalidParameterValueError(err.Error())
+			}
+			metrics = append(metrics, models.Metric{
+				Key:       point.Name,
+				Value:     value,
+				IsNan:     isNan,
+				Timestamp: timestamp,
+				Step:      point.Step,
+				RunID:     run.ID,
+				Context:   context,
+			})
+			continue
+		}
+		if images, ok := ConvertImages(point.Value); ok {
+			if err := s.storeRunImages(ctx, run, point, images); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := s.metricRepository.CreateBatch(ctx, run, 100, metrics); err != nil {
+		return api.NewInternalError("unable to insert metrics for run '%s': %s", run.ID, err)
+	}
+	s.livePublisher.PublishMetrics(ctx, c.namespaceID, run.ID, metrics)
+	return nil
+}
+
+// storeRunImages uploads tracked images into the run artifact storage and stores their metadata.
+func (s Service) storeRunImages(ctx context.Context, run *models.Run, point *sequencePoint, images []Image) error {
+	artifactStorage, err := s.artifactStorageFactory.GetStorage(ctx, run.ArtifactURI)
+	if err != nil {
+		return api.NewInternalError("error getting artifact storage for run '%s': %s", run.ID, err)
+	}
+	for i, img := range images {
+		path := ConvertImagePath(point.Name, point.Step, int64(i), img.Format)
+		if err := artifactStorage.Put(ctx, run.ArtifactURI, path, bytes.NewReader(img.Data)); err != nil {
+			return api.NewInternalError("error uploading image '%s' for run '%s': %s", path, run.ID, err)
+		}
+		blobURI, err := url.JoinPath(run.ArtifactURI, path)
+		if err != nil {
+			return api.NewInternalError("error constructing blob uri for image '%s': %s", path, err)
+		}
+		if err := s.artifactRepository.Create(ctx, &models.Artifact{
+			ID:      uuid.New(),
+			Name:    point.Name,
+			Step:    point.Step,
+			RunID:   run.ID,
+			Index:   int64(i),
+			Width:   img.Width,
+			Height:  img.Height,
+			Format:  img.Format,
+			Caption: img.Caption,
+			BlobURI: blobURI,
+		}); err != nil {
+			return api.NewInternalError("error creating run artifact: %s", err)
+		}
+	}
+	return nil
+}
+
+// decodeArgs decodes base64 encoded Aim tree of the resource or instruction arguments.
+func decodeArgs(args string) (any, error) {
+	if args == "" {
+		return nil, nil
+	}
+	data, err := base64.StdEncoding.DecodeString(args)
+	if err != nil {
+		return nil, api.NewInvalidParameterValueError("Invalid value for parameter 'args': %s", err)
+	}
+	tree, err := encoding.DecodeTree(bytes.NewReader(data))
+	if err != nil {
+		return nil, api.NewInvalidParameterValueError("Invalid value for parameter 'args': %s", err)
+	}
+	return tree, nil
+}
+
+// getArg returns the argument either by its name, when arguments are passed as keywords, or by its position.
+func getArg(args any, name string, position int) any {
+	switch args := args.(type) {
+	case map[string]any:
+		return args[name]
+	case []any:
+		if position >= 0 && position < len(args) {
+			return args[position]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package tracking
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// List of Aim resource types which are mapped onto FastTrackML entities.
+// Any other resource type is accepted, but instructions on it are ignored.
+const (
+	ResourceTypeTreeView      = "TreeView"
+	ResourceTypeStructuredRun = "StructuredRun"
+)
+
+// clientTTL defines how long a client is kept without any activity.
+const clientTTL = 10 * time.Minute
+
+// resource represents Aim storage object requested by the client.
+type resource struct {
+	Type  string
+	Name  string
+	RunID string
+}
+
+// treeKey returns the key of the shadow tree which keeps values written through the resource.
+func (r resource) treeKey() string {
+	return fmt.Sprintf("%s/%s", r.Name, r.RunID)
+}
+
+// client represents connected Aim SDK client with all the requested resources.
+type client struct {
+	sync.Mutex
+	namespaceID uint
+	lastSeen    time.Time
+	nextHandler uint64
+	resources   map[string]*resource
+	trees       map[string]map[string]any
+	contexts    map[string]map[string]any
+}
+
+// newClient creates new client bound to the provided namespace.
+func newClient(namespaceID uint) *client {
+	return &client{
+		namespaceID: namespaceID,
+		lastSeen:    time.Now(),
+		resources:   map[string]*resource{},
+		trees:       map[string]map[string]any{},
+		contexts:    map[string]map[string]any{},
+	}
+}
+
+// addResource registers the resource and returns its handler.
+func (c *client) addResource(r *resource) string {
+	c.nextHandler++
+	handler := strconv.FormatUint(c.nextHandler, 10)
+	c.resources[handler] = r
+	return handler
+}
+
+// tree returns the shadow tree of the resource.
+func (c *client) tree(r *resource) map[string]any {
+	tree, ok := c.trees[r.treeKey()]
+	if !ok {
+		tree = map[string]any{}
+		c.trees[r.treeKey()] = tree
+	}
+	return tree
+}
+
+// getTreeValue returns the value stored under the provided path.
+func getTreeValue(tree map[string]any, path []string) (any, bool) {
+	var value any = tree
+	for _, key := range path {
+		node, ok := value.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if value, ok = node[key]; !ok {
+			return nil, false
+		}
+	}
+	return value, true
+}
+
+// setTreeValue stores the value under the provided path, creating intermediate nodes when needed.
+func setTreeValue(tree map[string]any, path []string, value any) {
+	if len(path) == 0 {
+		if values, ok := value.(map[string]any); ok {
+			for key, value := range values {
+				tree[key] = value
+			}
+		}
+		return
+	}
+	node := tree
+	for _, key := range path[:len(path)-1] {
+		child, ok := node[key].(map[string]any)
+		if !ok {
+			child = map[string]any{}
+			node[key] = child
+		}
+		node = child
+	}
+	node[path[len(path)-1]] = value
+}
+
+// deleteTreeValue removes the value stored under the provided path.
+func deleteTreeValue(tree map[string]any, path []string) {
+	if len(path) == 0 {
+		for key := range tree {
+			delete(tree, key)
+		}
+		return
+	}
+	if parent, ok := getTreeValue(tree, path[:len(path)-1]); ok {
+		if node, ok := parent.(map[string]any); ok {
+			delete(node, path[len(path)-1])
+		}
+	}
+}
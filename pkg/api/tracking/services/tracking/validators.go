@@ -0,0 +1,46 @@
+package tracking
+
+import (
+	"regexp"
+
+	"github.com/G-Research/fasttrackml/pkg/api/tracking/api/request"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+)
+
+// runHashRegexp matches Aim run hashes, which have to fit `runs.run_uuid` column.
+var runHashRegexp = regexp.MustCompile(`^[0-9A-Za-z]{1,32}$`)
+
+// ValidateClientURI validates client uri provided by the Aim SDK.
+func ValidateClientURI(clientURI string) error {
+	if clientURI == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'client_uri'")
+	}
+	return nil
+}
+
+// ValidateGetResourceRequest validates `POST /tracking/:client_uri/get-resource/` request.
+func ValidateGetResourceRequest(req *request.GetResourceRequest) error {
+	if req.ResourceType == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'resource_type'")
+	}
+	return nil
+}
+
+// ValidateInstructionRequest validates a single instruction of read or write request.
+func ValidateInstructionRequest(req *request.InstructionRequest) error {
+	if req.ResourceHandler == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'resource_handler'")
+	}
+	if req.MethodName == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'method_name'")
+	}
+	return nil
+}
+
+// ValidateRunHash validates Aim run hash, which is used as the run id.
+func ValidateRunHash(hash string) error {
+	if !runHashRegexp.MatchString(hash) {
+		return api.NewInvalidParameterValueError("Invalid value for run hash '%s'", hash)
+	}
+	return nil
+}
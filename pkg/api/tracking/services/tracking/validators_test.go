@@ -0,0 +1,82 @@
+package tracking
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/G-Research/fasttrackml/pkg/api/tracking/api/request"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+)
+
+func TestValidateInstructionRequest_Ok(t *testing.T) {
+	err := ValidateInstructionRequest(&request.InstructionRequest{
+		ResourceHandler: "1",
+		MethodName:      "__setitem__",
+	})
+	require.Nil(t, err)
+}
+
+func TestValidateInstructionRequest_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.InstructionRequest
+	}{
+		{
+			name:    "EmptyResourceHandler",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'resource_handler'"),
+			request: &request.InstructionRequest{},
+		},
+		{
+			name:  "EmptyMethodName",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'method_name'"),
+			request: &request.InstructionRequest{
+				ResourceHandler: "1",
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateInstructionRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
+func TestValidateRunHash_Ok(t *testing.T) {
+	require.Nil(t, ValidateRunHash("8d9a2f0c1b2e4f6a8c0d2e4f"))
+}
+
+func TestValidateRunHash_Error(t *testing.T) {
+	testData := []struct {
+		name  string
+		hash  string
+		error *api.ErrorResponse
+	}{
+		{
+			name:  "EmptyHash",
+			hash:  "",
+			error: api.NewInvalidParameterValueError("Invalid value for run hash ''"),
+		},
+		{
+			name:  "UnsafeCharacters",
+			hash:  "../run",
+			error: api.NewInvalidParameterValueError("Invalid value for run hash '../run'"),
+		},
+		{
+			name:  "TooLongHash",
+			hash:  "0123456789abcdef0123456789abcdef0",
+			error: api.NewInvalidParameterValueError("Invalid value for run hash '0123456789abcdef0123456789abcdef0'"),
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateRunHash(tt.hash)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
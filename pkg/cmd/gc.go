@@ -0,0 +1,111 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/services/gc"
+	"github.com/G-Research/fasttrackml/pkg/common/config"
+	"github.com/G-Research/fasttrackml/pkg/common/services/artifact/storage"
+	"github.com/G-Research/fasttrackml/pkg/database"
+)
+
+var GCCmd = &cobra.Command{
+	Use:   "gc",
+	Short: "Permanently purges deleted runs and experiments",
+	Long: `The gc command permanently removes runs and experiments, which
+         have been deleted earlier than the provided age, together with
+         all their metrics, params, tags, logs and artifacts.`,
+	RunE: gcCmd,
+}
+
+func gcCmd(cmd *cobra.Command, args []string) error {
+	db, err := database.NewDBProvider(
+		viper.GetString("database-uri"),
+		time.Second*1,
+		20,
+	)
+	if err != nil {
+		return fmt.Errorf("error connecting to DB: %w", err)
+	}
+	//nolint:errcheck
+	defer db.Close()
+
+	ctx, cancel := context.WithCancel(cmd.Context())
+	defer cancel()
+
+	if err := database.CheckAndMigrateDB(false, db.GormDB().WithContext(ctx)); err != nil {
+		return fmt.Errorf("error checking database schema: %w", err)
+	}
+
+	artifactStorageFactory, err := storage.NewArtifactStorageFactory(&config.Config{
+		ArtifactsDestination:         viper.GetString("artifacts-destination"),
+		S3EndpointURI:                viper.GetString("s3-endpoint-uri"),
+		GSEndpointURI:                viper.GetString("gs-endpoint-uri"),
+		AzureEndpointURI:             viper.GetString("azure-endpoint-uri"),
+		AzureStorageAccountKey:       viper.GetString("azure-storage-account-key"),
+		AzureStorageSASToken:         viper.GetString("azure-storage-sas-token"),
+		AzureManagedIdentityClientID: viper.GetString("azure-managed-identity-client-id"),
+	})
+	if err != nil {
+		return fmt.Errorf("error creating artifact storage factory: %w", err)
+	}
+
+	dryRun := viper.GetBool("dry-run")
+	result, err := gc.NewService(
+		repositories.NewGCRepository(db.GormDB()),
+		repositories.NewNamespaceRepository(db.GormDB()),
+		artifactStorageFactory,
+	).Purge(ctx, gc.PurgeOptions{
+		NamespaceCode: viper.GetString("namespace"),
+		OlderThan:     viper.GetDuration("older-than"),
+		DryRun:        dryRun,
+	})
+	if err != nil {
+		return err
+	}
+
+	action := "Purged"
+	if dryRun {
+		action = "Would purge"
+	}
+	for _, experiment := range result.Experiments {
+		fmt.Fprintf(
+			cmd.OutOrStdout(), "%s experiment %d (%s, %s)\n",
+			action, *experiment.ID, experiment.Name, experiment.ArtifactLocation,
+		)
+	}
+	for _, run := range result.Runs {
+		fmt.Fprintf(cmd.OutOrStdout(), "%s run %s (%s)\n", action, run.ID, run.ArtifactURI)
+	}
+	fmt.Fprintf(
+		cmd.OutOrStdout(), "%s %d experiments and %d runs\n", action, len(result.Experiments), len(result.Runs),
+	)
+	return nil
+}
+
+// nolint:errcheck,gosec
+func init() {
+	RootCmd.AddCommand(GCCmd)
+
+	GCCmd.Flags().StringP("database-uri", "d", "sqlite://fasttrackml.db", "Database URI")
+	GCCmd.Flags().StringP("namespace", "n", "", "Namespace to purge (defaults to all namespaces)")
+	GCCmd.Flags().Duration("older-than", 30*24*time.Hour, "Minimal age of deleted runs and experiments to purge")
+	GCCmd.Flags().Bool("dry-run", false, "Only print runs and experiments which would be purged")
+	GCCmd.Flags().String("artifacts-destination", "", "Artifact location used by the proxy API")
+	GCCmd.Flags().String("s3-endpoint-uri", "", "S3 compatible storage base endpoint url")
+	GCCmd.Flags().String("gs-endpoint-uri", "", "Google Storage base endpoint url")
+	GCCmd.Flags().MarkHidden("gs-endpoint-uri")
+	GCCmd.Flags().String("azure-endpoint-uri", "", "Azure Blob Storage base endpoint url (eg., for Azurite)")
+	GCCmd.Flags().String("azure-storage-account-key", "", "Azure Blob Storage account key")
+	GCCmd.Flags().String("azure-storage-sas-token", "", "Azure Blob Storage SAS token")
+	GCCmd.Flags().String(
+		"azure-managed-identity-client-id", "",
+		"Client ID of user-assigned managed identity used when neither account key nor SAS token is provided",
+	)
+}
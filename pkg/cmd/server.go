@@ -38,6 +38,21 @@ func serverCmd(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	// reload auth users configuration on SIGHUP, so users could be changed without restart.
+	if mlflowConfig.Auth.IsAuthTypeUser() {
+		go func() {
+			sighup := make(chan os.Signal, 1)
+			signal.Notify(sighup, syscall.SIGHUP)
+			for range sighup {
+				if err := mlflowConfig.Auth.ReloadUsersConfiguration(); err != nil {
+					log.Errorf("Error reloading auth users configuration: %+v", err)
+					continue
+				}
+				log.Infof("Reloaded auth users configuration from %s", mlflowConfig.Auth.AuthUsersConfig)
+			}
+		}()
+	}
+
 	isRunning := make(chan struct{})
 	go func() {
 		sigint := make(chan os.Signal, 1)
@@ -67,11 +82,31 @@ func init() {
 
 	ServerCmd.Flags().StringP("listen-address", "a", "localhost:5000", "Address (host:post) to listen to")
 	ServerCmd.Flags().String("default-artifact-root", "./artifacts", "Default artifact root")
+	ServerCmd.Flags().Bool("serve-artifacts", false, "Serve artifacts through the mlflow-artifacts proxy API")
+	ServerCmd.Flags().String(
+		"artifacts-destination", "", "Artifact location used by the proxy API (defaults to default artifact root)",
+	)
+	ServerCmd.Flags().Int64("artifacts-archive-max-size", 5<<30, "Maximum size of downloaded artifact archive in bytes")
+	ServerCmd.Flags().Int("artifacts-archive-max-files", 10000, "Maximum number of files in downloaded artifact archive")
+	ServerCmd.Flags().String(
+		"artifacts-staging-directory", "",
+		"Directory to stage multipart uploads, if the artifacts destination doesn't support them (defaults to temp dir)",
+	)
+	ServerCmd.Flags().Duration(
+		"artifacts-staging-retention", 24*time.Hour, "Time after which incomplete staged multipart uploads are removed",
+	)
 	ServerCmd.Flags().String("s3-endpoint-uri", "", "S3 compatible storage base endpoint url")
 	ServerCmd.Flags().String("gs-endpoint-uri", "", "Google Storage base endpoint url")
 	ServerCmd.Flags().MarkHidden("gs-endpoint-uri")
+	ServerCmd.Flags().String("azure-endpoint-uri", "", "Azure Blob Storage base endpoint url (eg., for Azurite)")
+	ServerCmd.Flags().String("azure-storage-account-key", "", "Azure Blob Storage account key")
+	ServerCmd.Flags().String("azure-storage-sas-token", "", "Azure Blob Storage SAS token")
+	ServerCmd.Flags().String(
+		"azure-managed-identity-client-id", "",
+		"Client ID of user-assigned managed identity used when neither account key nor SAS token is provided",
+	)
 	ServerCmd.Flags().String("auth-username", "", "BasicAuth username")
-	ServerCmd.Flags().String("auth-password", "", "BasicAuth password")
+	ServerCmd.Flags().String("auth-password", "", "BasicAuth password (plain text, bcrypt or argon2id hash)")
 	ServerCmd.Flags().String("auth-users-config", "", "Users configuration file")
 	ServerCmd.Flags().String("auth-oidc-client-id", "", "OIDC auth client id")
 	ServerCmd.Flags().String("auth-oidc-client-secret", "", "OIDC auth client secret")
@@ -79,17 +114,27 @@ func init() {
 	ServerCmd.Flags().String("auth-oidc-scopes", "", "OIDC requested scopes")
 	ServerCmd.Flags().String("auth-oidc-admin-role", "", "OIDC admin role identifier")
 	ServerCmd.Flags().String("auth-oidc-claim-roles", "", "OIDC claim to inspect for roles")
+	ServerCmd.Flags().String("auth-jwt-audience", "", "Bearer JWT expected audience (enables bearer JWT auth)")
+	ServerCmd.Flags().String("auth-jwt-issuer", "", "Bearer JWT expected issuer (defaults to OIDC provider issuer)")
+	ServerCmd.Flags().String("auth-jwt-jwks-url", "", "Bearer JWT JWKS url (defaults to OIDC provider JWKS)")
+	ServerCmd.Flags().String("auth-jwt-jwks-file", "", "Bearer JWT local JWKS file for offline verification")
 	ServerCmd.Flags().StringP("database-uri", "d", "sqlite://fasttrackml.db", "Database URI")
 	ServerCmd.Flags().Int("database-pool-max", 20, "Maximum number of database connections in the pool")
 	ServerCmd.Flags().Duration("database-slow-threshold", 1*time.Second, "Slow SQL warning threshold")
 	ServerCmd.Flags().Bool("database-migrate", true, "Run database migrations")
 	ServerCmd.Flags().Bool("database-reset", false, "Reinitialize database - WARNING all data will be lost!")
-	ServerCmd.Flags().Bool("live-updates-enabled", false, "Enable 'live updates' in the Aim UI")
+	ServerCmd.Flags().Bool(
+		"live-updates-enabled", false, "Enable 'live updates' in the Aim UI and streaming of run updates",
+	)
 	ServerCmd.Flags().MarkHidden("database-reset")
 	ServerCmd.Flags().Bool("dev-mode", false, "Development mode - enable CORS")
 	ServerCmd.Flags().MarkHidden("dev-mode")
 	ServerCmd.Flags().Int("log-output-max", 2000, "Maximum log rows per run to retain.")
 	ServerCmd.Flags().Duration("log-output-retention", 7*24*time.Hour, "Run logs retention period")
+	ServerCmd.Flags().Duration("gc-interval", 0, "Interval of purging deleted runs and experiments (0 disables it)")
+	ServerCmd.Flags().Duration("gc-older-than", 30*24*time.Hour, "Minimal age of deleted runs and experiments to purge")
+	ServerCmd.Flags().Bool("audit-log-enabled", true, "Record mutating API requests in the audit log")
+	ServerCmd.Flags().Duration("audit-log-retention", 90*24*time.Hour, "Audit log retention period (0 keeps it forever)")
 	viper.BindEnv("auth-username", "MLFLOW_TRACKING_USERNAME")
 	viper.BindEnv("auth-password", "MLFLOW_TRACKING_PASSWORD")
 }
@@ -33,6 +33,8 @@ const (
 	ErrorCodeEndpointNotFound       = "ENDPOINT_NOT_FOUND"
 	ErrorCodeResourceAlreadyExists  = "RESOURCE_ALREADY_EXISTS"
 	ErrorCodeResourceDoesNotExist   = "RESOURCE_DOES_NOT_EXIST"
+	ErrorCodeUnauthenticated        = "UNAUTHENTICATED"
+	ErrorCodePermissionDenied       = "PERMISSION_DENIED"
 )
 
 // NewBadRequestError creates new Response object with ErrorCodeBadRequest.
@@ -80,6 +82,24 @@ func NewResourceAlreadyExistsError(msg string, args ...any) *ErrorResponse {
 	}
 }
 
+// NewUnauthenticatedError creates new Response object with ErrorCodeUnauthenticated.
+func NewUnauthenticatedError(msg string, args ...any) *ErrorResponse {
+	return &ErrorResponse{
+		Message:    fmt.Sprintf(msg, args...),
+		ErrorCode:  ErrorCodeUnauthenticated,
+		StatusCode: http.StatusUnauthorized,
+	}
+}
+
+// NewPermissionDeniedError creates new Response object with ErrorCodePermissionDenied.
+func NewPermissionDeniedError(msg string, args ...any) *ErrorResponse {
+	return &ErrorResponse{
+		Message:    fmt.Sprintf(msg, args...),
+		ErrorCode:  ErrorCodePermissionDenied,
+		StatusCode: http.StatusForbidden,
+	}
+}
+
 // NewEndpointNotFound creates new Response object with ErrorCodeEndpointNotFound.
 func NewEndpointNotFound(msg string, args ...any) *ErrorResponse {
 	return &ErrorResponse{
@@ -1,6 +1,13 @@
 package response
 
-import "github.com/G-Research/fasttrackml/pkg/common/services/artifact/storage"
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"strconv"
+
+	"github.com/G-Research/fasttrackml/pkg/common/services/artifact/storage"
+)
 
 // FilePartialResponse is a partial response object for different responses.
 type FilePartialResponse struct {
@@ -34,3 +41,70 @@ func NewListArtifactsResponse(
 
 	return &response
 }
+
+// ProxyFilePartialResponse is a partial response object for ListProxyArtifactsResponse.
+type ProxyFilePartialResponse struct {
+	Path     string `json:"path"`
+	IsDir    bool   `json:"is_dir"`
+	FileSize int64  `json:"file_size,omitempty"`
+}
+
+// ListProxyArtifactsResponse is a response object for `GET /mlflow-artifacts/artifacts` endpoint.
+type ListProxyArtifactsResponse struct {
+	Files []ProxyFilePartialResponse `json:"files"`
+}
+
+// NewListProxyArtifactsResponse creates new instance of ListProxyArtifactsResponse.
+func NewListProxyArtifactsResponse(artifacts []storage.ArtifactObject) *ListProxyArtifactsResponse {
+	response := ListProxyArtifactsResponse{
+		Files: make([]ProxyFilePartialResponse, len(artifacts)),
+	}
+
+	for i, artifact := range artifacts {
+		// proxy API returns only the names of the objects relative to the requested path.
+		response.Files[i] = ProxyFilePartialResponse{
+			Path:     path.Base(artifact.GetPath()),
+			IsDir:    artifact.IsDirectory(),
+			FileSize: artifact.GetSize(),
+		}
+	}
+
+	return &response
+}
+
+// MultipartUploadCredentialPartialResponse is a partial response object for CreateMultipartUploadResponse.
+type MultipartUploadCredentialPartialResponse struct {
+	URL        string            `json:"url"`
+	PartNumber int               `json:"part_number"`
+	Headers    map[string]string `json:"headers"`
+}
+
+// CreateMultipartUploadResponse is a response object for `POST /mlflow-artifacts/mpu/create/*` endpoint.
+type CreateMultipartUploadResponse struct {
+	UploadID    string                                     `json:"upload_id"`
+	Credentials []MultipartUploadCredentialPartialResponse `json:"credentials"`
+}
+
+// NewCreateMultipartUploadResponse creates new instance of CreateMultipartUploadResponse.
+// uploadURL is an url of `PUT /mlflow-artifacts/mpu/upload/*` endpoint for the artifact.
+func NewCreateMultipartUploadResponse(
+	uploadURL, uploadID string, numParts int,
+) *CreateMultipartUploadResponse {
+	response := CreateMultipartUploadResponse{
+		UploadID:    uploadID,
+		Credentials: make([]MultipartUploadCredentialPartialResponse, numParts),
+	}
+
+	for i := range response.Credentials {
+		query := url.Values{}
+		query.Set("upload_id", uploadID)
+		query.Set("part_number", strconv.Itoa(i+1))
+		response.Credentials[i] = MultipartUploadCredentialPartialResponse{
+			URL:        fmt.Sprintf("%s?%s", uploadURL, query.Encode()),
+			PartNumber: i + 1,
+			Headers:    map[string]string{},
+		}
+	}
+
+	return &response
+}
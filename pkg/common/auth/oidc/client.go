@@ -60,37 +60,53 @@ func (c Client) Verify(ctx context.Context, accessToken string) (*User, error) {
 	if err != nil {
 		return nil, eris.Wrap(err, "error verifying access token")
 	}
+	return newUserFromToken(c.config, idToken)
+}
+
+// Exchange converts an authorization code into a token.
+func (c Client) Exchange(ctx context.Context, code string) (*oauth2.Token, error) {
+	oauth2Token, err := c.oauth2Config.Exchange(ctx, code)
+	if err != nil {
+		return nil, eris.Wrap(err, "error converting an authorization code into a token")
+	}
+	return oauth2Token, nil
+}
+
+// GetOauth2Config returns oauth2 configuration.
+func (c Client) GetOauth2Config() *oauth2.Config {
+	return c.oauth2Config
+}
+
+// newUserFromToken creates User object from verified token. Roles are taken from `auth-oidc-claim-roles` claim.
+func newUserFromToken(config *config.Config, idToken *oidc.IDToken) (*User, error) {
 	// Extract custom claims.
 	var claims map[string]interface{}
 	if err := idToken.Claims(&claims); err != nil {
 		return nil, eris.Wrap(err, "error extracting token claims")
 	}
 
-	data, ok := claims[c.config.Auth.AuthOIDCClaimRoles]
+	data, ok := claims[config.Auth.AuthOIDCClaimRoles]
 	if !ok {
-		return nil, eris.Errorf("claim property: %s not found", c.config.Auth.AuthOIDCClaimRoles)
+		return nil, eris.Errorf("claim property: %s not found", config.Auth.AuthOIDCClaimRoles)
 	}
 
 	roles, err := ConvertAndNormaliseRoles(data)
 	if err != nil {
-		return nil, eris.Wrapf(err, "error converting claim %s property", c.config.Auth.AuthOIDCClaimRoles)
+		return nil, eris.Wrapf(err, "error converting claim %s property", config.Auth.AuthOIDCClaimRoles)
 	}
 	return &User{
+		name:    getUserName(claims, idToken.Subject),
 		roles:   roles,
-		isAdmin: slices.Contains(roles, c.config.Auth.AuthOIDCAdminRole),
+		isAdmin: slices.Contains(roles, config.Auth.AuthOIDCAdminRole),
 	}, nil
 }
 
-// Exchange converts an authorization code into a token.
-func (c Client) Exchange(ctx context.Context, code string) (*oauth2.Token, error) {
-	oauth2Token, err := c.oauth2Config.Exchange(ctx, code)
-	if err != nil {
-		return nil, eris.Wrap(err, "error converting an authorization code into a token")
+// getUserName returns the most human-readable user name available in the token claims.
+func getUserName(claims map[string]interface{}, subject string) string {
+	for _, claim := range []string{"preferred_username", "email", "name"} {
+		if name, ok := claims[claim].(string); ok && name != "" {
+			return name
+		}
 	}
-	return oauth2Token, nil
-}
-
-// GetOauth2Config returns oauth2 configuration.
-func (c Client) GetOauth2Config() *oauth2.Config {
-	return c.oauth2Config
+	return subject
 }
@@ -0,0 +1,119 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"encoding/json"
+	"os"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/go-jose/go-jose/v4"
+	"github.com/rotisserie/eris"
+
+	"github.com/G-Research/fasttrackml/pkg/common/config"
+)
+
+// jwtSupportedSigningAlgs is the list of asymmetric algorithms which bearer JWT could be signed with.
+var jwtSupportedSigningAlgs = []string{
+	oidc.RS256, oidc.RS384, oidc.RS512,
+	oidc.ES256, oidc.ES384, oidc.ES512,
+	oidc.PS256, oidc.PS384, oidc.PS512,
+	oidc.EdDSA,
+}
+
+// JWTVerifierProvider provides an interface to verify bearer JWT.
+type JWTVerifierProvider interface {
+	// Verify makes bearer JWT verification.
+	Verify(ctx context.Context, rawToken string) (*User, error)
+}
+
+// JWTVerifier verifies bearer JWT issued by the identity provider to API clients.
+type JWTVerifier struct {
+	config   *config.Config
+	verifier *oidc.IDTokenVerifier
+}
+
+// NewJWTVerifier creates a new bearer JWT verifier. Keys are loaded from the local JWKS file,
+// fetched from the JWKS url or discovered from the OIDC provider, in that order.
+func NewJWTVerifier(ctx context.Context, config *config.Config) (*JWTVerifier, error) {
+	issuer, keySet := config.Auth.AuthJWTIssuer, oidc.KeySet(nil)
+	switch {
+	case config.Auth.AuthJWTJWKSFile != "":
+		staticKeySet, err := loadJWKSFile(config.Auth.AuthJWTJWKSFile)
+		if err != nil {
+			return nil, eris.Wrapf(err, "error loading JWKS file: %s", config.Auth.AuthJWTJWKSFile)
+		}
+		keySet = staticKeySet
+	case config.Auth.AuthJWTJWKSURL != "":
+		keySet = oidc.NewRemoteKeySet(ctx, config.Auth.AuthJWTJWKSURL)
+	}
+
+	if keySet == nil || issuer == "" {
+		provider, err := oidc.NewProvider(ctx, config.Auth.AuthOIDCProviderEndpoint)
+		if err != nil {
+			return nil, eris.Wrap(err, "error creating OIDC provider")
+		}
+		var claims struct {
+			Issuer  string `json:"issuer"`
+			JWKSURL string `json:"jwks_uri"`
+		}
+		if err := provider.Claims(&claims); err != nil {
+			return nil, eris.Wrap(err, "error reading OIDC provider configuration")
+		}
+		if issuer == "" {
+			issuer = claims.Issuer
+		}
+		if keySet == nil {
+			keySet = oidc.NewRemoteKeySet(ctx, claims.JWKSURL)
+		}
+	}
+
+	return &JWTVerifier{
+		config: config,
+		verifier: oidc.NewVerifier(issuer, keySet, &oidc.Config{
+			ClientID:             config.Auth.AuthJWTAudience,
+			SupportedSigningAlgs: jwtSupportedSigningAlgs,
+		}),
+	}, nil
+}
+
+// Verify makes bearer JWT verification: signature, issuer, audience and expiry are checked.
+func (v JWTVerifier) Verify(ctx context.Context, rawToken string) (*User, error) {
+	idToken, err := v.verifier.Verify(ctx, rawToken)
+	if err != nil {
+		return nil, eris.Wrap(err, "error verifying bearer token")
+	}
+	return newUserFromToken(v.config, idToken)
+}
+
+// loadJWKSFile loads public keys from the local JWKS file.
+func loadJWKSFile(path string) (*oidc.StaticKeySet, error) {
+	//nolint:gosec
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, eris.Wrap(err, "error reading file")
+	}
+	var jwks jose.JSONWebKeySet
+	if err := json.Unmarshal(data, &jwks); err != nil {
+		return nil, eris.Wrap(err, "error unmarshaling JWKS")
+	}
+
+	keySet := oidc.StaticKeySet{}
+	for _, key := range jwks.Keys {
+		// keys intended for encryption can't verify signatures.
+		if key.Use != "" && key.Use != "sig" {
+			continue
+		}
+		if !key.IsPublic() {
+			key = key.Public()
+		}
+		if key.Key == nil {
+			continue
+		}
+		keySet.PublicKeys = append(keySet.PublicKeys, crypto.PublicKey(key.Key))
+	}
+	if len(keySet.PublicKeys) == 0 {
+		return nil, eris.New("no signing keys found")
+	}
+	return &keySet, nil
+}
@@ -2,6 +2,7 @@ package oidc
 
 // User represents an object to store current user information.
 type User struct {
+	name    string
 	roles   []string
 	isAdmin bool
 }
@@ -11,6 +12,11 @@ func (u User) IsAdmin() bool {
 	return u.isAdmin
 }
 
+// GetName returns current user name.
+func (u User) GetName() string {
+	return u.name
+}
+
 // GetRoles returns current user roles.
 func (u User) GetRoles() []string {
 	return u.roles
@@ -22,6 +22,10 @@ type Config struct {
 	AuthOIDCAdminRole         string
 	AuthOIDCClaimRoles        string
 	AuthOIDCProviderEndpoint  string
+	AuthJWTAudience           string
+	AuthJWTIssuer             string
+	AuthJWTJWKSURL            string
+	AuthJWTJWKSFile           string
 	AuthParsedUserPermissions *models.UserPermissions
 }
 
@@ -39,8 +43,41 @@ func (c *Config) IsAuthTypeUser() bool {
 	return c.AuthParsedUserPermissions != nil
 }
 
+// IsAuthTypeJWT makes check that bearer JWT authentication is enabled.
+// It could be used alone or together with TypeOIDC or TypeUser authentication.
+func (c *Config) IsAuthTypeJWT() bool {
+	return c.AuthJWTAudience != ""
+}
+
 // ValidateConfiguration validates service configuration for correctness.
 func (c *Config) ValidateConfiguration() error {
+	if !c.IsAuthTypeJWT() {
+		if c.AuthJWTIssuer != "" || c.AuthJWTJWKSURL != "" || c.AuthJWTJWKSFile != "" {
+			return eris.New("'auth-jwt-audience' flag has to be provided together with other 'auth-jwt-*' flags")
+		}
+		return nil
+	}
+	if c.AuthOIDCClaimRoles == "" {
+		return eris.New("'auth-oidc-claim-roles' flag has to be provided together with 'auth-jwt-audience' flag")
+	}
+	if c.AuthJWTJWKSURL != "" && c.AuthJWTJWKSFile != "" {
+		return eris.New("'auth-jwt-jwks-url' and 'auth-jwt-jwks-file' flags can not be used together")
+	}
+	// keys and issuer could be discovered from the OIDC provider, otherwise they have to be provided explicitly.
+	if c.AuthOIDCProviderEndpoint == "" {
+		if c.AuthJWTJWKSURL == "" && c.AuthJWTJWKSFile == "" {
+			return eris.New(
+				"'auth-jwt-jwks-url', 'auth-jwt-jwks-file' or 'auth-oidc-provider-endpoint' flag has to be provided " +
+					"together with 'auth-jwt-audience' flag",
+			)
+		}
+		if c.AuthJWTIssuer == "" {
+			return eris.New(
+				"'auth-jwt-issuer' or 'auth-oidc-provider-endpoint' flag has to be provided together with " +
+					"'auth-jwt-audience' flag",
+			)
+		}
+	}
 	return nil
 }
 
@@ -55,3 +92,17 @@ func (c *Config) NormalizeConfiguration() error {
 	}
 	return nil
 }
+
+// ReloadUsersConfiguration reloads auth user configuration from the file. Already running middlewares
+// immediately use the reloaded configuration, because the same UserPermissions object is updated.
+func (c *Config) ReloadUsersConfiguration() error {
+	if c.AuthUsersConfig == "" || c.AuthParsedUserPermissions == nil {
+		return nil
+	}
+	parsedUserPermissions, err := Load(c.AuthUsersConfig)
+	if err != nil {
+		return eris.Wrapf(err, "error loading auth user configuration from file: %s", c.AuthUsersConfig)
+	}
+	c.AuthParsedUserPermissions.Replace(parsedUserPermissions)
+	return nil
+}
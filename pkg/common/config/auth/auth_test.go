@@ -1,6 +1,7 @@
 package auth
 
 import (
+	"encoding/base64"
 	"fmt"
 	"os"
 	"testing"
@@ -56,3 +57,133 @@ func TestConfig_NormalizeConfiguration(t *testing.T) {
 		})
 	}
 }
+
+func TestConfig_ReloadUsersConfiguration(t *testing.T) {
+	configPath := fmt.Sprintf("%s/configuration.yml", t.TempDir())
+	assert.Nil(t, os.WriteFile(configPath, []byte("users:\n  - name: user1\n    password: password1\n"), 0o600))
+
+	config := &Config{
+		AuthUsersConfig: configPath,
+	}
+	assert.Nil(t, config.NormalizeConfiguration())
+	userPermissions := config.AuthParsedUserPermissions
+	assert.NotNil(t, userPermissions.ValidateAuthToken(base64.StdEncoding.EncodeToString([]byte("user1:password1"))))
+
+	assert.Nil(t, os.WriteFile(configPath, []byte("users:\n  - name: user1\n    password: password2\n"), 0o600))
+	assert.Nil(t, config.ReloadUsersConfiguration())
+
+	// the same object is updated, so already created middlewares use the new configuration.
+	assert.Same(t, userPermissions, config.AuthParsedUserPermissions)
+	assert.Nil(t, userPermissions.ValidateAuthToken(base64.StdEncoding.EncodeToString([]byte("user1:password1"))))
+	assert.NotNil(t, userPermissions.ValidateAuthToken(base64.StdEncoding.EncodeToString([]byte("user1:password2"))))
+
+	// broken configuration is rejected and the current one is kept.
+	assert.Nil(t, os.WriteFile(configPath, []byte("users:\n  - name: user1\n    roles: [ns:a:reader]\n"), 0o600))
+	assert.NotNil(t, config.ReloadUsersConfiguration())
+	assert.NotNil(t, userPermissions.ValidateAuthToken(base64.StdEncoding.EncodeToString([]byte("user1:password2"))))
+}
+
+func TestConfig_ValidateConfiguration_JWT_Ok(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *Config
+	}{
+		{
+			name: "LocalJWKSFile",
+			config: &Config{
+				AuthOIDCClaimRoles: "groups",
+				AuthJWTAudience:    "fasttrackml",
+				AuthJWTIssuer:      "https://idp.example.com",
+				AuthJWTJWKSFile:    "/path/to/jwks.json",
+			},
+		},
+		{
+			name: "RemoteJWKS",
+			config: &Config{
+				AuthOIDCClaimRoles: "groups",
+				AuthJWTAudience:    "fasttrackml",
+				AuthJWTIssuer:      "https://idp.example.com",
+				AuthJWTJWKSURL:     "https://idp.example.com/jwks",
+			},
+		},
+		{
+			name: "ProviderDiscovery",
+			config: &Config{
+				AuthOIDCClaimRoles:       "groups",
+				AuthOIDCProviderEndpoint: "https://idp.example.com",
+				AuthJWTAudience:          "fasttrackml",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Nil(t, tt.config.ValidateConfiguration())
+			assert.True(t, tt.config.IsAuthTypeJWT())
+		})
+	}
+}
+
+func TestConfig_ValidateConfiguration_JWT_Error(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *Config
+		error  string
+	}{
+		{
+			name: "MissingAudience",
+			config: &Config{
+				AuthJWTJWKSFile: "/path/to/jwks.json",
+			},
+			error: "'auth-jwt-audience' flag has to be provided together with other 'auth-jwt-*' flags",
+		},
+		{
+			name: "MissingClaimRoles",
+			config: &Config{
+				AuthJWTAudience: "fasttrackml",
+				AuthJWTIssuer:   "https://idp.example.com",
+				AuthJWTJWKSFile: "/path/to/jwks.json",
+			},
+			error: "'auth-oidc-claim-roles' flag has to be provided together with 'auth-jwt-audience' flag",
+		},
+		{
+			name: "BothJWKSSources",
+			config: &Config{
+				AuthOIDCClaimRoles: "groups",
+				AuthJWTAudience:    "fasttrackml",
+				AuthJWTIssuer:      "https://idp.example.com",
+				AuthJWTJWKSFile:    "/path/to/jwks.json",
+				AuthJWTJWKSURL:     "https://idp.example.com/jwks",
+			},
+			error: "'auth-jwt-jwks-url' and 'auth-jwt-jwks-file' flags can not be used together",
+		},
+		{
+			name: "MissingKeys",
+			config: &Config{
+				AuthOIDCClaimRoles: "groups",
+				AuthJWTAudience:    "fasttrackml",
+				AuthJWTIssuer:      "https://idp.example.com",
+			},
+			error: "'auth-jwt-jwks-url', 'auth-jwt-jwks-file' or 'auth-oidc-provider-endpoint' flag has to be " +
+				"provided together with 'auth-jwt-audience' flag",
+		},
+		{
+			name: "MissingIssuer",
+			config: &Config{
+				AuthOIDCClaimRoles: "groups",
+				AuthJWTAudience:    "fasttrackml",
+				AuthJWTJWKSFile:    "/path/to/jwks.json",
+			},
+			error: "'auth-jwt-issuer' or 'auth-oidc-provider-endpoint' flag has to be provided together with " +
+				"'auth-jwt-audience' flag",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.ValidateConfiguration()
+			assert.NotNil(t, err)
+			assert.Equal(t, tt.error, err.Error())
+		})
+	}
+}
@@ -29,6 +29,12 @@ func Load(configFilePath string) (*models.UserPermissions, error) {
 			return nil, eris.Wrap(err, "error parsing user configuration from yaml")
 		}
 		return permissions, nil
+	case ".htpasswd":
+		permissions, err := parseUserConfigFromHtpasswd(data)
+		if err != nil {
+			return nil, eris.Wrap(err, "error parsing user configuration from htpasswd")
+		}
+		return permissions, nil
 	}
 	return nil, eris.Errorf("unsupported user configuration file type")
 }
@@ -40,9 +46,10 @@ type YamlConfig struct {
 
 // YamlUserConfig partial object of YamlConfig.
 type YamlUserConfig struct {
-	Name     string   `yaml:"name"`
-	Password string   `yaml:"password"`
-	Roles    []string `yaml:"roles"`
+	Name         string   `yaml:"name"`
+	Password     string   `yaml:"password,omitempty"`
+	PasswordHash string   `yaml:"password_hash,omitempty"`
+	Roles        []string `yaml:"roles"`
 }
 
 // parseUserConfigFromYaml parse configuration from ".yaml", ".yml" files and transform it into internal representation.
@@ -53,9 +60,30 @@ func parseUserConfigFromYaml(content []byte) (*models.UserPermissions, error) {
 	}
 
 	data := make(map[string]map[string]struct{})
+	users := make(map[string]models.HashedUser)
 	passwordRegex := regexp.MustCompile(`^\$\{(.*)\}$`)
 	passwordReplacer := strings.NewReplacer("$", "", "{", "", "}", "")
 	for _, user := range config.Users {
+		roles, err := parseUserRoles(user.Name, user.Roles)
+		if err != nil {
+			return nil, err
+		}
+
+		// users with hashed passwords are verified on each request, so plain password is never stored.
+		if user.PasswordHash != "" {
+			if user.Password != "" {
+				return nil, eris.Errorf("user %s must have either password or password_hash, not both", user.Name)
+			}
+			if err := validateUserPasswordHash(user.Name, user.PasswordHash); err != nil {
+				return nil, err
+			}
+			users[user.Name] = models.HashedUser{
+				PasswordHash: user.PasswordHash,
+				Roles:        roles,
+			}
+			continue
+		}
+
 		// if a password format is ${PASSWORD_PARAMETER_FROM_ENV} then try to load it from ENV.
 		if passwordRegex.MatchString(user.Password) {
 			password, ok := os.LookupEnv(passwordReplacer.Replace(user.Password))
@@ -64,10 +92,6 @@ func parseUserConfigFromYaml(content []byte) (*models.UserPermissions, error) {
 			}
 			user.Password = password
 		}
-		roles := map[string]struct{}{}
-		for _, role := range user.Roles {
-			roles[role] = struct{}{}
-		}
 
 		// encode name + password into base64. it helps later to quickly access/find user,
 		// so we won't have any performance degradation.
@@ -77,5 +101,72 @@ func parseUserConfigFromYaml(content []byte) (*models.UserPermissions, error) {
 		data[loginEncoded] = roles
 	}
 
-	return models.NewUserPermissions(data), nil
+	return models.NewUserPermissionsWithHashedUsers(data, users), nil
+}
+
+// parseUserConfigFromHtpasswd parse configuration from ".htpasswd" files and transform it into internal
+// representation. Each line has `name:hash:roles` format, where roles are comma separated, e.g.:
+//
+//	user1:$2y$10$...:ns:namespace1:viewer,ns:namespace2
+//
+// Empty lines and lines started with `#` are ignored.
+func parseUserConfigFromHtpasswd(content []byte) (*models.UserPermissions, error) {
+	users := make(map[string]models.HashedUser)
+	for i, line := range strings.Split(string(content), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		name, rest, ok := strings.Cut(line, ":")
+		if !ok || name == "" {
+			return nil, eris.Errorf("error parsing line %d: must be `name:hash:roles`", i+1)
+		}
+		// neither bcrypt nor argon2id hashes contain `:`, so the hash ends with the next one.
+		passwordHash, rawRoles, _ := strings.Cut(rest, ":")
+		if err := validateUserPasswordHash(name, passwordHash); err != nil {
+			return nil, err
+		}
+
+		var userRoles []string
+		for _, role := range strings.Split(rawRoles, ",") {
+			if role = strings.TrimSpace(role); role != "" {
+				userRoles = append(userRoles, role)
+			}
+		}
+		roles, err := parseUserRoles(name, userRoles)
+		if err != nil {
+			return nil, err
+		}
+		users[name] = models.HashedUser{
+			PasswordHash: passwordHash,
+			Roles:        roles,
+		}
+	}
+	return models.NewUserPermissionsWithHashedUsers(map[string]map[string]struct{}{}, users), nil
+}
+
+// parseUserRoles validates user roles and transforms them into internal representation.
+func parseUserRoles(name string, userRoles []string) (map[string]struct{}, error) {
+	roles := map[string]struct{}{}
+	for _, role := range userRoles {
+		if strings.HasPrefix(role, "ns:") {
+			if _, _, ok := models.ParseNamespaceRole(role); !ok {
+				return nil, eris.Errorf(
+					"error parsing role %s of user %s: must be `ns:<namespace code>[:viewer|editor|owner]`",
+					role, name,
+				)
+			}
+		}
+		roles[role] = struct{}{}
+	}
+	return roles, nil
+}
+
+// validateUserPasswordHash makes check that user password hash is supported.
+func validateUserPasswordHash(name, passwordHash string) error {
+	if !models.IsPasswordHash(passwordHash) {
+		return eris.Errorf("error parsing password hash of user %s: must be bcrypt or argon2id hash", name)
+	}
+	return nil
 }
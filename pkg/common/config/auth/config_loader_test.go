@@ -1,11 +1,14 @@
 package auth
 
 import (
+	"encoding/base64"
 	"fmt"
 	"os"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
 	"gopkg.in/yaml.v3"
 
 	"github.com/G-Research/fasttrackml/pkg/common/dao/models"
@@ -143,6 +146,30 @@ func TestLoad_Error(t *testing.T) {
 	assert.Equal(t, "unsupported user configuration file type", err.Error())
 }
 
+func TestLoad_InvalidPermissionLevel_Error(t *testing.T) {
+	data, err := yaml.Marshal(YamlConfig{
+		Users: []YamlUserConfig{
+			{
+				Name:     "user1",
+				Roles:    []string{"ns:namespace1:reader"},
+				Password: "user1password",
+			},
+		},
+	})
+	assert.Nil(t, err)
+
+	configPath := fmt.Sprintf("%s/configuration.yaml", t.TempDir())
+	assert.Nil(t, os.WriteFile(configPath, data, 0o600))
+
+	_, err = Load(configPath)
+	assert.Equal(
+		t,
+		"error parsing user configuration from yaml: "+
+			"error parsing role ns:namespace1:reader of user user1: must be `ns:<namespace code>[:viewer|editor|owner]`",
+		err.Error(),
+	)
+}
+
 func TestUserPermissions_HasAccess_Ok(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -171,6 +198,52 @@ func TestUserPermissions_HasAccess_Ok(t *testing.T) {
 	}
 }
 
+func TestUserPermissions_GetNamespacePermissionLevel_Ok(t *testing.T) {
+	tests := []struct {
+		name      string
+		roles     map[string]struct{}
+		namespace string
+		level     models.PermissionLevel
+	}{
+		{
+			name:      "RoleWithoutLevelGrantsOwner",
+			roles:     map[string]struct{}{"ns:namespace1": {}},
+			namespace: "namespace1",
+			level:     models.PermissionLevelOwner,
+		},
+		{
+			name:      "RoleWithViewerLevel",
+			roles:     map[string]struct{}{"ns:namespace1:viewer": {}},
+			namespace: "namespace1",
+			level:     models.PermissionLevelViewer,
+		},
+		{
+			name:      "HighestLevelIsUsed",
+			roles:     map[string]struct{}{"ns:namespace1:viewer": {}, "ns:namespace1:editor": {}},
+			namespace: "namespace1",
+			level:     models.PermissionLevelEditor,
+		},
+		{
+			name:      "AdminGrantsOwner",
+			roles:     map[string]struct{}{"admin": {}},
+			namespace: "namespace1",
+			level:     models.PermissionLevelOwner,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			permissions := models.NewUserPermissions(map[string]map[string]struct{}{"token": tt.roles})
+			authToken := permissions.ValidateAuthToken("token")
+			assert.NotNil(t, authToken)
+			level, ok := authToken.GetNamespacePermissionLevel(tt.namespace)
+			assert.True(t, ok)
+			assert.Equal(t, tt.level, level)
+			assert.True(t, authToken.HasUserAccess(tt.namespace) || authToken.HasAdminAccess())
+		})
+	}
+}
+
 func TestUserPermissions_HasAdminAccess_Ok(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -199,6 +272,19 @@ func TestUserPermissions_HasAdminAccess_Ok(t *testing.T) {
 	}
 }
 
+func TestUserPermissions_GetUsername_Ok(t *testing.T) {
+	token := base64.StdEncoding.EncodeToString([]byte("user1:user1password"))
+	permissions := models.NewUserPermissions(map[string]map[string]struct{}{
+		token: {
+			"ns:namespace1": struct{}{},
+		},
+	})
+
+	authToken := permissions.ValidateAuthToken(token)
+	assert.NotNil(t, authToken)
+	assert.Equal(t, "user1", authToken.GetUsername())
+}
+
 func TestUserPermissions_HasAccess_Error(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -228,6 +314,16 @@ func TestUserPermissions_HasAccess_Error(t *testing.T) {
 				},
 			}),
 		},
+		{
+			name:      "TestUserPermissionsUserHasInvalidPermissionLevel",
+			token:     "token",
+			namespace: "namespace1",
+			permissions: models.NewUserPermissions(map[string]map[string]struct{}{
+				"token": {
+					"ns:namespace1:reader": struct{}{},
+				},
+			}),
+		},
 	}
 
 	for _, tt := range tests {
@@ -241,3 +337,147 @@ func TestUserPermissions_HasAccess_Error(t *testing.T) {
 		})
 	}
 }
+
+func TestLoad_HashedPasswords_Ok(t *testing.T) {
+	bcryptHash, err := bcrypt.GenerateFromPassword([]byte("user1password"), bcrypt.MinCost)
+	assert.Nil(t, err)
+	salt := []byte("somesalt")
+	argon2IDHash := fmt.Sprintf(
+		"$argon2id$v=%d$m=1024,t=1,p=1$%s$%s",
+		argon2.Version,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(argon2.IDKey([]byte("user2password"), salt, 1, 1024, 1, 32)),
+	)
+
+	tests := []struct {
+		name string
+		init func() string
+	}{
+		{
+			name: "TestLoadConfigurationWithYamlExtension",
+			init: func() string {
+				data, err := yaml.Marshal(YamlConfig{
+					Users: []YamlUserConfig{
+						{
+							Name:         "user1",
+							Roles:        []string{"ns:namespace1:viewer"},
+							PasswordHash: string(bcryptHash),
+						},
+						{
+							Name:         "user2",
+							Roles:        []string{"ns:namespace2", "admin"},
+							PasswordHash: argon2IDHash,
+						},
+					},
+				})
+				assert.Nil(t, err)
+
+				configPath := fmt.Sprintf("%s/configuration.yaml", t.TempDir())
+				assert.Nil(t, os.WriteFile(configPath, data, 0o600))
+				return configPath
+			},
+		},
+		{
+			name: "TestLoadConfigurationWithHtpasswdExtension",
+			init: func() string {
+				data := fmt.Sprintf(
+					"# fasttrackml users\nuser1:%s:ns:namespace1:viewer\n\nuser2:%s:ns:namespace2, admin\n",
+					bcryptHash, argon2IDHash,
+				)
+
+				configPath := fmt.Sprintf("%s/users.htpasswd", t.TempDir())
+				assert.Nil(t, os.WriteFile(configPath, []byte(data), 0o600))
+				return configPath
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userPermissions, err := Load(tt.init())
+			assert.Nil(t, err)
+			assert.Empty(t, userPermissions.GetData())
+
+			for i := 0; i < 2; i++ {
+				// the second attempt is served from the cache of verified auth tokens.
+				authToken := userPermissions.ValidateAuthToken(
+					base64.StdEncoding.EncodeToString([]byte("user1:user1password")),
+				)
+				assert.NotNil(t, authToken)
+				assert.Equal(t, "user1", authToken.GetUsername())
+				level, ok := authToken.GetNamespacePermissionLevel("namespace1")
+				assert.True(t, ok)
+				assert.Equal(t, models.PermissionLevelViewer, level)
+				assert.False(t, authToken.HasAdminAccess())
+			}
+
+			authToken := userPermissions.ValidateAuthToken(
+				base64.StdEncoding.EncodeToString([]byte("user2:user2password")),
+			)
+			assert.NotNil(t, authToken)
+			assert.Equal(t, "user2", authToken.GetUsername())
+			assert.True(t, authToken.HasUserAccess("namespace2"))
+			assert.True(t, authToken.HasAdminAccess())
+
+			for _, credentials := range []string{"user1:user2password", "user2:user1password", "user3:user1password"} {
+				assert.Nil(t, userPermissions.ValidateAuthToken(base64.StdEncoding.EncodeToString([]byte(credentials))))
+			}
+		})
+	}
+}
+
+func TestLoad_HashedPasswords_Error(t *testing.T) {
+	tests := []struct {
+		name     string
+		fileName string
+		data     string
+		error    string
+	}{
+		{
+			name:     "YamlUnsupportedHash",
+			fileName: "configuration.yaml",
+			data:     "users:\n  - name: user1\n    password_hash: $apr1$salt$hash\n",
+			error: "error parsing user configuration from yaml: " +
+				"error parsing password hash of user user1: must be bcrypt or argon2id hash",
+		},
+		{
+			name:     "YamlPasswordAndHash",
+			fileName: "configuration.yaml",
+			data: "users:\n  - name: user1\n    password: user1password\n" +
+				"    password_hash: $2y$10$YJbAbdGRJ4pJ5QmO2AlN4eOMaWvXuQ2h7lVImHFiX7n5xNdF/1xX6\n",
+			error: "error parsing user configuration from yaml: " +
+				"user user1 must have either password or password_hash, not both",
+		},
+		{
+			name:     "HtpasswdInvalidLine",
+			fileName: "users.htpasswd",
+			data:     "user1\n",
+			error:    "error parsing user configuration from htpasswd: error parsing line 1: must be `name:hash:roles`",
+		},
+		{
+			name:     "HtpasswdUnsupportedHash",
+			fileName: "users.htpasswd",
+			data:     "user1:{SHA}W6ph5Mm5Pz8GgiULbPgzG37mj9g=:ns:namespace1\n",
+			error: "error parsing user configuration from htpasswd: " +
+				"error parsing password hash of user user1: must be bcrypt or argon2id hash",
+		},
+		{
+			name:     "HtpasswdInvalidRole",
+			fileName: "users.htpasswd",
+			data:     "user1:$2y$10$YJbAbdGRJ4pJ5QmO2AlN4eOMaWvXuQ2h7lVImHFiX7n5xNdF/1xX6:ns:namespace1:reader\n",
+			error: "error parsing user configuration from htpasswd: error parsing role ns:namespace1:reader " +
+				"of user user1: must be `ns:<namespace code>[:viewer|editor|owner]`",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := fmt.Sprintf("%s/%s", t.TempDir(), tt.fileName)
+			assert.Nil(t, os.WriteFile(configPath, []byte(tt.data), 0o600))
+
+			_, err := Load(configPath)
+			assert.NotNil(t, err)
+			assert.Equal(t, tt.error, err.Error())
+		})
+	}
+}
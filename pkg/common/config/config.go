@@ -13,22 +13,42 @@ import (
 	"github.com/G-Research/fasttrackml/pkg/common/config/auth"
 )
 
+// MlflowArtifactsSchema is a schema of artifact locations served by the `mlflow-artifacts` proxy API.
+const MlflowArtifactsSchema = "mlflow-artifacts"
+
+// azureSchemas are schemas of Azure Blob Storage artifact locations.
+var azureSchemas = []string{"wasbs", "abfss"}
+
 // Config represents main service configuration.
 type Config struct {
-	Auth                  auth.Config
-	DevMode               bool
-	ListenAddress         string
-	DefaultArtifactRoot   string
-	S3EndpointURI         string
-	GSEndpointURI         string
-	DatabaseURI           string
-	DatabaseReset         bool
-	DatabasePoolMax       int
-	DatabaseMigrate       bool
-	DatabaseSlowThreshold time.Duration
-	LiveUpdatesEnabled    bool
-	RunLogOutputMax       int
-	RunLogOutputRetain    time.Duration
+	Auth                         auth.Config
+	DevMode                      bool
+	ListenAddress                string
+	DefaultArtifactRoot          string
+	ServeArtifacts               bool
+	ArtifactsDestination         string
+	ArtifactsArchiveMaxSize      int64
+	ArtifactsArchiveMaxFiles     int
+	ArtifactsStagingDirectory    string
+	ArtifactsStagingRetention    time.Duration
+	S3EndpointURI                string
+	GSEndpointURI                string
+	AzureEndpointURI             string
+	AzureStorageAccountKey       string
+	AzureStorageSASToken         string
+	AzureManagedIdentityClientID string
+	DatabaseURI                  string
+	DatabaseReset                bool
+	DatabasePoolMax              int
+	DatabaseMigrate              bool
+	DatabaseSlowThreshold        time.Duration
+	LiveUpdatesEnabled           bool
+	RunLogOutputMax              int
+	RunLogOutputRetain           time.Duration
+	GCInterval                   time.Duration
+	GCOlderThan                  time.Duration
+	AuditLogEnabled              bool
+	AuditLogRetention            time.Duration
 }
 
 // NewConfig creates a new instance of Config.
@@ -44,20 +64,38 @@ func NewConfig() *Config {
 			AuthOIDCClaimRoles:       viper.GetString("auth-oidc-claim-roles"),
 			AuthOIDCClientSecret:     viper.GetString("auth-oidc-client-secret"),
 			AuthOIDCProviderEndpoint: viper.GetString("auth-oidc-provider-endpoint"),
+			AuthJWTAudience:          viper.GetString("auth-jwt-audience"),
+			AuthJWTIssuer:            viper.GetString("auth-jwt-issuer"),
+			AuthJWTJWKSURL:           viper.GetString("auth-jwt-jwks-url"),
+			AuthJWTJWKSFile:          viper.GetString("auth-jwt-jwks-file"),
 		},
-		DevMode:               viper.GetBool("dev-mode"),
-		ListenAddress:         viper.GetString("listen-address"),
-		DefaultArtifactRoot:   viper.GetString("default-artifact-root"),
-		S3EndpointURI:         viper.GetString("s3-endpoint-uri"),
-		GSEndpointURI:         viper.GetString("gs-endpoint-uri"),
-		DatabaseURI:           viper.GetString("database-uri"),
-		DatabaseReset:         viper.GetBool("database-reset"),
-		DatabasePoolMax:       viper.GetInt("database-pool-max"),
-		DatabaseMigrate:       viper.GetBool("database-migrate"),
-		DatabaseSlowThreshold: viper.GetDuration("database-slow-threshold"),
-		LiveUpdatesEnabled:    viper.GetBool("live-updates-enabled"),
-		RunLogOutputMax:       viper.GetInt("log-output-max"),
-		RunLogOutputRetain:    viper.GetDuration("log-output-retention"),
+		DevMode:                      viper.GetBool("dev-mode"),
+		ListenAddress:                viper.GetString("listen-address"),
+		DefaultArtifactRoot:          viper.GetString("default-artifact-root"),
+		ServeArtifacts:               viper.GetBool("serve-artifacts"),
+		ArtifactsDestination:         viper.GetString("artifacts-destination"),
+		ArtifactsArchiveMaxSize:      viper.GetInt64("artifacts-archive-max-size"),
+		ArtifactsArchiveMaxFiles:     viper.GetInt("artifacts-archive-max-files"),
+		ArtifactsStagingDirectory:    viper.GetString("artifacts-staging-directory"),
+		ArtifactsStagingRetention:    viper.GetDuration("artifacts-staging-retention"),
+		S3EndpointURI:                viper.GetString("s3-endpoint-uri"),
+		GSEndpointURI:                viper.GetString("gs-endpoint-uri"),
+		AzureEndpointURI:             viper.GetString("azure-endpoint-uri"),
+		AzureStorageAccountKey:       viper.GetString("azure-storage-account-key"),
+		AzureStorageSASToken:         viper.GetString("azure-storage-sas-token"),
+		AzureManagedIdentityClientID: viper.GetString("azure-managed-identity-client-id"),
+		DatabaseURI:                  viper.GetString("database-uri"),
+		DatabaseReset:                viper.GetBool("database-reset"),
+		DatabasePoolMax:              viper.GetInt("database-pool-max"),
+		DatabaseMigrate:              viper.GetBool("database-migrate"),
+		DatabaseSlowThreshold:        viper.GetDuration("database-slow-threshold"),
+		LiveUpdatesEnabled:           viper.GetBool("live-updates-enabled"),
+		RunLogOutputMax:              viper.GetInt("log-output-max"),
+		RunLogOutputRetain:           viper.GetDuration("log-output-retention"),
+		GCInterval:                   viper.GetDuration("gc-interval"),
+		GCOlderThan:                  viper.GetDuration("gc-older-than"),
+		AuditLogEnabled:              viper.GetBool("audit-log-enabled"),
+		AuditLogRetention:            viper.GetDuration("audit-log-retention"),
 	}
 }
 
@@ -80,13 +118,38 @@ func (c *Config) validateConfiguration() error {
 		return eris.Wrap(err, "error parsing 'default-artifact-root' flag")
 	}
 
-	if parsed.User != nil || parsed.RawQuery != "" || parsed.RawFragment != "" {
+	if !isValidArtifactLocationFormat(parsed) {
 		return eris.New("incorrect format of 'default-artifact-root' flag")
 	}
 
-	if !slices.Contains([]string{"", "file", "s3", "gs"}, parsed.Scheme) {
+	supportedSchemas := append([]string{"", "file", "s3", "gs"}, azureSchemas...)
+	// `mlflow-artifacts` locations can be used only when artifacts are served by the proxy API.
+	if !slices.Contains(supportedSchemas, parsed.Scheme) &&
+		!(c.ServeArtifacts && parsed.Scheme == MlflowArtifactsSchema) {
 		return eris.New("unsupported schema of 'default-artifact-root' flag")
 	}
+	if parsed.Scheme == MlflowArtifactsSchema && c.ArtifactsDestination == "" {
+		return eris.New("'artifacts-destination' flag has to be provided together with proxied 'default-artifact-root'")
+	}
+
+	// 2. validate ArtifactsDestination configuration parameter for correctness and valid values.
+	if c.ArtifactsDestination != "" {
+		parsed, err := url.Parse(c.ArtifactsDestination)
+		if err != nil {
+			return eris.Wrap(err, "error parsing 'artifacts-destination' flag")
+		}
+		if !isValidArtifactLocationFormat(parsed) {
+			return eris.New("incorrect format of 'artifacts-destination' flag")
+		}
+		if !slices.Contains(supportedSchemas, parsed.Scheme) {
+			return eris.New("unsupported schema of 'artifacts-destination' flag")
+		}
+	}
+
+	// 3. validate Azure storage credentials.
+	if c.AzureStorageAccountKey != "" && c.AzureStorageSASToken != "" {
+		return eris.New("'azure-storage-account-key' and 'azure-storage-sas-token' flags can not be used together")
+	}
 
 	if err := c.Auth.ValidateConfiguration(); err != nil {
 		return eris.Wrap(err, "error validating auth configuration")
@@ -97,17 +160,26 @@ func (c *Config) validateConfiguration() error {
 
 // normalizeConfiguration normalizes service configuration parameters.
 func (c *Config) normalizeConfiguration() error {
-	parsed, err := url.Parse(c.DefaultArtifactRoot)
+	defaultArtifactRoot, err := normalizeArtifactLocation(c.DefaultArtifactRoot)
 	if err != nil {
-		return eris.Wrap(err, "error parsing 'default-artifact-root' flag")
+		return eris.Wrap(err, "error normalizing 'default-artifact-root' flag")
 	}
-	switch parsed.Scheme {
-	case "", "file":
-		absoluteArtifactRoot, err := filepath.Abs(path.Join(parsed.Host, parsed.Path))
+	c.DefaultArtifactRoot = defaultArtifactRoot
+
+	if c.ArtifactsDestination != "" {
+		artifactsDestination, err := normalizeArtifactLocation(c.ArtifactsDestination)
 		if err != nil {
-			return eris.Wrapf(err, "error getting absolute path for 'default-artifact-root': %s", c.DefaultArtifactRoot)
+			return eris.Wrap(err, "error normalizing 'artifacts-destination' flag")
 		}
-		c.DefaultArtifactRoot = "file://" + absoluteArtifactRoot
+		c.ArtifactsDestination = artifactsDestination
+	}
+
+	// when artifacts are served and no destination has been provided, then the proxy API
+	// stores artifacts under the default artifact root and clients are pointed to the proxy,
+	// the same way as `mlflow server --serve-artifacts` does.
+	if c.ServeArtifacts && c.ArtifactsDestination == "" {
+		c.ArtifactsDestination = c.DefaultArtifactRoot
+		c.DefaultArtifactRoot = MlflowArtifactsSchema + ":/"
 	}
 
 	if err := c.Auth.NormalizeConfiguration(); err != nil {
@@ -116,3 +188,33 @@ func (c *Config) normalizeConfiguration() error {
 
 	return nil
 }
+
+// isValidArtifactLocationFormat checks that artifact location has neither query nor fragment.
+// User info is allowed only for Azure locations, where it holds the container name.
+func isValidArtifactLocationFormat(parsed *url.URL) bool {
+	if parsed.RawQuery != "" || parsed.RawFragment != "" {
+		return false
+	}
+	if parsed.User != nil {
+		_, hasPassword := parsed.User.Password()
+		return slices.Contains(azureSchemas, parsed.Scheme) && !hasPassword
+	}
+	return true
+}
+
+// normalizeArtifactLocation converts local artifact location into absolute `file://` location.
+func normalizeArtifactLocation(location string) (string, error) {
+	parsed, err := url.Parse(location)
+	if err != nil {
+		return "", eris.Wrapf(err, "error parsing artifact location: %s", location)
+	}
+	switch parsed.Scheme {
+	case "", "file":
+		absoluteLocation, err := filepath.Abs(path.Join(parsed.Host, parsed.Path))
+		if err != nil {
+			return "", eris.Wrapf(err, "error getting absolute path for artifact location: %s", location)
+		}
+		return "file://" + absoluteLocation, nil
+	}
+	return location, nil
+}
@@ -17,6 +17,8 @@ type EventListenerProvider interface {
 	Listen()
 	// Subscribe subscribe to particular channel.
 	Subscribe(subscriber chan<- string)
+	// Notify sends event to the listeners of the channel.
+	Notify(ctx context.Context, payload string) error
 	// GetChannelName returns channel name.
 	GetChannelName() string
 }
@@ -25,6 +27,7 @@ type EventListenerProvider interface {
 type EventListener struct {
 	mu            sync.Mutex
 	ctx           context.Context
+	db            *gorm.DB
 	channel       string
 	connection    *stdlib.Conn
 	subscriptions map[string][]chan<- string
@@ -34,6 +37,7 @@ type EventListener struct {
 func NewEventListener(ctx context.Context, db *gorm.DB, channel string) (*EventListener, error) {
 	eventListener := EventListener{
 		ctx:           ctx,
+		db:            db,
 		channel:       channel,
 		subscriptions: make(map[string][]chan<- string),
 	}
@@ -76,6 +80,11 @@ func NewNamespaceListener(ctx context.Context, db *gorm.DB) (*EventListener, err
 	return NewEventListener(ctx, db, "namespace_update_events")
 }
 
+// NewRunListener creates new database event listener for Run entity.
+func NewRunListener(ctx context.Context, db *gorm.DB) (*EventListener, error) {
+	return NewEventListener(ctx, db, "run_update_events")
+}
+
 // Listen listens for incoming database events.
 func (el *EventListener) Listen() {
 	// if listener not nil, then listen for incoming events from database.
@@ -113,6 +122,25 @@ func (el *EventListener) Subscribe(subscriber chan<- string) {
 	}
 }
 
+// Notify sends event to the listeners of the channel.
+// Postgres delivers the event to the listeners of all the instances, for other databases the event is
+// broadcast in process to the subscribers of the current instance.
+func (el *EventListener) Notify(ctx context.Context, payload string) error {
+	if el.connection != nil {
+		if err := el.db.WithContext(ctx).Exec("SELECT pg_notify(?, ?)", el.channel, payload).Error; err != nil {
+			return eris.Wrap(err, "error triggering 'pg_notify'")
+		}
+		return nil
+	}
+
+	el.mu.Lock()
+	defer el.mu.Unlock()
+	for _, ch := range el.subscriptions[el.channel] {
+		ch <- payload
+	}
+	return nil
+}
+
 // GetChannelName returns current channel name.
 func (el *EventListener) GetChannelName() string {
 	return el.channel
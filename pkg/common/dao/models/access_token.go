@@ -0,0 +1,54 @@
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"slices"
+	"time"
+
+	"github.com/G-Research/fasttrackml/pkg/common/dao/types"
+)
+
+// AccessTokenPrefix is a prefix of each generated access token, so tokens are easy to recognise.
+const AccessTokenPrefix = "ftml_"
+
+// AccessToken represents model to work with `access_tokens` table.
+type AccessToken struct {
+	Base
+	Name       string           `gorm:"not null"`
+	TokenHash  string           `gorm:"type:varchar(64);unique;index;not null"`
+	Roles      types.StringList `gorm:"type:text;not null"`
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+}
+
+// HasAdminAccess makes check that token has admin permissions to access to the requested resource.
+func (t AccessToken) HasAdminAccess() bool {
+	return slices.Contains(t.Roles, "admin")
+}
+
+// HasUserAccess makes check that token has permission to access to the requested namespace.
+func (t AccessToken) HasUserAccess(namespace string) bool {
+	_, ok := getNamespacePermissionLevel(t.Roles, namespace)
+	return ok
+}
+
+// GetNamespacePermissionLevel returns the permission level which token has to the requested namespace.
+// Admin token has PermissionLevelOwner to all the namespaces.
+func (t AccessToken) GetNamespacePermissionLevel(namespace string) (PermissionLevel, bool) {
+	if t.HasAdminAccess() {
+		return PermissionLevelOwner, true
+	}
+	return getNamespacePermissionLevel(t.Roles, namespace)
+}
+
+// IsExpired makes check that token is already expired at the given moment.
+func (t AccessToken) IsExpired(now time.Time) bool {
+	return t.ExpiresAt != nil && !now.Before(*t.ExpiresAt)
+}
+
+// HashAccessToken returns hash of raw access token, which is the only form tokens are stored in.
+func HashAccessToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,50 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/common/dao/types"
+)
+
+// Supported types of the actor who performed audited request.
+const (
+	AuditLogActorTypeAnonymous   = "anonymous"
+	AuditLogActorTypeBasic       = "basic"
+	AuditLogActorTypeOIDC        = "oidc"
+	AuditLogActorTypeAccessToken = "token"
+	AuditLogActorTypeJWT         = "jwt"
+)
+
+// AuditLog represents model to work with `audit_logs` table.
+type AuditLog struct {
+	ID            uuid.UUID        `gorm:"type:uuid;primaryKey"`
+	CreatedAt     time.Time        `gorm:"not null;index"`
+	ActorType     string           `gorm:"type:varchar(16);not null"`
+	Actor         string           `gorm:"not null;index"`
+	NamespaceCode string           `gorm:"index"`
+	Method        string           `gorm:"type:varchar(16);not null"`
+	Action        string           `gorm:"not null;index"`
+	Path          string           `gorm:"not null"`
+	TargetIDs     types.StringList `gorm:"type:text;not null"`
+	StatusCode    int              `gorm:"not null"`
+}
+
+// BeforeCreate triggers by GORM before create.
+func (l *AuditLog) BeforeCreate(tx *gorm.DB) error {
+	l.ID = uuid.New()
+	return nil
+}
+
+// AuditLogFilter represents filter to search audit log records.
+type AuditLogFilter struct {
+	Actor         string
+	NamespaceCode string
+	Action        string
+	TargetID      string
+	From          *time.Time
+	To            *time.Time
+	Limit         int
+}
@@ -0,0 +1,114 @@
+package models
+
+import (
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/rotisserie/eris"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// supported prefixes of password hashes.
+const (
+	passwordHashPrefixArgon2ID = "$argon2id$"
+)
+
+// passwordHashPrefixesBcrypt contains all the prefixes produced by bcrypt implementations,
+// e.g. `htpasswd -B` generates `$2y$` hashes.
+var passwordHashPrefixesBcrypt = []string{"$2a$", "$2b$", "$2y$"}
+
+// argon2IDHash represents parsed `$argon2id$v=19$m=65536,t=3,p=4$<salt>$<key>` hash.
+type argon2IDHash struct {
+	memory  uint32
+	time    uint32
+	threads uint8
+	salt    []byte
+	key     []byte
+}
+
+// IsPasswordHash makes check that value is a supported bcrypt or argon2id password hash.
+func IsPasswordHash(value string) bool {
+	if isBcryptPasswordHash(value) {
+		_, err := bcrypt.Cost([]byte(value))
+		return err == nil
+	}
+	if strings.HasPrefix(value, passwordHashPrefixArgon2ID) {
+		_, err := parseArgon2IDHash(value)
+		return err == nil
+	}
+	return false
+}
+
+// VerifyPasswordHash makes check that password matches bcrypt or argon2id password hash.
+func VerifyPasswordHash(hash, password string) bool {
+	if isBcryptPasswordHash(hash) {
+		return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+	}
+	if strings.HasPrefix(hash, passwordHashPrefixArgon2ID) {
+		parsedHash, err := parseArgon2IDHash(hash)
+		if err != nil {
+			return false
+		}
+		key := argon2.IDKey(
+			[]byte(password),
+			parsedHash.salt,
+			parsedHash.time,
+			parsedHash.memory,
+			parsedHash.threads,
+			uint32(len(parsedHash.key)),
+		)
+		return subtle.ConstantTimeCompare(key, parsedHash.key) == 1
+	}
+	return false
+}
+
+// isBcryptPasswordHash makes check that hash has one of bcrypt prefixes.
+func isBcryptPasswordHash(hash string) bool {
+	for _, prefix := range passwordHashPrefixesBcrypt {
+		if strings.HasPrefix(hash, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseArgon2IDHash parses argon2id hash in PHC string format.
+func parseArgon2IDHash(hash string) (*argon2IDHash, error) {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 {
+		return nil, eris.New("argon2id hash has invalid format")
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return nil, eris.Wrap(err, "argon2id hash has invalid version")
+	}
+	if version != argon2.Version {
+		return nil, eris.Errorf("argon2id hash has unsupported version: %d", version)
+	}
+
+	parsedHash := argon2IDHash{}
+	if _, err := fmt.Sscanf(
+		parts[3], "m=%d,t=%d,p=%d", &parsedHash.memory, &parsedHash.time, &parsedHash.threads,
+	); err != nil {
+		return nil, eris.Wrap(err, "argon2id hash has invalid parameters")
+	}
+	if parsedHash.time == 0 || parsedHash.threads == 0 {
+		return nil, eris.New("argon2id hash has invalid parameters")
+	}
+
+	var err error
+	if parsedHash.salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		return nil, eris.Wrap(err, "argon2id hash has invalid salt")
+	}
+	if parsedHash.key, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil {
+		return nil, eris.Wrap(err, "argon2id hash has invalid key")
+	}
+	if len(parsedHash.key) == 0 {
+		return nil, eris.New("argon2id hash has empty key")
+	}
+	return &parsedHash, nil
+}
@@ -0,0 +1,73 @@
+package models
+
+import "strings"
+
+// PermissionLevel represents the level of access to a namespace.
+type PermissionLevel string
+
+// Supported permission levels, from the lowest to the highest one.
+const (
+	// PermissionLevelViewer allows only to read namespace resources.
+	PermissionLevelViewer PermissionLevel = "viewer"
+	// PermissionLevelEditor additionally allows to create and update namespace resources.
+	PermissionLevelEditor PermissionLevel = "editor"
+	// PermissionLevelOwner additionally allows to delete namespace resources.
+	PermissionLevelOwner PermissionLevel = "owner"
+)
+
+// permissionLevelRanks defines the order of permission levels.
+var permissionLevelRanks = map[PermissionLevel]int{
+	PermissionLevelViewer: 1,
+	PermissionLevelEditor: 2,
+	PermissionLevelOwner:  3,
+}
+
+// IsValid makes check that permission level is one of the supported levels.
+func (l PermissionLevel) IsValid() bool {
+	_, ok := permissionLevelRanks[l]
+	return ok
+}
+
+// Allows makes check that current permission level is enough to satisfy the required one.
+func (l PermissionLevel) Allows(required PermissionLevel) bool {
+	return permissionLevelRanks[l] >= permissionLevelRanks[required]
+}
+
+// Max returns the highest of two permission levels.
+func (l PermissionLevel) Max(other PermissionLevel) PermissionLevel {
+	if permissionLevelRanks[other] > permissionLevelRanks[l] {
+		return other
+	}
+	return l
+}
+
+// ParseNamespaceRole parses namespace role in `ns:<code>` or `ns:<code>:<level>` format.
+// Role without explicit level grants PermissionLevelOwner, as it did before permission levels were introduced.
+func ParseNamespaceRole(role string) (string, PermissionLevel, bool) {
+	role, ok := strings.CutPrefix(role, "ns:")
+	if !ok {
+		return "", "", false
+	}
+	code, level, hasLevel := strings.Cut(role, ":")
+	if code == "" {
+		return "", "", false
+	}
+	if !hasLevel {
+		return code, PermissionLevelOwner, true
+	}
+	if !PermissionLevel(level).IsValid() {
+		return "", "", false
+	}
+	return code, PermissionLevel(level), true
+}
+
+// getNamespacePermissionLevel returns the highest permission level which the roles grant to the namespace.
+func getNamespacePermissionLevel(roles []string, namespace string) (PermissionLevel, bool) {
+	var result PermissionLevel
+	for _, role := range roles {
+		if code, level, ok := ParseNamespaceRole(role); ok && code == namespace {
+			result = result.Max(level)
+		}
+	}
+	return result, result != ""
+}
@@ -1,10 +1,16 @@
 package models
 
-import "fmt"
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"strings"
+	"sync"
+)
 
 // BasicAuthToken represents object to store auth information related to Basic Auth.
 type BasicAuthToken struct {
-	roles map[string]struct{}
+	username string
+	roles    map[string]struct{}
 }
 
 // HasAdminAccess makes check that user has admin permissions to access to the requested resource.
@@ -17,10 +23,31 @@ func (p BasicAuthToken) HasAdminAccess() bool {
 
 // HasUserAccess makes check that user has permission to access to the requested namespace.
 func (p BasicAuthToken) HasUserAccess(namespace string) bool {
-	if _, ok := p.roles[fmt.Sprintf("ns:%s", namespace)]; !ok {
-		return ok
+	_, ok := getNamespacePermissionLevel(p.getRoleNames(), namespace)
+	return ok
+}
+
+// GetNamespacePermissionLevel returns the permission level which user has to the requested namespace.
+// Admin has PermissionLevelOwner to all the namespaces.
+func (p BasicAuthToken) GetNamespacePermissionLevel(namespace string) (PermissionLevel, bool) {
+	if p.HasAdminAccess() {
+		return PermissionLevelOwner, true
+	}
+	return getNamespacePermissionLevel(p.getRoleNames(), namespace)
+}
+
+// getRoleNames returns User roles assigned to current Auth token as a list.
+func (p BasicAuthToken) getRoleNames() []string {
+	roles := make([]string, 0, len(p.roles))
+	for role := range p.roles {
+		roles = append(roles, role)
 	}
-	return true
+	return roles
+}
+
+// GetUsername returns the name of the user who owns current Auth token.
+func (p BasicAuthToken) GetUsername() string {
+	return p.username
 }
 
 // GetRoles returns User roles assigned to current Auth token.
@@ -28,35 +55,101 @@ func (p BasicAuthToken) GetRoles() map[string]struct{} {
 	return p.roles
 }
 
+// HashedUser represents user whose password is stored as bcrypt or argon2id hash.
+type HashedUser struct {
+	PasswordHash string
+	Roles        map[string]struct{}
+}
+
 // UserPermissions represents model to store user permissions data.
 type UserPermissions struct {
-	data map[string]map[string]struct{}
+	mu    sync.RWMutex
+	data  map[string]map[string]struct{}
+	users map[string]HashedUser
+	// verified caches auth tokens which have been already verified against password hashes,
+	// because hash verification is intentionally slow. keys are sha256 sums of auth tokens.
+	verified map[[sha256.Size]byte]string
 }
 
 // NewUserPermissions creates new instance of UserPermissions object.
 func NewUserPermissions(data map[string]map[string]struct{}) *UserPermissions {
+	return NewUserPermissionsWithHashedUsers(data, map[string]HashedUser{})
+}
+
+// NewUserPermissionsWithHashedUsers creates new instance of UserPermissions object,
+// which additionally contains users with hashed passwords.
+func NewUserPermissionsWithHashedUsers(
+	data map[string]map[string]struct{}, users map[string]HashedUser,
+) *UserPermissions {
 	return &UserPermissions{
-		data: data,
+		data:     data,
+		users:    users,
+		verified: map[[sha256.Size]byte]string{},
 	}
 }
 
 // GetData returns current permissions data.
-func (p UserPermissions) GetData() map[string]map[string]struct{} {
+func (p *UserPermissions) GetData() map[string]map[string]struct{} {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
 	return p.data
 }
 
+// GetHashedUsers returns current users with hashed passwords.
+func (p *UserPermissions) GetHashedUsers() map[string]HashedUser {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.users
+}
+
+// Replace replaces current permissions data with the data of another UserPermissions object.
+// It is used to reload users configuration without restart.
+func (p *UserPermissions) Replace(permissions *UserPermissions) {
+	data, users := permissions.GetData(), permissions.GetHashedUsers()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.data = data
+	p.users = users
+	p.verified = map[[sha256.Size]byte]string{}
+}
+
 // ValidateAuthToken makes basic validation of auth token.
-func (p UserPermissions) ValidateAuthToken(authToken string) *BasicAuthToken {
+func (p *UserPermissions) ValidateAuthToken(authToken string) *BasicAuthToken {
 	if authToken == "" {
 		return nil
 	}
 
+	// auth token is base64 encoded `name:password` pair, so the user name could be restored from it.
+	username, password := "", ""
+	if credentials, err := base64.StdEncoding.DecodeString(authToken); err == nil {
+		username, password, _ = strings.Cut(string(credentials), ":")
+	}
+
+	p.mu.RLock()
 	roles, ok := p.data[authToken]
-	if !ok {
+	user, hashed := p.users[username]
+	verifiedUsername, verified := p.verified[sha256.Sum256([]byte(authToken))]
+	p.mu.RUnlock()
+
+	switch {
+	case ok:
+	case hashed && verified && verifiedUsername == username:
+		roles = user.Roles
+	case hashed && VerifyPasswordHash(user.PasswordHash, password):
+		p.mu.Lock()
+		// users could be reloaded during verification, so cache only still actual result.
+		if current, ok := p.users[username]; ok && current.PasswordHash == user.PasswordHash {
+			p.verified[sha256.Sum256([]byte(authToken))] = username
+		}
+		p.mu.Unlock()
+		roles = user.Roles
+	default:
 		return nil
 	}
 
 	return &BasicAuthToken{
-		roles: roles,
+		username: username,
+		roles:    roles,
 	}
 }
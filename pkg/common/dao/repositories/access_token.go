@@ -0,0 +1,99 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/common/dao/models"
+)
+
+// AccessTokenRepositoryProvider provides an interface to work with `access_token` entity.
+type AccessTokenRepositoryProvider interface {
+	// Create creates new models.AccessToken entity.
+	Create(ctx context.Context, token *models.AccessToken) error
+	// GetByID returns models.AccessToken entity by its ID.
+	GetByID(ctx context.Context, id string) (*models.AccessToken, error)
+	// GetByTokenHash returns models.AccessToken entity by hash of the raw token.
+	GetByTokenHash(ctx context.Context, tokenHash string) (*models.AccessToken, error)
+	// List returns all the existing models.AccessToken entities.
+	List(ctx context.Context) ([]models.AccessToken, error)
+	// UpdateLastUsedAt updates the moment when models.AccessToken entity was used last time.
+	UpdateLastUsedAt(ctx context.Context, token *models.AccessToken, lastUsedAt time.Time) error
+	// Delete deletes existing models.AccessToken entity.
+	Delete(ctx context.Context, token *models.AccessToken) error
+}
+
+// AccessTokenRepository repository to work with `access_token` entity.
+type AccessTokenRepository struct {
+	db *gorm.DB
+}
+
+// NewAccessTokenRepository creates a new instance of repository to work with `access_token` entity.
+func NewAccessTokenRepository(db *gorm.DB) *AccessTokenRepository {
+	return &AccessTokenRepository{
+		db: db,
+	}
+}
+
+// Create creates new models.AccessToken entity.
+func (r AccessTokenRepository) Create(ctx context.Context, token *models.AccessToken) error {
+	if err := r.db.WithContext(ctx).Create(token).Error; err != nil {
+		return eris.Wrap(err, "error creating access token entity")
+	}
+	return nil
+}
+
+// GetByID returns models.AccessToken entity by its ID.
+func (r AccessTokenRepository) GetByID(ctx context.Context, id string) (*models.AccessToken, error) {
+	var token models.AccessToken
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&token).Error; err != nil {
+		if eris.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, eris.Wrapf(err, "error getting access token by id: %s", id)
+	}
+	return &token, nil
+}
+
+// GetByTokenHash returns models.AccessToken entity by hash of the raw token.
+func (r AccessTokenRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*models.AccessToken, error) {
+	var token models.AccessToken
+	if err := r.db.WithContext(ctx).Where("token_hash = ?", tokenHash).First(&token).Error; err != nil {
+		if eris.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, eris.Wrap(err, "error getting access token by hash")
+	}
+	return &token, nil
+}
+
+// List returns all the existing models.AccessToken entities.
+func (r AccessTokenRepository) List(ctx context.Context) ([]models.AccessToken, error) {
+	var tokens []models.AccessToken
+	if err := r.db.WithContext(ctx).Order("created_at").Find(&tokens).Error; err != nil {
+		return nil, eris.Wrap(err, "error listing access tokens")
+	}
+	return tokens, nil
+}
+
+// UpdateLastUsedAt updates the moment when models.AccessToken entity was used last time.
+func (r AccessTokenRepository) UpdateLastUsedAt(
+	ctx context.Context, token *models.AccessToken, lastUsedAt time.Time,
+) error {
+	// use UpdateColumn to not touch `updated_at`, so it still reflects the last change of the token itself.
+	if err := r.db.WithContext(ctx).Model(token).UpdateColumn("last_used_at", lastUsedAt).Error; err != nil {
+		return eris.Wrapf(err, "error updating last used time of access token with id: %s", token.ID)
+	}
+	return nil
+}
+
+// Delete deletes existing models.AccessToken entity.
+func (r AccessTokenRepository) Delete(ctx context.Context, token *models.AccessToken) error {
+	if err := r.db.WithContext(ctx).Delete(token).Error; err != nil {
+		return eris.Wrapf(err, "error deleting access token with id: %s", token.ID)
+	}
+	return nil
+}
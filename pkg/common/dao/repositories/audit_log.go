@@ -0,0 +1,84 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/common/dao/models"
+)
+
+// AuditLogRepositoryProvider provides an interface to work with `audit_log` entity.
+type AuditLogRepositoryProvider interface {
+	// Create creates new models.AuditLog entity.
+	Create(ctx context.Context, auditLog *models.AuditLog) error
+	// Search returns models.AuditLog entities which satisfy the filter, the most recent first.
+	Search(ctx context.Context, filter *models.AuditLogFilter) ([]models.AuditLog, error)
+	// DeleteOlderThan deletes models.AuditLog entities created before provided moment.
+	DeleteOlderThan(ctx context.Context, moment time.Time) (int64, error)
+}
+
+// AuditLogRepository repository to work with `audit_log` entity.
+type AuditLogRepository struct {
+	db *gorm.DB
+}
+
+// NewAuditLogRepository creates a new instance of repository to work with `audit_log` entity.
+func NewAuditLogRepository(db *gorm.DB) *AuditLogRepository {
+	return &AuditLogRepository{
+		db: db,
+	}
+}
+
+// Create creates new models.AuditLog entity.
+func (r AuditLogRepository) Create(ctx context.Context, auditLog *models.AuditLog) error {
+	if err := r.db.WithContext(ctx).Create(auditLog).Error; err != nil {
+		return eris.Wrap(err, "error creating audit log entity")
+	}
+	return nil
+}
+
+// Search returns models.AuditLog entities which satisfy the filter, the most recent first.
+func (r AuditLogRepository) Search(
+	ctx context.Context, filter *models.AuditLogFilter,
+) ([]models.AuditLog, error) {
+	query := r.db.WithContext(ctx).Order("created_at DESC")
+	if filter.Actor != "" {
+		query = query.Where("actor = ?", filter.Actor)
+	}
+	if filter.NamespaceCode != "" {
+		query = query.Where("namespace_code = ?", filter.NamespaceCode)
+	}
+	if filter.Action != "" {
+		query = query.Where("action LIKE ?", "%"+filter.Action+"%")
+	}
+	if filter.TargetID != "" {
+		query = query.Where("target_ids LIKE ?", "%"+filter.TargetID+"%")
+	}
+	if filter.From != nil {
+		query = query.Where("created_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("created_at < ?", *filter.To)
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+
+	var auditLogs []models.AuditLog
+	if err := query.Find(&auditLogs).Error; err != nil {
+		return nil, eris.Wrap(err, "error searching audit logs")
+	}
+	return auditLogs, nil
+}
+
+// DeleteOlderThan deletes models.AuditLog entities created before provided moment.
+func (r AuditLogRepository) DeleteOlderThan(ctx context.Context, moment time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).Where("created_at < ?", moment).Delete(&models.AuditLog{})
+	if result.Error != nil {
+		return 0, eris.Wrap(result.Error, "error deleting expired audit logs")
+	}
+	return result.RowsAffected, nil
+}
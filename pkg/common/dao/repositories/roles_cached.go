@@ -3,28 +3,47 @@ package repositories
 import (
 	"context"
 	"encoding/json"
-	"slices"
 
+	"github.com/google/uuid"
 	lru "github.com/hashicorp/golang-lru/v2"
 	"github.com/rotisserie/eris"
 	log "github.com/sirupsen/logrus"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 	"github.com/G-Research/fasttrackml/pkg/common/dao"
+	commonModels "github.com/G-Research/fasttrackml/pkg/common/dao/models"
 	"github.com/G-Research/fasttrackml/pkg/common/events"
 )
 
 // RoleRepositoryProvider provides an interface to work with `role` entity.
 type RoleRepositoryProvider interface {
-	// ValidateRolesAccessToNamespace makes validation that requested roles has access to requested namespace.
-	ValidateRolesAccessToNamespace(ctx context.Context, roles []string, namespaceCode string) (bool, error)
+	// GetNamespacePermissionLevel returns the highest permission level which requested roles have
+	// to requested namespace. Empty level means that roles have no access to the namespace at all.
+	GetNamespacePermissionLevel(
+		ctx context.Context, roles []string, namespaceCode string,
+	) (commonModels.PermissionLevel, error)
+	// List returns all the existing roles.
+	List(ctx context.Context) ([]models.Role, error)
+	// GetByID returns role by its ID.
+	GetByID(ctx context.Context, id uuid.UUID) (*models.Role, error)
+	// Create creates new role.
+	Create(ctx context.Context, role *models.Role) error
+	// Delete deletes existing role together with all its namespace relations.
+	Delete(ctx context.Context, role *models.Role) error
+	// ListRoleNamespaces returns all the existing relations between roles and namespaces.
+	ListRoleNamespaces(ctx context.Context) ([]models.RoleNamespace, error)
+	// SaveRoleNamespace creates or updates relation between role and namespace.
+	SaveRoleNamespace(ctx context.Context, roleNamespace *models.RoleNamespace) error
+	// DeleteRoleNamespace deletes relation between role and namespace.
+	DeleteRoleNamespace(ctx context.Context, roleID uuid.UUID, namespaceID uint) error
 }
 
 // RoleCachedRepository cached repository to work with `role` entity.
 type RoleCachedRepository struct {
 	db                     *gorm.DB
-	cache                  *lru.Cache[string, []string]
+	cache                  *lru.Cache[string, map[string]commonModels.PermissionLevel]
 	namespaceEventListener dao.EventListenerProvider
 }
 
@@ -32,7 +51,7 @@ type RoleCachedRepository struct {
 func NewRoleCachedRepository(
 	ctx context.Context, db *gorm.DB, namespaceEventListener dao.EventListenerProvider,
 ) (*RoleCachedRepository, error) {
-	cache, err := lru.New[string, []string](1000)
+	cache, err := lru.New[string, map[string]commonModels.PermissionLevel](1000)
 	if err != nil {
 		return nil, eris.Wrap(err, "error creating lru cache for roles entities")
 	}
@@ -64,55 +83,131 @@ func NewRoleCachedRepository(
 	return &repository, nil
 }
 
-// ValidateRolesAccessToNamespace makes validation that requested roles have access to requested namespace.
-func (r RoleCachedRepository) ValidateRolesAccessToNamespace(
+// GetNamespacePermissionLevel returns the highest permission level which requested roles have
+// to requested namespace. Empty level means that roles have no access to the namespace at all.
+func (r RoleCachedRepository) GetNamespacePermissionLevel(
 	ctx context.Context, requestedRoles []string, requestedNamespaceCode string,
-) (bool, error) {
-	// if namespace already exists in cache, check permissions immediately.
+) (commonModels.PermissionLevel, error) {
+	// if namespace doesn't exist in cache, then check database and store result in cache.
 	namespaceRoles, ok := r.cache.Get(requestedNamespaceCode)
-	if ok {
-		for _, requestedRole := range requestedRoles {
-			if slices.Contains(namespaceRoles, requestedRole) {
-				return true, nil
-			}
+	if !ok {
+		var data []models.RoleNamespace
+		if err := r.db.WithContext(ctx).Model(
+			&models.RoleNamespace{},
+		).Joins(
+			"Role",
+			r.db.Select("name"),
+		).InnerJoins(
+			"Namespace",
+			r.db.Select(
+				"code",
+			).Where(
+				&models.Namespace{Code: requestedNamespaceCode},
+			),
+		).Find(&data).Error; err != nil {
+			return "", eris.Wrapf(err, "error getting roles for namespace with code: %s", requestedNamespaceCode)
+		}
+
+		namespaceRoles = make(map[string]commonModels.PermissionLevel, len(data))
+		for _, namespaceRole := range data {
+			namespaceRoles[namespaceRole.Role.Name] = namespaceRole.Level
+		}
+
+		// save into cache.
+		r.cache.Add(requestedNamespaceCode, namespaceRoles)
+	}
+
+	var level commonModels.PermissionLevel
+	for _, requestedRole := range requestedRoles {
+		if roleLevel, ok := namespaceRoles[requestedRole]; ok {
+			level = level.Max(roleLevel)
+		}
+	}
+	return level, nil
+}
+
+// List returns all the existing roles.
+func (r RoleCachedRepository) List(ctx context.Context) ([]models.Role, error) {
+	var roles []models.Role
+	if err := r.db.WithContext(ctx).Order("name").Find(&roles).Error; err != nil {
+		return nil, eris.Wrap(err, "error listing roles")
+	}
+	return roles, nil
+}
+
+// GetByID returns role by its ID.
+func (r RoleCachedRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Role, error) {
+	var role models.Role
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&role).Error; err != nil {
+		if eris.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, eris.Wrapf(err, "error getting role by id: %s", id)
+	}
+	return &role, nil
+}
+
+// Create creates new role.
+func (r RoleCachedRepository) Create(ctx context.Context, role *models.Role) error {
+	if err := r.db.WithContext(ctx).Create(role).Error; err != nil {
+		return eris.Wrap(err, "error creating role entity")
+	}
+	return nil
+}
+
+// Delete deletes existing role together with all its namespace relations.
+func (r RoleCachedRepository) Delete(ctx context.Context, role *models.Role) error {
+	if err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("role_id = ?", role.ID).Delete(&models.RoleNamespace{}).Error; err != nil {
+			return eris.Wrapf(err, "error deleting namespace relations of role with id: %s", role.ID)
+		}
+		if err := tx.Delete(role).Error; err != nil {
+			return eris.Wrapf(err, "error deleting role with id: %s", role.ID)
 		}
-		return false, nil
+		return nil
+	}); err != nil {
+		return err
 	}
+	r.cache.Purge()
+	return nil
+}
 
-	// otherwise, check database and store result in cache.
+// ListRoleNamespaces returns all the existing relations between roles and namespaces.
+func (r RoleCachedRepository) ListRoleNamespaces(ctx context.Context) ([]models.RoleNamespace, error) {
 	var data []models.RoleNamespace
-	if err := r.db.WithContext(ctx).Model(
-		&models.RoleNamespace{},
-	).Joins(
+	if err := r.db.WithContext(ctx).Joins(
 		"Role",
-		r.db.Select("name"),
 	).InnerJoins(
 		"Namespace",
-		r.db.Select(
-			"code",
-		).Where(
-			&models.Namespace{Code: requestedNamespaceCode},
-		),
+	).Order(
+		"\"Namespace\".\"code\"",
 	).Find(&data).Error; err != nil {
-		return false, eris.Wrapf(err, "error getting roles for namespace with code: %s", requestedNamespaceCode)
+		return nil, eris.Wrap(err, "error listing relations between roles and namespaces")
 	}
+	return data, nil
+}
 
-	namespaceRoles = make([]string, len(data))
-	for i, namespaceRole := range data {
-		namespaceRoles[i] = namespaceRole.Role.Name
+// SaveRoleNamespace creates or updates relation between role and namespace.
+func (r RoleCachedRepository) SaveRoleNamespace(ctx context.Context, roleNamespace *models.RoleNamespace) error {
+	if err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "role_id"}, {Name: "namespace_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"level", "updated_at"}),
+	}).Omit("Role", "Namespace").Create(roleNamespace).Error; err != nil {
+		return eris.Wrap(err, "error saving relation between role and namespace")
 	}
+	r.cache.Purge()
+	return nil
+}
 
-	// save into cache.
-	r.cache.Add(requestedNamespaceCode, namespaceRoles)
-
-	// check permissions from a database.
-	for _, requestedRole := range requestedRoles {
-		if slices.Contains(namespaceRoles, requestedRole) {
-			return true, nil
-		}
+// DeleteRoleNamespace deletes relation between role and namespace.
+func (r RoleCachedRepository) DeleteRoleNamespace(ctx context.Context, roleID uuid.UUID, namespaceID uint) error {
+	if err := r.db.WithContext(ctx).Where(
+		"role_id = ? AND namespace_id = ?", roleID, namespaceID,
+	).Delete(&models.RoleNamespace{}).Error; err != nil {
+		return eris.Wrap(err, "error deleting relation between role and namespace")
 	}
-
-	return false, nil
+	r.cache.Purge()
+	return nil
 }
 
 // processEvent process incoming event from database.
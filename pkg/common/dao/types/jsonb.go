@@ -1,11 +1,13 @@
 package types
 
 import (
+	"bytes"
 	"context"
 	"database/sql/driver"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -64,6 +66,23 @@ func (j JSONB) String() string {
 	return string(j)
 }
 
+// Normalize returns compact json with sorted keys, so the representation doesn't depend on the database.
+func (j JSONB) Normalize() (string, error) {
+	var value any
+	decoder := json.NewDecoder(bytes.NewReader(j))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return "", err
+	}
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(value); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(buffer.String(), "\n"), nil
+}
+
 // GormDataType gorm common data type
 func (JSONB) GormDataType() string {
 	return "json"
@@ -0,0 +1,35 @@
+package events
+
+import "encoding/json"
+
+// RunEventType represents type of run event.
+type RunEventType string
+
+// Supported run event types.
+const (
+	RunEventTypeMetrics = "metrics"
+	RunEventTypeStatus  = "status"
+	RunEventTypeLog     = "log"
+)
+
+// RunEvent represents database event, which notifies about new data of the run.
+type RunEvent struct {
+	Type        RunEventType     `json:"type"`
+	NamespaceID uint             `json:"namespace_id"`
+	RunID       string           `json:"run_id"`
+	Status      string           `json:"status,omitempty"`
+	Metrics     []RunEventMetric `json:"metrics,omitempty"`
+	Log         string           `json:"log,omitempty"`
+	Timestamp   int64            `json:"timestamp,omitempty"`
+}
+
+// RunEventMetric represents a single metric point of the run event.
+type RunEventMetric struct {
+	Key       string          `json:"key"`
+	Context   json.RawMessage `json:"context"`
+	Value     float64         `json:"value"`
+	IsNan     bool            `json:"is_nan"`
+	Step      int64           `json:"step"`
+	Iter      int64           `json:"iter"`
+	Timestamp int64           `json:"timestamp"`
+}
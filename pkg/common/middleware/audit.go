@@ -0,0 +1,194 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	log "github.com/sirupsen/logrus"
+
+	mlflowModels "github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/auth/oidc"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/repositories"
+)
+
+// auditSkippedRouteRegexp matches Aim remote tracking routes, which require `editor` permission level,
+// but only keep the client session alive or read the data, so they are not recorded.
+var auditSkippedRouteRegexp = regexp.MustCompile(
+	`^/client/(get-version|heartbeat)/|^/tracking/[^/]+/read-instruction/`,
+)
+
+// AuditMiddleware represents Audit middleware, which records each mutating request.
+type AuditMiddleware struct {
+	auditLogRepository repositories.AuditLogRepositoryProvider
+}
+
+// NewAuditMiddleware creates new Audit middleware logic. It has to be attached before the authentication
+// middlewares, so the mutating requests which were denied are recorded too.
+func NewAuditMiddleware(auditLogRepository repositories.AuditLogRepositoryProvider) fiber.Handler {
+	return AuditMiddleware{
+		auditLogRepository: auditLogRepository,
+	}.Handle()
+}
+
+// Handle handles Audit middleware logic.
+func (m AuditMiddleware) Handle() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		requestPath := ctx.Path()
+		err := ctx.Next()
+
+		// namespace prefix is removed from the path by the Namespace middleware, unless the request
+		// has been denied before it.
+		path := namespaceRegexp.ReplaceAllString(ctx.Path(), "/")
+		if !AdminPrefixRegexp.MatchString(path) && !MlflowAimPrefixRegexp.MatchString(path) {
+			return err
+		}
+
+		// only requests which require more than `viewer` permission level change something.
+		// the decision is made by the route template, so it can't be affected by the user-supplied path segments.
+		route, routeErr := GetRequestRouteFromContext(ctx.Context())
+		if routeErr != nil {
+			route = &RequestRoute{
+				PermissionLevel: GetRequiredPermissionLevel(ctx.Method(), ""),
+			}
+		}
+		if route.PermissionLevel == models.PermissionLevelViewer || auditSkippedRouteRegexp.MatchString(route.Path) {
+			return err
+		}
+
+		actorType, actor := getAuditActor(ctx)
+		auditLog := models.AuditLog{
+			ActorType:     actorType,
+			Actor:         actor,
+			NamespaceCode: getAuditNamespaceCode(ctx, requestPath, path),
+			Method:        ctx.Method(),
+			Action:        route.Path,
+			Path:          path,
+			TargetIDs:     getAuditTargetIDs(ctx),
+			StatusCode:    getAuditStatusCode(ctx, err),
+		}
+		// audit log must never break the request itself, so just report the problem.
+		if createErr := m.auditLogRepository.Create(ctx.Context(), &auditLog); createErr != nil {
+			log.Errorf("error recording audit log of request %s %s: %+v", auditLog.Method, path, createErr)
+		}
+		return err
+	}
+}
+
+// getAuditActor returns type and name of the actor who performed the request.
+func getAuditActor(ctx *fiber.Ctx) (string, string) {
+	if accessToken, ok := ctx.Locals(accessTokenContextKey).(*models.AccessToken); ok {
+		return models.AuditLogActorTypeAccessToken, accessToken.Name
+	}
+	if user, ok := ctx.Locals(jwtUserContextKey).(*oidc.User); ok {
+		return models.AuditLogActorTypeJWT, user.GetName()
+	}
+	if user, ok := ctx.Locals(oidcUserContextKey).(*oidc.User); ok {
+		return models.AuditLogActorTypeOIDC, user.GetName()
+	}
+	if username := GetUsernameFromContext(ctx.Context()); username != "" {
+		return models.AuditLogActorTypeBasic, username
+	}
+	return models.AuditLogActorTypeAnonymous, ""
+}
+
+// getAuditNamespaceCode returns the code of requested namespace. Admin requests have no namespace.
+// If the request has been denied before the namespace is resolved, the code is taken from the request path.
+func getAuditNamespaceCode(ctx *fiber.Ctx, requestPath, path string) string {
+	if AdminPrefixRegexp.MatchString(path) {
+		return ""
+	}
+	if namespace, err := GetNamespaceFromContext(ctx.Context()); err == nil {
+		return namespace.Code
+	}
+	if matches := namespaceRegexp.FindStringSubmatch(requestPath); matches != nil {
+		return matches[1]
+	}
+	return mlflowModels.DefaultNamespaceCode
+}
+
+// getAuditTargetIDs returns identifiers of the resources which the request targets.
+// They are taken from the path parameters and from the top level identifier fields of the JSON body.
+func getAuditTargetIDs(ctx *fiber.Ctx) []string {
+	targetIDs := make([]string, 0)
+	for key, value := range ctx.AllParams() {
+		targetIDs = append(targetIDs, fmt.Sprintf("%s=%s", key, value))
+	}
+
+	// streamed body of the uploaded artifact could be large, so it is never read here.
+	contentLength := ctx.Request().Header.ContentLength()
+	isBodyLimited := !ctx.Request().IsBodyStream() ||
+		(contentLength >= 0 && contentLength <= ctx.App().Config().BodyLimit)
+	if isBodyLimited && strings.HasPrefix(ctx.Get(fiber.HeaderContentType), fiber.MIMEApplicationJSON) {
+		body := bytes.TrimSpace(ctx.Body())
+		switch {
+		case bytes.HasPrefix(body, []byte("{")):
+			var fields map[string]json.RawMessage
+			if err := json.Unmarshal(body, &fields); err == nil {
+				for key, value := range fields {
+					if isAuditTargetIDField(key) {
+						targetIDs = append(targetIDs, formatAuditTargetIDs(key, value)...)
+					}
+				}
+			}
+		case bytes.HasPrefix(body, []byte("[")):
+			// Aim batch endpoints accept plain list of run IDs.
+			targetIDs = append(targetIDs, formatAuditTargetIDs("id", body)...)
+		}
+	}
+
+	sort.Strings(targetIDs)
+	return targetIDs
+}
+
+// isAuditTargetIDField makes check that JSON body field identifies a resource.
+func isAuditTargetIDField(key string) bool {
+	return key == "id" || key == "name" ||
+		strings.HasSuffix(key, "_id") || strings.HasSuffix(key, "_ids") || strings.HasSuffix(key, "_uuid")
+}
+
+// formatAuditTargetIDs formats scalar or list JSON value as `key=value` pairs.
+func formatAuditTargetIDs(key string, data json.RawMessage) []string {
+	var value any
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return nil
+	}
+	values, ok := value.([]any)
+	if !ok {
+		values = []any{value}
+	}
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		switch value.(type) {
+		case string, json.Number:
+			result = append(result, fmt.Sprintf("%s=%v", key, value))
+		}
+	}
+	return result
+}
+
+// getAuditStatusCode returns the status code which will be sent to the client.
+func getAuditStatusCode(ctx *fiber.Ctx, err error) int {
+	if err == nil {
+		return ctx.Response().StatusCode()
+	}
+	var fiberError *fiber.Error
+	if errors.As(err, &fiberError) {
+		return fiberError.Code
+	}
+	var errorResponse *api.ErrorResponse
+	if errors.As(err, &errorResponse) {
+		return errorResponse.StatusCode
+	}
+	return http.StatusInternalServerError
+}
@@ -32,6 +32,10 @@ func NewBasicAuthMiddleware(userPermissions *models.UserPermissions) fiber.Handl
 // Handle handles OIDC middleware logic.
 func (m BasicAuthMiddleware) Handle() fiber.Handler {
 	return func(ctx *fiber.Ctx) (err error) {
+		// request has been already authenticated by Access Token or bearer JWT middleware.
+		if isAuthenticatedByAccessToken(ctx) || isAuthenticatedByJWT(ctx) {
+			return ctx.Next()
+		}
 		authToken := m.userPermissions.ValidateAuthToken(ctx.Get(fiber.HeaderAuthorization)[6:])
 		switch {
 		case AdminPrefixRegexp.MatchString(ctx.Path()):
@@ -50,6 +54,7 @@ func (m BasicAuthMiddleware) handleAdminResourceRequest(ctx *fiber.Ctx, authToke
 	if authToken == nil || !authToken.HasAdminAccess() {
 		return ctx.Redirect("/errors/not-found", http.StatusMovedPermanently)
 	}
+	ctx.Locals(usernameContextKey, authToken.GetUsername())
 	return ctx.Next()
 }
 
@@ -88,14 +93,16 @@ func (m BasicAuthMiddleware) handleAimMlflowResourceRequest(ctx *fiber.Ctx, auth
 			api.NewResourceDoesNotExistError("unable to find namespace with code: %s", namespace.Code),
 		)
 	}
-	if !authToken.HasUserAccess(namespace.Code) && !authToken.HasAdminAccess() {
+	level, ok := authToken.GetNamespacePermissionLevel(namespace.Code)
+	if !ok {
 		return ctx.Status(
 			http.StatusNotFound,
 		).JSON(
 			api.NewResourceDoesNotExistError("unable to find namespace with code: %s", namespace.Code),
 		)
 	}
-	return ctx.Next()
+	ctx.Locals(usernameContextKey, authToken.GetUsername())
+	return checkNamespacePermissionLevel(ctx, namespace.Code, level)
 }
 
 // GetBasicAuthTokenFromContext returns Basic Auth Token from the context.
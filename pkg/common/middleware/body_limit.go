@@ -0,0 +1,62 @@
+package middleware
+
+import (
+	"io"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// BodyLimitMiddleware represents Body Limit middleware.
+type BodyLimitMiddleware struct {
+	limit          int
+	streamedRoutes map[string]struct{}
+}
+
+// NewBodyLimitMiddleware creates new Body Limit middleware logic. The request body is streamed by the server,
+// so its size is limited here for all the routes, except the streamed ones, which upload the artifacts.
+func NewBodyLimitMiddleware(limit int, streamedRoutes ...string) fiber.Handler {
+	m := BodyLimitMiddleware{
+		limit:          limit,
+		streamedRoutes: make(map[string]struct{}, len(streamedRoutes)),
+	}
+	for _, route := range streamedRoutes {
+		m.streamedRoutes[route] = struct{}{}
+	}
+	return m.Handle()
+}
+
+// Handle handles Body Limit middleware logic.
+func (m BodyLimitMiddleware) Handle() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		if route, err := GetRequestRouteFromContext(ctx.Context()); err == nil {
+			if _, ok := m.streamedRoutes[route.Path]; ok {
+				err := ctx.Next()
+				// rejected request leaves the unread body in the connection, so it can't be reused.
+				if err != nil || ctx.Response().StatusCode() >= fiber.StatusBadRequest {
+					ctx.Context().SetConnectionClose()
+				}
+				return err
+			}
+		}
+
+		contentLength := ctx.Request().Header.ContentLength()
+		if contentLength > m.limit {
+			ctx.Context().SetConnectionClose()
+			return fiber.ErrRequestEntityTooLarge
+		}
+		// chunked body has no length in advance, so it is read until the limit is exceeded.
+		if contentLength < 0 && ctx.Request().IsBodyStream() {
+			body, err := io.ReadAll(io.LimitReader(ctx.Request().BodyStream(), int64(m.limit)+1))
+			if err != nil {
+				ctx.Context().SetConnectionClose()
+				return fiber.NewError(fiber.StatusBadRequest, err.Error())
+			}
+			if len(body) > m.limit {
+				ctx.Context().SetConnectionClose()
+				return fiber.ErrRequestEntityTooLarge
+			}
+			ctx.Request().SetBody(body)
+		}
+		return ctx.Next()
+	}
+}
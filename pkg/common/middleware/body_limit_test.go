@@ -0,0 +1,96 @@
+package middleware
+
+import (
+	"bytes"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBodyLimitMiddleware_Ok(t *testing.T) {
+	handler := func(ctx *fiber.Ctx) error {
+		if stream := ctx.Request().BodyStream(); stream != nil {
+			body, err := io.ReadAll(stream)
+			if err != nil {
+				return err
+			}
+			return ctx.SendString(string(body))
+		}
+		return ctx.Send(ctx.Body())
+	}
+
+	app := fiber.New(fiber.Config{
+		BodyLimit:         8,
+		StreamRequestBody: true,
+	})
+	app.Use(NewRouteMiddleware())
+	app.Use(NewBodyLimitMiddleware(8, "/api/2.0/mlflow-artifacts/artifacts/*"))
+	app.Post("/api/2.0/mlflow/runs/create", handler)
+	app.Put("/api/2.0/mlflow-artifacts/artifacts/*", handler)
+
+	tests := []struct {
+		name          string
+		method        string
+		path          string
+		body          string
+		chunked       bool
+		expectedCode  int
+		expectedClose bool
+	}{
+		{
+			name:         "BodyWithinLimit",
+			method:       fiber.MethodPost,
+			path:         "/api/2.0/mlflow/runs/create",
+			body:         "12345678",
+			expectedCode: fiber.StatusOK,
+		},
+		{
+			name:          "BodyExceedsLimit",
+			method:        fiber.MethodPost,
+			path:          "/api/2.0/mlflow/runs/create",
+			body:          "123456789",
+			expectedCode:  fiber.StatusRequestEntityTooLarge,
+			expectedClose: true,
+		},
+		{
+			name:          "ChunkedBodyExceedsLimit",
+			method:        fiber.MethodPost,
+			path:          "/api/2.0/mlflow/runs/create",
+			body:          "123456789",
+			chunked:       true,
+			expectedCode:  fiber.StatusRequestEntityTooLarge,
+			expectedClose: true,
+		},
+		{
+			name:         "StreamedRouteIsNotLimited",
+			method:       fiber.MethodPut,
+			path:         "/api/2.0/mlflow-artifacts/artifacts/0/model.bin",
+			body:         strings.Repeat("0123456789", 10),
+			expectedCode: fiber.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			if tt.chunked {
+				req.ContentLength = -1
+				req.TransferEncoding = []string{"chunked"}
+			}
+			resp, err := app.Test(req)
+			require.Nil(t, err)
+			assert.Equal(t, tt.expectedCode, resp.StatusCode)
+			assert.Equal(t, tt.expectedClose, resp.Close)
+			if tt.expectedCode == fiber.StatusOK {
+				data, err := io.ReadAll(resp.Body)
+				require.Nil(t, err)
+				assert.True(t, bytes.Equal([]byte(tt.body), data))
+			}
+		})
+	}
+}
@@ -6,5 +6,8 @@ import "regexp"
 var (
 	AdminPrefixRegexp     = regexp.MustCompile(`^/admin`)
 	ChooserPrefixRegexp   = regexp.MustCompile(`^/chooser|^/$`)
-	MlflowAimPrefixRegexp = regexp.MustCompile(`^/aim/api|^/ajax-api/2.0/mlflow|^/api/2.0/mlflow`)
+	TrackingPrefixRegexp  = regexp.MustCompile(`^/client/|^/tracking/`)
+	MlflowAimPrefixRegexp = regexp.MustCompile(
+		`^/aim/api|^/ajax-api/2.0/mlflow|^/api/2.0/mlflow|^/client/|^/tracking/`,
+	)
 )
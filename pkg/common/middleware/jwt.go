@@ -0,0 +1,131 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/auth/oidc"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/repositories"
+)
+
+// nolint:gosec
+const (
+	jwtUserContextKey = "jwt_user"
+)
+
+// JWTMiddleware represents bearer JWT middleware.
+type JWTMiddleware struct {
+	verifier        oidc.JWTVerifierProvider
+	rolesRepository repositories.RoleRepositoryProvider
+	// required defines that bearer JWT is the only configured authentication,
+	// so unauthenticated requests have to be rejected.
+	required bool
+}
+
+// NewJWTMiddleware creates new bearer JWT middleware logic.
+func NewJWTMiddleware(
+	verifier oidc.JWTVerifierProvider,
+	rolesRepository repositories.RoleRepositoryProvider,
+	required bool,
+) fiber.Handler {
+	return JWTMiddleware{
+		verifier:        verifier,
+		rolesRepository: rolesRepository,
+		required:        required,
+	}.Handle()
+}
+
+// Handle handles bearer JWT middleware logic.
+func (m JWTMiddleware) Handle() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		path := ctx.Path()
+		if !AdminPrefixRegexp.MatchString(path) && !MlflowAimPrefixRegexp.MatchString(path) {
+			return ctx.Next()
+		}
+
+		// `Authorization: Bearer ftml_...` requests are handled by Access Token middleware.
+		rawToken, ok := strings.CutPrefix(ctx.Get(fiber.HeaderAuthorization), "Bearer ")
+		if !ok || strings.HasPrefix(rawToken, models.AccessTokenPrefix) {
+			if m.required && !isAuthenticatedByAccessToken(ctx) {
+				return ctx.Status(
+					http.StatusUnauthorized,
+				).JSON(
+					api.NewUnauthenticatedError("bearer token is required"),
+				)
+			}
+			return ctx.Next()
+		}
+
+		user, err := m.verifier.Verify(ctx.Context(), rawToken)
+		if err != nil {
+			log.Debugf("error verifying bearer token: %+v", err)
+			return ctx.Status(
+				http.StatusUnauthorized,
+			).JSON(
+				api.NewUnauthenticatedError("bearer token is invalid or expired"),
+			)
+		}
+		log.Debugf("user has roles: %v associated", user.GetRoles())
+		ctx.Locals(jwtUserContextKey, user)
+		ctx.Locals(oidcUserContextKey, user)
+		ctx.Locals(usernameContextKey, user.GetName())
+
+		if AdminPrefixRegexp.MatchString(path) {
+			return m.handleAdminResourceRequest(ctx, user)
+		}
+		return m.handleAimMlflowResourceRequest(ctx, user)
+	}
+}
+
+// handleAdminResourceRequest applies bearer JWT check for Admin resources.
+func (m JWTMiddleware) handleAdminResourceRequest(ctx *fiber.Ctx, user *oidc.User) error {
+	if !user.IsAdmin() {
+		return ctx.Status(
+			http.StatusNotFound,
+		).JSON(
+			api.NewEndpointNotFound("unable to find requested resource"),
+		)
+	}
+	return ctx.Next()
+}
+
+// handleAimMlflowResourceRequest applies bearer JWT check for Aim or Mlflow resources.
+func (m JWTMiddleware) handleAimMlflowResourceRequest(ctx *fiber.Ctx, user *oidc.User) error {
+	namespace, err := GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("checking access permission to %s namespace", namespace.Code)
+
+	if user.IsAdmin() {
+		return ctx.Next()
+	}
+
+	// roles are mapped to the namespaces in the same way as for OIDC users.
+	level, err := m.rolesRepository.GetNamespacePermissionLevel(ctx.Context(), user.GetRoles(), namespace.Code)
+	if err != nil {
+		log.Errorf("error validating access to requested namespace with code: %s, %+v", namespace.Code, err)
+		return api.NewInternalError(
+			"error validating access to requested namespace with code: %s", namespace.Code,
+		)
+	}
+	if level == "" {
+		return ctx.Status(
+			http.StatusNotFound,
+		).JSON(
+			api.NewResourceDoesNotExistError("unable to find namespace with code: %s", namespace.Code),
+		)
+	}
+	return checkNamespacePermissionLevel(ctx, namespace.Code, level)
+}
+
+// isAuthenticatedByJWT makes check that request has been already authenticated by bearer JWT.
+func isAuthenticatedByJWT(ctx *fiber.Ctx) bool {
+	_, ok := ctx.Locals(jwtUserContextKey).(*oidc.User)
+	return ok
+}
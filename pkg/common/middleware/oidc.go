@@ -39,6 +39,10 @@ func NewOIDCMiddleware(
 // Handle handles OIDC middleware logic.
 func (m OIDCMiddleware) Handle() fiber.Handler {
 	return func(ctx *fiber.Ctx) (err error) {
+		// request has been already authenticated by Access Token or bearer JWT middleware.
+		if isAuthenticatedByAccessToken(ctx) || isAuthenticatedByJWT(ctx) {
+			return ctx.Next()
+		}
 		path := ctx.Path()
 		// if requested resource related to something static, then we don't need to apply auth.
 		if !strings.Contains(path, "static") {
@@ -69,6 +73,8 @@ func (m OIDCMiddleware) handleAdminResourceRequest(ctx *fiber.Ctx) error {
 	if !user.IsAdmin() {
 		return ctx.Redirect("/errors/not-found", http.StatusMovedPermanently)
 	}
+	ctx.Locals(oidcUserContextKey, user)
+	ctx.Locals(usernameContextKey, user.GetName())
 	return ctx.Next()
 }
 
@@ -107,26 +113,28 @@ func (m OIDCMiddleware) handleAimMlflowResourceRequest(ctx *fiber.Ctx) error {
 		)
 	}
 	log.Debugf("user has roles: %v associated", user.GetRoles())
+	ctx.Locals(oidcUserContextKey, user)
+	ctx.Locals(usernameContextKey, user.GetName())
 
 	if user.IsAdmin() {
 		return ctx.Next()
 	}
 
-	isValid, err := m.rolesRepository.ValidateRolesAccessToNamespace(ctx.Context(), user.GetRoles(), namespace.Code)
+	level, err := m.rolesRepository.GetNamespacePermissionLevel(ctx.Context(), user.GetRoles(), namespace.Code)
 	if err != nil {
 		log.Errorf("error validating access to requested namespace with code: %s, %+v", namespace.Code, err)
 		return api.NewInternalError(
 			"error validating access to requested namespace with code: %s", namespace.Code,
 		)
 	}
-	if !isValid {
+	if level == "" {
 		return ctx.Status(
 			http.StatusForbidden,
 		).JSON(
 			api.NewResourceDoesNotExistError("unable to find namespace with code: %s", namespace.Code),
 		)
 	}
-	return ctx.Next()
+	return checkNamespacePermissionLevel(ctx, namespace.Code, level)
 }
 
 // GetOIDCUserFromContext returns OIDC User object from the context.
@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"net/http"
+	"regexp"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/models"
+)
+
+// regexps to detect what kind of Aim or Mlflow request is requested.
+var (
+	// ReadOnlyRequestPathRegexp matches endpoints which use POST method, but only read data: searches and batch gets.
+	ReadOnlyRequestPathRegexp = regexp.MustCompile(`/search(/|$)|/get-[\w-]+/?$`)
+	// DeleteRequestPathRegexp matches endpoints which use POST method to delete data.
+	DeleteRequestPathRegexp = regexp.MustCompile(`/delete[\w-]*/?$`)
+)
+
+// GetRequiredPermissionLevel returns the namespace permission level which is required to perform the request
+// served by the route with the given template: deleting anything requires models.PermissionLevelOwner, reading
+// only requires models.PermissionLevelViewer and everything else requires models.PermissionLevelEditor.
+// Aim remote tracking protocol is used only to write the data, so it always requires
+// models.PermissionLevelEditor, even for GET requests. Route template has to be used instead of the request path,
+// otherwise user-supplied path segments, like `/search/` in the artifact path, would affect the decision.
+func GetRequiredPermissionLevel(method, route string) models.PermissionLevel {
+	switch {
+	case method == fiber.MethodDelete || DeleteRequestPathRegexp.MatchString(route):
+		return models.PermissionLevelOwner
+	case TrackingPrefixRegexp.MatchString(route):
+		return models.PermissionLevelEditor
+	case method == fiber.MethodGet || method == fiber.MethodHead || method == fiber.MethodOptions,
+		ReadOnlyRequestPathRegexp.MatchString(route):
+		return models.PermissionLevelViewer
+	default:
+		return models.PermissionLevelEditor
+	}
+}
+
+// checkNamespacePermissionLevel makes check that granted permission level is enough to perform the request.
+func checkNamespacePermissionLevel(ctx *fiber.Ctx, namespaceCode string, level models.PermissionLevel) error {
+	route, err := GetRequestRouteFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting request route from context")
+	}
+	requiredLevel := route.PermissionLevel
+	if level.Allows(requiredLevel) {
+		return ctx.Next()
+	}
+	return ctx.Status(
+		http.StatusForbidden,
+	).JSON(
+		api.NewPermissionDeniedError(
+			"`%s` permission level to namespace %s is required, but `%s` is granted",
+			requiredLevel, namespaceCode, level,
+		),
+	)
+}
@@ -0,0 +1,122 @@
+package middleware
+
+import (
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/fasttrackml/pkg/common/dao/models"
+)
+
+func TestGetRequiredPermissionLevel_Ok(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		level  models.PermissionLevel
+	}{
+		{
+			name:   "MlflowGetRun",
+			method: fiber.MethodGet,
+			path:   "/api/2.0/mlflow/runs/get",
+			level:  models.PermissionLevelViewer,
+		},
+		{
+			name:   "MlflowSearchRuns",
+			method: fiber.MethodPost,
+			path:   "/api/2.0/mlflow/runs/search",
+			level:  models.PermissionLevelViewer,
+		},
+		{
+			name:   "MlflowGetMetricHistories",
+			method: fiber.MethodPost,
+			path:   "/api/2.0/mlflow/metrics/get-histories",
+			level:  models.PermissionLevelViewer,
+		},
+		{
+			name:   "MlflowLogBatch",
+			method: fiber.MethodPost,
+			path:   "/api/2.0/mlflow/runs/log-batch",
+			level:  models.PermissionLevelEditor,
+		},
+		{
+			name:   "MlflowUpdateRun",
+			method: fiber.MethodPost,
+			path:   "/api/2.0/mlflow/runs/update",
+			level:  models.PermissionLevelEditor,
+		},
+		{
+			name:   "MlflowDeleteRun",
+			method: fiber.MethodPost,
+			path:   "/api/2.0/mlflow/runs/delete",
+			level:  models.PermissionLevelOwner,
+		},
+		{
+			name:   "MlflowDeleteTag",
+			method: fiber.MethodPost,
+			path:   "/api/2.0/mlflow/runs/delete-tag",
+			level:  models.PermissionLevelOwner,
+		},
+		{
+			name:   "AimSearchMetrics",
+			method: fiber.MethodPost,
+			path:   "/aim/api/runs/search/metric/",
+			level:  models.PermissionLevelViewer,
+		},
+		{
+			name:   "AimArchiveBatch",
+			method: fiber.MethodPost,
+			path:   "/aim/api/runs/archive-batch",
+			level:  models.PermissionLevelEditor,
+		},
+		{
+			name:   "AimCreateDashboard",
+			method: fiber.MethodPost,
+			path:   "/aim/api/dashboards/",
+			level:  models.PermissionLevelEditor,
+		},
+		{
+			name:   "AimUpdateApp",
+			method: fiber.MethodPut,
+			path:   "/aim/api/apps/8f3a0a56-7a0b-4d6d-8a8b-8f0b0b0b0b0b",
+			level:  models.PermissionLevelEditor,
+		},
+		{
+			name:   "AimDeleteBatch",
+			method: fiber.MethodPost,
+			path:   "/aim/api/runs/delete-batch",
+			level:  models.PermissionLevelOwner,
+		},
+		{
+			name:   "AimDeleteExperiment",
+			method: fiber.MethodDelete,
+			path:   "/aim/api/experiments/1",
+			level:  models.PermissionLevelOwner,
+		},
+		{
+			name:   "TrackingConnect",
+			method: fiber.MethodGet,
+			path:   "/client/connect/5d8b4f1c2a3e4b6d8f0a1c3e/",
+			level:  models.PermissionLevelEditor,
+		},
+		{
+			name:   "TrackingGetResource",
+			method: fiber.MethodPost,
+			path:   "/tracking/5d8b4f1c2a3e4b6d8f0a1c3e/get-resource/",
+			level:  models.PermissionLevelEditor,
+		},
+		{
+			name:   "TrackingReleaseResource",
+			method: fiber.MethodGet,
+			path:   "/tracking/5d8b4f1c2a3e4b6d8f0a1c3e/release-resource/1/",
+			level:  models.PermissionLevelEditor,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.level, GetRequiredPermissionLevel(tt.method, tt.path))
+		})
+	}
+}
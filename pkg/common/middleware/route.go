@@ -0,0 +1,119 @@
+package middleware
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	"github.com/rotisserie/eris"
+
+	"github.com/G-Research/fasttrackml/pkg/common/dao/models"
+)
+
+const (
+	requestRouteContextKey = "request_route"
+)
+
+// RequestRoute represents the registered route which serves the request.
+type RequestRoute struct {
+	// Path is the route template, e.g. `/api/2.0/mlflow-artifacts/artifacts/*`. It is empty,
+	// if there is no registered route for the request.
+	Path string
+	// PermissionLevel is the namespace permission level which is required to perform the request.
+	PermissionLevel models.PermissionLevel
+}
+
+// registeredRoute represents the registered route with precalculated matching details.
+type registeredRoute struct {
+	path            string
+	pattern         string
+	static          bool
+	permissionLevel models.PermissionLevel
+}
+
+// RouteMiddleware represents Route middleware.
+type RouteMiddleware struct {
+	once   sync.Once
+	routes map[string][]registeredRoute
+}
+
+// NewRouteMiddleware creates new Route middleware logic, which finds the registered route serving
+// the `aim`, `mlflow` and `admin` request. Required permission level is decided from the route template,
+// so it can't be affected by the user-supplied path segments.
+func NewRouteMiddleware() fiber.Handler {
+	return (&RouteMiddleware{
+		routes: map[string][]registeredRoute{},
+	}).Handle()
+}
+
+// Handle handles Route middleware logic.
+func (m *RouteMiddleware) Handle() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		if !AdminPrefixRegexp.MatchString(ctx.Path()) && !MlflowAimPrefixRegexp.MatchString(ctx.Path()) {
+			return ctx.Next()
+		}
+		// all the routes are registered at the moment when the first request is served.
+		m.once.Do(func() {
+			m.loadRoutes(ctx.App())
+		})
+		ctx.Locals(requestRouteContextKey, m.findRoute(ctx.App().Config(), ctx.Method(), ctx.Path()))
+		return ctx.Next()
+	}
+}
+
+// loadRoutes loads the registered routes of the application in the order in which they are matched.
+func (m *RouteMiddleware) loadRoutes(app *fiber.App) {
+	config := app.Config()
+	for _, route := range app.GetRoutes(true) {
+		pattern := normalizeRoutePath(config, route.Path)
+		m.routes[route.Method] = append(m.routes[route.Method], registeredRoute{
+			path:            route.Path,
+			pattern:         pattern,
+			static:          !strings.ContainsAny(pattern, ":*+"),
+			permissionLevel: GetRequiredPermissionLevel(route.Method, route.Path),
+		})
+	}
+}
+
+// findRoute finds the first registered route which matches the request.
+func (m *RouteMiddleware) findRoute(config fiber.Config, method, path string) *RequestRoute {
+	normalizedPath := normalizeRoutePath(config, path)
+	for _, route := range m.routes[method] {
+		if (route.static && route.pattern == normalizedPath) ||
+			(!route.static && fiber.RoutePatternMatch(normalizedPath, route.path, config)) {
+			return &RequestRoute{
+				Path:            route.path,
+				PermissionLevel: route.permissionLevel,
+			}
+		}
+	}
+	// there is no such route, so the request will fail anyway. decide only by the method.
+	return &RequestRoute{
+		PermissionLevel: GetRequiredPermissionLevel(method, ""),
+	}
+}
+
+// normalizeRoutePath normalizes the path in the same way as the application does to match the routes.
+func normalizeRoutePath(config fiber.Config, path string) string {
+	if path == "" {
+		path = "/"
+	}
+	if !config.CaseSensitive {
+		path = utils.ToLower(path)
+	}
+	if !config.StrictRouting && len(path) > 1 {
+		path = utils.TrimRight(path, '/')
+	}
+	return path
+}
+
+// GetRequestRouteFromContext returns RequestRoute object from the context.
+func GetRequestRouteFromContext(ctx context.Context) (*RequestRoute, error) {
+	route, ok := ctx.Value(requestRouteContextKey).(*RequestRoute)
+	if !ok {
+		return nil, eris.New("error getting request route from context")
+	}
+	return route, nil
+}
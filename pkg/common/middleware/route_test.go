@@ -0,0 +1,111 @@
+package middleware
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/G-Research/fasttrackml/pkg/common/dao/models"
+)
+
+func TestRouteMiddleware_Ok(t *testing.T) {
+	var route *RequestRoute
+	handler := func(ctx *fiber.Ctx) error {
+		var err error
+		route, err = GetRequestRouteFromContext(ctx.Context())
+		return err
+	}
+
+	app := fiber.New()
+	app.Use(NewRouteMiddleware())
+	app.Post("/api/2.0/mlflow/runs/search", handler)
+	app.Post("/api/2.0/mlflow/runs/delete", handler)
+	app.Put("/api/2.0/mlflow-artifacts/artifacts/*", handler)
+	app.Post("/api/2.0/mlflow-artifacts/mpu/create/*", handler)
+	app.Patch("/api/2.0/mlflow/traces/:request_id", handler)
+	app.Get("/client/connect/:client_uri/", handler)
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		route  *RequestRoute
+	}{
+		{
+			name:   "StaticRoute",
+			method: fiber.MethodPost,
+			path:   "/api/2.0/mlflow/runs/search/",
+			route: &RequestRoute{
+				Path:            "/api/2.0/mlflow/runs/search",
+				PermissionLevel: models.PermissionLevelViewer,
+			},
+		},
+		{
+			name:   "DeleteRoute",
+			method: fiber.MethodPost,
+			path:   "/api/2.0/mlflow/runs/delete",
+			route: &RequestRoute{
+				Path:            "/api/2.0/mlflow/runs/delete",
+				PermissionLevel: models.PermissionLevelOwner,
+			},
+		},
+		{
+			name:   "UploadArtifactWithSearchSegment",
+			method: fiber.MethodPut,
+			path:   "/api/2.0/mlflow-artifacts/artifacts/1/run/artifacts/search/model.bin",
+			route: &RequestRoute{
+				Path:            "/api/2.0/mlflow-artifacts/artifacts/*",
+				PermissionLevel: models.PermissionLevelEditor,
+			},
+		},
+		{
+			name:   "UploadArtifactWithGetSegment",
+			method: fiber.MethodPut,
+			path:   "/api/2.0/mlflow-artifacts/artifacts/1/run/artifacts/get-model",
+			route: &RequestRoute{
+				Path:            "/api/2.0/mlflow-artifacts/artifacts/*",
+				PermissionLevel: models.PermissionLevelEditor,
+			},
+		},
+		{
+			name:   "CreateMultipartUploadWithDeleteSegment",
+			method: fiber.MethodPost,
+			path:   "/api/2.0/mlflow-artifacts/mpu/create/1/run/artifacts/delete-me",
+			route: &RequestRoute{
+				Path:            "/api/2.0/mlflow-artifacts/mpu/create/*",
+				PermissionLevel: models.PermissionLevelEditor,
+			},
+		},
+		{
+			name:   "EndTraceWithGetSegment",
+			method: fiber.MethodPatch,
+			path:   "/api/2.0/mlflow/traces/get-trace",
+			route: &RequestRoute{
+				Path:            "/api/2.0/mlflow/traces/:request_id",
+				PermissionLevel: models.PermissionLevelEditor,
+			},
+		},
+		{
+			name:   "TrackingConnect",
+			method: fiber.MethodGet,
+			path:   "/client/connect/5d8b4f1c2a3e4b6d8f0a1c3e/",
+			route: &RequestRoute{
+				Path:            "/client/connect/:client_uri/",
+				PermissionLevel: models.PermissionLevelEditor,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			route = nil
+			resp, err := app.Test(httptest.NewRequest(tt.method, tt.path, nil))
+			require.Nil(t, err)
+			assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+			assert.Equal(t, tt.route, route)
+		})
+	}
+}
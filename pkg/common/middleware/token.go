@@ -0,0 +1,148 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rotisserie/eris"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/repositories"
+)
+
+// nolint:gosec
+const (
+	accessTokenContextKey = "access_token"
+)
+
+// accessTokenLastUsedResolution defines how often `last_used_at` of the same token is written to the database.
+const accessTokenLastUsedResolution = time.Minute
+
+// AccessTokenMiddleware represents Access Token middleware.
+type AccessTokenMiddleware struct {
+	accessTokenRepository repositories.AccessTokenRepositoryProvider
+}
+
+// NewAccessTokenMiddleware creates new Access Token middleware logic.
+func NewAccessTokenMiddleware(accessTokenRepository repositories.AccessTokenRepositoryProvider) fiber.Handler {
+	return AccessTokenMiddleware{
+		accessTokenRepository: accessTokenRepository,
+	}.Handle()
+}
+
+// Handle handles Access Token middleware logic.
+func (m AccessTokenMiddleware) Handle() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		// only `Authorization: Bearer ftml_...` requests are handled here,
+		// everything else is handled by the Basic Auth or OIDC middleware.
+		rawToken, ok := strings.CutPrefix(ctx.Get(fiber.HeaderAuthorization), "Bearer ")
+		if !ok || !strings.HasPrefix(rawToken, models.AccessTokenPrefix) {
+			return ctx.Next()
+		}
+		switch {
+		case AdminPrefixRegexp.MatchString(ctx.Path()):
+			return m.handleAdminResourceRequest(ctx, rawToken)
+		case MlflowAimPrefixRegexp.MatchString(ctx.Path()):
+			return m.handleAimMlflowResourceRequest(ctx, rawToken)
+		}
+		return ctx.Next()
+	}
+}
+
+// handleAdminResourceRequest applies Access Token check for Admin resources.
+func (m AccessTokenMiddleware) handleAdminResourceRequest(ctx *fiber.Ctx, rawToken string) error {
+	accessToken, err := m.validateAccessToken(ctx, rawToken)
+	if err != nil {
+		return err
+	}
+	if accessToken == nil {
+		return ctx.Status(
+			http.StatusUnauthorized,
+		).JSON(
+			api.NewUnauthenticatedError("access token is invalid or expired"),
+		)
+	}
+	if !accessToken.HasAdminAccess() {
+		return ctx.Status(
+			http.StatusNotFound,
+		).JSON(
+			api.NewEndpointNotFound("unable to find requested resource"),
+		)
+	}
+	return ctx.Next()
+}
+
+// handleAimMlflowResourceRequest applies Access Token check for Aim or Mlflow resources.
+func (m AccessTokenMiddleware) handleAimMlflowResourceRequest(ctx *fiber.Ctx, rawToken string) error {
+	namespace, err := GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("checking access permission to %s namespace", namespace.Code)
+
+	accessToken, err := m.validateAccessToken(ctx, rawToken)
+	if err != nil {
+		return err
+	}
+	if accessToken == nil {
+		return ctx.Status(
+			http.StatusUnauthorized,
+		).JSON(
+			api.NewUnauthenticatedError("access token is invalid or expired"),
+		)
+	}
+	level, ok := accessToken.GetNamespacePermissionLevel(namespace.Code)
+	if !ok {
+		return ctx.Status(
+			http.StatusNotFound,
+		).JSON(
+			api.NewResourceDoesNotExistError("unable to find namespace with code: %s", namespace.Code),
+		)
+	}
+	return checkNamespacePermissionLevel(ctx, namespace.Code, level)
+}
+
+// validateAccessToken finds active Access Token by its raw value and stores it in the context.
+func (m AccessTokenMiddleware) validateAccessToken(ctx *fiber.Ctx, rawToken string) (*models.AccessToken, error) {
+	accessToken, err := m.accessTokenRepository.GetByTokenHash(ctx.Context(), models.HashAccessToken(rawToken))
+	if err != nil {
+		log.Errorf("error getting access token: %+v", err)
+		return nil, api.NewInternalError("error validating access token")
+	}
+
+	now := time.Now().UTC()
+	if accessToken == nil || accessToken.IsExpired(now) {
+		return nil, nil
+	}
+
+	// `last_used_at` is informational only, so don't fail the request if it can't be updated.
+	if accessToken.LastUsedAt == nil || now.Sub(*accessToken.LastUsedAt) >= accessTokenLastUsedResolution {
+		if err := m.accessTokenRepository.UpdateLastUsedAt(ctx.Context(), accessToken, now); err != nil {
+			log.Errorf("error updating access token last used time: %+v", err)
+		}
+	}
+
+	ctx.Locals(accessTokenContextKey, accessToken)
+	ctx.Locals(usernameContextKey, accessToken.Name)
+	return accessToken, nil
+}
+
+// isAuthenticatedByAccessToken makes check that request has been already authenticated by Access Token.
+func isAuthenticatedByAccessToken(ctx *fiber.Ctx) bool {
+	_, ok := ctx.Locals(accessTokenContextKey).(*models.AccessToken)
+	return ok
+}
+
+// GetAccessTokenFromContext returns Access Token from the context.
+func GetAccessTokenFromContext(ctx context.Context) (*models.AccessToken, error) {
+	accessToken, ok := ctx.Value(accessTokenContextKey).(*models.AccessToken)
+	if !ok {
+		return nil, eris.New("error getting access token from context")
+	}
+	return accessToken, nil
+}
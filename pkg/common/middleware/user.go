@@ -0,0 +1,12 @@
+package middleware
+
+import "context"
+
+// usernameContextKey is the same key the built-in fiber Basic Auth middleware uses to store user name.
+const usernameContextKey = "username"
+
+// GetUsernameFromContext returns the name of authenticated user or empty string if auth is disabled.
+func GetUsernameFromContext(ctx context.Context) string {
+	username, _ := ctx.Value(usernameContextKey).(string)
+	return username
+}
@@ -0,0 +1,175 @@
+package artifact
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"context"
+	"io"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/rotisserie/eris"
+
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/services/artifact/storage"
+)
+
+// Supported archive formats of `GET /artifacts/download` endpoint.
+const (
+	ArchiveFormatZip   = "zip"
+	ArchiveFormatTarGz = "tar.gz"
+)
+
+// SupportedArchiveFormats is a list of supported archive formats.
+var SupportedArchiveFormats = []string{ArchiveFormatZip, ArchiveFormatTarGz}
+
+// Archive represents artifact directory which is streamed to the client as a single archive.
+type Archive struct {
+	Name        string
+	Format      string
+	root        string
+	artifactURI string
+	objects     []storage.ArtifactObject
+	storage     storage.ArtifactStorageProvider
+}
+
+// GetContentType returns content type of the archive.
+func (a Archive) GetContentType() string {
+	if a.Format == ArchiveFormatTarGz {
+		return "application/gzip"
+	}
+	return "application/zip"
+}
+
+// Write streams archive content into the provided writer object by object,
+// so the whole archive is never held in memory.
+func (a Archive) Write(ctx context.Context, w io.Writer) error {
+	if a.Format == ArchiveFormatTarGz {
+		return a.writeTarGz(ctx, w)
+	}
+	return a.writeZip(ctx, w)
+}
+
+// writeZip writes objects as zip archive.
+func (a Archive) writeZip(ctx context.Context, w io.Writer) error {
+	archive := zip.NewWriter(w)
+	modified := time.Now()
+	for _, object := range a.objects {
+		writer, err := archive.CreateHeader(&zip.FileHeader{
+			Name:     a.getEntryName(object),
+			Method:   zip.Deflate,
+			Modified: modified,
+		})
+		if err != nil {
+			return eris.Wrapf(err, "error creating archive entry for object: %s", object.Path)
+		}
+		if err := a.copyObject(ctx, writer, object); err != nil {
+			return err
+		}
+	}
+	return eris.Wrap(archive.Close(), "error closing zip archive")
+}
+
+// writeTarGz writes objects as gzipped tar archive.
+func (a Archive) writeTarGz(ctx context.Context, w io.Writer) error {
+	compressor := gzip.NewWriter(w)
+	archive := tar.NewWriter(compressor)
+	modified := time.Now()
+	for _, object := range a.objects {
+		if err := archive.WriteHeader(&tar.Header{
+			Name:     a.getEntryName(object),
+			Mode:     0o644,
+			Size:     object.Size,
+			ModTime:  modified,
+			Typeflag: tar.TypeReg,
+		}); err != nil {
+			return eris.Wrapf(err, "error creating archive entry for object: %s", object.Path)
+		}
+		if err := a.copyObject(ctx, archive, object); err != nil {
+			return err
+		}
+	}
+	if err := archive.Close(); err != nil {
+		return eris.Wrap(err, "error closing tar archive")
+	}
+	return eris.Wrap(compressor.Close(), "error closing gzip stream")
+}
+
+// copyObject copies exactly the listed size of the object into the archive entry.
+func (a Archive) copyObject(ctx context.Context, w io.Writer, object storage.ArtifactObject) error {
+	reader, err := a.storage.Get(ctx, a.artifactURI, object.Path)
+	if err != nil {
+		return eris.Wrapf(err, "error getting artifact object: %s", object.Path)
+	}
+	//nolint:errcheck
+	defer reader.Close()
+
+	if _, err := io.CopyN(w, reader, object.Size); err != nil {
+		return eris.Wrapf(err, "error copying artifact object: %s", object.Path)
+	}
+	return nil
+}
+
+// getEntryName returns name of the archive entry relative to the downloaded directory.
+func (a Archive) getEntryName(object storage.ArtifactObject) string {
+	return filepath.ToSlash(strings.TrimPrefix(object.Path, a.root+"/"))
+}
+
+// listArchiveObjects recursively lists all the files under the provided directory
+// and checks that they fit into the configured archive limits.
+func (s Service) listArchiveObjects(
+	ctx context.Context, artifactStorage storage.ArtifactStorageProvider, artifactURI, root string,
+) ([]storage.ArtifactObject, error) {
+	var (
+		size    int64
+		objects []storage.ArtifactObject
+	)
+	directories := []string{root}
+	for len(directories) > 0 {
+		directory := directories[0]
+		directories = directories[1:]
+
+		items, err := artifactStorage.List(ctx, artifactURI, directory)
+		if err != nil {
+			return nil, api.NewInternalError("error getting artifact list from storage")
+		}
+		for _, item := range items {
+			// never follow objects which lead outside the listed directory.
+			if !isDirectChild(directory, item.Path) {
+				if item.Path == directory {
+					continue
+				}
+				return nil, api.NewInternalError("unexpected artifact object path: %s", item.Path)
+			}
+			if item.IsDir {
+				directories = append(directories, item.Path)
+				continue
+			}
+
+			size += item.Size
+			objects = append(objects, item)
+			if s.config.ArtifactsArchiveMaxFiles > 0 && len(objects) > s.config.ArtifactsArchiveMaxFiles {
+				return nil, api.NewInvalidParameterValueError(
+					"artifact directory contains more than %d files", s.config.ArtifactsArchiveMaxFiles,
+				)
+			}
+			if s.config.ArtifactsArchiveMaxSize > 0 && size > s.config.ArtifactsArchiveMaxSize {
+				return nil, api.NewInvalidParameterValueError(
+					"artifact directory exceeds maximum archive size of %d bytes", s.config.ArtifactsArchiveMaxSize,
+				)
+			}
+		}
+	}
+	return objects, nil
+}
+
+// isDirectChild checks that path is a valid path located right under the provided directory.
+func isDirectChild(directory, path string) bool {
+	if path == "" || validatePath(path) != nil {
+		return false
+	}
+	rel, err := filepath.Rel(directory, path)
+	return err == nil && rel != "." && !strings.Contains(rel, "/") && !strings.HasPrefix(rel, "..")
+}
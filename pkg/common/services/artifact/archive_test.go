@@ -0,0 +1,196 @@
+package artifact
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/api/request"
+	"github.com/G-Research/fasttrackml/pkg/common/config"
+	"github.com/G-Research/fasttrackml/pkg/common/services/artifact/storage"
+)
+
+// newArchiveStorage creates storage mock with `checkpoint` directory containing nested shards.
+func newArchiveStorage() *storage.MockArtifactStorageProvider {
+	artifactStorage := storage.MockArtifactStorageProvider{}
+	artifactStorage.On(
+		"List", context.TODO(), "/artifact/uri", "checkpoint",
+	).Return(
+		[]storage.ArtifactObject{
+			{Path: "checkpoint", IsDir: true},
+			{Path: "checkpoint/shard-1", Size: 7},
+			{Path: "checkpoint/nested", IsDir: true},
+		}, nil,
+	)
+	artifactStorage.On(
+		"List", context.TODO(), "/artifact/uri", "checkpoint/nested",
+	).Return(
+		[]storage.ArtifactObject{
+			{Path: "checkpoint/nested/shard-2", Size: 8},
+		}, nil,
+	)
+	artifactStorage.On(
+		"Get", context.TODO(), "/artifact/uri", "checkpoint/shard-1",
+	).Return(io.NopCloser(strings.NewReader("shard-1")), nil)
+	artifactStorage.On(
+		"Get", context.TODO(), "/artifact/uri", "checkpoint/nested/shard-2",
+	).Return(io.NopCloser(strings.NewReader("shard-22")), nil)
+	return &artifactStorage
+}
+
+// newArchiveService creates service with storage and repository mocks.
+func newArchiveService(cfg *config.Config, artifactStorage storage.ArtifactStorageProvider) *Service {
+	artifactStorageFactory := storage.MockArtifactStorageFactoryProvider{}
+	artifactStorageFactory.On(
+		"GetStorage", context.TODO(), "/artifact/uri",
+	).Return(artifactStorage, nil)
+
+	runRepository := repositories.MockRunRepositoryProvider{}
+	runRepository.On(
+		"GetByNamespaceIDAndRunID",
+		context.TODO(),
+		uint(1),
+		"id",
+	).Return(&models.Run{
+		ID:          "id",
+		ArtifactURI: "/artifact/uri",
+	}, nil)
+	return NewService(cfg, &runRepository, &repositories.MockExperimentRepositoryProvider{}, &artifactStorageFactory)
+}
+
+func TestService_DownloadArtifacts_Ok(t *testing.T) {
+	expectedContent := map[string]string{
+		"nested/shard-2": "shard-22",
+		"shard-1":        "shard-1",
+	}
+
+	// zip archive.
+	archive, err := newArchiveService(&config.Config{}, newArchiveStorage()).DownloadArtifacts(
+		context.TODO(),
+		&models.Namespace{ID: 1},
+		&request.DownloadArtifactsRequest{RunID: "id", Path: "checkpoint/"},
+	)
+	require.Nil(t, err)
+	assert.Equal(t, "checkpoint.zip", archive.Name)
+	assert.Equal(t, "application/zip", archive.GetContentType())
+
+	data := new(bytes.Buffer)
+	require.Nil(t, archive.Write(context.TODO(), data))
+	zipReader, err := zip.NewReader(bytes.NewReader(data.Bytes()), int64(data.Len()))
+	require.Nil(t, err)
+	content := map[string]string{}
+	for _, file := range zipReader.File {
+		reader, err := file.Open()
+		require.Nil(t, err)
+		value, err := io.ReadAll(reader)
+		require.Nil(t, err)
+		content[file.Name] = string(value)
+	}
+	assert.Equal(t, expectedContent, content)
+
+	// tar.gz archive.
+	archive, err = newArchiveService(&config.Config{}, newArchiveStorage()).DownloadArtifacts(
+		context.TODO(),
+		&models.Namespace{ID: 1},
+		&request.DownloadArtifactsRequest{RunID: "id", Path: "checkpoint", Format: ArchiveFormatTarGz},
+	)
+	require.Nil(t, err)
+	assert.Equal(t, "checkpoint.tar.gz", archive.Name)
+	assert.Equal(t, "application/gzip", archive.GetContentType())
+
+	data = new(bytes.Buffer)
+	require.Nil(t, archive.Write(context.TODO(), data))
+	gzipReader, err := gzip.NewReader(data)
+	require.Nil(t, err)
+	tarReader := tar.NewReader(gzipReader)
+	content = map[string]string{}
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		require.Nil(t, err)
+		value, err := io.ReadAll(tarReader)
+		require.Nil(t, err)
+		content[header.Name] = string(value)
+	}
+	assert.Equal(t, expectedContent, content)
+}
+
+func TestService_DownloadArtifacts_Error(t *testing.T) {
+	traversalStorage := storage.MockArtifactStorageProvider{}
+	traversalStorage.On(
+		"List", context.TODO(), "/artifact/uri", "checkpoint",
+	).Return(
+		[]storage.ArtifactObject{
+			{Path: "../secret", Size: 1},
+		}, nil,
+	)
+
+	emptyStorage := storage.MockArtifactStorageProvider{}
+	emptyStorage.On(
+		"List", context.TODO(), "/artifact/uri", "checkpoint",
+	).Return([]storage.ArtifactObject{}, nil)
+
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.DownloadArtifactsRequest
+		service *Service
+	}{
+		{
+			name:    "EmptyOrIncorrectRunID",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'"),
+			request: &request.DownloadArtifactsRequest{},
+			service: newArchiveService(&config.Config{}, newArchiveStorage()),
+		},
+		{
+			name:    "PathIsRelativeAndContains2Dots",
+			error:   api.NewInvalidParameterValueError("Invalid path"),
+			request: &request.DownloadArtifactsRequest{RunID: "id", Path: "checkpoint/../../"},
+			service: newArchiveService(&config.Config{}, newArchiveStorage()),
+		},
+		{
+			name:    "StorageReturnsObjectOutsideOfDirectory",
+			error:   api.NewInternalError("unexpected artifact object path: ../secret"),
+			request: &request.DownloadArtifactsRequest{RunID: "id", Path: "checkpoint"},
+			service: newArchiveService(&config.Config{}, &traversalStorage),
+		},
+		{
+			name:    "EmptyDirectory",
+			error:   api.NewResourceDoesNotExistError("unable to find artifacts for URI: /artifact/uri/checkpoint"),
+			request: &request.DownloadArtifactsRequest{RunID: "id", Path: "checkpoint"},
+			service: newArchiveService(&config.Config{}, &emptyStorage),
+		},
+		{
+			name:    "TooManyFiles",
+			error:   api.NewInvalidParameterValueError("artifact directory contains more than 1 files"),
+			request: &request.DownloadArtifactsRequest{RunID: "id", Path: "checkpoint"},
+			service: newArchiveService(&config.Config{ArtifactsArchiveMaxFiles: 1}, newArchiveStorage()),
+		},
+		{
+			name:    "TooLarge",
+			error:   api.NewInvalidParameterValueError("artifact directory exceeds maximum archive size of 10 bytes"),
+			request: &request.DownloadArtifactsRequest{RunID: "id", Path: "checkpoint"},
+			service: newArchiveService(&config.Config{ArtifactsArchiveMaxSize: 10}, newArchiveStorage()),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.service.DownloadArtifacts(context.TODO(), &models.Namespace{ID: 1}, tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
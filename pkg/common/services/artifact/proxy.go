@@ -0,0 +1,281 @@
+package artifact
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/api/request"
+	"github.com/G-Research/fasttrackml/pkg/common/config"
+	"github.com/G-Research/fasttrackml/pkg/common/services/artifact/storage"
+)
+
+// ProxyArtifactRoot is a root of all the artifacts served by `mlflow-artifacts` API.
+const ProxyArtifactRoot = config.MlflowArtifactsSchema + ":/"
+
+// ListProxyArtifacts handles the business logic of `GET /mlflow-artifacts/artifacts` endpoint.
+func (s Service) ListProxyArtifacts(
+	ctx context.Context, namespace *models.Namespace, req *request.ListProxyArtifactsRequest,
+) ([]storage.ArtifactObject, error) {
+	if err := ValidateListProxyArtifactsRequest(req); err != nil {
+		return nil, err
+	}
+	if err := s.checkProxyArtifactPath(ctx, namespace, req.Path); err != nil {
+		return nil, err
+	}
+
+	artifactStorage, err := s.getProxyStorage(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	artifacts, err := artifactStorage.List(ctx, ProxyArtifactRoot, req.Path)
+	if err != nil {
+		return nil, api.NewInternalError("error getting artifact list from storage")
+	}
+
+	// sort artifacts by path
+	slices.SortFunc(artifacts, func(a, b storage.ArtifactObject) int {
+		return cmp.Compare(a.Path, b.Path)
+	})
+
+	return artifacts, nil
+}
+
+// GetProxyArtifact handles the business logic of `GET /mlflow-artifacts/artifacts/*` endpoint.
+func (s Service) GetProxyArtifact(
+	ctx context.Context, namespace *models.Namespace, req *request.ProxyArtifactRequest,
+) (io.ReadCloser, error) {
+	if err := ValidateProxyArtifactRequest(req); err != nil {
+		return nil, err
+	}
+	if err := s.checkProxyArtifactPath(ctx, namespace, req.Path); err != nil {
+		return nil, err
+	}
+
+	artifactStorage, err := s.getProxyStorage(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	artifactReader, err := artifactStorage.Get(ctx, ProxyArtifactRoot, req.Path)
+	if err != nil {
+		msg := fmt.Sprintf("error getting artifact object for path: %s", req.Path)
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, api.NewResourceDoesNotExistError(msg)
+		}
+		return nil, api.NewInternalError(msg)
+	}
+	return artifactReader, nil
+}
+
+// PutProxyArtifact handles the business logic of `PUT /mlflow-artifacts/artifacts/*` endpoint.
+func (s Service) PutProxyArtifact(
+	ctx context.Context, namespace *models.Namespace, req *request.ProxyArtifactRequest, reader io.Reader,
+) error {
+	if err := ValidateProxyArtifactRequest(req); err != nil {
+		return err
+	}
+	if err := s.checkProxyArtifactPath(ctx, namespace, req.Path); err != nil {
+		return err
+	}
+
+	artifactStorage, err := s.getProxyStorage(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := artifactStorage.Put(ctx, ProxyArtifactRoot, req.Path, reader); err != nil {
+		return api.NewInternalError("error uploading artifact object for path: %s", req.Path)
+	}
+	return nil
+}
+
+// DeleteProxyArtifact handles the business logic of `DELETE /mlflow-artifacts/artifacts/*` endpoint.
+func (s Service) DeleteProxyArtifact(
+	ctx context.Context, namespace *models.Namespace, req *request.ProxyArtifactRequest,
+) error {
+	if err := ValidateProxyArtifactRequest(req); err != nil {
+		return err
+	}
+	if err := s.checkProxyArtifactPath(ctx, namespace, req.Path); err != nil {
+		return err
+	}
+
+	artifactStorage, err := s.getProxyStorage(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := artifactStorage.Delete(ctx, ProxyArtifactRoot, req.Path); err != nil {
+		return api.NewInternalError("error deleting artifact object for path: %s", req.Path)
+	}
+	return nil
+}
+
+// CreateMultipartUpload handles the business logic of `POST /mlflow-artifacts/mpu/create/*` endpoint.
+func (s Service) CreateMultipartUpload(
+	ctx context.Context, namespace *models.Namespace, req *request.CreateMultipartUploadRequest,
+) (string, error) {
+	if err := ValidateCreateMultipartUploadRequest(req); err != nil {
+		return "", err
+	}
+	if err := s.checkProxyArtifactPath(ctx, namespace, req.Path); err != nil {
+		return "", err
+	}
+
+	multipartUpload, err := s.getProxyMultipartUpload(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	uploadID, err := multipartUpload.CreateMultipartUpload(ctx, ProxyArtifactRoot, req.Path, req.NumParts)
+	if err != nil {
+		return "", api.NewInternalError("error creating multipart upload: %s", err)
+	}
+	return uploadID, nil
+}
+
+// UploadMultipartPart handles the business logic of `PUT /mlflow-artifacts/mpu/upload/*` endpoint.
+// It uploads the part and returns its ETag which has to be provided to complete the upload.
+func (s Service) UploadMultipartPart(
+	ctx context.Context, namespace *models.Namespace, req *request.UploadMultipartPartRequest, reader io.Reader,
+) (string, error) {
+	if err := ValidateUploadMultipartPartRequest(req); err != nil {
+		return "", err
+	}
+	if err := s.checkProxyArtifactPath(ctx, namespace, req.Path); err != nil {
+		return "", err
+	}
+
+	multipartUpload, err := s.getProxyMultipartUpload(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	etag, err := multipartUpload.UploadPart(ctx, ProxyArtifactRoot, req.Path, req.UploadID, req.PartNumber, reader)
+	if err != nil {
+		return "", convertMultipartUploadError(
+			err, req.UploadID, fmt.Sprintf("error uploading part %d", req.PartNumber),
+		)
+	}
+	return etag, nil
+}
+
+// CompleteMultipartUpload handles the business logic of `POST /mlflow-artifacts/mpu/complete/*` endpoint.
+func (s Service) CompleteMultipartUpload(
+	ctx context.Context, namespace *models.Namespace, req *request.CompleteMultipartUploadRequest,
+) error {
+	if err := ValidateCompleteMultipartUploadRequest(req); err != nil {
+		return err
+	}
+	if err := s.checkProxyArtifactPath(ctx, namespace, req.Path); err != nil {
+		return err
+	}
+
+	// parts are assembled in the order of part numbers.
+	parts := make([]storage.MultipartUploadPart, len(req.Parts))
+	for i, part := range req.Parts {
+		parts[i] = storage.MultipartUploadPart{PartNumber: part.PartNumber, ETag: part.ETag}
+	}
+	slices.SortFunc(parts, func(a, b storage.MultipartUploadPart) int {
+		return cmp.Compare(a.PartNumber, b.PartNumber)
+	})
+	for i, part := range parts {
+		if part.PartNumber != i+1 {
+			return api.NewInvalidParameterValueError("Missing part %d of multipart upload", i+1)
+		}
+	}
+
+	multipartUpload, err := s.getProxyMultipartUpload(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := multipartUpload.CompleteMultipartUpload(
+		ctx, ProxyArtifactRoot, req.Path, req.UploadID, parts,
+	); err != nil {
+		return convertMultipartUploadError(err, req.UploadID, "error completing multipart upload")
+	}
+	return nil
+}
+
+// AbortMultipartUpload handles the business logic of `POST /mlflow-artifacts/mpu/abort/*` endpoint.
+func (s Service) AbortMultipartUpload(
+	ctx context.Context, namespace *models.Namespace, req *request.AbortMultipartUploadRequest,
+) error {
+	if err := ValidateAbortMultipartUploadRequest(req); err != nil {
+		return err
+	}
+	if err := s.checkProxyArtifactPath(ctx, namespace, req.Path); err != nil {
+		return err
+	}
+
+	multipartUpload, err := s.getProxyMultipartUpload(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := multipartUpload.AbortMultipartUpload(ctx, ProxyArtifactRoot, req.Path, req.UploadID); err != nil {
+		return convertMultipartUploadError(err, req.UploadID, "error aborting multipart upload")
+	}
+	return nil
+}
+
+// checkProxyArtifactPath makes check that the path belongs to the experiment of the namespace, so artifacts of
+// one namespace can't be reached through another one. Artifact locations served by `mlflow-artifacts` API look
+// like `mlflow-artifacts:/<experiment id>`, so the first segment of the path identifies the experiment.
+func (s Service) checkProxyArtifactPath(ctx context.Context, namespace *models.Namespace, path string) error {
+	segment, _, _ := strings.Cut(filepath.ToSlash(filepath.Clean(path)), "/")
+	if experimentID, err := strconv.ParseInt(segment, 10, 32); err == nil {
+		if _, err := s.experimentRepository.GetByNamespaceIDAndExperimentID(
+			ctx, namespace.ID, int32(experimentID),
+		); err == nil {
+			return nil
+		}
+	}
+	return api.NewResourceDoesNotExistError("unable to find experiment of artifact path '%s'", path)
+}
+
+// getProxyStorage returns storage behind `mlflow-artifacts` API.
+func (s Service) getProxyStorage(ctx context.Context) (storage.ArtifactStorageProvider, error) {
+	artifactStorage, err := s.artifactStorageFactory.GetStorage(ctx, ProxyArtifactRoot)
+	if err != nil {
+		return nil, api.NewInternalError("unable to get proxied artifact storage: %s", err)
+	}
+	return artifactStorage, nil
+}
+
+// getProxyMultipartUpload returns multipart upload of the storage behind `mlflow-artifacts` API.
+func (s Service) getProxyMultipartUpload(ctx context.Context) (storage.MultipartUploadProvider, error) {
+	artifactStorage, err := s.getProxyStorage(ctx)
+	if err != nil {
+		return nil, err
+	}
+	multipartUpload, ok := artifactStorage.(storage.MultipartUploadProvider)
+	if !ok {
+		return nil, api.NewInternalError("proxied artifact storage doesn't support multipart upload")
+	}
+	return multipartUpload, nil
+}
+
+// convertMultipartUploadError converts multipart upload error of the storage into the api error.
+func convertMultipartUploadError(err error, uploadID, message string) error {
+	if errors.Is(err, storage.ErrMultipartUploadNotFound) {
+		return api.NewResourceDoesNotExistError("unable to find multipart upload '%s'", uploadID)
+	}
+	var invalidMultipartUploadErr *storage.InvalidMultipartUploadError
+	if errors.As(err, &invalidMultipartUploadErr) {
+		return api.NewInvalidParameterValueError("%s", invalidMultipartUploadErr.Message)
+	}
+	return api.NewInternalError("%s: %s", message, err)
+}
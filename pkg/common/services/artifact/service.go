@@ -14,22 +14,29 @@ import (
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
 	"github.com/G-Research/fasttrackml/pkg/common/api"
 	"github.com/G-Research/fasttrackml/pkg/common/api/request"
+	"github.com/G-Research/fasttrackml/pkg/common/config"
 	"github.com/G-Research/fasttrackml/pkg/common/services/artifact/storage"
 )
 
 // Service provides service layer to work with `artifact` business logic.
 type Service struct {
+	config                 *config.Config
 	runRepository          repositories.RunRepositoryProvider
+	experimentRepository   repositories.ExperimentRepositoryProvider
 	artifactStorageFactory storage.ArtifactStorageFactoryProvider
 }
 
 // NewService creates new Service instance.
 func NewService(
+	config *config.Config,
 	runRepository repositories.RunRepositoryProvider,
+	experimentRepository repositories.ExperimentRepositoryProvider,
 	artifactStorageFactory storage.ArtifactStorageFactoryProvider,
 ) *Service {
 	return &Service{
+		config:                 config,
 		runRepository:          runRepository,
+		experimentRepository:   experimentRepository,
 		artifactStorageFactory: artifactStorageFactory,
 	}
 }
@@ -100,3 +107,61 @@ func (s Service) GetArtifact(
 	}
 	return artifactReader, nil
 }
+
+// DownloadArtifacts handles the business logic of `GET /artifacts/download` endpoint.
+func (s Service) DownloadArtifacts(
+	ctx context.Context, namespace *models.Namespace, req *request.DownloadArtifactsRequest,
+) (*Archive, error) {
+	if err := ValidateDownloadArtifactsRequest(req); err != nil {
+		return nil, err
+	}
+
+	run, err := s.runRepository.GetByNamespaceIDAndRunID(ctx, namespace.ID, req.GetRunID())
+	if err != nil {
+		return nil, api.NewInternalError("unable to find run '%s': %s", req.GetRunID(), err)
+	}
+	if run == nil {
+		return nil, api.NewResourceDoesNotExistError("unable to find run '%s'", req.GetRunID())
+	}
+
+	artifactStorage, err := s.artifactStorageFactory.GetStorage(ctx, run.ArtifactURI)
+	if err != nil {
+		return nil, api.NewInternalError("run with id '%s' has unsupported artifact storage", run.ID)
+	}
+
+	root := filepath.Clean(req.Path)
+	if root == "." {
+		root = ""
+	}
+	objects, err := s.listArchiveObjects(ctx, artifactStorage, run.ArtifactURI, root)
+	if err != nil {
+		return nil, err
+	}
+	if len(objects) == 0 {
+		return nil, api.NewResourceDoesNotExistError(
+			"unable to find artifacts for URI: %s", filepath.Join(run.ArtifactURI, req.Path),
+		)
+	}
+
+	// sort artifacts by path, so the archive content is stable.
+	slices.SortFunc(objects, func(a, b storage.ArtifactObject) int {
+		return cmp.Compare(a.Path, b.Path)
+	})
+
+	format := req.Format
+	if format == "" {
+		format = ArchiveFormatZip
+	}
+	name := run.ID
+	if root != "" {
+		name = filepath.Base(root)
+	}
+	return &Archive{
+		Name:        fmt.Sprintf("%s.%s", name, format),
+		Format:      format,
+		root:        root,
+		artifactURI: run.ArtifactURI,
+		objects:     objects,
+		storage:     artifactStorage,
+	}, nil
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
 	"github.com/G-Research/fasttrackml/pkg/common/api"
 	"github.com/G-Research/fasttrackml/pkg/common/api/request"
+	"github.com/G-Research/fasttrackml/pkg/common/config"
 	"github.com/G-Research/fasttrackml/pkg/common/services/artifact/storage"
 )
 
@@ -55,7 +56,9 @@ func TestService_ListArtifacts_Ok(t *testing.T) {
 	}, nil)
 
 	// call service under testing.
-	service := NewService(&runRepository, &artifactStorageFactory)
+	service := NewService(
+		&config.Config{}, &runRepository, &repositories.MockExperimentRepositoryProvider{}, &artifactStorageFactory,
+	)
 	rootURI, artifacts, err := service.ListArtifacts(
 		context.TODO(),
 		&models.Namespace{
@@ -95,7 +98,9 @@ func TestService_ListArtifacts_Error(t *testing.T) {
 			request: &request.ListArtifactsRequest{},
 			service: func() *Service {
 				return NewService(
+					&config.Config{},
 					&repositories.MockRunRepositoryProvider{},
+					&repositories.MockExperimentRepositoryProvider{},
 					&storage.MockArtifactStorageFactoryProvider{},
 				)
 			},
@@ -109,7 +114,9 @@ func TestService_ListArtifacts_Error(t *testing.T) {
 			},
 			service: func() *Service {
 				return NewService(
+					&config.Config{},
 					&repositories.MockRunRepositoryProvider{},
+					&repositories.MockExperimentRepositoryProvider{},
 					&storage.MockArtifactStorageFactoryProvider{},
 				)
 			},
@@ -129,7 +136,9 @@ func TestService_ListArtifacts_Error(t *testing.T) {
 					"id",
 				).Return(nil, errors.New("database error"))
 				return NewService(
+					&config.Config{},
 					&runRepository,
+					&repositories.MockExperimentRepositoryProvider{},
 					&storage.MockArtifactStorageFactoryProvider{},
 				)
 			},
@@ -164,7 +173,9 @@ func TestService_ListArtifacts_Error(t *testing.T) {
 					ArtifactURI: "/artifact/uri",
 				}, nil)
 				return NewService(
+					&config.Config{},
 					&runRepository,
+					&repositories.MockExperimentRepositoryProvider{},
 					&artifactStorageFactory,
 				)
 			},
@@ -208,7 +219,9 @@ func TestService_GetArtifact_Ok(t *testing.T) {
 	}, nil)
 
 	// call service under testing.
-	service := NewService(&runRepository, &artifactStorageFactory)
+	service := NewService(
+		&config.Config{}, &runRepository, &repositories.MockExperimentRepositoryProvider{}, &artifactStorageFactory,
+	)
 	data, err := service.GetArtifact(
 		context.TODO(),
 		&models.Namespace{
@@ -239,7 +252,9 @@ func TestService_GetArtifact_Error(t *testing.T) {
 			request: &request.GetArtifactRequest{},
 			service: func() *Service {
 				return NewService(
+					&config.Config{},
 					&repositories.MockRunRepositoryProvider{},
+					&repositories.MockExperimentRepositoryProvider{},
 					&storage.MockArtifactStorageFactoryProvider{},
 				)
 			},
@@ -253,7 +268,9 @@ func TestService_GetArtifact_Error(t *testing.T) {
 			},
 			service: func() *Service {
 				return NewService(
+					&config.Config{},
 					&repositories.MockRunRepositoryProvider{},
+					&repositories.MockExperimentRepositoryProvider{},
 					&storage.MockArtifactStorageFactoryProvider{},
 				)
 			},
@@ -273,7 +290,9 @@ func TestService_GetArtifact_Error(t *testing.T) {
 					"id",
 				).Return(nil, errors.New("database error"))
 				return NewService(
+					&config.Config{},
 					&runRepository,
+					&repositories.MockExperimentRepositoryProvider{},
 					&storage.MockArtifactStorageFactoryProvider{},
 				)
 			},
@@ -308,7 +327,9 @@ func TestService_GetArtifact_Error(t *testing.T) {
 					ArtifactURI: "/artifact/uri",
 				}, nil)
 				return NewService(
+					&config.Config{},
 					&runRepository,
+					&repositories.MockExperimentRepositoryProvider{},
 					&artifactStorageFactory,
 				)
 			},
@@ -343,7 +364,9 @@ func TestService_GetArtifact_Error(t *testing.T) {
 					ArtifactURI: "/artifact/uri",
 				}, nil)
 				return NewService(
+					&config.Config{},
 					&runRepository,
+					&repositories.MockExperimentRepositoryProvider{},
 					&artifactStorageFactory,
 				)
 			},
@@ -0,0 +1,354 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/streaming"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
+	"github.com/google/uuid"
+	"github.com/rotisserie/eris"
+
+	"github.com/G-Research/fasttrackml/pkg/common/config"
+)
+
+// Azure storage names.
+const (
+	AzureBlobStorageName      = "wasbs"
+	AzureDataLakeStorageName  = "abfss"
+	azureDefaultEndpointLabel = "blob"
+)
+
+// Azure represents adapter to work with Azure Blob Storage artifacts.
+// Both `wasbs://<container>@<account>.blob.core.windows.net/<path>` and
+// `abfss://<filesystem>@<account>.dfs.core.windows.net/<path>` locations are accessed through the Blob API.
+type Azure struct {
+	endpointURI string
+	accountKey  string
+	sasToken    string
+	credential  azcore.TokenCredential
+	clients     sync.Map
+}
+
+// NewAzure creates new Azure Blob Storage instance.
+func NewAzure(config *config.Config) (*Azure, error) {
+	storage := Azure{
+		endpointURI: strings.TrimRight(config.AzureEndpointURI, "/"),
+		accountKey:  config.AzureStorageAccountKey,
+		sasToken:    strings.TrimPrefix(config.AzureStorageSASToken, "?"),
+	}
+	// use Azure AD credentials only when neither account key nor SAS token has been provided.
+	if storage.accountKey == "" && storage.sasToken == "" {
+		var err error
+		if config.AzureManagedIdentityClientID != "" {
+			storage.credential, err = azidentity.NewManagedIdentityCredential(&azidentity.ManagedIdentityCredentialOptions{
+				ID: azidentity.ClientID(config.AzureManagedIdentityClientID),
+			})
+		} else {
+			storage.credential, err = azidentity.NewDefaultAzureCredential(nil)
+		}
+		if err != nil {
+			return nil, eris.Wrap(err, "error creating Azure credential")
+		}
+	}
+	return &storage, nil
+}
+
+// List implements ArtifactStorageProvider interface.
+func (s *Azure) List(ctx context.Context, artifactURI, path string) ([]ArtifactObject, error) {
+	// 1. process input parameters.
+	client, containerName, rootPrefix, err := s.getClient(artifactURI)
+	if err != nil {
+		return nil, err
+	}
+	prefix := filepath.Join(rootPrefix, path)
+	if prefix != "" {
+		prefix = prefix + "/"
+	}
+
+	// 2. read data from azure storage.
+	var artifactList []ArtifactObject
+	pager := client.ServiceClient().NewContainerClient(containerName).NewListBlobsHierarchyPager(
+		"/", &container.ListBlobsHierarchyOptions{Prefix: &prefix},
+	)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, eris.Wrap(err, "error getting blobs information")
+		}
+		for _, blobPrefix := range page.Segment.BlobPrefixes {
+			relPath, err := filepath.Rel(rootPrefix, *blobPrefix.Name)
+			if err != nil {
+				return nil, eris.Wrapf(err, "error getting relative path for prefix: %s", *blobPrefix.Name)
+			}
+			artifactList = append(artifactList, ArtifactObject{
+				Path:  relPath,
+				IsDir: true,
+			})
+		}
+		for _, blob := range page.Segment.BlobItems {
+			relPath, err := filepath.Rel(rootPrefix, *blob.Name)
+			if err != nil {
+				return nil, eris.Wrapf(err, "error getting relative path for blob: %s", *blob.Name)
+			}
+			// filter current directory from the result set.
+			if relPath == path {
+				continue
+			}
+			var size int64
+			if blob.Properties != nil && blob.Properties.ContentLength != nil {
+				size = *blob.Properties.ContentLength
+			}
+			artifactList = append(artifactList, ArtifactObject{
+				Path: relPath,
+				Size: size,
+			})
+		}
+	}
+
+	return artifactList, nil
+}
+
+// Get returns file content at the storage location.
+func (s *Azure) Get(ctx context.Context, artifactURI, path string) (io.ReadCloser, error) {
+	// 1. process input parameters.
+	client, containerName, prefix, err := s.getClient(artifactURI)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. get blob from azure storage.
+	resp, err := client.DownloadStream(ctx, containerName, filepath.Join(prefix, path), nil)
+	if err != nil {
+		if bloberror.HasCode(err, bloberror.BlobNotFound, bloberror.ContainerNotFound) {
+			return nil, eris.Wrap(fs.ErrNotExist, "object does not exist")
+		}
+		return nil, eris.Wrap(err, "error getting object")
+	}
+
+	return resp.Body, nil
+}
+
+// Put writes file content at the storage location.
+func (s *Azure) Put(ctx context.Context, artifactURI, path string, reader io.Reader) error {
+	// 1. process input parameters.
+	client, containerName, prefix, err := s.getClient(artifactURI)
+	if err != nil {
+		return err
+	}
+
+	// 2. write blob into azure storage.
+	if _, err := client.UploadStream(ctx, containerName, filepath.Join(prefix, path), reader, nil); err != nil {
+		return eris.Wrap(err, "error writing object")
+	}
+
+	return nil
+}
+
+// Delete removes object or all the objects under the prefix at the storage location.
+func (s *Azure) Delete(ctx context.Context, artifactURI, path string) error {
+	// 1. process input parameters.
+	client, containerName, rootPrefix, err := s.getClient(artifactURI)
+	if err != nil {
+		return err
+	}
+	key := filepath.Join(rootPrefix, path)
+
+	// 2. delete the blob itself.
+	if _, err := client.DeleteBlob(ctx, containerName, key, nil); err != nil &&
+		!bloberror.HasCode(err, bloberror.BlobNotFound, bloberror.ContainerNotFound) {
+		return eris.Wrap(err, "error deleting object")
+	}
+
+	// 3. delete all the blobs under the prefix.
+	prefix := key + "/"
+	pager := client.NewListBlobsFlatPager(containerName, &azblob.ListBlobsFlatOptions{Prefix: &prefix})
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			if bloberror.HasCode(err, bloberror.ContainerNotFound) {
+				return nil
+			}
+			return eris.Wrap(err, "error getting blobs information")
+		}
+		for _, blob := range page.Segment.BlobItems {
+			if _, err := client.DeleteBlob(ctx, containerName, *blob.Name, nil); err != nil &&
+				!bloberror.HasCode(err, bloberror.BlobNotFound) {
+				return eris.Wrapf(err, "error deleting object: %s", *blob.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// getClient returns client of the storage account together with the container name
+// and the path prefix extracted from provided artifact uri.
+func (s *Azure) getClient(artifactURI string) (*azblob.Client, string, string, error) {
+	containerName, account, serviceURL, prefix, err := ExtractAzureContainerAndPrefix(artifactURI)
+	if err != nil {
+		return nil, "", "", eris.Wrap(err, "error extracting container and prefix from provided uri")
+	}
+	if s.endpointURI != "" {
+		serviceURL = s.endpointURI + "/"
+	}
+
+	if client, ok := s.clients.Load(serviceURL); ok {
+		return client.(*azblob.Client), containerName, prefix, nil
+	}
+
+	var client *azblob.Client
+	switch {
+	case s.accountKey != "":
+		credential, err := azblob.NewSharedKeyCredential(account, s.accountKey)
+		if err != nil {
+			return nil, "", "", eris.Wrap(err, "error creating Azure shared key credential")
+		}
+		client, err = azblob.NewClientWithSharedKeyCredential(serviceURL, credential, nil)
+		if err != nil {
+			return nil, "", "", eris.Wrap(err, "error creating Azure storage client")
+		}
+	case s.sasToken != "":
+		client, err = azblob.NewClientWithNoCredential(serviceURL+"?"+s.sasToken, nil)
+		if err != nil {
+			return nil, "", "", eris.Wrap(err, "error creating Azure storage client")
+		}
+	default:
+		client, err = azblob.NewClient(serviceURL, s.credential, nil)
+		if err != nil {
+			return nil, "", "", eris.Wrap(err, "error creating Azure storage client")
+		}
+	}
+
+	actual, _ := s.clients.LoadOrStore(serviceURL, client)
+	return actual.(*azblob.Client), containerName, prefix, nil
+}
+
+// ExtractAzureContainerAndPrefix extracts container name, storage account name, Blob service url
+// and path prefix from `wasbs://<container>@<account>.blob.core.windows.net/<path>` or
+// `abfss://<filesystem>@<account>.dfs.core.windows.net/<path>` uri.
+func ExtractAzureContainerAndPrefix(uri string) (string, string, string, string, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return "", "", "", "", eris.Wrapf(err, "error parsing provided uri: %s", uri)
+	}
+	if u.User == nil || u.User.Username() == "" {
+		return "", "", "", "", eris.Errorf("container is missing in provided uri: %s", uri)
+	}
+
+	labels := strings.SplitN(u.Hostname(), ".", 3)
+	if len(labels) != 3 || labels[0] == "" {
+		return "", "", "", "", eris.Errorf("unsupported storage account host in provided uri: %s", uri)
+	}
+	account, suffix := labels[0], labels[2]
+	serviceURL := fmt.Sprintf("https://%s.%s.%s/", account, azureDefaultEndpointLabel, suffix)
+
+	return u.User.Username(), account, serviceURL, strings.TrimLeft(u.Path, "/"), nil
+}
+
+// CreateMultipartUpload implements MultipartUploadProvider interface. Blocks of Azure block blob
+// are kept uncommitted until the upload is completed, so nothing has to be created in advance.
+func (s *Azure) CreateMultipartUpload(ctx context.Context, artifactURI, path string, numParts int) (string, error) {
+	return uuid.New().String(), nil
+}
+
+// UploadPart implements MultipartUploadProvider interface.
+func (s *Azure) UploadPart(
+	ctx context.Context, artifactURI, path, uploadID string, partNumber int, reader io.Reader,
+) (string, error) {
+	// 1. process input parameters.
+	client, containerName, prefix, err := s.getClient(artifactURI)
+	if err != nil {
+		return "", err
+	}
+	if err := validateAzureUploadID(uploadID); err != nil {
+		return "", err
+	}
+
+	// 2. block body has to be seekable, so read the content into the memory.
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return "", eris.Wrap(err, "error reading part content")
+	}
+	hash := md5.Sum(data) // #nosec G401
+	etag := hex.EncodeToString(hash[:])
+
+	// 3. stage block of the blob.
+	if _, err := client.ServiceClient().NewContainerClient(
+		containerName,
+	).NewBlockBlobClient(
+		filepath.Join(prefix, path),
+	).StageBlock(
+		ctx, getAzureBlockID(uploadID, partNumber, etag), streaming.NopCloser(bytes.NewReader(data)), nil,
+	); err != nil {
+		return "", eris.Wrap(err, "error staging block")
+	}
+
+	return etag, nil
+}
+
+// CompleteMultipartUpload implements MultipartUploadProvider interface.
+func (s *Azure) CompleteMultipartUpload(
+	ctx context.Context, artifactURI, path, uploadID string, parts []MultipartUploadPart,
+) error {
+	// 1. process input parameters.
+	client, containerName, prefix, err := s.getClient(artifactURI)
+	if err != nil {
+		return err
+	}
+	if err := validateAzureUploadID(uploadID); err != nil {
+		return err
+	}
+
+	// 2. commit staged blocks. block id contains ETag, so the block can't be found, if ETag is incorrect.
+	blockIDs := make([]string, len(parts))
+	for i, part := range parts {
+		blockIDs[i] = getAzureBlockID(uploadID, part.PartNumber, strings.Trim(part.ETag, `"`))
+	}
+	if _, err := client.ServiceClient().NewContainerClient(
+		containerName,
+	).NewBlockBlobClient(
+		filepath.Join(prefix, path),
+	).CommitBlockList(ctx, blockIDs, nil); err != nil {
+		if bloberror.HasCode(err, bloberror.InvalidBlockList, bloberror.InvalidBlockID) {
+			return NewInvalidMultipartUploadError("Parts of multipart upload have not been uploaded or ETags are invalid")
+		}
+		return eris.Wrap(err, "error committing blocks")
+	}
+
+	return nil
+}
+
+// AbortMultipartUpload implements MultipartUploadProvider interface. Uncommitted blocks
+// can't be deleted explicitly, Azure removes them itself, if they aren't committed within a week.
+func (s *Azure) AbortMultipartUpload(ctx context.Context, artifactURI, path, uploadID string) error {
+	return validateAzureUploadID(uploadID)
+}
+
+// validateAzureUploadID validates upload id, which has to be exactly what we generated,
+// because all the block ids of the blob must have the same length.
+func validateAzureUploadID(uploadID string) error {
+	if parsed, err := uuid.Parse(uploadID); err != nil || parsed.String() != uploadID {
+		return eris.Wrapf(ErrMultipartUploadNotFound, "invalid upload id: %s", uploadID)
+	}
+	return nil
+}
+
+// getAzureBlockID returns id of the block which stores the part of multipart upload.
+func getAzureBlockID(uploadID string, partNumber int, etag string) string {
+	return base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s-%05d-%s", uploadID, partNumber, etag)))
+}
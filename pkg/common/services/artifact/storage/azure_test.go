@@ -0,0 +1,79 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractAzureContainerAndPrefix_Ok(t *testing.T) {
+	tests := []struct {
+		name               string
+		uri                string
+		expectedContainer  string
+		expectedAccount    string
+		expectedServiceURL string
+		expectedPrefix     string
+	}{
+		{
+			name:               "BlobURI",
+			uri:                "wasbs://container@account.blob.core.windows.net/path/to/artifacts",
+			expectedContainer:  "container",
+			expectedAccount:    "account",
+			expectedServiceURL: "https://account.blob.core.windows.net/",
+			expectedPrefix:     "path/to/artifacts",
+		},
+		{
+			name:               "DataLakeURI",
+			uri:                "abfss://filesystem@account.dfs.core.windows.net/path",
+			expectedContainer:  "filesystem",
+			expectedAccount:    "account",
+			expectedServiceURL: "https://account.blob.core.windows.net/",
+			expectedPrefix:     "path",
+		},
+		{
+			name:               "SovereignCloudURIWithoutPath",
+			uri:                "wasbs://container@account.blob.core.chinacloudapi.cn",
+			expectedContainer:  "container",
+			expectedAccount:    "account",
+			expectedServiceURL: "https://account.blob.core.chinacloudapi.cn/",
+			expectedPrefix:     "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			container, account, serviceURL, prefix, err := ExtractAzureContainerAndPrefix(tt.uri)
+			require.Nil(t, err)
+			assert.Equal(t, tt.expectedContainer, container)
+			assert.Equal(t, tt.expectedAccount, account)
+			assert.Equal(t, tt.expectedServiceURL, serviceURL)
+			assert.Equal(t, tt.expectedPrefix, prefix)
+		})
+	}
+}
+
+func TestExtractAzureContainerAndPrefix_Error(t *testing.T) {
+	tests := []struct {
+		name  string
+		uri   string
+		error string
+	}{
+		{
+			name:  "MissingContainer",
+			uri:   "wasbs://account.blob.core.windows.net/path",
+			error: "container is missing in provided uri: wasbs://account.blob.core.windows.net/path",
+		},
+		{
+			name:  "IncorrectHost",
+			uri:   "wasbs://container@localhost/path",
+			error: "unsupported storage account host in provided uri: wasbs://container@localhost/path",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, _, _, err := ExtractAzureContainerAndPrefix(tt.uri)
+			assert.EqualError(t, err, tt.error)
+		})
+	}
+}
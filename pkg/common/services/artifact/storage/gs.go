@@ -2,12 +2,16 @@ package storage
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
+	"fmt"
 	"io"
 	"io/fs"
 	"path/filepath"
+	"strings"
 
 	"cloud.google.com/go/storage"
+	"github.com/google/uuid"
 	"github.com/rotisserie/eris"
 	"google.golang.org/api/iterator"
 	"google.golang.org/api/option"
@@ -20,6 +24,12 @@ const (
 	GSStorageName = "gs"
 )
 
+// Parts of multipart upload are uploaded as separate objects into this directory and composed together.
+const (
+	gsMultipartUploadsDirectory = ".fasttrackml-mpu"
+	gsComposeMaxSources         = 32
+)
+
 // GS represents adapter to work with GS storage artifacts.
 type GS struct {
 	client *storage.Client
@@ -112,3 +122,171 @@ func (s GS) Get(ctx context.Context, artifactURI, path string) (io.ReadCloser, e
 
 	return reader, nil
 }
+
+// Put writes file content at the storage location.
+func (s GS) Put(ctx context.Context, artifactURI, path string, reader io.Reader) error {
+	// 1. process input parameters.
+	bucketName, prefix, err := ExtractBucketAndPrefix(artifactURI)
+	if err != nil {
+		return eris.Wrap(err, "error extracting bucket and prefix from provided uri")
+	}
+
+	// 2. write object into gcp storage.
+	writer := s.client.Bucket(bucketName).Object(filepath.Join(prefix, path)).NewWriter(ctx)
+	if _, err := io.Copy(writer, reader); err != nil {
+		//nolint:errcheck
+		writer.Close()
+		return eris.Wrap(err, "error writing object")
+	}
+	if err := writer.Close(); err != nil {
+		return eris.Wrap(err, "error writing object")
+	}
+
+	return nil
+}
+
+// Delete removes object or all the objects under the prefix at the storage location.
+func (s GS) Delete(ctx context.Context, artifactURI, path string) error {
+	// 1. process input parameters.
+	bucketName, rootPrefix, err := ExtractBucketAndPrefix(artifactURI)
+	if err != nil {
+		return eris.Wrap(err, "error extracting bucket and prefix from provided uri")
+	}
+	key := filepath.Join(rootPrefix, path)
+	bucket := s.client.Bucket(bucketName)
+
+	// 2. delete the object itself.
+	if err := bucket.Object(key).Delete(ctx); err != nil && !errors.Is(err, storage.ErrObjectNotExist) {
+		return eris.Wrap(err, "error deleting object")
+	}
+
+	// 3. delete all the objects under the prefix.
+	it := bucket.Objects(ctx, &storage.Query{
+		Prefix: key + "/",
+	})
+	for {
+		object, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+		if err != nil {
+			return eris.Wrap(err, "error getting object information")
+		}
+		if err := bucket.Object(object.Name).Delete(ctx); err != nil && !errors.Is(err, storage.ErrObjectNotExist) {
+			return eris.Wrapf(err, "error deleting object: %s", object.Name)
+		}
+	}
+
+	return nil
+}
+
+// CreateMultipartUpload implements MultipartUploadProvider interface. Parts are uploaded
+// as separate objects and composed together, so nothing has to be created in advance.
+func (s GS) CreateMultipartUpload(ctx context.Context, artifactURI, path string, numParts int) (string, error) {
+	return uuid.New().String(), nil
+}
+
+// UploadPart implements MultipartUploadProvider interface.
+func (s GS) UploadPart(
+	ctx context.Context, artifactURI, path, uploadID string, partNumber int, reader io.Reader,
+) (string, error) {
+	// 1. process input parameters.
+	bucketName, prefix, err := ExtractBucketAndPrefix(artifactURI)
+	if err != nil {
+		return "", eris.Wrap(err, "error extracting bucket and prefix from provided uri")
+	}
+	if err := validateGSUploadID(uploadID); err != nil {
+		return "", err
+	}
+
+	// 2. write part object into gcp storage.
+	writer := s.client.Bucket(bucketName).Object(getGSPartName(prefix, uploadID, partNumber)).NewWriter(ctx)
+	if _, err := io.Copy(writer, reader); err != nil {
+		//nolint:errcheck
+		writer.Close()
+		return "", eris.Wrap(err, "error writing part")
+	}
+	if err := writer.Close(); err != nil {
+		return "", eris.Wrap(err, "error writing part")
+	}
+
+	return hex.EncodeToString(writer.Attrs().MD5), nil
+}
+
+// CompleteMultipartUpload implements MultipartUploadProvider interface.
+func (s GS) CompleteMultipartUpload(
+	ctx context.Context, artifactURI, path, uploadID string, parts []MultipartUploadPart,
+) error {
+	// 1. process input parameters.
+	bucketName, prefix, err := ExtractBucketAndPrefix(artifactURI)
+	if err != nil {
+		return eris.Wrap(err, "error extracting bucket and prefix from provided uri")
+	}
+	if err := validateGSUploadID(uploadID); err != nil {
+		return err
+	}
+	bucket := s.client.Bucket(bucketName)
+
+	// 2. check that all the parts have been uploaded with provided ETags.
+	sources := make([]*storage.ObjectHandle, len(parts))
+	for i, part := range parts {
+		sources[i] = bucket.Object(getGSPartName(prefix, uploadID, part.PartNumber))
+		attrs, err := sources[i].Attrs(ctx)
+		if err != nil {
+			if errors.Is(err, storage.ErrObjectNotExist) {
+				return NewInvalidMultipartUploadError(
+					"Part %d of multipart upload has not been uploaded", part.PartNumber,
+				)
+			}
+			return eris.Wrapf(err, "error getting part %d information", part.PartNumber)
+		}
+		if hex.EncodeToString(attrs.MD5) != strings.Trim(part.ETag, `"`) {
+			return NewInvalidMultipartUploadError("Invalid ETag supplied for part %d", part.PartNumber)
+		}
+	}
+
+	// 3. compose the parts into the object. the number of composed objects is limited,
+	// so the parts are composed into intermediate objects first, if there are too many of them.
+	for level := 0; len(sources) > gsComposeMaxSources; level++ {
+		composed := make([]*storage.ObjectHandle, 0, len(sources)/gsComposeMaxSources+1)
+		for start := 0; start < len(sources); start += gsComposeMaxSources {
+			end := min(start+gsComposeMaxSources, len(sources))
+			object := bucket.Object(filepath.Join(
+				prefix, gsMultipartUploadsDirectory, uploadID, fmt.Sprintf("compose-%d-%d", level, start),
+			))
+			if _, err := object.ComposerFrom(sources[start:end]...).Run(ctx); err != nil {
+				return eris.Wrap(err, "error composing parts")
+			}
+			composed = append(composed, object)
+		}
+		sources = composed
+	}
+	if _, err := bucket.Object(filepath.Join(prefix, path)).ComposerFrom(sources...).Run(ctx); err != nil {
+		return eris.Wrap(err, "error composing parts")
+	}
+
+	// 4. remove uploaded parts.
+	return s.Delete(ctx, artifactURI, filepath.Join(gsMultipartUploadsDirectory, uploadID))
+}
+
+// AbortMultipartUpload implements MultipartUploadProvider interface.
+func (s GS) AbortMultipartUpload(ctx context.Context, artifactURI, path, uploadID string) error {
+	if err := validateGSUploadID(uploadID); err != nil {
+		return err
+	}
+	return s.Delete(ctx, artifactURI, filepath.Join(gsMultipartUploadsDirectory, uploadID))
+}
+
+// validateGSUploadID validates upload id, which is a part of the object names, so it has to be exactly
+// what we generated.
+func validateGSUploadID(uploadID string) error {
+	if parsed, err := uuid.Parse(uploadID); err != nil || parsed.String() != uploadID {
+		return eris.Wrapf(ErrMultipartUploadNotFound, "invalid upload id: %s", uploadID)
+	}
+	return nil
+}
+
+// getGSPartName returns name of the object which stores the part of multipart upload.
+func getGSPartName(prefix, uploadID string, partNumber int) string {
+	return filepath.Join(prefix, gsMultipartUploadsDirectory, uploadID, fmt.Sprintf("part-%05d", partNumber))
+}
@@ -95,3 +95,47 @@ func (s Local) Get(ctx context.Context, artifactURI, path string) (io.ReadCloser
 
 	return file, nil
 }
+
+// Put writes file content at the storage location.
+func (s Local) Put(ctx context.Context, artifactURI, path string, reader io.Reader) error {
+	// 1. trim the `file://` prefix if it exists.
+	artifactURI = strings.TrimPrefix(artifactURI, "file://")
+
+	// 2. process `path` parameter and create parent directories.
+	absPath := filepath.Join(artifactURI, path)
+	if err := os.MkdirAll(filepath.Dir(absPath), os.ModePerm); err != nil {
+		return eris.Wrap(err, "unable to create parent directories")
+	}
+
+	// 3. write content into the file.
+	// artifactURI and path are validated by the caller
+	// #nosec G304
+	file, err := os.Create(absPath)
+	if err != nil {
+		return eris.Wrap(err, "unable to create file")
+	}
+	if _, err := io.Copy(file, reader); err != nil {
+		//nolint:errcheck,gosec
+		file.Close()
+		return eris.Wrap(err, "unable to write file")
+	}
+	// buffered content could be flushed only on close, so the error has to be reported.
+	if err := file.Close(); err != nil {
+		return eris.Wrap(err, "unable to close file")
+	}
+
+	return nil
+}
+
+// Delete removes file or directory at the storage location.
+func (s Local) Delete(ctx context.Context, artifactURI, path string) error {
+	// 1. trim the `file://` prefix if it exists.
+	artifactURI = strings.TrimPrefix(artifactURI, "file://")
+
+	// 2. remove file or directory with all its content.
+	if err := os.RemoveAll(filepath.Join(artifactURI, path)); err != nil {
+		return eris.Wrap(err, "unable to delete path")
+	}
+
+	return nil
+}
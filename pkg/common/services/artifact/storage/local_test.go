@@ -5,6 +5,7 @@ import (
 	"io/fs"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -132,3 +133,45 @@ func TestLocal_ListArtifacts_Ok(t *testing.T) {
 		})
 	}
 }
+
+func TestPutArtifact_Ok(t *testing.T) {
+	// setup
+	runArtifactRoot := t.TempDir()
+
+	// invoke
+	storage, err := NewLocal(nil)
+	require.Nil(t, err)
+
+	err = storage.Put(
+		context.Background(), "file://"+runArtifactRoot, "subdir/file.txt", strings.NewReader("artifact content"),
+	)
+	require.Nil(t, err)
+
+	// verify
+	// #nosec G304
+	content, err := os.ReadFile(filepath.Join(runArtifactRoot, "subdir", "file.txt"))
+	require.Nil(t, err)
+	assert.Equal(t, "artifact content", string(content))
+}
+
+func TestDeleteArtifact_Ok(t *testing.T) {
+	// setup
+	runArtifactRoot := t.TempDir()
+	require.Nil(t, os.MkdirAll(filepath.Join(runArtifactRoot, "subdir"), os.ModePerm))
+	require.Nil(t, os.WriteFile(filepath.Join(runArtifactRoot, "subdir", "file.txt"), []byte("content"), 0o600))
+	require.Nil(t, os.WriteFile(filepath.Join(runArtifactRoot, "file.txt"), []byte("content"), 0o600))
+
+	// invoke
+	storage, err := NewLocal(nil)
+	require.Nil(t, err)
+
+	require.Nil(t, storage.Delete(context.Background(), runArtifactRoot, "subdir"))
+	// deletion of non-existing path is not an error.
+	require.Nil(t, storage.Delete(context.Background(), runArtifactRoot, "non-existent-file"))
+
+	// verify
+	_, err = os.Stat(filepath.Join(runArtifactRoot, "subdir"))
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+	_, err = os.Stat(filepath.Join(runArtifactRoot, "file.txt"))
+	assert.Nil(t, err)
+}
@@ -0,0 +1,293 @@
+package storage
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rotisserie/eris"
+	log "github.com/sirupsen/logrus"
+)
+
+// ErrMultipartUploadNotFound is returned when multipart upload doesn't exist.
+var ErrMultipartUploadNotFound = errors.New("multipart upload not found")
+
+// InvalidMultipartUploadError is returned when provided parts don't match the parts of multipart upload.
+type InvalidMultipartUploadError struct {
+	Message string
+}
+
+// NewInvalidMultipartUploadError creates new InvalidMultipartUploadError instance.
+func NewInvalidMultipartUploadError(format string, args ...any) *InvalidMultipartUploadError {
+	return &InvalidMultipartUploadError{
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+// Error implements error interface.
+func (e InvalidMultipartUploadError) Error() string {
+	return e.Message
+}
+
+// MultipartUploadPart represents uploaded part of multipart upload.
+type MultipartUploadPart struct {
+	PartNumber int
+	ETag       string
+}
+
+// MultipartUploadProvider provides an interface to upload artifact in parts.
+type MultipartUploadProvider interface {
+	// CreateMultipartUpload starts multipart upload of specific artifact and returns its identifier.
+	CreateMultipartUpload(ctx context.Context, artifactURI, path string, numParts int) (string, error)
+	// UploadPart uploads a part of multipart upload and returns its ETag.
+	UploadPart(
+		ctx context.Context, artifactURI, path, uploadID string, partNumber int, reader io.Reader,
+	) (string, error)
+	// CompleteMultipartUpload assembles uploaded parts, sorted by part number, into the artifact.
+	CompleteMultipartUpload(
+		ctx context.Context, artifactURI, path, uploadID string, parts []MultipartUploadPart,
+	) error
+	// AbortMultipartUpload aborts multipart upload and removes uploaded parts.
+	AbortMultipartUpload(ctx context.Context, artifactURI, path, uploadID string) error
+}
+
+// stagedMultipartUploadsDirectory is a directory inside temp directory where parts are staged by default.
+const stagedMultipartUploadsDirectory = "fasttrackml-mpu"
+
+// stagedMultipartUpload represents metadata of multipart upload stored alongside staged parts.
+type stagedMultipartUpload struct {
+	ArtifactURI string `json:"artifact_uri"`
+	Path        string `json:"path"`
+	NumParts    int    `json:"num_parts"`
+}
+
+// StagedMultipartUpload represents multipart upload for the storages, which don't support it natively.
+// Parts are staged in the directory, which has to be shared by all the server replicas,
+// and the artifact is written into the storage, when the upload is completed.
+type StagedMultipartUpload struct {
+	directory string
+	retention time.Duration
+	storage   ArtifactStorageProvider
+}
+
+// NewStagedMultipartUpload creates new StagedMultipartUpload instance.
+func NewStagedMultipartUpload(
+	directory string, retention time.Duration, storage ArtifactStorageProvider,
+) *StagedMultipartUpload {
+	if directory == "" {
+		directory = filepath.Join(os.TempDir(), stagedMultipartUploadsDirectory)
+	}
+	return &StagedMultipartUpload{
+		directory: directory,
+		retention: retention,
+		storage:   storage,
+	}
+}
+
+// CreateMultipartUpload implements MultipartUploadProvider interface.
+func (s StagedMultipartUpload) CreateMultipartUpload(
+	ctx context.Context, artifactURI, path string, numParts int,
+) (string, error) {
+	// 1. remove stale uploads, which have been neither completed nor aborted.
+	s.removeStaleUploads()
+
+	// 2. create upload directory together with upload metadata.
+	uploadID := uuid.New().String()
+	if err := os.MkdirAll(s.getUploadDirectory(uploadID), 0o700); err != nil {
+		return "", eris.Wrap(err, "error creating upload directory")
+	}
+	data, err := json.Marshal(stagedMultipartUpload{
+		ArtifactURI: artifactURI,
+		Path:        path,
+		NumParts:    numParts,
+	})
+	if err != nil {
+		return "", eris.Wrap(err, "error marshaling upload metadata")
+	}
+	if err := os.WriteFile(s.getUploadMetadataPath(uploadID), data, 0o600); err != nil {
+		return "", eris.Wrap(err, "error writing upload metadata")
+	}
+
+	return uploadID, nil
+}
+
+// UploadPart implements MultipartUploadProvider interface.
+func (s StagedMultipartUpload) UploadPart(
+	ctx context.Context, artifactURI, path, uploadID string, partNumber int, reader io.Reader,
+) (string, error) {
+	// 1. check that part belongs to the upload.
+	upload, err := s.getUpload(artifactURI, path, uploadID)
+	if err != nil {
+		return "", err
+	}
+	if partNumber > upload.NumParts {
+		return "", NewInvalidMultipartUploadError(
+			"Invalid value for parameter 'part_number' supplied. It must be at most %d", upload.NumParts,
+		)
+	}
+
+	// 2. stage the part and calculate its ETag.
+	file, err := os.Create(s.getUploadPartPath(uploadID, partNumber))
+	if err != nil {
+		return "", eris.Wrapf(err, "error creating part %d", partNumber)
+	}
+	defer file.Close()
+
+	hash := md5.New() // #nosec G401
+	if _, err := io.Copy(io.MultiWriter(file, hash), reader); err != nil {
+		return "", eris.Wrapf(err, "error writing part %d", partNumber)
+	}
+	if err := file.Close(); err != nil {
+		return "", eris.Wrapf(err, "error writing part %d", partNumber)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// CompleteMultipartUpload implements MultipartUploadProvider interface.
+func (s StagedMultipartUpload) CompleteMultipartUpload(
+	ctx context.Context, artifactURI, path, uploadID string, parts []MultipartUploadPart,
+) error {
+	// 1. check that parts belong to the upload.
+	upload, err := s.getUpload(artifactURI, path, uploadID)
+	if err != nil {
+		return err
+	}
+	if len(parts) != upload.NumParts {
+		return NewInvalidMultipartUploadError(
+			"Invalid value for parameter 'parts' supplied. Expected %d parts, got %d", upload.NumParts, len(parts),
+		)
+	}
+
+	// 2. concatenate staged parts into the single file.
+	objectPath := filepath.Join(s.getUploadDirectory(uploadID), "object")
+	object, err := os.Create(objectPath)
+	if err != nil {
+		return eris.Wrap(err, "error creating object")
+	}
+	defer object.Close()
+	for _, part := range parts {
+		if err := s.appendPart(object, uploadID, part); err != nil {
+			return err
+		}
+	}
+	if _, err := object.Seek(0, io.SeekStart); err != nil {
+		return eris.Wrap(err, "error reading object")
+	}
+
+	// 3. write the object into the storage and remove staged parts.
+	if err := s.storage.Put(ctx, artifactURI, path, object); err != nil {
+		return eris.Wrap(err, "error putting object")
+	}
+	if err := os.RemoveAll(s.getUploadDirectory(uploadID)); err != nil {
+		return eris.Wrap(err, "error removing staged parts")
+	}
+	return nil
+}
+
+// AbortMultipartUpload implements MultipartUploadProvider interface.
+func (s StagedMultipartUpload) AbortMultipartUpload(ctx context.Context, artifactURI, path, uploadID string) error {
+	if _, err := s.getUpload(artifactURI, path, uploadID); err != nil {
+		return err
+	}
+	if err := os.RemoveAll(s.getUploadDirectory(uploadID)); err != nil {
+		return eris.Wrap(err, "error removing staged parts")
+	}
+	return nil
+}
+
+// getUpload reads metadata of the upload and checks that it belongs to provided artifact.
+func (s StagedMultipartUpload) getUpload(artifactURI, path, uploadID string) (*stagedMultipartUpload, error) {
+	// upload id is used as a directory name, so it has to be exactly what we generated.
+	if parsed, err := uuid.Parse(uploadID); err != nil || parsed.String() != uploadID {
+		return nil, eris.Wrapf(ErrMultipartUploadNotFound, "invalid upload id: %s", uploadID)
+	}
+
+	data, err := os.ReadFile(s.getUploadMetadataPath(uploadID))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, eris.Wrapf(ErrMultipartUploadNotFound, "error reading upload metadata: %s", uploadID)
+		}
+		return nil, eris.Wrapf(err, "error reading upload metadata: %s", uploadID)
+	}
+
+	var upload stagedMultipartUpload
+	if err := json.Unmarshal(data, &upload); err != nil {
+		return nil, eris.Wrapf(err, "error unmarshaling upload metadata: %s", uploadID)
+	}
+	if upload.ArtifactURI != artifactURI || upload.Path != path {
+		return nil, eris.Wrapf(ErrMultipartUploadNotFound, "upload %s belongs to another artifact", uploadID)
+	}
+	return &upload, nil
+}
+
+// appendPart checks ETag of the staged part and appends its content to the object.
+func (s StagedMultipartUpload) appendPart(object io.Writer, uploadID string, part MultipartUploadPart) error {
+	file, err := os.Open(s.getUploadPartPath(uploadID, part.PartNumber))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return NewInvalidMultipartUploadError("Part %d of multipart upload has not been uploaded", part.PartNumber)
+		}
+		return eris.Wrapf(err, "error opening part %d", part.PartNumber)
+	}
+	defer file.Close()
+
+	hash := md5.New() // #nosec G401
+	if _, err := io.Copy(io.MultiWriter(object, hash), file); err != nil {
+		return eris.Wrapf(err, "error appending part %d", part.PartNumber)
+	}
+	if hex.EncodeToString(hash.Sum(nil)) != strings.Trim(part.ETag, `"`) {
+		return NewInvalidMultipartUploadError("Invalid ETag supplied for part %d", part.PartNumber)
+	}
+	return nil
+}
+
+// removeStaleUploads removes the uploads, which have been created earlier than retention period.
+// The errors are only logged, because another replica could remove the same upload at the same time.
+func (s StagedMultipartUpload) removeStaleUploads() {
+	if s.retention <= 0 {
+		return
+	}
+	entries, err := os.ReadDir(s.directory)
+	if err != nil {
+		if !errors.Is(err, fs.ErrNotExist) {
+			log.Warnf("error reading multipart uploads directory: %s", err)
+		}
+		return
+	}
+	for _, entry := range entries {
+		info, err := os.Stat(s.getUploadMetadataPath(entry.Name()))
+		if err != nil || time.Since(info.ModTime()) < s.retention {
+			continue
+		}
+		log.Debugf("removing stale multipart upload: %s", entry.Name())
+		if err := os.RemoveAll(s.getUploadDirectory(entry.Name())); err != nil {
+			log.Warnf("error removing stale multipart upload %s: %s", entry.Name(), err)
+		}
+	}
+}
+
+// getUploadDirectory returns directory where parts of multipart upload are staged.
+func (s StagedMultipartUpload) getUploadDirectory(uploadID string) string {
+	return filepath.Join(s.directory, uploadID)
+}
+
+// getUploadMetadataPath returns path of multipart upload metadata file.
+func (s StagedMultipartUpload) getUploadMetadataPath(uploadID string) string {
+	return filepath.Join(s.getUploadDirectory(uploadID), "upload.json")
+}
+
+// getUploadPartPath returns path of staged multipart upload part.
+func (s StagedMultipartUpload) getUploadPartPath(uploadID string, partNumber int) string {
+	return filepath.Join(s.getUploadDirectory(uploadID), fmt.Sprintf("part-%d", partNumber))
+}
@@ -0,0 +1,201 @@
+package storage
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStagedMultipartUpload_Ok(t *testing.T) {
+	// setup
+	runArtifactRoot, stagingDirectory := t.TempDir(), t.TempDir()
+	storage, err := NewLocal(nil)
+	require.Nil(t, err)
+	upload := NewStagedMultipartUpload(stagingDirectory, time.Hour, storage)
+
+	// invoke
+	ctx := context.Background()
+	uploadID, err := upload.CreateMultipartUpload(ctx, runArtifactRoot, "model.bin", 2)
+	require.Nil(t, err)
+
+	// parts could be uploaded in any order.
+	etag2, err := upload.UploadPart(ctx, runArtifactRoot, "model.bin", uploadID, 2, strings.NewReader("world"))
+	require.Nil(t, err)
+	etag1, err := upload.UploadPart(ctx, runArtifactRoot, "model.bin", uploadID, 1, strings.NewReader("hello "))
+	require.Nil(t, err)
+
+	err = upload.CompleteMultipartUpload(ctx, runArtifactRoot, "model.bin", uploadID, []MultipartUploadPart{
+		{PartNumber: 1, ETag: etag1},
+		{PartNumber: 2, ETag: `"` + etag2 + `"`},
+	})
+	require.Nil(t, err)
+
+	// verify
+	// #nosec G304
+	content, err := os.ReadFile(filepath.Join(runArtifactRoot, "model.bin"))
+	require.Nil(t, err)
+	assert.Equal(t, "hello world", string(content))
+	assert.NoDirExists(t, filepath.Join(stagingDirectory, uploadID))
+}
+
+func TestStagedMultipartUpload_Abort_Ok(t *testing.T) {
+	// setup
+	runArtifactRoot, stagingDirectory := t.TempDir(), t.TempDir()
+	storage, err := NewLocal(nil)
+	require.Nil(t, err)
+	upload := NewStagedMultipartUpload(stagingDirectory, time.Hour, storage)
+
+	ctx := context.Background()
+	uploadID, err := upload.CreateMultipartUpload(ctx, runArtifactRoot, "model.bin", 1)
+	require.Nil(t, err)
+	_, err = upload.UploadPart(ctx, runArtifactRoot, "model.bin", uploadID, 1, strings.NewReader("content"))
+	require.Nil(t, err)
+
+	// invoke
+	err = upload.AbortMultipartUpload(ctx, runArtifactRoot, "model.bin", uploadID)
+	require.Nil(t, err)
+
+	// verify
+	assert.NoDirExists(t, filepath.Join(stagingDirectory, uploadID))
+	assert.NoFileExists(t, filepath.Join(runArtifactRoot, "model.bin"))
+}
+
+func TestStagedMultipartUpload_RemoveStaleUploads_Ok(t *testing.T) {
+	// setup
+	runArtifactRoot, stagingDirectory := t.TempDir(), t.TempDir()
+	storage, err := NewLocal(nil)
+	require.Nil(t, err)
+	upload := NewStagedMultipartUpload(stagingDirectory, time.Hour, storage)
+
+	ctx := context.Background()
+	staleUploadID, err := upload.CreateMultipartUpload(ctx, runArtifactRoot, "stale.bin", 1)
+	require.Nil(t, err)
+	staleTime := time.Now().Add(-2 * time.Hour)
+	require.Nil(t, os.Chtimes(upload.getUploadMetadataPath(staleUploadID), staleTime, staleTime))
+
+	// invoke
+	uploadID, err := upload.CreateMultipartUpload(ctx, runArtifactRoot, "model.bin", 1)
+	require.Nil(t, err)
+
+	// verify
+	assert.NoDirExists(t, filepath.Join(stagingDirectory, staleUploadID))
+	assert.DirExists(t, filepath.Join(stagingDirectory, uploadID))
+	_, err = upload.UploadPart(ctx, runArtifactRoot, "stale.bin", staleUploadID, 1, strings.NewReader("content"))
+	assert.ErrorIs(t, err, ErrMultipartUploadNotFound)
+}
+
+func TestStagedMultipartUpload_Error(t *testing.T) {
+	// setup
+	runArtifactRoot := t.TempDir()
+	storage, err := NewLocal(nil)
+	require.Nil(t, err)
+	upload := NewStagedMultipartUpload(t.TempDir(), time.Hour, storage)
+
+	ctx := context.Background()
+	uploadID, err := upload.CreateMultipartUpload(ctx, runArtifactRoot, "model.bin", 2)
+	require.Nil(t, err)
+	etag, err := upload.UploadPart(ctx, runArtifactRoot, "model.bin", uploadID, 1, strings.NewReader("content"))
+	require.Nil(t, err)
+
+	tests := []struct {
+		name          string
+		call          func() error
+		expectedError string
+		notFound      bool
+	}{
+		{
+			name: "UploadPartWithIncorrectUploadID",
+			call: func() error {
+				_, err := upload.UploadPart(ctx, runArtifactRoot, "model.bin", "../upload", 1, strings.NewReader(""))
+				return err
+			},
+			notFound: true,
+		},
+		{
+			name: "UploadPartWithUnknownUploadID",
+			call: func() error {
+				_, err := upload.UploadPart(
+					ctx, runArtifactRoot, "model.bin", "00000000-0000-0000-0000-000000000000", 1, strings.NewReader(""),
+				)
+				return err
+			},
+			notFound: true,
+		},
+		{
+			name: "UploadPartOfAnotherArtifact",
+			call: func() error {
+				_, err := upload.UploadPart(ctx, runArtifactRoot, "other.bin", uploadID, 1, strings.NewReader(""))
+				return err
+			},
+			notFound: true,
+		},
+		{
+			name: "UploadPartWithIncorrectPartNumber",
+			call: func() error {
+				_, err := upload.UploadPart(ctx, runArtifactRoot, "model.bin", uploadID, 3, strings.NewReader(""))
+				return err
+			},
+			expectedError: "Invalid value for parameter 'part_number' supplied. It must be at most 2",
+		},
+		{
+			name: "CompleteWithIncorrectNumberOfParts",
+			call: func() error {
+				return upload.CompleteMultipartUpload(ctx, runArtifactRoot, "model.bin", uploadID, []MultipartUploadPart{
+					{PartNumber: 1, ETag: etag},
+				})
+			},
+			expectedError: "Invalid value for parameter 'parts' supplied. Expected 2 parts, got 1",
+		},
+		{
+			name: "CompleteWithMissingPart",
+			call: func() error {
+				return upload.CompleteMultipartUpload(ctx, runArtifactRoot, "model.bin", uploadID, []MultipartUploadPart{
+					{PartNumber: 1, ETag: etag},
+					{PartNumber: 2, ETag: etag},
+				})
+			},
+			expectedError: "Part 2 of multipart upload has not been uploaded",
+		},
+		{
+			name: "CompleteWithIncorrectETag",
+			call: func() error {
+				return upload.CompleteMultipartUpload(ctx, runArtifactRoot, "model.bin", uploadID, []MultipartUploadPart{
+					{PartNumber: 1, ETag: "incorrect"},
+					{PartNumber: 2, ETag: etag},
+				})
+			},
+			expectedError: "Invalid ETag supplied for part 1",
+		},
+		{
+			name: "AbortUnknownUpload",
+			call: func() error {
+				return upload.AbortMultipartUpload(
+					ctx, runArtifactRoot, "model.bin", "00000000-0000-0000-0000-000000000000",
+				)
+			},
+			notFound: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call()
+			if tt.notFound {
+				assert.ErrorIs(t, err, ErrMultipartUploadNotFound)
+				return
+			}
+			var invalidErr *InvalidMultipartUploadError
+			require.ErrorAs(t, err, &invalidErr)
+			assert.Equal(t, tt.expectedError, invalidErr.Message)
+		})
+	}
+
+	// verify that failed attempts don't create the artifact.
+	assert.NoFileExists(t, filepath.Join(runArtifactRoot, "model.bin"))
+}
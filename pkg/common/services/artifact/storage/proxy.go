@@ -0,0 +1,144 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/rotisserie/eris"
+
+	"github.com/G-Research/fasttrackml/pkg/common/config"
+)
+
+// MlflowArtifactsStorageName is a name of storage proxied through `mlflow-artifacts` API.
+const (
+	MlflowArtifactsStorageName = config.MlflowArtifactsSchema
+)
+
+// Proxy represents adapter which resolves `mlflow-artifacts:/` artifact uri
+// against configured artifacts destination and delegates to the actual storage.
+type Proxy struct {
+	destination     string
+	storage         ArtifactStorageProvider
+	multipartUpload MultipartUploadProvider
+}
+
+// NewProxy creates new Proxy storage instance.
+func NewProxy(
+	ctx context.Context, config *config.Config, artifactStorageFactory ArtifactStorageFactoryProvider,
+) (*Proxy, error) {
+	if config.ArtifactsDestination == "" {
+		return nil, eris.New("artifacts destination has not been configured")
+	}
+	storage, err := artifactStorageFactory.GetStorage(ctx, config.ArtifactsDestination)
+	if err != nil {
+		return nil, eris.Wrap(err, "error getting artifacts destination storage")
+	}
+	// parts are staged by the server only if the actual storage doesn't support multipart upload natively.
+	multipartUpload, ok := storage.(MultipartUploadProvider)
+	if !ok {
+		multipartUpload = NewStagedMultipartUpload(
+			config.ArtifactsStagingDirectory, config.ArtifactsStagingRetention, storage,
+		)
+	}
+	return &Proxy{
+		destination:     config.ArtifactsDestination,
+		storage:         storage,
+		multipartUpload: multipartUpload,
+	}, nil
+}
+
+// List implements ArtifactStorageProvider interface.
+func (s Proxy) List(ctx context.Context, artifactURI, path string) ([]ArtifactObject, error) {
+	artifactURI, err := s.resolve(artifactURI)
+	if err != nil {
+		return nil, err
+	}
+	return s.storage.List(ctx, artifactURI, path)
+}
+
+// Get implements ArtifactStorageProvider interface.
+func (s Proxy) Get(ctx context.Context, artifactURI, path string) (io.ReadCloser, error) {
+	artifactURI, err := s.resolve(artifactURI)
+	if err != nil {
+		return nil, err
+	}
+	return s.storage.Get(ctx, artifactURI, path)
+}
+
+// Put implements ArtifactStorageProvider interface.
+func (s Proxy) Put(ctx context.Context, artifactURI, path string, reader io.Reader) error {
+	artifactURI, err := s.resolve(artifactURI)
+	if err != nil {
+		return err
+	}
+	return s.storage.Put(ctx, artifactURI, path, reader)
+}
+
+// Delete implements ArtifactStorageProvider interface.
+func (s Proxy) Delete(ctx context.Context, artifactURI, path string) error {
+	artifactURI, err := s.resolve(artifactURI)
+	if err != nil {
+		return err
+	}
+	return s.storage.Delete(ctx, artifactURI, path)
+}
+
+// CreateMultipartUpload implements MultipartUploadProvider interface.
+func (s Proxy) CreateMultipartUpload(ctx context.Context, artifactURI, path string, numParts int) (string, error) {
+	artifactURI, err := s.resolve(artifactURI)
+	if err != nil {
+		return "", err
+	}
+	return s.multipartUpload.CreateMultipartUpload(ctx, artifactURI, path, numParts)
+}
+
+// UploadPart implements MultipartUploadProvider interface.
+func (s Proxy) UploadPart(
+	ctx context.Context, artifactURI, path, uploadID string, partNumber int, reader io.Reader,
+) (string, error) {
+	artifactURI, err := s.resolve(artifactURI)
+	if err != nil {
+		return "", err
+	}
+	return s.multipartUpload.UploadPart(ctx, artifactURI, path, uploadID, partNumber, reader)
+}
+
+// CompleteMultipartUpload implements MultipartUploadProvider interface.
+func (s Proxy) CompleteMultipartUpload(
+	ctx context.Context, artifactURI, path, uploadID string, parts []MultipartUploadPart,
+) error {
+	artifactURI, err := s.resolve(artifactURI)
+	if err != nil {
+		return err
+	}
+	return s.multipartUpload.CompleteMultipartUpload(ctx, artifactURI, path, uploadID, parts)
+}
+
+// AbortMultipartUpload implements MultipartUploadProvider interface.
+func (s Proxy) AbortMultipartUpload(ctx context.Context, artifactURI, path, uploadID string) error {
+	artifactURI, err := s.resolve(artifactURI)
+	if err != nil {
+		return err
+	}
+	return s.multipartUpload.AbortMultipartUpload(ctx, artifactURI, path, uploadID)
+}
+
+// resolve converts `mlflow-artifacts:/1/2/artifacts` uri into the uri of the actual storage,
+// for example `s3://bucket/1/2/artifacts` in case of `s3://bucket` artifacts destination.
+func (s Proxy) resolve(artifactURI string) (string, error) {
+	u, err := url.Parse(artifactURI)
+	if err != nil {
+		return "", eris.Wrapf(err, "error parsing artifact uri: %s", artifactURI)
+	}
+	path := u.Path
+	if u.Opaque != "" {
+		path = u.Opaque
+	}
+	path = strings.Trim(path, "/")
+	if path == "" {
+		return s.destination, nil
+	}
+	return strings.TrimRight(s.destination, "/") + "/" + path, nil
+}
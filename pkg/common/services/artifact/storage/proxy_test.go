@@ -0,0 +1,66 @@
+package storage
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/G-Research/fasttrackml/pkg/common/config"
+)
+
+func TestProxy_Ok(t *testing.T) {
+	tests := []struct {
+		name        string
+		destination string
+		artifactURI string
+		expectedURI string
+	}{
+		{
+			name:        "Root",
+			destination: "s3://bucket/prefix",
+			artifactURI: "mlflow-artifacts:/",
+			expectedURI: "s3://bucket/prefix",
+		},
+		{
+			name:        "RunArtifacts",
+			destination: "s3://bucket/prefix/",
+			artifactURI: "mlflow-artifacts:/1/run/artifacts",
+			expectedURI: "s3://bucket/prefix/1/run/artifacts",
+		},
+		{
+			name:        "RunArtifactsWithHost",
+			destination: "file:///tmp/artifacts",
+			artifactURI: "mlflow-artifacts://localhost:5000/1/run/artifacts",
+			expectedURI: "file:///tmp/artifacts/1/run/artifacts",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// setup
+			storage := NewMockArtifactStorageProvider(t)
+			storage.On("List", mock.Anything, tt.expectedURI, "path").Return([]ArtifactObject{}, nil)
+			storage.On("Put", mock.Anything, tt.expectedURI, "path", mock.Anything).Return(nil)
+			factory := NewMockArtifactStorageFactoryProvider(t)
+			factory.On("GetStorage", mock.Anything, tt.destination).Return(storage, nil)
+
+			// invoke
+			proxy, err := NewProxy(context.Background(), &config.Config{ArtifactsDestination: tt.destination}, factory)
+			require.Nil(t, err)
+			_, err = proxy.List(context.Background(), tt.artifactURI, "path")
+			require.Nil(t, err)
+			err = proxy.Put(context.Background(), tt.artifactURI, "path", strings.NewReader("content"))
+			require.Nil(t, err)
+		})
+	}
+}
+
+func TestProxy_Error(t *testing.T) {
+	proxy, err := NewProxy(context.Background(), &config.Config{}, NewMockArtifactStorageFactoryProvider(t))
+	assert.Nil(t, proxy)
+	assert.EqualError(t, err, "artifacts destination has not been configured")
+}
@@ -1,16 +1,19 @@
 package storage
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"io"
 	"io/fs"
 	"path/filepath"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsConfig "github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
 	"github.com/rotisserie/eris"
 	log "github.com/sirupsen/logrus"
 
@@ -22,6 +25,9 @@ const (
 	S3StorageName = "s3"
 )
 
+// s3DeleteBatchSize is a maximum number of objects which could be deleted in one request.
+const s3DeleteBatchSize = 1000
+
 // S3 represents S3 adapter to work with artifacts.
 type S3 struct {
 	client *s3.Client
@@ -133,3 +139,195 @@ func (s S3) Get(ctx context.Context, artifactURI, path string) (io.ReadCloser, e
 
 	return resp.Body, nil
 }
+
+// Put writes file content at the storage location.
+func (s S3) Put(ctx context.Context, artifactURI, path string, reader io.Reader) error {
+	// 1. create s3 request input.
+	bucketName, prefix, err := ExtractBucketAndPrefix(artifactURI)
+	if err != nil {
+		return eris.Wrap(err, "error extracting bucket and prefix from provided uri")
+	}
+
+	// 2. S3 client requires seekable body to calculate payload checksum,
+	// so read the content into the memory if it is not seekable already.
+	body, ok := reader.(io.ReadSeeker)
+	if !ok {
+		data, err := io.ReadAll(reader)
+		if err != nil {
+			return eris.Wrap(err, "error reading object content")
+		}
+		body = bytes.NewReader(data)
+	}
+
+	// 3. put object into s3 storage.
+	if _, err := s.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(filepath.Join(prefix, path)),
+		Body:   body,
+	}); err != nil {
+		return eris.Wrap(err, "error putting object")
+	}
+
+	return nil
+}
+
+// Delete removes object or all the objects under the prefix at the storage location.
+func (s S3) Delete(ctx context.Context, artifactURI, path string) error {
+	// 1. create s3 request input.
+	bucketName, rootPrefix, err := ExtractBucketAndPrefix(artifactURI)
+	if err != nil {
+		return eris.Wrap(err, "error extracting bucket and prefix from provided uri")
+	}
+	key := filepath.Join(rootPrefix, path)
+
+	// 2. collect the object itself and all the objects under the prefix.
+	objects := []types.ObjectIdentifier{{Key: aws.String(key)}}
+	paginator := s3.NewListObjectsV2Paginator(s.client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucketName),
+		Prefix: aws.String(key + "/"),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return eris.Wrap(err, "error getting s3 page objects")
+		}
+		for _, object := range page.Contents {
+			objects = append(objects, types.ObjectIdentifier{Key: object.Key})
+		}
+	}
+
+	// 3. delete objects in batches, S3 allows to delete up to 1000 objects in one request.
+	for start := 0; start < len(objects); start += s3DeleteBatchSize {
+		end := min(start+s3DeleteBatchSize, len(objects))
+		if _, err := s.client.DeleteObjects(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(bucketName),
+			Delete: &types.Delete{
+				Objects: objects[start:end],
+				Quiet:   aws.Bool(true),
+			},
+		}); err != nil {
+			return eris.Wrap(err, "error deleting objects")
+		}
+	}
+
+	return nil
+}
+
+// CreateMultipartUpload implements MultipartUploadProvider interface.
+func (s S3) CreateMultipartUpload(ctx context.Context, artifactURI, path string, numParts int) (string, error) {
+	bucketName, prefix, err := ExtractBucketAndPrefix(artifactURI)
+	if err != nil {
+		return "", eris.Wrap(err, "error extracting bucket and prefix from provided uri")
+	}
+
+	resp, err := s.client.CreateMultipartUpload(ctx, &s3.CreateMultipartUploadInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(filepath.Join(prefix, path)),
+	})
+	if err != nil {
+		return "", eris.Wrap(err, "error creating multipart upload")
+	}
+
+	return aws.ToString(resp.UploadId), nil
+}
+
+// UploadPart implements MultipartUploadProvider interface.
+func (s S3) UploadPart(
+	ctx context.Context, artifactURI, path, uploadID string, partNumber int, reader io.Reader,
+) (string, error) {
+	// 1. create s3 request input.
+	bucketName, prefix, err := ExtractBucketAndPrefix(artifactURI)
+	if err != nil {
+		return "", eris.Wrap(err, "error extracting bucket and prefix from provided uri")
+	}
+
+	// 2. S3 client requires seekable body to calculate payload checksum,
+	// so read the content into the memory if it is not seekable already.
+	body, ok := reader.(io.ReadSeeker)
+	if !ok {
+		data, err := io.ReadAll(reader)
+		if err != nil {
+			return "", eris.Wrap(err, "error reading part content")
+		}
+		body = bytes.NewReader(data)
+	}
+
+	// 3. upload part into s3 storage.
+	resp, err := s.client.UploadPart(ctx, &s3.UploadPartInput{
+		Bucket:     aws.String(bucketName),
+		Key:        aws.String(filepath.Join(prefix, path)),
+		UploadId:   aws.String(uploadID),
+		PartNumber: aws.Int32(int32(partNumber)), // #nosec G115
+		Body:       body,
+	})
+	if err != nil {
+		return "", convertS3MultipartUploadError(err, "error uploading part")
+	}
+
+	return strings.Trim(aws.ToString(resp.ETag), `"`), nil
+}
+
+// CompleteMultipartUpload implements MultipartUploadProvider interface.
+func (s S3) CompleteMultipartUpload(
+	ctx context.Context, artifactURI, path, uploadID string, parts []MultipartUploadPart,
+) error {
+	bucketName, prefix, err := ExtractBucketAndPrefix(artifactURI)
+	if err != nil {
+		return eris.Wrap(err, "error extracting bucket and prefix from provided uri")
+	}
+
+	completedParts := make([]types.CompletedPart, len(parts))
+	for i, part := range parts {
+		completedParts[i] = types.CompletedPart{
+			ETag:       aws.String(part.ETag),
+			PartNumber: aws.Int32(int32(part.PartNumber)), // #nosec G115
+		}
+	}
+	if _, err := s.client.CompleteMultipartUpload(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:   aws.String(bucketName),
+		Key:      aws.String(filepath.Join(prefix, path)),
+		UploadId: aws.String(uploadID),
+		MultipartUpload: &types.CompletedMultipartUpload{
+			Parts: completedParts,
+		},
+	}); err != nil {
+		return convertS3MultipartUploadError(err, "error completing multipart upload")
+	}
+
+	return nil
+}
+
+// AbortMultipartUpload implements MultipartUploadProvider interface.
+func (s S3) AbortMultipartUpload(ctx context.Context, artifactURI, path, uploadID string) error {
+	bucketName, prefix, err := ExtractBucketAndPrefix(artifactURI)
+	if err != nil {
+		return eris.Wrap(err, "error extracting bucket and prefix from provided uri")
+	}
+
+	if _, err := s.client.AbortMultipartUpload(ctx, &s3.AbortMultipartUploadInput{
+		Bucket:   aws.String(bucketName),
+		Key:      aws.String(filepath.Join(prefix, path)),
+		UploadId: aws.String(uploadID),
+	}); err != nil {
+		return convertS3MultipartUploadError(err, "error aborting multipart upload")
+	}
+
+	return nil
+}
+
+// convertS3MultipartUploadError converts errors caused by the client into the multipart upload errors.
+func convertS3MultipartUploadError(err error, message string) error {
+	// errors.Is is not working for s3 errors, so we need to use errors.As instead.
+	var s3NoSuchUpload *types.NoSuchUpload
+	if errors.As(err, &s3NoSuchUpload) {
+		return eris.Wrap(ErrMultipartUploadNotFound, message)
+	}
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.ErrorCode() {
+		case "InvalidPart", "InvalidPartOrder", "EntityTooSmall":
+			return NewInvalidMultipartUploadError("%s", apiErr.ErrorMessage())
+		}
+	}
+	return eris.Wrap(err, message)
+}
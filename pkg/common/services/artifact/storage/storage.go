@@ -39,6 +39,10 @@ type ArtifactStorageProvider interface {
 	Get(ctx context.Context, artifactURI, path string) (io.ReadCloser, error)
 	// List lists all artifact objects under a provided path.
 	List(ctx context.Context, artifactURI, path string) ([]ArtifactObject, error)
+	// Put writes content of the provided reader to specific artifact.
+	Put(ctx context.Context, artifactURI, path string, reader io.Reader) error
+	// Delete deletes artifact object or, in case of directory, all the objects under a provided path.
+	Delete(ctx context.Context, artifactURI, path string) error
 }
 
 // ArtifactStorageFactoryProvider provides an interface provider to work with Artifact Storage.
@@ -90,6 +94,18 @@ func (s *ArtifactStorageFactory) GetStorage(
 		if err != nil {
 			return nil, eris.Wrap(err, "error initializing s3 artifact storage")
 		}
+	case AzureBlobStorageName, AzureDataLakeStorageName:
+		var err error
+		storage, err = NewAzure(s.config)
+		if err != nil {
+			return nil, eris.Wrap(err, "error initializing azure artifact storage")
+		}
+	case MlflowArtifactsStorageName:
+		var err error
+		storage, err = NewProxy(ctx, s.config, s)
+		if err != nil {
+			return nil, eris.Wrap(err, "error initializing proxied artifact storage")
+		}
 	case "", LocalStorageName:
 		var err error
 		storage, err = NewLocal(s.config)
@@ -3,6 +3,7 @@ package artifact
 import (
 	"net/url"
 	"path/filepath"
+	"regexp"
 	"slices"
 	"strings"
 
@@ -28,6 +29,112 @@ func ValidateGetArtifactRequest(req *request.GetArtifactRequest) error {
 	return validatePath(req.Path)
 }
 
+// ValidateDownloadArtifactsRequest validates `GET /artifacts/download` request.
+func ValidateDownloadArtifactsRequest(req *request.DownloadArtifactsRequest) error {
+	if req.RunID == "" && req.RunUUID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'")
+	}
+
+	if req.Format != "" && !slices.Contains(SupportedArchiveFormats, req.Format) {
+		return api.NewInvalidParameterValueError(
+			"Invalid value for parameter 'format' supplied. Supported values are: %s",
+			strings.Join(SupportedArchiveFormats, ", "),
+		)
+	}
+
+	return validatePath(req.Path)
+}
+
+// MaxMultipartUploadParts is a maximum number of parts of single multipart upload.
+const MaxMultipartUploadParts = 10000
+
+// uploadIDRegexp is a regexp of multipart upload id.
+var uploadIDRegexp = regexp.MustCompile(`^[\w.~=+-]+$`)
+
+// ValidateListProxyArtifactsRequest validates `GET /mlflow-artifacts/artifacts` request.
+func ValidateListProxyArtifactsRequest(req *request.ListProxyArtifactsRequest) error {
+	return validatePath(req.Path)
+}
+
+// ValidateProxyArtifactRequest validates `GET|PUT|DELETE /mlflow-artifacts/artifacts/*` request.
+func ValidateProxyArtifactRequest(req *request.ProxyArtifactRequest) error {
+	return validateRequiredPath(req.Path)
+}
+
+// ValidateCreateMultipartUploadRequest validates `POST /mlflow-artifacts/mpu/create/*` request.
+func ValidateCreateMultipartUploadRequest(req *request.CreateMultipartUploadRequest) error {
+	if req.NumParts < 1 || req.NumParts > MaxMultipartUploadParts {
+		return api.NewInvalidParameterValueError(
+			"Invalid value for parameter 'num_parts' supplied. It must be between 1 and %d", MaxMultipartUploadParts,
+		)
+	}
+
+	return validateRequiredPath(req.Path)
+}
+
+// ValidateUploadMultipartPartRequest validates `PUT /mlflow-artifacts/mpu/upload/*` request.
+func ValidateUploadMultipartPartRequest(req *request.UploadMultipartPartRequest) error {
+	if err := validateUploadID(req.UploadID); err != nil {
+		return err
+	}
+
+	if req.PartNumber < 1 {
+		return api.NewInvalidParameterValueError(
+			"Invalid value for parameter 'part_number' supplied. It must be at least 1",
+		)
+	}
+
+	return validateRequiredPath(req.Path)
+}
+
+// ValidateCompleteMultipartUploadRequest validates `POST /mlflow-artifacts/mpu/complete/*` request.
+func ValidateCompleteMultipartUploadRequest(req *request.CompleteMultipartUploadRequest) error {
+	if err := validateUploadID(req.UploadID); err != nil {
+		return err
+	}
+
+	for _, part := range req.Parts {
+		if part.ETag == "" {
+			return api.NewInvalidParameterValueError("Missing value for required parameter 'parts.etag'")
+		}
+	}
+
+	return validateRequiredPath(req.Path)
+}
+
+// ValidateAbortMultipartUploadRequest validates `POST /mlflow-artifacts/mpu/abort/*` request.
+func ValidateAbortMultipartUploadRequest(req *request.AbortMultipartUploadRequest) error {
+	if err := validateUploadID(req.UploadID); err != nil {
+		return err
+	}
+
+	return validateRequiredPath(req.Path)
+}
+
+// validateUploadID validates upload_id parameter.
+func validateUploadID(uploadID string) error {
+	if uploadID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'upload_id'")
+	}
+	// upload id is generated either by the server or by the storage, but it is never a path.
+	if !uploadIDRegexp.MatchString(uploadID) || uploadID == "." || uploadID == ".." {
+		return api.NewInvalidParameterValueError("Invalid value for parameter 'upload_id' supplied")
+	}
+	return nil
+}
+
+// validateRequiredPath validates path parameter which has to be provided.
+// The path must point inside the artifact root, otherwise the whole root could be overwritten or deleted.
+func validateRequiredPath(path string) error {
+	if path == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'path'")
+	}
+	if filepath.Clean(path) == "." {
+		return api.NewInvalidParameterValueError("Invalid path")
+	}
+	return validatePath(path)
+}
+
 // validatePath validates path parameter.
 func validatePath(path string) error {
 	parsedUrl, err := url.Parse(path)
@@ -300,3 +300,156 @@ func TestValidateGetArtifactRequest_Error(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateDownloadArtifactsRequest_Ok(t *testing.T) {
+	tests := []struct {
+		name    string
+		request *request.DownloadArtifactsRequest
+	}{
+		{
+			name: "EmptyPathAndFormat",
+			request: &request.DownloadArtifactsRequest{
+				RunID: "run_id",
+			},
+		},
+		{
+			name: "ZipFormat",
+			request: &request.DownloadArtifactsRequest{
+				RunID:  "run_id",
+				Path:   "checkpoints",
+				Format: ArchiveFormatZip,
+			},
+		},
+		{
+			name: "TarGzFormat",
+			request: &request.DownloadArtifactsRequest{
+				RunUUID: "run_id",
+				Path:    "./checkpoints/",
+				Format:  ArchiveFormatTarGz,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Nil(t, ValidateDownloadArtifactsRequest(tt.request))
+		})
+	}
+}
+
+func TestValidateDownloadArtifactsRequest_Error(t *testing.T) {
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.DownloadArtifactsRequest
+	}{
+		{
+			name:    "EmptyRunIDAndRunUUID",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'"),
+			request: &request.DownloadArtifactsRequest{},
+		},
+		{
+			name: "IncorrectFormat",
+			error: api.NewInvalidParameterValueError(
+				"Invalid value for parameter 'format' supplied. Supported values are: zip, tar.gz",
+			),
+			request: &request.DownloadArtifactsRequest{
+				RunID:  "run_id",
+				Format: "rar",
+			},
+		},
+		{
+			name:  "IncorrectPathProvidedCase1",
+			error: api.NewInvalidParameterValueError("Invalid path"),
+			request: &request.DownloadArtifactsRequest{
+				RunID: "run_id",
+				Path:  "..",
+			},
+		},
+		{
+			name:  "IncorrectPathProvidedCase2",
+			error: api.NewInvalidParameterValueError("Invalid path"),
+			request: &request.DownloadArtifactsRequest{
+				RunID: "run_id",
+				Path:  "foo/../../bar",
+			},
+		},
+		{
+			name:  "IncorrectLeadingSlash",
+			error: api.NewInvalidParameterValueError("Invalid path"),
+			request: &request.DownloadArtifactsRequest{
+				RunID: "run_id",
+				Path:  "/etc",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateDownloadArtifactsRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
+func TestValidateProxyArtifactRequest_Ok(t *testing.T) {
+	tests := []struct {
+		name    string
+		request *request.ProxyArtifactRequest
+	}{
+		{
+			name:    "Filename",
+			request: &request.ProxyArtifactRequest{Path: "foo.txt"},
+		},
+		{
+			name:    "Dirname/Filename",
+			request: &request.ProxyArtifactRequest{Path: "./foo/bar.txt"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Nil(t, ValidateProxyArtifactRequest(tt.request))
+		})
+	}
+}
+
+func TestValidateProxyArtifactRequest_Error(t *testing.T) {
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.ProxyArtifactRequest
+	}{
+		{
+			name:    "EmptyPath",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'path'"),
+			request: &request.ProxyArtifactRequest{},
+		},
+		{
+			name:    "CurrentDirectory",
+			error:   api.NewInvalidParameterValueError("Invalid path"),
+			request: &request.ProxyArtifactRequest{Path: "."},
+		},
+		{
+			name:    "CurrentDirectoryWithTrailingSlash",
+			error:   api.NewInvalidParameterValueError("Invalid path"),
+			request: &request.ProxyArtifactRequest{Path: "./"},
+		},
+		{
+			name:    "CurrentDirectoryResolved",
+			error:   api.NewInvalidParameterValueError("Invalid path"),
+			request: &request.ProxyArtifactRequest{Path: "foo/.."},
+		},
+		{
+			name:    "ParentDirectory",
+			error:   api.NewInvalidParameterValueError("Invalid path"),
+			request: &request.ProxyArtifactRequest{Path: "foo/../../bar"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.error, ValidateProxyArtifactRequest(tt.request))
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/fasttrackml/pkg/common/config"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/repositories"
+)
+
+// cleanerInterval defines how often expired audit log records are deleted.
+const cleanerInterval = time.Hour
+
+// Cleaner represents a background job which periodically deletes audit log records older than retention period.
+type Cleaner struct {
+	ctx                context.Context
+	config             *config.Config
+	auditLogRepository repositories.AuditLogRepositoryProvider
+}
+
+// NewCleaner creates a new instance of Cleaner.
+func NewCleaner(
+	ctx context.Context, config *config.Config, auditLogRepository repositories.AuditLogRepositoryProvider,
+) *Cleaner {
+	return &Cleaner{
+		ctx:                ctx,
+		config:             config,
+		auditLogRepository: auditLogRepository,
+	}
+}
+
+// Run runs audit log cleaner background job.
+func (c Cleaner) Run() {
+	if c.config.AuditLogRetention <= 0 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(cleanerInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-c.ctx.Done():
+				log.Debug("audit log cleaner finished. exiting.")
+				return
+			case <-ticker.C:
+				numberOfDeleted, err := c.auditLogRepository.DeleteOlderThan(
+					c.ctx, time.Now().UTC().Add(-c.config.AuditLogRetention),
+				)
+				if err != nil {
+					log.Errorf("error cleaning expired audit logs: %+v", err)
+				} else {
+					log.Debugf("%d expired audit logs were successfully cleaned", numberOfDeleted)
+				}
+			}
+		}
+	}()
+}
@@ -0,0 +1,116 @@
+package live
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/fasttrackml/pkg/common/dao"
+	"github.com/G-Research/fasttrackml/pkg/common/events"
+)
+
+// subscriptionBufferSize is the number of events buffered for a subscription.
+// Events are dropped for the subscription, which fell behind by more than that.
+const subscriptionBufferSize = 1000
+
+// Broker delivers run events from the database event listener to the subscriptions of the namespace.
+type Broker struct {
+	mu            sync.RWMutex
+	subscriptions map[*Subscription]struct{}
+}
+
+// NewBroker creates new Broker instance, which receives run events from the provided listener.
+func NewBroker(ctx context.Context, eventListener dao.EventListenerProvider) *Broker {
+	broker := &Broker{
+		subscriptions: make(map[*Subscription]struct{}),
+	}
+
+	ch := make(chan string)
+	go func() {
+		defer broker.closeSubscriptions()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case data := <-ch:
+				broker.dispatch(data)
+			}
+		}
+	}()
+
+	// subscribe to incoming events.
+	eventListener.Subscribe(ch)
+
+	return broker
+}
+
+// Subscribe creates new subscription to the run events of the namespace.
+func (b *Broker) Subscribe(namespaceID uint) *Subscription {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	subscription := &Subscription{
+		broker:      b,
+		namespaceID: namespaceID,
+		events:      make(chan events.RunEvent, subscriptionBufferSize),
+	}
+	b.subscriptions[subscription] = struct{}{}
+	return subscription
+}
+
+// unsubscribe removes the subscription.
+func (b *Broker) unsubscribe(subscription *Subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.subscriptions, subscription)
+}
+
+// dispatch delivers the event to the subscriptions of the event namespace without blocking.
+func (b *Broker) dispatch(data string) {
+	var event events.RunEvent
+	if err := json.Unmarshal([]byte(data), &event); err != nil {
+		log.Errorf("error deserializing run event: %s, error: %+v", data, err)
+		return
+	}
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for subscription := range b.subscriptions {
+		if subscription.namespaceID != event.NamespaceID {
+			continue
+		}
+		select {
+		case subscription.events <- event:
+		default:
+			log.Warnf("subscription fell behind, event of run '%s' has been dropped", event.RunID)
+		}
+	}
+}
+
+// closeSubscriptions closes all the subscriptions, so the subscribers stop waiting for new events.
+func (b *Broker) closeSubscriptions() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for subscription := range b.subscriptions {
+		close(subscription.events)
+		delete(b.subscriptions, subscription)
+	}
+}
+
+// Subscription represents subscription to the run events of the namespace.
+type Subscription struct {
+	broker      *Broker
+	namespaceID uint
+	events      chan events.RunEvent
+}
+
+// Events returns channel of the subscription events. The channel is closed when the broker stops.
+func (s *Subscription) Events() <-chan events.RunEvent {
+	return s.events
+}
+
+// Close stops delivery of the events to the subscription.
+func (s *Subscription) Close() {
+	s.broker.unsubscribe(s)
+}
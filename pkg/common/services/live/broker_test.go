@@ -0,0 +1,143 @@
+package live
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/events"
+)
+
+// testEventListener broadcasts events in process, the same way as the database event listener does for sqlite.
+type testEventListener struct {
+	subscribers []chan<- string
+	payloads    []string
+}
+
+func (l *testEventListener) Listen() {}
+
+func (l *testEventListener) Subscribe(subscriber chan<- string) {
+	l.subscribers = append(l.subscribers, subscriber)
+}
+
+func (l *testEventListener) Notify(_ context.Context, payload string) error {
+	l.payloads = append(l.payloads, payload)
+	for _, subscriber := range l.subscribers {
+		subscriber <- payload
+	}
+	return nil
+}
+
+func (l *testEventListener) GetChannelName() string {
+	return "test"
+}
+
+func receiveEvent(t *testing.T, subscription *Subscription) events.RunEvent {
+	select {
+	case event := <-subscription.Events():
+		return event
+	case <-time.After(time.Second):
+		require.FailNow(t, "event has not been received")
+	}
+	return events.RunEvent{}
+}
+
+func TestBroker_Ok(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	listener := testEventListener{}
+	broker := NewBroker(ctx, &listener)
+	publisher := NewPublisher(&listener, true)
+
+	subscription := broker.Subscribe(1)
+	otherSubscription := broker.Subscribe(2)
+	defer otherSubscription.Close()
+
+	publisher.PublishMetrics(ctx, 1, "run", []models.Metric{
+		{
+			Key:       "loss",
+			Value:     0.5,
+			Step:      1,
+			Iter:      2,
+			Timestamp: 123,
+			Context:   models.Context{Json: []byte(`{"subset":"train"}`)},
+		},
+	})
+	assert.Equal(t, events.RunEvent{
+		Type:        events.RunEventTypeMetrics,
+		NamespaceID: 1,
+		RunID:       "run",
+		Metrics: []events.RunEventMetric{
+			{
+				Key:       "loss",
+				Context:   []byte(`{"subset":"train"}`),
+				Value:     0.5,
+				Step:      1,
+				Iter:      2,
+				Timestamp: 123,
+			},
+		},
+	}, receiveEvent(t, subscription))
+
+	publisher.PublishStatus(ctx, 1, &models.Run{ID: "run", Status: models.StatusFinished})
+	assert.Equal(t, events.RunEvent{
+		Type:        events.RunEventTypeStatus,
+		NamespaceID: 1,
+		RunID:       "run",
+		Status:      string(models.StatusFinished),
+	}, receiveEvent(t, subscription))
+
+	publisher.PublishLog(ctx, 1, &models.Log{RunID: "run", Value: "line", Timestamp: 123})
+	assert.Equal(t, events.RunEvent{
+		Type:        events.RunEventTypeLog,
+		NamespaceID: 1,
+		RunID:       "run",
+		Log:         "line",
+		Timestamp:   123,
+	}, receiveEvent(t, subscription))
+
+	// events of other namespaces are not delivered.
+	assert.Empty(t, otherSubscription.Events())
+
+	// closed subscription doesn't receive events anymore.
+	subscription.Close()
+	publisher.PublishStatus(ctx, 1, &models.Run{ID: "run", Status: models.StatusRunning})
+	assert.Empty(t, subscription.Events())
+
+	// subscriptions are closed, when the broker stops.
+	cancel()
+	_, ok := <-otherSubscription.Events()
+	assert.False(t, ok)
+}
+
+func TestPublisher_Ok(t *testing.T) {
+	t.Run("Disabled", func(t *testing.T) {
+		listener := testEventListener{}
+		publisher := NewPublisher(&listener, false)
+		publisher.PublishStatus(context.Background(), 1, &models.Run{ID: "run"})
+		assert.Empty(t, listener.payloads)
+	})
+
+	t.Run("SplitLargeEvents", func(t *testing.T) {
+		listener := testEventListener{}
+		publisher := NewPublisher(&listener, true)
+
+		metrics := make([]models.Metric, 200)
+		for i := range metrics {
+			metrics[i] = models.Metric{Key: "loss", Step: int64(i)}
+		}
+		publisher.PublishMetrics(context.Background(), 1, "run", metrics)
+		publisher.PublishLog(context.Background(), 1, &models.Log{RunID: "run", Value: strings.Repeat("ä", 5000)})
+
+		assert.Greater(t, len(listener.payloads), 2)
+		for _, payload := range listener.payloads {
+			assert.LessOrEqual(t, len(payload), maxPayloadSize)
+		}
+	})
+}
@@ -0,0 +1,136 @@
+package live
+
+import (
+	"context"
+	"encoding/json"
+	"unicode/utf8"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/dao"
+	"github.com/G-Research/fasttrackml/pkg/common/events"
+)
+
+// maxPayloadSize is the maximum size of the event payload. Postgres rejects notifications of 8000 bytes and more.
+const maxPayloadSize = 7900
+
+// PublisherProvider provides an interface to publish live updates of the runs.
+type PublisherProvider interface {
+	// PublishMetrics publishes new metric points of the run.
+	PublishMetrics(ctx context.Context, namespaceID uint, runID string, metrics []models.Metric)
+	// PublishStatus publishes the current status of the run.
+	PublishStatus(ctx context.Context, namespaceID uint, run *models.Run)
+	// PublishLog publishes new log output of the run.
+	PublishLog(ctx context.Context, namespaceID uint, runLog *models.Log)
+}
+
+// Publisher publishes live updates of the runs as database events.
+type Publisher struct {
+	enabled       bool
+	eventListener dao.EventListenerProvider
+}
+
+// NewPublisher creates new Publisher instance.
+func NewPublisher(eventListener dao.EventListenerProvider, enabled bool) *Publisher {
+	return &Publisher{
+		enabled:       enabled,
+		eventListener: eventListener,
+	}
+}
+
+// PublishMetrics publishes new metric points of the run.
+func (p Publisher) PublishMetrics(ctx context.Context, namespaceID uint, runID string, metrics []models.Metric) {
+	if !p.enabled || len(metrics) == 0 {
+		return
+	}
+
+	points := make([]events.RunEventMetric, len(metrics))
+	for i, metric := range metrics {
+		metricContext := json.RawMessage(metric.Context.Json)
+		if len(metricContext) == 0 {
+			metricContext = json.RawMessage("{}")
+		}
+		points[i] = events.RunEventMetric{
+			Key:       metric.Key,
+			Context:   metricContext,
+			Value:     metric.Value,
+			IsNan:     metric.IsNan,
+			Step:      metric.Step,
+			Iter:      metric.Iter,
+			Timestamp: metric.Timestamp,
+		}
+	}
+	p.publish(ctx, events.RunEvent{
+		Type:        events.RunEventTypeMetrics,
+		NamespaceID: namespaceID,
+		RunID:       runID,
+		Metrics:     points,
+	})
+}
+
+// PublishStatus publishes the current status of the run.
+func (p Publisher) PublishStatus(ctx context.Context, namespaceID uint, run *models.Run) {
+	if !p.enabled {
+		return
+	}
+
+	p.publish(ctx, events.RunEvent{
+		Type:        events.RunEventTypeStatus,
+		NamespaceID: namespaceID,
+		RunID:       run.ID,
+		Status:      string(run.Status),
+	})
+}
+
+// PublishLog publishes new log output of the run.
+func (p Publisher) PublishLog(ctx context.Context, namespaceID uint, runLog *models.Log) {
+	if !p.enabled {
+		return
+	}
+
+	p.publish(ctx, events.RunEvent{
+		Type:        events.RunEventTypeLog,
+		NamespaceID: namespaceID,
+		RunID:       runLog.RunID,
+		Log:         runLog.Value,
+		Timestamp:   runLog.Timestamp,
+	})
+}
+
+// publish sends the event, splitting it into smaller ones when it exceeds the payload limit.
+// Errors are only logged, as live updates should never fail tracking of the data itself.
+func (p Publisher) publish(ctx context.Context, event events.RunEvent) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		log.Errorf("error serializing run event: %+v", err)
+		return
+	}
+
+	if len(data) > maxPayloadSize {
+		switch {
+		case len(event.Metrics) > 1:
+			head, tail := event, event
+			head.Metrics, tail.Metrics = event.Metrics[:len(event.Metrics)/2], event.Metrics[len(event.Metrics)/2:]
+			p.publish(ctx, head)
+			p.publish(ctx, tail)
+		case utf8.RuneCountInString(event.Log) > 1:
+			// split on the rune boundary to keep both parts valid UTF-8 strings.
+			half := len(event.Log) / 2
+			for !utf8.RuneStart(event.Log[half]) {
+				half--
+			}
+			head, tail := event, event
+			head.Log, tail.Log = event.Log[:half], event.Log[half:]
+			p.publish(ctx, head)
+			p.publish(ctx, tail)
+		default:
+			log.Warnf("event of run '%s' exceeds payload limit and has been skipped", event.RunID)
+		}
+		return
+	}
+
+	if err := p.eventListener.Notify(ctx, string(data)); err != nil {
+		log.Errorf("error sending event of run '%s': %+v", event.RunID, err)
+	}
+}
@@ -91,6 +91,8 @@ func (s *Importer) Import() error {
 		"latest_metrics",
 		"shared_tags",
 		"run_shared_tags",
+		"notes",
+		"reports",
 	}
 	for _, table := range tables {
 		if err := s.importTable(table); err != nil {
@@ -244,8 +246,8 @@ func (s *Importer) translateFields(item map[string]any) (map[string]any, error)
 			}
 		}
 	}
-	// items with experiment_id need to reference the new ID
-	if expID, ok := item["experiment_id"]; ok {
+	// items with experiment_id need to reference the new ID (notes could have no experiment_id at all)
+	if expID, ok := item["experiment_id"]; ok && expID != nil {
 		var id int32
 		switch v := expID.(type) {
 		case int32:
@@ -316,7 +318,7 @@ func (s *Importer) updateNamespaceDefaultExperiment() error {
 // ApplyNamespaceRestriction overwrite Namespace if it is needed.
 func ApplyNamespaceRestriction(table string, item map[string]any, namespace *Namespace) map[string]any {
 	if namespace != nil {
-		if slices.Contains([]string{"apps", "experiments"}, table) {
+		if slices.Contains([]string{"apps", "experiments", "notes", "reports"}, table) {
 			item["namespace_id"] = namespace.ID
 		}
 	}
@@ -351,7 +353,7 @@ func EntityLimitedByNamespace(table string, db *gorm.DB, namespace *Namespace) *
 			).Where(
 				"experiments.namespace_id = ?", namespace.ID,
 			)
-		case "apps", "experiments":
+		case "apps", "experiments", "notes", "reports":
 			return db.Where(fmt.Sprintf("%s.namespace_id = ?", table), namespace.ID)
 		case "dashboards":
 			return db.Joins(
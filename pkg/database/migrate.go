@@ -150,6 +150,26 @@ func CheckAndMigrateDB(migrate bool, db *gorm.DB) error {
 				&SchemaVersion{},
 				&Log{},
 				&Artifact{},
+				&RegisteredModel{},
+				&RegisteredModelTag{},
+				&RegisteredModelAlias{},
+				&ModelVersion{},
+				&ModelVersionTag{},
+				&Dataset{},
+				&Input{},
+				&InputTag{},
+				&Trace{},
+				&TraceTag{},
+				&TraceRequestMetadata{},
+				&TraceSpan{},
+				&Text{},
+				&Distribution{},
+				&Figure{},
+				&Audio{},
+				&Note{},
+				&Report{},
+				&AccessToken{},
+				&AuditLog{},
 			); err != nil {
 				return fmt.Errorf("error initializing database: %w", err)
 			}
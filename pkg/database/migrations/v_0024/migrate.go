@@ -0,0 +1,28 @@
+package v_0024
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/database/migrations"
+)
+
+const Version = "20261018035733"
+
+func Migrate(db *gorm.DB) error {
+	return migrations.RunWithoutForeignKeyIfNeeded(db, func() error {
+		return db.Transaction(func(tx *gorm.DB) error {
+			// add the new typed Value columns for bool and nested (dict or list) params.
+			for _, column := range []string{"ValueBool", "ValueJSON"} {
+				if err := tx.Migrator().AddColumn(&Param{}, column); err != nil {
+					return err
+				}
+			}
+
+			// Update the schema version
+			return tx.Model(&SchemaVersion{}).
+				Where("1 = 1").
+				Update("Version", Version).
+				Error
+		})
+	})
+}
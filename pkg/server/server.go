@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"net/http"
 	"strings"
@@ -22,7 +23,9 @@ import (
 	aimAppService "github.com/G-Research/fasttrackml/pkg/api/aim/services/app"
 	aimDashboardService "github.com/G-Research/fasttrackml/pkg/api/aim/services/dashboard"
 	aimExperimentService "github.com/G-Research/fasttrackml/pkg/api/aim/services/experiment"
+	aimNoteService "github.com/G-Research/fasttrackml/pkg/api/aim/services/note"
 	aimProjectService "github.com/G-Research/fasttrackml/pkg/api/aim/services/project"
+	aimReportService "github.com/G-Research/fasttrackml/pkg/api/aim/services/report"
 	aimRunService "github.com/G-Research/fasttrackml/pkg/api/aim/services/run"
 	aimTagService "github.com/G-Research/fasttrackml/pkg/api/aim/services/tag"
 	mlflowAPI "github.com/G-Research/fasttrackml/pkg/api/mlflow"
@@ -30,20 +33,31 @@ import (
 	mlflowRepositories "github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
 	mlflowService "github.com/G-Research/fasttrackml/pkg/api/mlflow/services"
 	mlflowExperimentService "github.com/G-Research/fasttrackml/pkg/api/mlflow/services/experiment"
+	mlflowGCService "github.com/G-Research/fasttrackml/pkg/api/mlflow/services/gc"
 	mlflowMetricService "github.com/G-Research/fasttrackml/pkg/api/mlflow/services/metric"
 	mlflowModelService "github.com/G-Research/fasttrackml/pkg/api/mlflow/services/model"
 	mlflowRunService "github.com/G-Research/fasttrackml/pkg/api/mlflow/services/run"
+	mlflowTraceService "github.com/G-Research/fasttrackml/pkg/api/mlflow/services/trace"
+	trackingAPI "github.com/G-Research/fasttrackml/pkg/api/tracking"
+	trackingController "github.com/G-Research/fasttrackml/pkg/api/tracking/controller"
+	trackingService "github.com/G-Research/fasttrackml/pkg/api/tracking/services/tracking"
 	"github.com/G-Research/fasttrackml/pkg/common/auth/oidc"
 	"github.com/G-Research/fasttrackml/pkg/common/config"
 	"github.com/G-Research/fasttrackml/pkg/common/dao"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/models"
 	"github.com/G-Research/fasttrackml/pkg/common/dao/repositories"
 	"github.com/G-Research/fasttrackml/pkg/common/middleware"
 	artifactService "github.com/G-Research/fasttrackml/pkg/common/services/artifact"
 	"github.com/G-Research/fasttrackml/pkg/common/services/artifact/storage"
+	"github.com/G-Research/fasttrackml/pkg/common/services/audit"
+	"github.com/G-Research/fasttrackml/pkg/common/services/live"
 	"github.com/G-Research/fasttrackml/pkg/database"
 	adminUI "github.com/G-Research/fasttrackml/pkg/ui/admin"
 	adminUIController "github.com/G-Research/fasttrackml/pkg/ui/admin/controller"
+	adminUIAuditService "github.com/G-Research/fasttrackml/pkg/ui/admin/service/audit"
 	adminUINamespaceService "github.com/G-Research/fasttrackml/pkg/ui/admin/service/namespace"
+	adminUIRoleService "github.com/G-Research/fasttrackml/pkg/ui/admin/service/role"
+	adminUITokenService "github.com/G-Research/fasttrackml/pkg/ui/admin/service/token"
 	aimUI "github.com/G-Research/fasttrackml/pkg/ui/aim"
 	"github.com/G-Research/fasttrackml/pkg/ui/chooser"
 	chooserController "github.com/G-Research/fasttrackml/pkg/ui/chooser/controller"
@@ -52,6 +66,10 @@ import (
 	"github.com/G-Research/fasttrackml/pkg/version"
 )
 
+// bodyLimit is the maximum size of the request body. It doesn't apply to the uploaded artifacts,
+// which are streamed.
+const bodyLimit = 16 * 1024 * 1024
+
 type Server interface {
 	Listen(address string) error
 	ShutdownWithTimeout(timeout time.Duration) error
@@ -135,7 +153,8 @@ func createApp(
 	artifactStorageFactory storage.ArtifactStorageFactoryProvider,
 ) (*fiber.App, error) {
 	app := fiber.New(fiber.Config{
-		BodyLimit:             16 * 1024 * 1024,
+		BodyLimit:             bodyLimit,
+		StreamRequestBody:     true,
 		ReadBufferSize:        16384,
 		ReadTimeout:           5 * time.Second,
 		WriteTimeout:          600 * time.Second,
@@ -145,11 +164,15 @@ func createApp(
 		ErrorHandler: func(c *fiber.Ctx, err error) error {
 			p := string(c.Request().URI().Path())
 			switch {
-			case strings.HasPrefix(p, "/aim"):
+			case strings.HasPrefix(p, "/aim"),
+				strings.HasPrefix(p, "/client/"),
+				strings.HasPrefix(p, "/tracking/"):
 				return aimAPI.ErrorHandler(c, err)
 			case strings.HasPrefix(p, "/api/2.0/mlflow/") ||
 				strings.HasPrefix(p, "/ajax-api/2.0/mlflow/") ||
-				strings.HasPrefix(p, "/mlflow/ajax-api/2.0/mlflow/"):
+				strings.HasPrefix(p, "/mlflow/ajax-api/2.0/mlflow/") ||
+				strings.HasPrefix(p, "/api/2.0/mlflow-artifacts/") ||
+				strings.HasPrefix(p, "/ajax-api/2.0/mlflow-artifacts/"):
 				return mlflowService.ErrorHandler(c, err)
 
 			default:
@@ -187,24 +210,62 @@ func createApp(
 		return nil, eris.Wrap(err, "error creating roles repository")
 	}
 
+	// create run notification listener, which feeds live updates of the runs.
+	runEventListener, err := dao.NewRunListener(ctx, db.GormDB())
+	if err != nil {
+		return nil, eris.Wrap(err, "error creating run notification listener")
+	}
+	livePublisher := live.NewPublisher(runEventListener, config.LiveUpdatesEnabled)
+	liveBroker := live.NewBroker(ctx, runEventListener)
+
 	namespaceEventListener.Listen()
+	runEventListener.Listen()
 
 	// attach global middlewares.
+	// record each mutating `aim`, `mlflow` and `admin` request into the audit log, if it is enabled.
+	// it goes before the authentication, so the denied requests are recorded too.
+	auditLogRepository := repositories.NewAuditLogRepository(db.GormDB())
+	if config.AuditLogEnabled {
+		log.Info("Audit log - recording mutating requests")
+		app.Use(middleware.NewAuditMiddleware(auditLogRepository))
+		audit.NewCleaner(ctx, config, auditLogRepository).Run()
+	}
 	if config.Auth.AuthUsername != "" && config.Auth.AuthPassword != "" {
 		log.Info("Auth - enabling Basic Auth")
-		app.Use(basicauth.New(basicauth.Config{
+		basicAuthConfig := basicauth.Config{
 			Users: map[string]string{
 				config.Auth.AuthUsername: config.Auth.AuthPassword,
 			},
-		}))
+		}
+		// password could be provided as a hash, so it is never kept in clear.
+		// hash verification is intentionally slow, so verified credentials are cached in the same way
+		// as for users from `auth-users-config` file.
+		if models.IsPasswordHash(config.Auth.AuthPassword) {
+			userPermissions := models.NewUserPermissionsWithHashedUsers(
+				map[string]map[string]struct{}{},
+				map[string]models.HashedUser{
+					config.Auth.AuthUsername: {PasswordHash: config.Auth.AuthPassword},
+				},
+			)
+			basicAuthConfig.Authorizer = func(username, password string) bool {
+				return userPermissions.ValidateAuthToken(
+					base64.StdEncoding.EncodeToString([]byte(username+":"+password)),
+				) != nil
+			}
+		}
+		app.Use(basicauth.New(basicAuthConfig))
 	}
 	app.Use(middleware.NewNamespaceMiddleware(namespaceCachedRepository))
+	app.Use(middleware.NewRouteMiddleware())
+	app.Use(middleware.NewBodyLimitMiddleware(bodyLimit, mlflowAPI.StreamedRoutes()...))
 
 	app.Use(compress.New(compress.Config{
 		Next: func(c *fiber.Ctx) bool {
 			// This is a little brittle, maybe there is a better way?
 			// Do not compress metric histories as urllib3 did not support file-like compressed reads until 2.0.0a1
-			return strings.HasSuffix(c.Path(), "/metrics/get-histories")
+			// Do not compress live updates as compression buffers the events of the stream.
+			return strings.HasSuffix(c.Path(), "/metrics/get-histories") ||
+				strings.HasSuffix(strings.TrimSuffix(c.Path(), "/"), "/runs/live")
 		},
 	}))
 
@@ -221,6 +282,25 @@ func createApp(
 		return c.SendString(version.Version)
 	})
 
+	// access tokens are accepted alongside any of the configured authentication types.
+	if config.Auth.IsAuthTypeOIDC() || config.Auth.IsAuthTypeUser() || config.Auth.IsAuthTypeJWT() {
+		app.Use(middleware.NewAccessTokenMiddleware(repositories.NewAccessTokenRepository(db.GormDB())))
+	}
+
+	// bearer JWT issued by the identity provider is accepted by API endpoints, if it is configured.
+	if config.Auth.IsAuthTypeJWT() {
+		log.Info("Auth - enabling bearer JWT Auth")
+		jwtVerifier, err := oidc.NewJWTVerifier(ctx, config)
+		if err != nil {
+			return nil, eris.Wrap(err, "error creating bearer JWT verifier")
+		}
+		app.Use(middleware.NewJWTMiddleware(
+			jwtVerifier,
+			rolesCachedRepository,
+			!config.Auth.IsAuthTypeOIDC() && !config.Auth.IsAuthTypeUser(),
+		))
+	}
+
 	// based on Auth configuration, attach global OIDC or Basic Auth middleware.
 	switch {
 	case config.Auth.IsAuthTypeOIDC():
@@ -263,7 +343,9 @@ func createApp(
 		Next: func(c *fiber.Ctx) bool {
 			// This is a little brittle, maybe there is a better way?
 			// Do not compress metric histories as urllib3 did not support file-like compressed reads until 2.0.0a1
-			return strings.HasSuffix(c.Path(), "/metrics/get-histories")
+			// Do not compress live updates as compression buffers the events of the stream.
+			return strings.HasSuffix(c.Path(), "/metrics/get-histories") ||
+				strings.HasSuffix(strings.TrimSuffix(c.Path(), "/"), "/runs/live")
 		},
 	}))
 
@@ -284,9 +366,17 @@ func createApp(
 				aimRepositories.NewSharedTagRepository(db.GormDB()),
 				artifactStorageFactory,
 				aimRepositories.NewArtifactRepository(db.GormDB()),
+				aimRepositories.NewTextRepository(db.GormDB()),
+				aimRepositories.NewDistributionRepository(db.GormDB()),
+				aimRepositories.NewFigureRepository(db.GormDB()),
+				aimRepositories.NewAudioRepository(db.GormDB()),
+				liveBroker,
+				config.LiveUpdatesEnabled,
 			),
 			artifactService.NewService(
+				config,
 				mlflowRepositories.NewRunRepository(db.GormDB()),
+				mlflowRepositories.NewExperimentRepository(db.GormDB()),
 				artifactStorageFactory,
 			),
 			aimProjectService.NewService(
@@ -296,6 +386,10 @@ func createApp(
 				aimRepositories.NewMetricRepository(db.GormDB()),
 				aimRepositories.NewExperimentRepository(db.GormDB()),
 				aimRepositories.NewArtifactRepository(db.GormDB()),
+				aimRepositories.NewTextRepository(db.GormDB()),
+				aimRepositories.NewDistributionRepository(db.GormDB()),
+				aimRepositories.NewFigureRepository(db.GormDB()),
+				aimRepositories.NewAudioRepository(db.GormDB()),
 				config.LiveUpdatesEnabled,
 			),
 			aimDashboardService.NewService(
@@ -306,12 +400,20 @@ func createApp(
 				aimRepositories.NewTagRepository(db.GormDB()),
 				aimRepositories.NewExperimentRepository(db.GormDB()),
 			),
+			aimNoteService.NewService(
+				aimRepositories.NewNoteRepository(db.GormDB()),
+				aimRepositories.NewRunRepository(db.GormDB()),
+				aimRepositories.NewExperimentRepository(db.GormDB()),
+			),
+			aimReportService.NewService(
+				aimRepositories.NewReportRepository(db.GormDB()),
+			),
 		),
 	).Init(app)
 
 	// init `mlflow` api and ui routes.
 	// TODO:refactoring right now it might look scary. we prettify it a bit later.
-	mlflowAPI.NewRouter(
+	mlflowRouter := mlflowAPI.NewRouter(
 		mlflowController.NewController(
 			mlflowRunService.NewService(
 				mlflowRepositories.NewTagRepository(db.GormDB()),
@@ -321,14 +423,26 @@ func createApp(
 				mlflowRepositories.NewExperimentRepository(db.GormDB()),
 				mlflowRepositories.NewLogRepository(db.GormDB(), config.RunLogOutputMax),
 				mlflowRepositories.NewArtifactRepository(db.GormDB()),
+				mlflowRepositories.NewInputRepository(db.GormDB()),
+				mlflowRepositories.NewTextRepository(db.GormDB()),
+				mlflowRepositories.NewDistributionRepository(db.GormDB()),
+				mlflowRepositories.NewFigureRepository(db.GormDB()),
+				mlflowRepositories.NewAudioRepository(db.GormDB()),
+				livePublisher,
+			),
+			mlflowModelService.NewService(
+				mlflowRepositories.NewRunRepository(db.GormDB()),
+				mlflowRepositories.NewModelVersionRepository(db.GormDB()),
+				mlflowRepositories.NewRegisteredModelRepository(db.GormDB()),
 			),
-			mlflowModelService.NewService(),
 			mlflowMetricService.NewService(
 				mlflowRepositories.NewRunRepository(db.GormDB()),
 				mlflowRepositories.NewMetricRepository(db.GormDB()),
 			),
 			artifactService.NewService(
+				config,
 				mlflowRepositories.NewRunRepository(db.GormDB()),
+				mlflowRepositories.NewExperimentRepository(db.GormDB()),
 				artifactStorageFactory,
 			),
 			mlflowExperimentService.NewService(
@@ -336,6 +450,32 @@ func createApp(
 				mlflowRepositories.NewTagRepository(db.GormDB()),
 				mlflowRepositories.NewExperimentRepository(db.GormDB()),
 			),
+			mlflowTraceService.NewService(
+				mlflowRepositories.NewTraceRepository(db.GormDB()),
+				mlflowRepositories.NewExperimentRepository(db.GormDB()),
+				artifactStorageFactory,
+			),
+		),
+	)
+	if config.ServeArtifacts {
+		log.Info("Serving artifacts through the mlflow-artifacts proxy API")
+		mlflowRouter.EnableArtifactsProxy()
+	}
+	mlflowRouter.Init(app)
+
+	// init Aim remote `tracking` api routes.
+	trackingAPI.NewRouter(
+		trackingController.NewController(
+			trackingService.NewService(
+				config,
+				mlflowRepositories.NewRunRepository(db.GormDB()),
+				mlflowRepositories.NewParamRepository(db.GormDB()),
+				mlflowRepositories.NewMetricRepository(db.GormDB()),
+				mlflowRepositories.NewArtifactRepository(db.GormDB()),
+				mlflowRepositories.NewExperimentRepository(db.GormDB()),
+				artifactStorageFactory,
+				livePublisher,
+			),
 		),
 	).Init(app)
 
@@ -346,6 +486,17 @@ func createApp(
 		mlflowRepositories.NewLogRepository(db.GormDB(), config.RunLogOutputMax),
 	).Run()
 
+	// run a garbage collector background job.
+	mlflowGCService.NewCollector(
+		ctx,
+		config,
+		mlflowGCService.NewService(
+			mlflowRepositories.NewGCRepository(db.GormDB()),
+			namespaceCachedRepository,
+			artifactStorageFactory,
+		),
+	).Run()
+
 	mlflowUI.AddRoutes(app)
 	aimUI.AddRoutes(app)
 
@@ -357,6 +508,15 @@ func createApp(
 				namespaceCachedRepository,
 				mlflowRepositories.NewExperimentRepository(db.GormDB()),
 			),
+			adminUITokenService.NewService(
+				repositories.NewAccessTokenRepository(db.GormDB()),
+				namespaceCachedRepository,
+			),
+			adminUIRoleService.NewService(
+				rolesCachedRepository,
+				namespaceCachedRepository,
+			),
+			adminUIAuditService.NewService(auditLogRepository),
 		),
 	).Init(app); err != nil {
 		return nil, eris.Wrap(err, "error initializing admin routes")
@@ -0,0 +1,42 @@
+package controller
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/ui/admin/request"
+	"github.com/G-Research/fasttrackml/pkg/ui/admin/response"
+)
+
+// GetAuditLogsPage renders the list view of audit log records.
+func (c Controller) GetAuditLogsPage(ctx *fiber.Ctx) error {
+	var req request.SearchAuditLogsRequest
+	if err := ctx.QueryParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "unable to parse query parameters")
+	}
+	auditLogs, err := c.auditService.SearchAuditLogs(ctx.Context(), &req)
+	if err != nil {
+		return ctx.Render("audit/index", fiber.Map{
+			"Filter":  req,
+			"Status":  StatusError,
+			"Message": err.Error(),
+		})
+	}
+	return ctx.Render("audit/index", fiber.Map{
+		"Filter":    req,
+		"AuditLogs": response.NewSearchAuditLogsResponse(auditLogs),
+	})
+}
+
+// SearchAuditLogs handles `GET /admin/api/audit` endpoint.
+func (c Controller) SearchAuditLogs(ctx *fiber.Ctx) error {
+	var req request.SearchAuditLogsRequest
+	if err := ctx.QueryParser(&req); err != nil {
+		return sendAPIError(ctx, api.NewBadRequestError("unable to parse query parameters: %s", err))
+	}
+	auditLogs, err := c.auditService.SearchAuditLogs(ctx.Context(), &req)
+	if err != nil {
+		return sendAPIError(ctx, err)
+	}
+	return ctx.JSON(response.NewSearchAuditLogsResponse(auditLogs))
+}
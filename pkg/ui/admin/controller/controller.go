@@ -1,15 +1,31 @@
 package controller
 
-import "github.com/G-Research/fasttrackml/pkg/ui/admin/service/namespace"
+import (
+	"github.com/G-Research/fasttrackml/pkg/ui/admin/service/audit"
+	"github.com/G-Research/fasttrackml/pkg/ui/admin/service/namespace"
+	"github.com/G-Research/fasttrackml/pkg/ui/admin/service/role"
+	"github.com/G-Research/fasttrackml/pkg/ui/admin/service/token"
+)
 
 // Controller contains all the request handler functions for the admin ui.
 type Controller struct {
 	namespaceService *namespace.Service
+	tokenService     *token.Service
+	roleService      *role.Service
+	auditService     *audit.Service
 }
 
 // NewController creates new Controller instance.
-func NewController(namespaceService *namespace.Service) *Controller {
+func NewController(
+	namespaceService *namespace.Service,
+	tokenService *token.Service,
+	roleService *role.Service,
+	auditService *audit.Service,
+) *Controller {
 	return &Controller{
 		namespaceService: namespaceService,
+		tokenService:     tokenService,
+		roleService:      roleService,
+		auditService:     auditService,
 	}
 }
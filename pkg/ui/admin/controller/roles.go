@@ -0,0 +1,143 @@
+package controller
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/G-Research/fasttrackml/pkg/common/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/ui/admin/request"
+	"github.com/G-Research/fasttrackml/pkg/ui/admin/response"
+	"github.com/G-Research/fasttrackml/pkg/ui/common"
+)
+
+// GetRoles renders the list view with no message.
+func (c Controller) GetRoles(ctx *fiber.Ctx) error {
+	return c.renderRolesIndex(ctx, "")
+}
+
+// GetRole renders the update view for a role.
+func (c Controller) GetRole(ctx *fiber.Ctx) error {
+	id, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, "unable to parse id")
+	}
+	role, roleNamespaces, err := c.roleService.GetRole(ctx.Context(), id)
+	if err != nil {
+		return fiber.NewError(fiber.ErrInternalServerError.Code, "unable to find role")
+	}
+	if role == nil {
+		return fiber.NewError(fiber.StatusNotFound, "role not found")
+	}
+	namespaces, err := c.namespaceService.ListNamespaces(ctx.Context())
+	if err != nil {
+		return fiber.NewError(fiber.ErrInternalServerError.Code, "unable to list namespaces")
+	}
+	return ctx.Render("roles/update", fiber.Map{
+		"Role":       response.NewRoleResponse(role, roleNamespaces),
+		"Namespaces": namespaces,
+		"Levels": []models.PermissionLevel{
+			models.PermissionLevelViewer, models.PermissionLevelEditor, models.PermissionLevelOwner,
+		},
+	})
+}
+
+// NewRole renders the create view for a role.
+func (c Controller) NewRole(ctx *fiber.Ctx) error {
+	return ctx.Render("roles/create", fiber.Map{
+		"Role": response.Role{},
+	})
+}
+
+// CreateRole creates a new role record.
+func (c Controller) CreateRole(ctx *fiber.Ctx) error {
+	var role request.Role
+	if err := ctx.BodyParser(&role); err != nil {
+		return fiber.NewError(400, "unable to parse request body")
+	}
+	if _, err := c.roleService.CreateRole(ctx.Context(), role.Name); err != nil {
+		return ctx.Render("roles/create", fiber.Map{
+			"Role":    role,
+			"Status":  StatusError,
+			"Message": common.ErrorMessageForUI("role name", err.Error()),
+		})
+	}
+	return c.renderRolesIndex(ctx, "Successfully added new role")
+}
+
+// DeleteRole deletes a role record.
+func (c Controller) DeleteRole(ctx *fiber.Ctx) error {
+	id, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, "unable to parse id")
+	}
+	if err := c.roleService.DeleteRole(ctx.Context(), id); err != nil {
+		return ctx.JSON(fiber.Map{
+			"status":  StatusError,
+			"message": common.ErrorMessageForUI("role", err.Error()),
+		})
+	}
+	return ctx.JSON(fiber.Map{
+		"status":  StatusSuccess,
+		"message": "Successfully deleted role.",
+	})
+}
+
+// SaveRoleNamespace grants a role permission level to a namespace.
+func (c Controller) SaveRoleNamespace(ctx *fiber.Ctx) error {
+	id, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, "unable to parse id")
+	}
+	var req request.RoleNamespace
+	if err := ctx.BodyParser(&req); err != nil {
+		return fiber.NewError(400, "unable to parse request body")
+	}
+	if err := c.roleService.SaveRoleNamespace(ctx.Context(), id, req.NamespaceID, req.Level); err != nil {
+		return ctx.JSON(fiber.Map{
+			"status":  StatusError,
+			"message": common.ErrorMessageForUI("permission level", err.Error()),
+		})
+	}
+	return ctx.JSON(fiber.Map{
+		"status":  StatusSuccess,
+		"message": "Successfully saved role namespace.",
+	})
+}
+
+// DeleteRoleNamespace revokes any access of a role to a namespace.
+func (c Controller) DeleteRoleNamespace(ctx *fiber.Ctx) error {
+	id, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, "unable to parse id")
+	}
+	namespaceID, err := ctx.ParamsInt("namespace_id")
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, "unable to parse namespace id")
+	}
+	if err := c.roleService.DeleteRoleNamespace(ctx.Context(), id, uint(namespaceID)); err != nil {
+		return ctx.JSON(fiber.Map{
+			"status":  StatusError,
+			"message": common.ErrorMessageForUI("role namespace", err.Error()),
+		})
+	}
+	return ctx.JSON(fiber.Map{
+		"status":  StatusSuccess,
+		"message": "Successfully deleted role namespace.",
+	})
+}
+
+// renderRolesIndex renders the roles index page with the given message.
+func (c Controller) renderRolesIndex(ctx *fiber.Ctx, msg string) error {
+	roles, roleNamespaces, err := c.roleService.ListRoles(ctx.Context())
+	if err != nil {
+		return ctx.Render("roles/index", fiber.Map{
+			"Status":  StatusError,
+			"Message": common.ErrorMessageForUI("role", err.Error()),
+		})
+	}
+	return ctx.Render("roles/index", fiber.Map{
+		"Roles":   response.NewRolesResponse(roles, roleNamespaces),
+		"Status":  StatusSuccess,
+		"Message": msg,
+	})
+}
@@ -0,0 +1,82 @@
+package controller
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/ui/admin/request"
+	"github.com/G-Research/fasttrackml/pkg/ui/admin/response"
+	"github.com/G-Research/fasttrackml/pkg/ui/common"
+)
+
+// GetAccessTokensPage renders the list view of access tokens.
+func (c Controller) GetAccessTokensPage(ctx *fiber.Ctx) error {
+	tokens, err := c.tokenService.ListAccessTokens(ctx.Context())
+	if err != nil {
+		return ctx.Render("tokens/index", fiber.Map{
+			"Tokens":  response.NewGetAccessTokensResponse(tokens),
+			"Status":  StatusError,
+			"Message": common.ErrorMessageForUI("access token", err.Error()),
+		})
+	}
+	return ctx.Render("tokens/index", fiber.Map{
+		"Tokens": response.NewGetAccessTokensResponse(tokens),
+	})
+}
+
+// NewAccessTokenPage renders the create view for an access token.
+func (c Controller) NewAccessTokenPage(ctx *fiber.Ctx) error {
+	return ctx.Render("tokens/create", fiber.Map{})
+}
+
+// GetAccessTokens handles `GET /admin/api/tokens` endpoint.
+func (c Controller) GetAccessTokens(ctx *fiber.Ctx) error {
+	tokens, err := c.tokenService.ListAccessTokens(ctx.Context())
+	if err != nil {
+		return sendAPIError(ctx, err)
+	}
+	return ctx.JSON(response.NewGetAccessTokensResponse(tokens))
+}
+
+// CreateAccessToken handles `POST /admin/api/tokens` endpoint.
+func (c Controller) CreateAccessToken(ctx *fiber.Ctx) error {
+	var req request.CreateAccessTokenRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return sendAPIError(ctx, api.NewBadRequestError("unable to parse request body: %s", err))
+	}
+	token, rawToken, err := c.tokenService.CreateAccessToken(ctx.Context(), &req)
+	if err != nil {
+		return sendAPIError(ctx, err)
+	}
+	return ctx.Status(fiber.StatusCreated).JSON(response.NewCreateAccessTokenResponse(token, rawToken))
+}
+
+// DeleteAccessToken handles `DELETE /admin/api/tokens/:id` endpoint.
+func (c Controller) DeleteAccessToken(ctx *fiber.Ctx) error {
+	id, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return sendAPIError(ctx, api.NewBadRequestError("unable to parse id: %s", err))
+	}
+	if err := c.tokenService.DeleteAccessToken(ctx.Context(), id); err != nil {
+		return sendAPIError(ctx, err)
+	}
+	return ctx.JSON(fiber.Map{
+		"status":  StatusSuccess,
+		"message": "Successfully revoked access token.",
+	})
+}
+
+// sendAPIError sends error to the client in the same json format as the other APIs do.
+func sendAPIError(ctx *fiber.Ctx, err error) error {
+	var errorResponse *api.ErrorResponse
+	if !errors.As(err, &errorResponse) {
+		errorResponse = api.NewInternalError(err.Error())
+	}
+	if errorResponse.ErrorCode == api.ErrorCodeResourceDoesNotExist {
+		errorResponse.StatusCode = fiber.StatusNotFound
+	}
+	return ctx.Status(errorResponse.StatusCode).JSON(errorResponse)
+}
@@ -0,0 +1,3 @@
+function auditIndex() {
+  redirectTo('/admin/audit/');
+}
@@ -0,0 +1,70 @@
+function handleSaveRoleNamespace() {
+  $("#roleNamespaceForm").on("submit", function(event) {
+    event.preventDefault(); // Prevent the default form submission
+
+    const id = $("#id").val();
+    const formDataObject = {
+      namespace_id: parseInt($("#namespace_id").val(), 10),
+      level: $("#level").val(),
+    };
+
+    // Perform a POST request using jQuery's $.ajax
+    $.ajax({
+      url: `/admin/roles/${id}/namespaces`,
+      type: "POST",
+      contentType: "application/json",
+      data: JSON.stringify(formDataObject),
+    }).done(function(data) {
+      handleRoleResponse(data, `/admin/roles/${id}/`);
+    });
+  });
+}
+
+function createRole() {
+  redirectTo('/admin/roles/new');
+}
+
+function editRole(id) {
+  redirectTo(`/admin/roles/${id}/`);
+}
+
+function roleIndex() {
+  redirectTo('/admin/roles/');
+}
+
+function deleteRole(id) {
+  if (confirm("Are you sure?") != true ){
+    return
+  }
+  $.ajax({
+    url: `/admin/roles/${id}`,
+    type: "DELETE",
+    contentType: "application/json",
+  }).done(function(data) {
+    handleRoleResponse(data, '/admin/roles/');
+  });
+}
+
+function deleteRoleNamespace(id, namespaceID) {
+  if (confirm("Are you sure?") != true ){
+    return
+  }
+  $.ajax({
+    url: `/admin/roles/${id}/namespaces/${namespaceID}`,
+    type: "DELETE",
+    contentType: "application/json",
+  }).done(function(data) {
+    handleRoleResponse(data, `/admin/roles/${id}/`);
+  });
+}
+
+function handleRoleResponse(data, path) {
+  if (data['status'] == 'success'){
+    redirectTo(path
+        + `?message=${encodeURIComponent(data["message"])}`
+        + `&status=success`);
+  }
+  else {
+    showErrorMessage(data['message']);
+  }
+}
@@ -0,0 +1,54 @@
+function handleCreateToken() {
+  $("#createTokenForm").on("submit", function(event) {
+    event.preventDefault(); // Prevent the default form submission
+
+    const request = {
+      name: $("#name").val(),
+      roles: $("#roles").val().split(",").map((role) => role.trim()).filter((role) => role !== ""),
+    };
+    if ($("#expires_at").val() !== "") {
+      request["expires_at"] = new Date($("#expires_at").val()).toISOString();
+    }
+
+    // Perform a POST request using jQuery's $.ajax
+    $.ajax({
+      url: "/admin/api/tokens",
+      type: "POST",
+      contentType: "application/json",
+      data: JSON.stringify(request),
+    }).done(function(data) {
+      $("#createTokenForm").hide();
+      $("#token-value").text(data["token"]);
+      $("#created-token").show();
+    }).fail(handleTokenError);
+  });
+}
+
+function createToken() {
+  redirectTo('/admin/tokens/new');
+}
+
+function tokenIndex() {
+  redirectTo('/admin/tokens/');
+}
+
+function deleteToken(id) {
+  if (confirm("Are you sure? Clients using this token will lose access.") != true ){
+    return
+  }
+  // Perform a DELETE request using jQuery's $.ajax
+  $.ajax({
+    url: `/admin/api/tokens/${id}`,
+    type: "DELETE",
+    contentType: "application/json",
+  }).done(function(data) {
+    redirectTo('/admin/tokens/'
+        + `?message=${encodeURIComponent(data["message"])}`
+        + `&status=success`);
+  }).fail(handleTokenError);
+}
+
+function handleTokenError(jqxhr) {
+  const data = jqxhr.responseJSON || {};
+  showErrorMessage(data['message'] || 'An unexpected error was encountered.');
+}
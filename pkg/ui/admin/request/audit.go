@@ -0,0 +1,13 @@
+package request
+
+// SearchAuditLogsRequest represents the filter of audit log records.
+// `from` and `to` are RFC 3339 timestamps or `YYYY-MM-DDTHH:MM` values of an HTML form in UTC.
+type SearchAuditLogsRequest struct {
+	Actor     string `query:"actor"`
+	Namespace string `query:"namespace"`
+	Action    string `query:"action"`
+	TargetID  string `query:"target_id"`
+	From      string `query:"from"`
+	To        string `query:"to"`
+	Limit     int    `query:"limit"`
+}
@@ -0,0 +1,14 @@
+package request
+
+import "github.com/G-Research/fasttrackml/pkg/common/dao/models"
+
+// Role represents the data to create a Role.
+type Role struct {
+	Name string `json:"name"`
+}
+
+// RoleNamespace represents the data to grant a Role permission level to a Namespace.
+type RoleNamespace struct {
+	NamespaceID uint                   `json:"namespace_id"`
+	Level       models.PermissionLevel `json:"level"`
+}
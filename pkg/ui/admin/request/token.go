@@ -0,0 +1,10 @@
+package request
+
+import "time"
+
+// CreateAccessTokenRequest represents the data to create an Access Token.
+type CreateAccessTokenRequest struct {
+	Name      string     `json:"name"`
+	Roles     []string   `json:"roles"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
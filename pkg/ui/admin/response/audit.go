@@ -0,0 +1,43 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/G-Research/fasttrackml/pkg/common/dao/models"
+)
+
+// AuditLog represents the data for viewing an Audit Log record.
+type AuditLog struct {
+	ID         uuid.UUID `json:"id"`
+	CreatedAt  time.Time `json:"created_at"`
+	ActorType  string    `json:"actor_type"`
+	Actor      string    `json:"actor"`
+	Namespace  string    `json:"namespace"`
+	Method     string    `json:"method"`
+	Action     string    `json:"action"`
+	Path       string    `json:"path"`
+	TargetIDs  []string  `json:"target_ids"`
+	StatusCode int       `json:"status_code"`
+}
+
+// NewSearchAuditLogsResponse creates new response object for `GET /admin/api/audit` endpoint.
+func NewSearchAuditLogsResponse(auditLogs []models.AuditLog) []AuditLog {
+	resp := make([]AuditLog, len(auditLogs))
+	for i, auditLog := range auditLogs {
+		resp[i] = AuditLog{
+			ID:         auditLog.ID,
+			CreatedAt:  auditLog.CreatedAt,
+			ActorType:  auditLog.ActorType,
+			Actor:      auditLog.Actor,
+			Namespace:  auditLog.NamespaceCode,
+			Method:     auditLog.Method,
+			Action:     auditLog.Action,
+			Path:       auditLog.Path,
+			TargetIDs:  auditLog.TargetIDs,
+			StatusCode: auditLog.StatusCode,
+		}
+	}
+	return resp
+}
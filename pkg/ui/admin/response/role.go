@@ -0,0 +1,55 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	commonModels "github.com/G-Research/fasttrackml/pkg/common/dao/models"
+)
+
+// Role represents the data for viewing/editing a Role.
+type Role struct {
+	ID         uuid.UUID       `json:"id"`
+	Name       string          `json:"name"`
+	Namespaces []RoleNamespace `json:"namespaces"`
+	CreatedAt  time.Time       `json:"created_at"`
+}
+
+// RoleNamespace represents the permission level which a Role has to a Namespace.
+type RoleNamespace struct {
+	NamespaceID   uint                         `json:"namespace_id"`
+	NamespaceCode string                       `json:"namespace_code"`
+	Level         commonModels.PermissionLevel `json:"level"`
+}
+
+// NewRoleResponse creates new Role response object.
+func NewRoleResponse(role *models.Role, roleNamespaces []models.RoleNamespace) Role {
+	resp := Role{
+		ID:         role.ID,
+		Name:       role.Name,
+		Namespaces: make([]RoleNamespace, 0),
+		CreatedAt:  role.CreatedAt,
+	}
+	for _, roleNamespace := range roleNamespaces {
+		if roleNamespace.RoleID != role.ID {
+			continue
+		}
+		resp.Namespaces = append(resp.Namespaces, RoleNamespace{
+			NamespaceID:   roleNamespace.NamespaceID,
+			NamespaceCode: roleNamespace.Namespace.Code,
+			Level:         roleNamespace.Level,
+		})
+	}
+	return resp
+}
+
+// NewRolesResponse creates new list of Role response objects.
+func NewRolesResponse(roles []models.Role, roleNamespaces []models.RoleNamespace) []Role {
+	resp := make([]Role, len(roles))
+	for i := range roles {
+		resp[i] = NewRoleResponse(&roles[i], roleNamespaces)
+	}
+	return resp
+}
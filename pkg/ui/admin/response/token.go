@@ -0,0 +1,55 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/G-Research/fasttrackml/pkg/common/dao/models"
+)
+
+// AccessToken represents the data for viewing an Access Token. The raw token value is never part of it.
+type AccessToken struct {
+	ID         uuid.UUID  `json:"id"`
+	Name       string     `json:"name"`
+	Roles      []string   `json:"roles"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// CreateAccessTokenResponse represents the response of `POST /admin/api/tokens` endpoint.
+// It is the only time when the raw token value is returned to the client.
+type CreateAccessTokenResponse struct {
+	AccessToken
+	Token string `json:"token"`
+}
+
+// NewAccessTokenResponse creates new AccessToken response object.
+func NewAccessTokenResponse(token *models.AccessToken) AccessToken {
+	return AccessToken{
+		ID:         token.ID,
+		Name:       token.Name,
+		Roles:      token.Roles,
+		ExpiresAt:  token.ExpiresAt,
+		LastUsedAt: token.LastUsedAt,
+		CreatedAt:  token.CreatedAt,
+	}
+}
+
+// NewGetAccessTokensResponse creates new response object for `GET /admin/api/tokens` endpoint.
+func NewGetAccessTokensResponse(tokens []models.AccessToken) []AccessToken {
+	resp := make([]AccessToken, len(tokens))
+	for i := range tokens {
+		resp[i] = NewAccessTokenResponse(&tokens[i])
+	}
+	return resp
+}
+
+// NewCreateAccessTokenResponse creates new response object for `POST /admin/api/tokens` endpoint.
+func NewCreateAccessTokenResponse(token *models.AccessToken, rawToken string) *CreateAccessTokenResponse {
+	return &CreateAccessTokenResponse{
+		AccessToken: NewAccessTokenResponse(token),
+		Token:       rawToken,
+	}
+}
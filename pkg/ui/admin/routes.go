@@ -59,6 +59,51 @@ func (r *Router) Init(router fiber.Router) error {
 	namespaces.Put("/:id<int>/", r.controller.UpdateNamespace)
 	namespaces.Delete("/:id<int>/", r.controller.DeleteNamespace)
 
+	roles := app.Group("roles")
+	// apply global middlewares.
+	for _, globalMiddleware := range r.globalMiddlewares {
+		roles.Use(globalMiddleware)
+	}
+	roles.Get("/", r.controller.GetRoles)
+	roles.Post("/", r.controller.CreateRole)
+	roles.Get("/new", r.controller.NewRole)
+	roles.Get("/:id/", r.controller.GetRole)
+	roles.Delete("/:id/", r.controller.DeleteRole)
+	roles.Post("/:id/namespaces", r.controller.SaveRoleNamespace)
+	roles.Delete("/:id/namespaces/:namespace_id<int>/", r.controller.DeleteRoleNamespace)
+
+	tokens := app.Group("tokens")
+	// apply global middlewares.
+	for _, globalMiddleware := range r.globalMiddlewares {
+		tokens.Use(globalMiddleware)
+	}
+	tokens.Get("/", r.controller.GetAccessTokensPage)
+	tokens.Get("/new", r.controller.NewAccessTokenPage)
+
+	audit := app.Group("audit")
+	// apply global middlewares.
+	for _, globalMiddleware := range r.globalMiddlewares {
+		audit.Use(globalMiddleware)
+	}
+	audit.Get("/", r.controller.GetAuditLogsPage)
+
+	// json api routes
+	apiTokens := app.Group("api/tokens")
+	// apply global middlewares.
+	for _, globalMiddleware := range r.globalMiddlewares {
+		apiTokens.Use(globalMiddleware)
+	}
+	apiTokens.Get("/", r.controller.GetAccessTokens)
+	apiTokens.Post("/", r.controller.CreateAccessToken)
+	apiTokens.Delete("/:id/", r.controller.DeleteAccessToken)
+
+	apiAudit := app.Group("api/audit")
+	// apply global middlewares.
+	for _, globalMiddleware := range r.globalMiddlewares {
+		apiAudit.Use(globalMiddleware)
+	}
+	apiAudit.Get("/", r.controller.SearchAuditLogs)
+
 	// default route
 	app.Use("/", etag.New(), filesystem.New(filesystem.Config{
 		Root: http.FS(sub),
@@ -0,0 +1,51 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/ui/admin/request"
+)
+
+// Service provides service layer to work with `audit log` business logic.
+type Service struct {
+	auditLogRepository repositories.AuditLogRepositoryProvider
+}
+
+// NewService creates new Service instance.
+func NewService(auditLogRepository repositories.AuditLogRepositoryProvider) *Service {
+	return &Service{
+		auditLogRepository: auditLogRepository,
+	}
+}
+
+// SearchAuditLogs returns the most recent audit log records which satisfy the filter.
+func (s Service) SearchAuditLogs(
+	ctx context.Context, req *request.SearchAuditLogsRequest,
+) ([]models.AuditLog, error) {
+	if err := ValidateSearchAuditLogsRequest(req); err != nil {
+		return nil, err
+	}
+
+	filter := models.AuditLogFilter{
+		Actor:         req.Actor,
+		NamespaceCode: req.Namespace,
+		Action:        req.Action,
+		TargetID:      req.TargetID,
+		Limit:         req.Limit,
+	}
+	// values have been already validated, so errors are not possible here.
+	filter.From, _ = parseAuditLogTime(req.From)
+	filter.To, _ = parseAuditLogTime(req.To)
+	if filter.Limit == 0 {
+		filter.Limit = defaultAuditLogsLimit
+	}
+
+	auditLogs, err := s.auditLogRepository.Search(ctx, &filter)
+	if err != nil {
+		return nil, api.NewInternalError("unable to search audit logs: %s", err)
+	}
+	return auditLogs, nil
+}
@@ -0,0 +1,47 @@
+package audit
+
+import (
+	"time"
+
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/ui/admin/request"
+)
+
+const (
+	// defaultAuditLogsLimit is the number of returned records when the limit is not provided.
+	defaultAuditLogsLimit = 100
+	// maxAuditLogsLimit is the maximum number of returned records.
+	maxAuditLogsLimit = 1000
+)
+
+// auditLogTimeLayouts are the supported formats of `from` and `to` filter values.
+var auditLogTimeLayouts = []string{time.RFC3339, "2006-01-02T15:04"}
+
+// ValidateSearchAuditLogsRequest validates `GET /admin/api/audit` request.
+func ValidateSearchAuditLogsRequest(req *request.SearchAuditLogsRequest) error {
+	if req.Limit < 0 || req.Limit > maxAuditLogsLimit {
+		return api.NewInvalidParameterValueError("limit is invalid -- must be between 0 and %d", maxAuditLogsLimit)
+	}
+	if _, err := parseAuditLogTime(req.From); err != nil {
+		return api.NewInvalidParameterValueError("from is invalid -- must be RFC 3339 timestamp")
+	}
+	if _, err := parseAuditLogTime(req.To); err != nil {
+		return api.NewInvalidParameterValueError("to is invalid -- must be RFC 3339 timestamp")
+	}
+	return nil
+}
+
+// parseAuditLogTime parses optional `from` and `to` filter values.
+func parseAuditLogTime(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	var err error
+	for _, layout := range auditLogTimeLayouts {
+		var moment time.Time
+		if moment, err = time.Parse(layout, value); err == nil {
+			return &moment, nil
+		}
+	}
+	return nil, err
+}
@@ -0,0 +1,133 @@
+package role
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/rotisserie/eris"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+	commonModels "github.com/G-Research/fasttrackml/pkg/common/dao/models"
+	commonRepositories "github.com/G-Research/fasttrackml/pkg/common/dao/repositories"
+)
+
+// Service provides service layer to work with `role` business logic.
+type Service struct {
+	roleRepository      commonRepositories.RoleRepositoryProvider
+	namespaceRepository repositories.NamespaceRepositoryProvider
+}
+
+// NewService creates new Service instance.
+func NewService(
+	roleRepository commonRepositories.RoleRepositoryProvider,
+	namespaceRepository repositories.NamespaceRepositoryProvider,
+) *Service {
+	return &Service{
+		roleRepository:      roleRepository,
+		namespaceRepository: namespaceRepository,
+	}
+}
+
+// ListRoles returns all roles together with all their namespace relations.
+func (s Service) ListRoles(ctx context.Context) ([]models.Role, []models.RoleNamespace, error) {
+	roles, err := s.roleRepository.List(ctx)
+	if err != nil {
+		return nil, nil, eris.Wrap(err, "error listing roles")
+	}
+	roleNamespaces, err := s.roleRepository.ListRoleNamespaces(ctx)
+	if err != nil {
+		return nil, nil, eris.Wrap(err, "error listing role namespaces")
+	}
+	return roles, roleNamespaces, nil
+}
+
+// GetRole returns one role by ID together with its namespace relations.
+func (s Service) GetRole(ctx context.Context, id uuid.UUID) (*models.Role, []models.RoleNamespace, error) {
+	role, err := s.roleRepository.GetByID(ctx, id)
+	if err != nil {
+		return nil, nil, eris.Wrapf(err, "error getting role by id: %s", id)
+	}
+	if role == nil {
+		return nil, nil, nil
+	}
+	roleNamespaces, err := s.roleRepository.ListRoleNamespaces(ctx)
+	if err != nil {
+		return nil, nil, eris.Wrap(err, "error listing role namespaces")
+	}
+	var filtered []models.RoleNamespace
+	for _, roleNamespace := range roleNamespaces {
+		if roleNamespace.RoleID == role.ID {
+			filtered = append(filtered, roleNamespace)
+		}
+	}
+	return role, filtered, nil
+}
+
+// CreateRole creates a new role.
+func (s Service) CreateRole(ctx context.Context, name string) (*models.Role, error) {
+	if err := ValidateRoleName(name); err != nil {
+		return nil, eris.Wrap(err, "error validating role")
+	}
+	role := models.Role{
+		Name: name,
+	}
+	if err := s.roleRepository.Create(ctx, &role); err != nil {
+		return nil, eris.Wrap(err, "error creating role")
+	}
+	return &role, nil
+}
+
+// DeleteRole deletes the role together with all its namespace relations.
+func (s Service) DeleteRole(ctx context.Context, id uuid.UUID) error {
+	role, err := s.roleRepository.GetByID(ctx, id)
+	if err != nil {
+		return eris.Wrapf(err, "error finding role by id: %s", id)
+	}
+	if role == nil {
+		return eris.Errorf("role not found by id: %s", id)
+	}
+	if err := s.roleRepository.Delete(ctx, role); err != nil {
+		return eris.Wrap(err, "error deleting role")
+	}
+	return nil
+}
+
+// SaveRoleNamespace grants the role requested permission level to the namespace.
+func (s Service) SaveRoleNamespace(
+	ctx context.Context, roleID uuid.UUID, namespaceID uint, level commonModels.PermissionLevel,
+) error {
+	if err := ValidatePermissionLevel(level); err != nil {
+		return eris.Wrap(err, "error validating permission level")
+	}
+	role, err := s.roleRepository.GetByID(ctx, roleID)
+	if err != nil {
+		return eris.Wrapf(err, "error finding role by id: %s", roleID)
+	}
+	if role == nil {
+		return eris.Errorf("role not found by id: %s", roleID)
+	}
+	namespace, err := s.namespaceRepository.GetByID(ctx, namespaceID)
+	if err != nil {
+		return eris.Wrapf(err, "error finding namespace by id: %d", namespaceID)
+	}
+	if namespace == nil {
+		return eris.Errorf("namespace not found by id: %d", namespaceID)
+	}
+	if err := s.roleRepository.SaveRoleNamespace(ctx, &models.RoleNamespace{
+		RoleID:      role.ID,
+		NamespaceID: namespace.ID,
+		Level:       level,
+	}); err != nil {
+		return eris.Wrap(err, "error saving role namespace")
+	}
+	return nil
+}
+
+// DeleteRoleNamespace revokes any access of the role to the namespace.
+func (s Service) DeleteRoleNamespace(ctx context.Context, roleID uuid.UUID, namespaceID uint) error {
+	if err := s.roleRepository.DeleteRoleNamespace(ctx, roleID, namespaceID); err != nil {
+		return eris.Wrap(err, "error deleting role namespace")
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+package role
+
+import (
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/models"
+)
+
+// maxRoleNameLength defines the maximum length of role name.
+const maxRoleNameLength = 256
+
+// ValidateRoleName validates role name.
+func ValidateRoleName(name string) error {
+	if name == "" || len(name) > maxRoleNameLength {
+		return api.NewInvalidParameterValueError("role name is invalid -- must be 1-256 characters")
+	}
+	return nil
+}
+
+// ValidatePermissionLevel validates namespace permission level.
+func ValidatePermissionLevel(level models.PermissionLevel) error {
+	if !level.IsValid() {
+		return api.NewInvalidParameterValueError(
+			"permission level '%s' is invalid -- must be `viewer`, `editor` or `owner`", level,
+		)
+	}
+	return nil
+}
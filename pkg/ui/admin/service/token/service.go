@@ -0,0 +1,110 @@
+package token
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rotisserie/eris"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/models"
+	commonRepositories "github.com/G-Research/fasttrackml/pkg/common/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/ui/admin/request"
+)
+
+// accessTokenLength is the number of random bytes in each generated access token.
+const accessTokenLength = 32
+
+// Service provides service layer to work with `access token` business logic.
+type Service struct {
+	accessTokenRepository commonRepositories.AccessTokenRepositoryProvider
+	namespaceRepository   repositories.NamespaceRepositoryProvider
+}
+
+// NewService creates new Service instance.
+func NewService(
+	accessTokenRepository commonRepositories.AccessTokenRepositoryProvider,
+	namespaceRepository repositories.NamespaceRepositoryProvider,
+) *Service {
+	return &Service{
+		accessTokenRepository: accessTokenRepository,
+		namespaceRepository:   namespaceRepository,
+	}
+}
+
+// ListAccessTokens returns all access tokens.
+func (s Service) ListAccessTokens(ctx context.Context) ([]models.AccessToken, error) {
+	tokens, err := s.accessTokenRepository.List(ctx)
+	if err != nil {
+		return nil, api.NewInternalError("unable to list access tokens: %s", err)
+	}
+	return tokens, nil
+}
+
+// CreateAccessToken creates a new access token and returns it together with its raw value.
+func (s Service) CreateAccessToken(
+	ctx context.Context, req *request.CreateAccessTokenRequest,
+) (*models.AccessToken, string, error) {
+	if err := ValidateCreateAccessTokenRequest(req, time.Now()); err != nil {
+		return nil, "", err
+	}
+
+	// make sure that each requested namespace really exists, otherwise such role is useless.
+	for _, role := range req.Roles {
+		code, _, ok := models.ParseNamespaceRole(role)
+		if !ok {
+			continue
+		}
+		namespace, err := s.namespaceRepository.GetByCode(ctx, code)
+		if err != nil {
+			return nil, "", api.NewInternalError("unable to find namespace by code '%s': %s", code, err)
+		}
+		if namespace == nil {
+			return nil, "", api.NewResourceDoesNotExistError("namespace with code '%s' not found", code)
+		}
+	}
+
+	rawToken, err := generateAccessToken()
+	if err != nil {
+		return nil, "", api.NewInternalError("unable to generate access token: %s", err)
+	}
+
+	token := models.AccessToken{
+		Name:      req.Name,
+		TokenHash: models.HashAccessToken(rawToken),
+		Roles:     req.Roles,
+		ExpiresAt: req.ExpiresAt,
+	}
+	if err := s.accessTokenRepository.Create(ctx, &token); err != nil {
+		return nil, "", api.NewInternalError("unable to create access token: %s", err)
+	}
+	return &token, rawToken, nil
+}
+
+// DeleteAccessToken revokes the access token by deleting it.
+func (s Service) DeleteAccessToken(ctx context.Context, id uuid.UUID) error {
+	token, err := s.accessTokenRepository.GetByID(ctx, id.String())
+	if err != nil {
+		return api.NewInternalError("unable to find access token by id '%s': %s", id, err)
+	}
+	if token == nil {
+		return api.NewResourceDoesNotExistError("access token with id '%s' not found", id)
+	}
+	if err := s.accessTokenRepository.Delete(ctx, token); err != nil {
+		return api.NewInternalError("unable to delete access token by id '%s': %s", id, err)
+	}
+	return nil
+}
+
+// generateAccessToken generates new random access token.
+func generateAccessToken() (string, error) {
+	data := make([]byte, accessTokenLength)
+	if _, err := rand.Read(data); err != nil {
+		return "", eris.Wrap(err, "error reading random data")
+	}
+	return models.AccessTokenPrefix + base64.RawURLEncoding.EncodeToString(data), nil
+}
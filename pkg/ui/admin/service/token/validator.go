@@ -0,0 +1,33 @@
+package token
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/ui/admin/request"
+)
+
+// validation rule for access token role.
+var validAccessTokenRole = regexp.MustCompile(`^admin$|^ns:[\w\d-_]{2,12}(:(viewer|editor|owner))?$`)
+
+// ValidateCreateAccessTokenRequest validates `POST /admin/api/tokens` request.
+func ValidateCreateAccessTokenRequest(req *request.CreateAccessTokenRequest, now time.Time) error {
+	if req.Name == "" || len(req.Name) > 256 {
+		return api.NewInvalidParameterValueError("token name is invalid -- must be 1-256 characters")
+	}
+	if len(req.Roles) == 0 {
+		return api.NewInvalidParameterValueError("token roles are invalid -- at least one role is required")
+	}
+	for _, role := range req.Roles {
+		if !validAccessTokenRole.MatchString(role) {
+			return api.NewInvalidParameterValueError(
+				"token role '%s' is invalid -- must be `admin` or `ns:<namespace code>[:viewer|editor|owner]`", role,
+			)
+		}
+	}
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(now) {
+		return api.NewInvalidParameterValueError("token expiration time is invalid -- must be in the future")
+	}
+	return nil
+}
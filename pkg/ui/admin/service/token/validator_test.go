@@ -0,0 +1,84 @@
+package token
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/ui/admin/request"
+)
+
+func TestValidateCreateAccessTokenRequest_Ok(t *testing.T) {
+	now := time.Now()
+	expiresAt := now.Add(time.Hour)
+	err := ValidateCreateAccessTokenRequest(&request.CreateAccessTokenRequest{
+		Name:      "ci-job",
+		Roles:     []string{"admin", "ns:default", "ns:legit-123_ns", "ns:viewers:viewer", "ns:editors:editor"},
+		ExpiresAt: &expiresAt,
+	}, now)
+	require.Nil(t, err)
+}
+
+func TestValidateCreateAccessTokenRequest_Error(t *testing.T) {
+	now := time.Now()
+	expiresAt := now.Add(-time.Hour)
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.CreateAccessTokenRequest
+	}{
+		{
+			name:  "EmptyName",
+			error: api.NewInvalidParameterValueError("token name is invalid -- must be 1-256 characters"),
+			request: &request.CreateAccessTokenRequest{
+				Roles: []string{"admin"},
+			},
+		},
+		{
+			name:  "EmptyRoles",
+			error: api.NewInvalidParameterValueError("token roles are invalid -- at least one role is required"),
+			request: &request.CreateAccessTokenRequest{
+				Name: "ci-job",
+			},
+		},
+		{
+			name: "IncorrectRole",
+			error: api.NewInvalidParameterValueError(
+				"token role 'namespace1' is invalid -- must be `admin` or `ns:<namespace code>[:viewer|editor|owner]`",
+			),
+			request: &request.CreateAccessTokenRequest{
+				Name:  "ci-job",
+				Roles: []string{"ns:default", "namespace1"},
+			},
+		},
+		{
+			name: "IncorrectPermissionLevel",
+			error: api.NewInvalidParameterValueError(
+				"token role 'ns:default:reader' is invalid -- must be `admin` or `ns:<namespace code>[:viewer|editor|owner]`",
+			),
+			request: &request.CreateAccessTokenRequest{
+				Name:  "ci-job",
+				Roles: []string{"ns:default:reader"},
+			},
+		},
+		{
+			name:  "ExpiresInThePast",
+			error: api.NewInvalidParameterValueError("token expiration time is invalid -- must be in the future"),
+			request: &request.CreateAccessTokenRequest{
+				Name:      "ci-job",
+				Roles:     []string{"admin"},
+				ExpiresAt: &expiresAt,
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateCreateAccessTokenRequest(tt.request, now)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
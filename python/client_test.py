@@ -128,3 +128,34 @@ def test_log_image(client, server, run):
             )
             == None
         )
+
+
+def test_log_text(client, server, run):
+    # test logging some texts
+    for i in range(10):
+        assert client.log_text(run.info.run_id, "sequence name", str(uuid.uuid4()), i, 0, {"subset": "val"}) == None
+
+
+def test_log_distribution(client, server, run):
+    # test logging some distributions
+    for i in range(10):
+        counts = [uniform(0, 100) for _ in range(4)]
+        assert client.log_distribution(run.info.run_id, "weights", [0, 1, 2, 3, 4], counts, i, {"layer": "dense"}) == None
+
+
+def test_log_figure(client, server, run):
+    # test logging some figures
+    for i in range(10):
+        figure = {"data": [{"type": "scatter", "x": [0, 1, 2], "y": [i, i + 1, i + 2]}], "layout": {}}
+        assert client.log_figure(run.info.run_id, "sequence name", figure, i) == None
+
+
+def test_log_audio(client, server, run, tmp_path):
+    # test logging some audios
+    for i in range(10):
+        audio_local = tmp_path / f"audio_{i}.wav"
+        audio_local.write_bytes(os.urandom(64))
+        assert (
+            client.log_audio(run.info.run_id, "sequence name", str(audio_local), "audios", "", "wav", 22050, i)
+            == None
+        )
@@ -1,3 +1,7 @@
+import json
+import os
+import tempfile
+import uuid
 from functools import partial
 from itertools import zip_longest
 from typing import Dict, Optional, Sequence
@@ -145,6 +149,73 @@ class FasttrackmlTrackingServiceClient(TrackingServiceClient):
     ):
         self.custom_store.log_output(run_id, data)
 
+    def log_text(
+        self,
+        run_id: str,
+        name: str,
+        text: str,
+        step: int = 0,
+        index: int = 0,
+        context: Optional[dict] = None,
+    ):
+        self.custom_store.log_text(run_id, name, text, step, index, context)
+
+    def log_distribution(
+        self,
+        run_id: str,
+        name: str,
+        bin_edges: Sequence[float],
+        counts: Sequence[float],
+        step: int = 0,
+        context: Optional[dict] = None,
+    ):
+        self.custom_store.log_distribution(run_id, name, bin_edges, counts, step, context)
+
+    def log_figure(
+        self,
+        run_id: str,
+        name: str,
+        figure,
+        step: int = 0,
+        caption: str = "",
+        artifact_path: str = "figures",
+        context: Optional[dict] = None,
+    ):
+        if hasattr(figure, "to_json"):
+            data = figure.to_json()
+        elif isinstance(figure, str):
+            data = figure
+        else:
+            data = json.dumps(figure)
+        with tempfile.TemporaryDirectory() as tmp_dir:
+            filename = os.path.join(tmp_dir, f"{uuid.uuid4().hex}.json")
+            with open(filename, "w") as f:
+                f.write(data)
+            # 1. log the artifact
+            self.log_artifact(run_id, filename, artifact_path)
+            # 2. log the figure metadata
+            self.custom_store.log_figure(run_id, name, filename, artifact_path, step, caption, context)
+
+    def log_audio(
+        self,
+        run_id: str,
+        name: str,
+        filename: str,
+        artifact_path: str = "audios",
+        caption: str = "",
+        format: str = "",
+        sample_rate: int = 0,
+        step: int = 0,
+        index: int = 0,
+        context: Optional[dict] = None,
+    ):
+        # 1. log the artifact
+        self.log_artifact(run_id, filename, artifact_path)
+        # 2. log the audio metadata
+        self.custom_store.log_audio(
+            run_id, name, filename, artifact_path, caption, format, sample_rate, step, index, context
+        )
+
     def log_image(
         self,
         run_id: str,
@@ -462,6 +462,189 @@ class FasttrackmlClient(MlflowClient):
         """
         self._tracking_client.log_output(run_id, data)
 
+    def log_text(
+        self,
+        run_id: str,
+        name: str,
+        text: str,
+        step: int = 0,
+        index: int = 0,
+        context: Optional[dict] = None,
+    ) -> None:
+        """
+        Log a text value for the provided run which will be viewable in the Texts explorer.
+
+        Args:
+            run_id: String ID of the run
+            name: String the name for this sequence of texts
+            text: The text to log
+            step: The text step
+            index: The text index within the step
+            context: The optional context dictionary of the text sequence
+
+        .. code-block:: python
+            :caption: Example
+
+            from fasttrackml import FasttrackmlClient
+
+            # Create a run under the default experiment (whose id is '0').
+            # Since these are low-level CRUD operations, this method will create a run.
+            # To end the run, you'll have to explicitly end it.
+            client = FasttrackmlClient()
+            experiment_id = "0"
+            run = client.create_run(experiment_id)
+            print_run_info(run)
+            print("--")
+
+            # Log some texts
+            for step in range(10):
+                client.log_text(run.info.run_id, "predictions", f"prediction {step}", step, 0, {"subset": "val"})
+            client.set_terminated(run.info.run_id)
+        """
+        self._tracking_client.log_text(run_id, name, text, step, index, context)
+
+    def log_distribution(
+        self,
+        run_id: str,
+        name: str,
+        bin_edges: Sequence[float],
+        counts: Sequence[float],
+        step: int = 0,
+        context: Optional[dict] = None,
+    ) -> None:
+        """
+        Log a distribution (histogram) for the provided run which will be viewable in the Distributions explorer.
+
+        Args:
+            run_id: String ID of the run
+            name: String the name for this sequence of distributions
+            bin_edges: The ascending bin edges of the histogram, one more than the number of counts
+            counts: The number of values within each bin of the histogram
+            step: The distribution step
+            context: The optional context dictionary of the distribution sequence
+
+        .. code-block:: python
+            :caption: Example
+
+            import numpy as np
+            from fasttrackml import FasttrackmlClient
+
+            # Create a run under the default experiment (whose id is '0').
+            # Since these are low-level CRUD operations, this method will create a run.
+            # To end the run, you'll have to explicitly end it.
+            client = FasttrackmlClient()
+            experiment_id = "0"
+            run = client.create_run(experiment_id)
+            print_run_info(run)
+            print("--")
+
+            # Log some distributions
+            for step in range(10):
+                counts, bin_edges = np.histogram(np.random.normal(size=1000), bins=32)
+                client.log_distribution(run.info.run_id, "weights", bin_edges, counts, step, {"layer": "dense"})
+            client.set_terminated(run.info.run_id)
+        """
+        self._tracking_client.log_distribution(run_id, name, bin_edges, counts, step, context)
+
+    def log_figure(
+        self,
+        run_id: str,
+        name: str,
+        figure,
+        step: int = 0,
+        caption: str = "",
+        artifact_path: str = "figures",
+        context: Optional[dict] = None,
+    ) -> None:
+        """
+        Log a Plotly figure for the provided run which will be viewable in the Figures explorer.
+        The figure JSON itself will be stored in the configured artifact store.
+
+        Args:
+            run_id: String ID of the run
+            name: String the name for this sequence of figures
+            figure: The Plotly figure, its dictionary or JSON string representation
+            step: The figure step
+            caption: The figure caption
+            artifact_path: The optional path to append to the artifact_uri
+            context: The optional context dictionary of the figure sequence
+
+        .. code-block:: python
+            :caption: Example
+
+            import plotly.express as px
+            from fasttrackml import FasttrackmlClient
+
+            # Create a run under the default experiment (whose id is '0').
+            # Since these are low-level CRUD operations, this method will create a run.
+            # To end the run, you'll have to explicitly end it.
+            client = FasttrackmlClient()
+            experiment_id = "0"
+            run = client.create_run(experiment_id)
+            print_run_info(run)
+            print("--")
+
+            # Log some figures
+            for step in range(10):
+                figure = px.line(x=list(range(step + 1)), y=[x * x for x in range(step + 1)])
+                client.log_figure(run.info.run_id, "squares", figure, step)
+            client.set_terminated(run.info.run_id)
+        """
+        self._tracking_client.log_figure(run_id, name, figure, step, caption, artifact_path, context)
+
+    def log_audio(
+        self,
+        run_id: str,
+        name: str,
+        filename: str,
+        artifact_path: str = "audios",
+        caption: str = "",
+        format: str = "",
+        sample_rate: int = 0,
+        step: int = 0,
+        index: int = 0,
+        context: Optional[dict] = None,
+    ) -> None:
+        """
+        Log an audio artifact for the provided run which will be viewable in the Audios explorer.
+        The audio itself will be stored in the configured artifact store.
+
+        Args:
+            run_id: String ID of the run
+            name: String the name for this sequence of audios
+            filename: The filename of the audio in the local filesystem
+            artifact_path: The optional path to append to the artifact_uri
+            caption: The audio caption
+            format: The audio format, e.g. wav or mp3
+            sample_rate: The audio sample rate
+            step: The audio step
+            index: The audio index within the step
+            context: The optional context dictionary of the audio sequence
+
+        .. code-block:: python
+            :caption: Example
+
+            from fasttrackml import FasttrackmlClient
+
+            # Create a run under the default experiment (whose id is '0').
+            # Since these are low-level CRUD operations, this method will create a run.
+            # To end the run, you'll have to explicitly end it.
+            client = FasttrackmlClient()
+            experiment_id = "0"
+            run = client.create_run(experiment_id)
+            print_run_info(run)
+            print("--")
+
+            # Log some audios
+            for step in range(10):
+                filename = generate_audio(step) # some function that generates a wav file
+                client.log_audio(run.info.run_id, "samples", filename, "audios", "A sample", "wav", 22050, step)
+            client.set_terminated(run.info.run_id)
+        """
+        self._tracking_client.log_audio(
+            run_id, name, filename, artifact_path, caption, format, sample_rate, step, index, context
+        )
+
     def log_image(
         self,
         run_id: str,
@@ -277,6 +277,150 @@ class CustomRestStore(RestStore):
             )
         return result
 
+    def log_text(
+        self,
+        run_id: str,
+        name: str,
+        text: str,
+        step: int,
+        index: int,
+        context: Optional[dict],
+    ):
+        request_body = {
+            "run_id": run_id,
+            "name": name,
+            "text": text,
+            "step": step,
+            "index": index,
+            "context": context or {},
+        }
+        result = http_request(
+            **{
+                "host_creds": self.get_host_creds(),
+                "endpoint": "/api/2.0/mlflow/runs/log-text",
+                "method": "POST",
+                "json": request_body,
+            }
+        )
+        if result.status_code != 201:
+            result = result.json()
+        if "error_code" in result:
+            raise MlflowException(
+                message=result["message"],
+                error_code=result["error_code"],
+            )
+        return result
+
+    def log_distribution(
+        self,
+        run_id: str,
+        name: str,
+        bin_edges: Sequence[float],
+        counts: Sequence[float],
+        step: int,
+        context: Optional[dict],
+    ):
+        request_body = {
+            "run_id": run_id,
+            "name": name,
+            "bin_edges": [float(edge) for edge in bin_edges],
+            "counts": [float(count) for count in counts],
+            "step": step,
+            "context": context or {},
+        }
+        result = http_request(
+            **{
+                "host_creds": self.get_host_creds(),
+                "endpoint": "/api/2.0/mlflow/runs/log-distribution",
+                "method": "POST",
+                "json": request_body,
+            }
+        )
+        if result.status_code != 201:
+            result = result.json()
+        if "error_code" in result:
+            raise MlflowException(
+                message=result["message"],
+                error_code=result["error_code"],
+            )
+        return result
+
+    def log_figure(
+        self,
+        run_id: str,
+        name: str,
+        filename: str,
+        artifact_path: str,
+        step: int,
+        caption: str,
+        context: Optional[dict],
+    ):
+        request_body = {
+            "run_id": run_id,
+            "name": name,
+            "blob_uri": posixpath.join(artifact_path, os.path.basename(filename)),
+            "step": step,
+            "caption": caption,
+            "context": context or {},
+        }
+        result = http_request(
+            **{
+                "host_creds": self.get_host_creds(),
+                "endpoint": "/api/2.0/mlflow/runs/log-figure",
+                "method": "POST",
+                "json": request_body,
+            }
+        )
+        if result.status_code != 201:
+            result = result.json()
+        if "error_code" in result:
+            raise MlflowException(
+                message=result["message"],
+                error_code=result["error_code"],
+            )
+        return result
+
+    def log_audio(
+        self,
+        run_id: str,
+        name: str,
+        filename: str,
+        artifact_path: str,
+        caption: str,
+        format: str,
+        sample_rate: int,
+        step: int,
+        index: int,
+        context: Optional[dict],
+    ):
+        request_body = {
+            "run_id": run_id,
+            "name": name,
+            "blob_uri": posixpath.join(artifact_path, os.path.basename(filename)),
+            "caption": caption,
+            "format": format,
+            "sample_rate": sample_rate,
+            "step": step,
+            "index": index,
+            "context": context or {},
+        }
+        result = http_request(
+            **{
+                "host_creds": self.get_host_creds(),
+                "endpoint": "/api/2.0/mlflow/runs/log-audio",
+                "method": "POST",
+                "json": request_body,
+            }
+        )
+        if result.status_code != 201:
+            result = result.json()
+        if "error_code" in result:
+            raise MlflowException(
+                message=result["message"],
+                error_code=result["error_code"],
+            )
+        return result
+
     def log_image(
         self,
         run_id: str,
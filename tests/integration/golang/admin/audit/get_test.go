@@ -0,0 +1,185 @@
+package audit
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type GetAuditLogsTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestGetAuditLogsTestSuite(t *testing.T) {
+	suite.Run(t, new(GetAuditLogsTestSuite))
+}
+
+func (s *GetAuditLogsTestSuite) Test_Ok() {
+	now := time.Now().UTC().Truncate(time.Second)
+	auditLog1, err := s.AuditLogFixtures.CreateAuditLog(context.Background(), &models.AuditLog{
+		CreatedAt:     now.Add(-2 * time.Hour),
+		ActorType:     models.AuditLogActorTypeBasic,
+		Actor:         "user1",
+		NamespaceCode: "default",
+		Method:        http.MethodPost,
+		Action:        "/api/2.0/mlflow/experiments/create",
+		Path:          "/api/2.0/mlflow/experiments/create",
+		TargetIDs:     []string{"name=experiment1"},
+		StatusCode:    http.StatusOK,
+	})
+	s.Require().Nil(err)
+	auditLog2, err := s.AuditLogFixtures.CreateAuditLog(context.Background(), &models.AuditLog{
+		CreatedAt:     now.Add(-time.Hour),
+		ActorType:     models.AuditLogActorTypeAccessToken,
+		Actor:         "ci-job",
+		NamespaceCode: "namespace1",
+		Method:        http.MethodDelete,
+		Action:        "/aim/api/runs/:id",
+		Path:          "/aim/api/runs/run1",
+		TargetIDs:     []string{"id=run1"},
+		StatusCode:    http.StatusOK,
+	})
+	s.Require().Nil(err)
+	auditLog3, err := s.AuditLogFixtures.CreateAuditLog(context.Background(), &models.AuditLog{
+		CreatedAt:  now,
+		ActorType:  models.AuditLogActorTypeOIDC,
+		Actor:      "admin",
+		Method:     http.MethodDelete,
+		Action:     "/admin/namespaces/:id/",
+		Path:       "/admin/namespaces/2/",
+		TargetIDs:  []string{"id=2"},
+		StatusCode: http.StatusOK,
+	})
+	s.Require().Nil(err)
+
+	tests := []struct {
+		name     string
+		query    map[any]any
+		expected []*models.AuditLog
+	}{
+		{
+			name:     "WithoutFilter",
+			query:    map[any]any{},
+			expected: []*models.AuditLog{auditLog3, auditLog2, auditLog1},
+		},
+		{
+			name:     "FilterByActor",
+			query:    map[any]any{"actor": "ci-job"},
+			expected: []*models.AuditLog{auditLog2},
+		},
+		{
+			name:     "FilterByNamespace",
+			query:    map[any]any{"namespace": "default"},
+			expected: []*models.AuditLog{auditLog1},
+		},
+		{
+			name:     "FilterByAction",
+			query:    map[any]any{"action": "experiments"},
+			expected: []*models.AuditLog{auditLog1},
+		},
+		{
+			name:     "FilterByTargetID",
+			query:    map[any]any{"target_id": "run1"},
+			expected: []*models.AuditLog{auditLog2},
+		},
+		{
+			name: "FilterByTimeRange",
+			query: map[any]any{
+				"from": now.Add(-90 * time.Minute).Format(time.RFC3339),
+				"to":   now.Format(time.RFC3339),
+			},
+			expected: []*models.AuditLog{auditLog2},
+		},
+		{
+			name:     "WithLimit",
+			query:    map[any]any{"limit": 2},
+			expected: []*models.AuditLog{auditLog3, auditLog2},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp []map[string]any
+			s.Require().Nil(s.AdminClient().WithQuery(tt.query).WithResponse(&resp).DoRequest("/api/audit"))
+			s.Require().Equal(len(tt.expected), len(resp))
+			for i, auditLog := range tt.expected {
+				s.Equal(auditLog.ID.String(), resp[i]["id"])
+				s.Equal(auditLog.ActorType, resp[i]["actor_type"])
+				s.Equal(auditLog.Actor, resp[i]["actor"])
+				s.Equal(auditLog.NamespaceCode, resp[i]["namespace"])
+				s.Equal(auditLog.Method, resp[i]["method"])
+				s.Equal(auditLog.Action, resp[i]["action"])
+				s.Equal(auditLog.Path, resp[i]["path"])
+				s.Equal([]any{auditLog.TargetIDs[0]}, resp[i]["target_ids"])
+				s.Equal(float64(auditLog.StatusCode), resp[i]["status_code"])
+			}
+		})
+	}
+}
+
+func (s *GetAuditLogsTestSuite) Test_Error() {
+	tests := []struct {
+		name  string
+		query map[any]any
+		error *api.ErrorResponse
+	}{
+		{
+			name:  "IncorrectLimit",
+			query: map[any]any{"limit": 1001},
+			error: api.NewInvalidParameterValueError("limit is invalid -- must be between 0 and 1000"),
+		},
+		{
+			name:  "IncorrectFrom",
+			query: map[any]any{"from": "yesterday"},
+			error: api.NewInvalidParameterValueError("from is invalid -- must be RFC 3339 timestamp"),
+		},
+		{
+			name:  "IncorrectTo",
+			query: map[any]any{"to": "tomorrow"},
+			error: api.NewInvalidParameterValueError("to is invalid -- must be RFC 3339 timestamp"),
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp api.ErrorResponse
+			s.Require().Nil(s.AdminClient().WithQuery(tt.query).WithResponse(&resp).DoRequest("/api/audit"))
+			s.Equal(http.StatusBadRequest, resp.StatusCode)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
+
+func (s *GetAuditLogsTestSuite) Test_Page_Ok() {
+	_, err := s.AuditLogFixtures.CreateAuditLog(context.Background(), &models.AuditLog{
+		CreatedAt:     time.Now().UTC(),
+		ActorType:     models.AuditLogActorTypeBasic,
+		Actor:         "user1",
+		NamespaceCode: "default",
+		Method:        http.MethodPost,
+		Action:        "/api/2.0/mlflow/runs/delete",
+		Path:          "/api/2.0/mlflow/runs/delete",
+		TargetIDs:     []string{"run_id=run1"},
+		StatusCode:    http.StatusOK,
+	})
+	s.Require().Nil(err)
+
+	var resp goquery.Document
+	s.Require().Nil(
+		s.AdminClient().WithResponseType(
+			helpers.ResponseTypeHTML,
+		).WithResponse(
+			&resp,
+		).DoRequest("/audit/"),
+	)
+	rows := resp.Find("#audit-logs tbody tr")
+	s.Equal(1, rows.Length())
+	s.Contains(rows.Text(), "user1")
+	s.Contains(rows.Text(), "run_id=run1")
+}
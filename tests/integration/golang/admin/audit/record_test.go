@@ -0,0 +1,174 @@
+package audit
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/zeebo/assert"
+	"gopkg.in/yaml.v3"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	mlflowResponse "github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	mlflowModels "github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common"
+	"github.com/G-Research/fasttrackml/pkg/common/config"
+	"github.com/G-Research/fasttrackml/pkg/common/config/auth"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type RecordAuditLogTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestRecordAuditLogTestSuite(t *testing.T) {
+	data, err := yaml.Marshal(auth.YamlConfig{
+		Users: []auth.YamlUserConfig{
+			{
+				Name:     "user1",
+				Roles:    []string{"ns:namespace1"},
+				Password: "user1password",
+			},
+			{
+				Name:     "viewer1",
+				Roles:    []string{"ns:namespace1:viewer"},
+				Password: "viewer1password",
+			},
+		},
+	})
+	assert.Nil(t, err)
+
+	configPath := fmt.Sprintf("%s/users-config.yaml", t.TempDir())
+	assert.Nil(t, os.WriteFile(configPath, data, 0o600))
+
+	testSuite := new(RecordAuditLogTestSuite)
+	testSuite.Config = config.Config{
+		Auth: auth.Config{
+			AuthUsersConfig: configPath,
+		},
+		AuditLogEnabled: true,
+	}
+	assert.Nil(t, testSuite.Config.Validate())
+	suite.Run(t, testSuite)
+}
+
+func (s *RecordAuditLogTestSuite) Test_Ok() {
+	namespace1, err := s.NamespaceFixtures.CreateNamespace(context.Background(), &mlflowModels.Namespace{
+		ID:                  2,
+		Code:                "namespace1",
+		DefaultExperimentID: common.GetPointer(mlflowModels.DefaultExperimentID),
+	})
+	s.Require().Nil(err)
+	_, err = s.AccessTokenFixtures.CreateAccessToken(context.Background(), &models.AccessToken{
+		Name:  "ci-job",
+		Roles: []string{"ns:namespace1"},
+	}, "ftml_token")
+	s.Require().Nil(err)
+
+	basicHeaders := map[string]string{
+		"Authorization": fmt.Sprintf(
+			"Basic %s", base64.StdEncoding.EncodeToString([]byte("user1:user1password")),
+		),
+		"Content-Type": "application/json",
+	}
+
+	// create experiment by basic auth user.
+	createResponse := mlflowResponse.CreateExperimentResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithNamespace(
+			namespace1.Code,
+		).WithHeaders(
+			basicHeaders,
+		).WithRequest(
+			request.CreateExperimentRequest{Name: "experiment1"},
+		).WithResponse(
+			&createResponse,
+		).DoRequest("%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsCreateRoute),
+	)
+	s.Require().NotEmpty(createResponse.ID)
+
+	// read-only request is not recorded.
+	searchResponse := mlflowResponse.SearchExperimentsResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithNamespace(
+			namespace1.Code,
+		).WithHeaders(
+			basicHeaders,
+		).WithRequest(
+			map[string]any{},
+		).WithResponse(
+			&searchResponse,
+		).DoRequest("%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsSearchRoute),
+	)
+	s.Require().NotEmpty(searchResponse.Experiments)
+
+	// delete experiment by access token.
+	s.Require().Nil(
+		s.AIMClient().WithMethod(
+			http.MethodDelete,
+		).WithNamespace(
+			namespace1.Code,
+		).WithHeaders(map[string]string{
+			"Authorization": "Bearer ftml_token",
+		}).DoRequest("/experiments/%s", createResponse.ID),
+	)
+
+	// denied mutating request is recorded too.
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithNamespace(
+			namespace1.Code,
+		).WithHeaders(map[string]string{
+			"Authorization": fmt.Sprintf(
+				"Basic %s", base64.StdEncoding.EncodeToString([]byte("viewer1:viewer1password")),
+			),
+			"Content-Type": "application/json",
+		}).WithRequest(
+			request.CreateExperimentRequest{Name: "experiment2"},
+		).WithResponse(
+			&map[string]any{},
+		).DoRequest("%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsCreateRoute),
+	)
+
+	auditLogs, err := s.AuditLogFixtures.GetAuditLogs(context.Background())
+	s.Require().Nil(err)
+	s.Require().Equal(3, len(auditLogs))
+
+	s.Equal(models.AuditLogActorTypeBasic, auditLogs[0].ActorType)
+	s.Equal("user1", auditLogs[0].Actor)
+	s.Equal(namespace1.Code, auditLogs[0].NamespaceCode)
+	s.Equal(http.MethodPost, auditLogs[0].Method)
+	s.Equal("/api/2.0/mlflow/experiments/create", auditLogs[0].Action)
+	s.Equal("/api/2.0/mlflow/experiments/create", auditLogs[0].Path)
+	s.Equal([]string{"name=experiment1"}, []string(auditLogs[0].TargetIDs))
+	s.Equal(http.StatusOK, auditLogs[0].StatusCode)
+
+	s.Equal(models.AuditLogActorTypeAccessToken, auditLogs[1].ActorType)
+	s.Equal("ci-job", auditLogs[1].Actor)
+	s.Equal(namespace1.Code, auditLogs[1].NamespaceCode)
+	s.Equal(http.MethodDelete, auditLogs[1].Method)
+	s.Equal("/aim/api/experiments/:id/", auditLogs[1].Action)
+	s.Equal(fmt.Sprintf("/aim/api/experiments/%s", createResponse.ID), auditLogs[1].Path)
+	s.Equal([]string{fmt.Sprintf("id=%s", createResponse.ID)}, []string(auditLogs[1].TargetIDs))
+	s.Equal(http.StatusOK, auditLogs[1].StatusCode)
+
+	s.Equal(models.AuditLogActorTypeBasic, auditLogs[2].ActorType)
+	s.Equal("viewer1", auditLogs[2].Actor)
+	s.Equal(namespace1.Code, auditLogs[2].NamespaceCode)
+	s.Equal(http.MethodPost, auditLogs[2].Method)
+	s.Equal("/api/2.0/mlflow/experiments/create", auditLogs[2].Action)
+	s.Equal("/api/2.0/mlflow/experiments/create", auditLogs[2].Path)
+	s.Equal([]string{"name=experiment2"}, []string(auditLogs[2].TargetIDs))
+	s.Equal(http.StatusForbidden, auditLogs[2].StatusCode)
+}
@@ -0,0 +1,87 @@
+package role
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/ui/admin/request"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type CreateRoleTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestCreateRoleTestSuite(t *testing.T) {
+	suite.Run(t, new(CreateRoleTestSuite))
+}
+
+func (s *CreateRoleTestSuite) Test_Ok() {
+	var resp goquery.Document
+	s.Require().Nil(
+		s.AdminClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.Role{Name: "data-scientists"},
+		).WithResponseType(
+			helpers.ResponseTypeHTML,
+		).WithResponse(
+			&resp,
+		).DoRequest("/roles"),
+	)
+	s.Equal("Successfully added new role", resp.Find(".success-message").Text())
+	s.Equal("data-scientists", strings.TrimSpace(resp.Find("#roles tbody td").First().Text()))
+
+	role, err := s.RolesFixtures.GetRoleByName(context.Background(), "data-scientists")
+	s.Require().Nil(err)
+	s.Equal("data-scientists", role.Name)
+}
+
+func (s *CreateRoleTestSuite) Test_Error() {
+	s.Require().Nil(s.RolesFixtures.CreateRole(context.Background(), &models.Role{Name: "existing"}))
+
+	testData := []struct {
+		name    string
+		request *request.Role
+		error   string
+	}{
+		{
+			name:    "EmptyName",
+			request: &request.Role{Name: ""},
+			error:   "The role name is invalid.",
+		},
+		{
+			name:    "TooLongName",
+			request: &request.Role{Name: strings.Repeat("a", 257)},
+			error:   "The role name is invalid.",
+		},
+		{
+			name:    "NameAlreadyExists",
+			request: &request.Role{Name: "existing"},
+			error:   "The role name is already in use.",
+		},
+	}
+	for _, tt := range testData {
+		s.Run(tt.name, func() {
+			var resp goquery.Document
+			s.Require().Nil(
+				s.AdminClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponseType(
+					helpers.ResponseTypeHTML,
+				).WithResponse(
+					&resp,
+				).DoRequest("/roles"),
+			)
+			s.Equal(tt.error, resp.Find(".error-message").Text())
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package role
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type DeleteRoleTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestDeleteRoleTestSuite(t *testing.T) {
+	suite.Run(t, new(DeleteRoleTestSuite))
+}
+
+func (s *DeleteRoleTestSuite) Test_Ok() {
+	namespace, err := s.NamespaceFixtures.CreateNamespace(context.Background(), &models.Namespace{
+		ID:                  2,
+		Code:                "test2",
+		DefaultExperimentID: common.GetPointer(models.DefaultExperimentID),
+	})
+	s.Require().Nil(err)
+	role := models.Role{Name: "group1"}
+	s.Require().Nil(s.RolesFixtures.CreateRole(context.Background(), &role))
+	s.Require().Nil(s.RolesFixtures.AttachNamespaceToRole(context.Background(), &role, namespace))
+
+	resp := map[string]any{}
+	s.Require().Nil(
+		s.AdminClient().WithMethod(
+			http.MethodDelete,
+		).WithResponse(
+			&resp,
+		).DoRequest("/roles/%s", role.ID),
+	)
+	s.Equal(map[string]any{"status": "success", "message": "Successfully deleted role."}, resp)
+
+	_, err = s.RolesFixtures.GetRoleByName(context.Background(), role.Name)
+	s.NotNil(err)
+	roleNamespaces, err := s.RolesFixtures.GetRoleNamespaces(context.Background(), &role)
+	s.Require().Nil(err)
+	s.Empty(roleNamespaces)
+}
+
+func (s *DeleteRoleTestSuite) Test_Error() {
+	id := uuid.New()
+	resp := map[string]any{}
+	s.Require().Nil(
+		s.AdminClient().WithMethod(
+			http.MethodDelete,
+		).WithResponse(
+			&resp,
+		).DoRequest("/roles/%s", id),
+	)
+	s.Equal(map[string]any{
+		"status":  "error",
+		"message": "An unexpected error was encountered: role not found by id: " + id.String(),
+	}, resp)
+}
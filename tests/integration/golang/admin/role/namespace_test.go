@@ -0,0 +1,125 @@
+package role
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	commonModels "github.com/G-Research/fasttrackml/pkg/common/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/ui/admin/request"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type RoleNamespaceTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestRoleNamespaceTestSuite(t *testing.T) {
+	suite.Run(t, new(RoleNamespaceTestSuite))
+}
+
+func (s *RoleNamespaceTestSuite) Test_Ok() {
+	namespace, err := s.NamespaceFixtures.CreateNamespace(context.Background(), &models.Namespace{
+		ID:                  2,
+		Code:                "test2",
+		DefaultExperimentID: common.GetPointer(models.DefaultExperimentID),
+	})
+	s.Require().Nil(err)
+	role := models.Role{Name: "group1"}
+	s.Require().Nil(s.RolesFixtures.CreateRole(context.Background(), &role))
+
+	// grant `viewer` level firstly and then raise it to `editor`.
+	for _, level := range []commonModels.PermissionLevel{
+		commonModels.PermissionLevelViewer, commonModels.PermissionLevelEditor,
+	} {
+		resp := map[string]any{}
+		s.Require().Nil(
+			s.AdminClient().WithMethod(
+				http.MethodPost,
+			).WithRequest(
+				request.RoleNamespace{NamespaceID: namespace.ID, Level: level},
+			).WithResponse(
+				&resp,
+			).DoRequest("/roles/%s/namespaces", role.ID),
+		)
+		s.Equal(map[string]any{"status": "success", "message": "Successfully saved role namespace."}, resp)
+
+		roleNamespaces, err := s.RolesFixtures.GetRoleNamespaces(context.Background(), &role)
+		s.Require().Nil(err)
+		s.Require().Len(roleNamespaces, 1)
+		s.Equal(namespace.ID, roleNamespaces[0].NamespaceID)
+		s.Equal(level, roleNamespaces[0].Level)
+	}
+
+	// revoke access completely.
+	resp := map[string]any{}
+	s.Require().Nil(
+		s.AdminClient().WithMethod(
+			http.MethodDelete,
+		).WithResponse(
+			&resp,
+		).DoRequest("/roles/%s/namespaces/%d", role.ID, namespace.ID),
+	)
+	s.Equal(map[string]any{"status": "success", "message": "Successfully deleted role namespace."}, resp)
+
+	roleNamespaces, err := s.RolesFixtures.GetRoleNamespaces(context.Background(), &role)
+	s.Require().Nil(err)
+	s.Empty(roleNamespaces)
+}
+
+func (s *RoleNamespaceTestSuite) Test_Error() {
+	namespace, err := s.NamespaceFixtures.CreateNamespace(context.Background(), &models.Namespace{
+		ID:                  2,
+		Code:                "test2",
+		DefaultExperimentID: common.GetPointer(models.DefaultExperimentID),
+	})
+	s.Require().Nil(err)
+	role := models.Role{Name: "group1"}
+	s.Require().Nil(s.RolesFixtures.CreateRole(context.Background(), &role))
+
+	testData := []struct {
+		name     string
+		request  request.RoleNamespace
+		response map[string]any
+	}{
+		{
+			name:    "InvalidLevel",
+			request: request.RoleNamespace{NamespaceID: namespace.ID, Level: "reader"},
+			response: map[string]any{
+				"status":  "error",
+				"message": "The permission level is invalid.",
+			},
+		},
+		{
+			name:    "NotFoundNamespace",
+			request: request.RoleNamespace{NamespaceID: 10, Level: commonModels.PermissionLevelViewer},
+			response: map[string]any{
+				"status":  "error",
+				"message": "An unexpected error was encountered: namespace not found by id: 10",
+			},
+		},
+	}
+	for _, tt := range testData {
+		s.Run(tt.name, func() {
+			resp := map[string]any{}
+			s.Require().Nil(
+				s.AdminClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest("/roles/%s/namespaces", role.ID),
+			)
+			s.Equal(tt.response, resp)
+
+			roleNamespaces, err := s.RolesFixtures.GetRoleNamespaces(context.Background(), &role)
+			s.Require().Nil(err)
+			s.Empty(roleNamespaces)
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package token
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/ui/admin/request"
+	"github.com/G-Research/fasttrackml/pkg/ui/admin/response"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type CreateAccessTokenTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestCreateAccessTokenTestSuite(t *testing.T) {
+	suite.Run(t, new(CreateAccessTokenTestSuite))
+}
+
+func (s *CreateAccessTokenTestSuite) Test_Ok() {
+	expiresAt := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+	tests := []struct {
+		name    string
+		request request.CreateAccessTokenRequest
+	}{
+		{
+			name: "CreateAccessTokenWithExpiration",
+			request: request.CreateAccessTokenRequest{
+				Name:      "ci-job",
+				Roles:     []string{"ns:default"},
+				ExpiresAt: &expiresAt,
+			},
+		},
+		{
+			name: "CreateAccessTokenWithoutExpiration",
+			request: request.CreateAccessTokenRequest{
+				Name:  "admin-script",
+				Roles: []string{"admin"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := response.CreateAccessTokenResponse{}
+			s.Require().Nil(
+				s.AdminClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest("/api/tokens"),
+			)
+			s.True(strings.HasPrefix(resp.Token, models.AccessTokenPrefix))
+			s.Equal(tt.request.Name, resp.Name)
+			s.Equal(tt.request.Roles, resp.Roles)
+			if tt.request.ExpiresAt != nil {
+				s.Require().NotNil(resp.ExpiresAt)
+				s.True(tt.request.ExpiresAt.Equal(*resp.ExpiresAt))
+			} else {
+				s.Nil(resp.ExpiresAt)
+			}
+			s.Nil(resp.LastUsedAt)
+
+			// only hash of the token has to be stored.
+			token, err := s.AccessTokenFixtures.GetAccessToken(context.Background(), resp.ID.String())
+			s.Require().Nil(err)
+			s.Equal(models.HashAccessToken(resp.Token), token.TokenHash)
+			s.NotEqual(resp.Token, token.TokenHash)
+		})
+	}
+}
+
+func (s *CreateAccessTokenTestSuite) Test_Error() {
+	expiresAt := time.Now().Add(-time.Hour)
+	tests := []struct {
+		name       string
+		request    request.CreateAccessTokenRequest
+		statusCode int
+		error      string
+	}{
+		{
+			name: "CreateAccessTokenWithEmptyName",
+			request: request.CreateAccessTokenRequest{
+				Roles: []string{"admin"},
+			},
+			statusCode: http.StatusBadRequest,
+			error:      "token name is invalid",
+		},
+		{
+			name: "CreateAccessTokenWithIncorrectRole",
+			request: request.CreateAccessTokenRequest{
+				Name:  "ci-job",
+				Roles: []string{"superuser"},
+			},
+			statusCode: http.StatusBadRequest,
+			error:      "token role 'superuser' is invalid",
+		},
+		{
+			name: "CreateAccessTokenWithNotExistingNamespace",
+			request: request.CreateAccessTokenRequest{
+				Name:  "ci-job",
+				Roles: []string{"ns:unknown"},
+			},
+			statusCode: http.StatusNotFound,
+			error:      "namespace with code 'unknown' not found",
+		},
+		{
+			name: "CreateAccessTokenWithExpirationInThePast",
+			request: request.CreateAccessTokenRequest{
+				Name:      "ci-job",
+				Roles:     []string{"admin"},
+				ExpiresAt: &expiresAt,
+			},
+			statusCode: http.StatusBadRequest,
+			error:      "token expiration time is invalid",
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.AdminClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest("/api/tokens"),
+			)
+			s.Equal(tt.statusCode, resp.StatusCode)
+			s.Contains(resp.Message, tt.error)
+
+			tokens, err := s.AccessTokenFixtures.GetAccessTokens(context.Background())
+			s.Require().Nil(err)
+			s.Empty(tokens)
+		})
+	}
+}
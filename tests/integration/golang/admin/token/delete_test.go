@@ -0,0 +1,90 @@
+package token
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type DeleteAccessTokenTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestDeleteAccessTokenTestSuite(t *testing.T) {
+	suite.Run(t, new(DeleteAccessTokenTestSuite))
+}
+
+func (s *DeleteAccessTokenTestSuite) Test_Ok() {
+	token, err := s.AccessTokenFixtures.CreateAccessToken(context.Background(), &models.AccessToken{
+		Name:  "ci-job",
+		Roles: []string{"ns:default"},
+	}, "ftml_token")
+	s.Require().Nil(err)
+
+	resp := map[string]any{}
+	s.Require().Nil(
+		s.AdminClient().WithMethod(
+			http.MethodDelete,
+		).WithResponse(
+			&resp,
+		).DoRequest("/api/tokens/%s", token.ID),
+	)
+	s.Equal("success", resp["status"])
+
+	tokens, err := s.AccessTokenFixtures.GetAccessTokens(context.Background())
+	s.Require().Nil(err)
+	s.Empty(tokens)
+}
+
+func (s *DeleteAccessTokenTestSuite) Test_Error() {
+	_, err := s.AccessTokenFixtures.CreateAccessToken(context.Background(), &models.AccessToken{
+		Name:  "ci-job",
+		Roles: []string{"ns:default"},
+	}, "ftml_token")
+	s.Require().Nil(err)
+
+	tests := []struct {
+		name       string
+		id         string
+		statusCode int
+		error      string
+	}{
+		{
+			name:       "DeleteAccessTokenWithNotFoundID",
+			id:         uuid.New().String(),
+			statusCode: http.StatusNotFound,
+			error:      "not found",
+		},
+		{
+			name:       "DeleteAccessTokenWithIncorrectID",
+			id:         "incorrect",
+			statusCode: http.StatusBadRequest,
+			error:      "unable to parse id",
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.AdminClient().WithMethod(
+					http.MethodDelete,
+				).WithResponse(
+					&resp,
+				).DoRequest("/api/tokens/%s", tt.id),
+			)
+			s.Equal(tt.statusCode, resp.StatusCode)
+			s.Contains(resp.Message, tt.error)
+
+			tokens, err := s.AccessTokenFixtures.GetAccessTokens(context.Background())
+			s.Require().Nil(err)
+			s.Equal(1, len(tokens))
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package token
+
+import (
+	"context"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/common/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type GetAccessTokensTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestGetAccessTokensTestSuite(t *testing.T) {
+	suite.Run(t, new(GetAccessTokensTestSuite))
+}
+
+func (s *GetAccessTokensTestSuite) Test_Ok() {
+	token1, err := s.AccessTokenFixtures.CreateAccessToken(context.Background(), &models.AccessToken{
+		Name:  "token1",
+		Roles: []string{"ns:default"},
+	}, "ftml_token1")
+	s.Require().Nil(err)
+	token2, err := s.AccessTokenFixtures.CreateAccessToken(context.Background(), &models.AccessToken{
+		Name:  "token2",
+		Roles: []string{"admin"},
+	}, "ftml_token2")
+	s.Require().Nil(err)
+
+	var resp []map[string]any
+	s.Require().Nil(s.AdminClient().WithResponse(&resp).DoRequest("/api/tokens"))
+	s.Require().Equal(2, len(resp))
+	for i, token := range []*models.AccessToken{token1, token2} {
+		s.Equal(token.ID.String(), resp[i]["id"])
+		s.Equal(token.Name, resp[i]["name"])
+		s.Equal([]any{token.Roles[0]}, resp[i]["roles"])
+		// neither raw token nor its hash are exposed.
+		s.NotContains(resp[i], "token")
+		s.NotContains(resp[i], "token_hash")
+	}
+
+}
+
+func (s *GetAccessTokensTestSuite) Test_Page_Ok() {
+	_, err := s.AccessTokenFixtures.CreateAccessToken(context.Background(), &models.AccessToken{
+		Name:  "ci-job",
+		Roles: []string{"ns:default"},
+	}, "ftml_token")
+	s.Require().Nil(err)
+
+	var resp goquery.Document
+	s.Require().Nil(
+		s.AdminClient().WithResponseType(
+			helpers.ResponseTypeHTML,
+		).WithResponse(
+			&resp,
+		).DoRequest("/tokens/"),
+	)
+	rows := resp.Find("#tokens tbody tr")
+	s.Equal(1, rows.Length())
+	s.Equal("ci-job", rows.Find("td").First().Text())
+	s.Equal("ns:default", rows.Find("code").Text())
+}
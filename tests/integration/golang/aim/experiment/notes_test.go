@@ -0,0 +1,180 @@
+package experiment
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/api/response"
+	aimModels "github.com/G-Research/fasttrackml/pkg/api/aim/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type ExperimentNotesTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestExperimentNotesTestSuite(t *testing.T) {
+	suite.Run(t, new(ExperimentNotesTestSuite))
+}
+
+func (s *ExperimentNotesTestSuite) Test_Ok() {
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           uuid.New().String(),
+		NamespaceID:    s.DefaultNamespace.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	// create new note.
+	var createResp response.NoteResponse
+	s.Require().Nil(
+		s.AIMClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			map[string]any{"content": "# experiment note"},
+		).WithResponse(
+			&createResp,
+		).DoRequest(
+			"/experiments/%d/note", *experiment.ID,
+		),
+	)
+	s.Equal("# experiment note", createResp.Content)
+
+	note, err := s.NoteFixtures.GetNote(context.Background(), createResp.ID)
+	s.Require().Nil(err)
+	s.Equal(*experiment.ID, *note.ExperimentID)
+	s.Nil(note.RunID)
+	s.Equal(s.DefaultNamespace.ID, note.NamespaceID)
+
+	// get the list of experiment notes. notes of other experiments should not be there.
+	_, err = s.NoteFixtures.CreateNote(context.Background(), &aimModels.Note{
+		Content:      "default experiment note",
+		ExperimentID: s.DefaultExperiment.ID,
+		NamespaceID:  s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+
+	var listResp response.GetNotesResponse
+	s.Require().Nil(
+		s.AIMClient().WithResponse(
+			&listResp,
+		).DoRequest(
+			"/experiments/%d/note", *experiment.ID,
+		),
+	)
+	s.Require().Len(listResp, 1)
+	s.Equal(createResp.ID, listResp[0].ID)
+
+	// update the note.
+	var updateResp response.NoteResponse
+	s.Require().Nil(
+		s.AIMClient().WithMethod(
+			http.MethodPut,
+		).WithRequest(
+			map[string]any{"content": "# updated experiment note"},
+		).WithResponse(
+			&updateResp,
+		).DoRequest(
+			"/experiments/%d/note/%s", *experiment.ID, createResp.ID,
+		),
+	)
+	s.Equal("# updated experiment note", updateResp.Content)
+
+	var getResp response.NoteResponse
+	s.Require().Nil(
+		s.AIMClient().WithResponse(
+			&getResp,
+		).DoRequest(
+			"/experiments/%d/note/%s", *experiment.ID, createResp.ID,
+		),
+	)
+	s.Equal("# updated experiment note", getResp.Content)
+
+	// delete the note.
+	var deleteResp response.DeleteNoteResponse
+	s.Require().Nil(
+		s.AIMClient().WithMethod(
+			http.MethodDelete,
+		).WithResponse(
+			&deleteResp,
+		).DoRequest(
+			"/experiments/%d/note/%s", *experiment.ID, createResp.ID,
+		),
+	)
+	s.Equal("OK", deleteResp.Status)
+
+	_, err = s.NoteFixtures.GetNote(context.Background(), createResp.ID)
+	s.NotNil(err)
+}
+
+func (s *ExperimentNotesTestSuite) Test_Error() {
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           uuid.New().String(),
+		NamespaceID:    s.DefaultNamespace.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	anotherExperimentNote, err := s.NoteFixtures.CreateNote(context.Background(), &aimModels.Note{
+		Content:      "default experiment note",
+		ExperimentID: s.DefaultExperiment.ID,
+		NamespaceID:  s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+	}{
+		{
+			name:   "GetNotesOfNotFoundExperiment",
+			method: http.MethodGet,
+			path:   "/experiments/123456/note",
+		},
+		{
+			name:   "CreateNoteOfNotFoundExperiment",
+			method: http.MethodPost,
+			path:   "/experiments/123456/note",
+		},
+		{
+			name:   "GetNotFoundNote",
+			method: http.MethodGet,
+			path:   fmt.Sprintf("/experiments/%d/note/%s", *experiment.ID, uuid.New()),
+		},
+		{
+			name:   "UpdateNoteOfAnotherExperiment",
+			method: http.MethodPut,
+			path:   fmt.Sprintf("/experiments/%d/note/%s", *experiment.ID, anotherExperimentNote.ID),
+		},
+		{
+			name:   "DeleteNoteOfAnotherExperiment",
+			method: http.MethodDelete,
+			path:   fmt.Sprintf("/experiments/%d/note/%s", *experiment.ID, anotherExperimentNote.ID),
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp api.ErrorResponse
+			s.Require().Nil(
+				s.AIMClient().WithMethod(
+					tt.method,
+				).WithRequest(
+					map[string]any{"content": "note"},
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					tt.path,
+				),
+			)
+			s.Contains(resp.Message, "Not Found")
+		})
+	}
+}
@@ -0,0 +1,305 @@
+package run
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type SearchAggregatedMetricsTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestSearchAggregatedMetricsTestSuite(t *testing.T) {
+	suite.Run(t, new(SearchAggregatedMetricsTestSuite))
+}
+
+func (s *SearchAggregatedMetricsTestSuite) Test_Ok() {
+	// create test experiments.
+	experiment1, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           uuid.New().String(),
+		LifecycleStage: models.LifecycleStageActive,
+		NamespaceID:    s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+	experiment2, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           uuid.New().String(),
+		LifecycleStage: models.LifecycleStageActive,
+		NamespaceID:    s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+
+	// create test runs, which log `loss` metric on the same steps, with params and tags to group by.
+	for _, tt := range []struct {
+		id         string
+		experiment *models.Experiment
+		optimizer  string
+		team       string
+		values     []float64
+	}{
+		{id: "id1", experiment: experiment1, optimizer: "adam", team: "a", values: []float64{1, 2, 3}},
+		{id: "id2", experiment: experiment1, optimizer: "adam", team: "b", values: []float64{3, 4, 5}},
+		{id: "id3", experiment: experiment2, optimizer: "sgd", team: "b", values: []float64{5, 6, 7}},
+	} {
+		run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+			ID:             tt.id,
+			Name:           tt.id,
+			Status:         models.StatusFinished,
+			SourceType:     "JOB",
+			ExperimentID:   *tt.experiment.ID,
+			ArtifactURI:    "artifact_uri",
+			LifecycleStage: models.LifecycleStageActive,
+		})
+		s.Require().Nil(err)
+		_, err = s.ParamFixtures.CreateParam(context.Background(), &models.Param{
+			Key:      "optimizer",
+			ValueStr: common.GetPointer(tt.optimizer),
+			RunID:    run.ID,
+		})
+		s.Require().Nil(err)
+		_, err = s.TagFixtures.CreateTag(context.Background(), &models.Tag{
+			Key:   "team",
+			Value: tt.team,
+			RunID: run.ID,
+		})
+		s.Require().Nil(err)
+		for i, value := range tt.values {
+			_, err = s.MetricFixtures.CreateMetric(context.Background(), &models.Metric{
+				Key:       "loss",
+				Value:     value,
+				Timestamp: 123456789,
+				Step:      int64(i * 10),
+				RunID:     run.ID,
+				Iter:      int64(i),
+				Context: models.Context{
+					Json: []byte(`{"subset":"train"}`),
+				},
+			})
+			s.Require().Nil(err)
+		}
+		_, err = s.MetricFixtures.CreateLatestMetric(context.Background(), &models.LatestMetric{
+			Key:       "loss",
+			Value:     tt.values[len(tt.values)-1],
+			Timestamp: 123456789,
+			Step:      int64((len(tt.values) - 1) * 10),
+			RunID:     run.ID,
+			LastIter:  int64(len(tt.values) - 1),
+			Context: models.Context{
+				Json: []byte(`{"subset":"train"}`),
+			},
+		})
+		s.Require().Nil(err)
+	}
+
+	metrics := []request.MetricTuple{
+		{
+			Key:     "loss",
+			Context: fiber.Map{"subset": "train"},
+		},
+	}
+	tests := []struct {
+		name     string
+		request  request.SearchAggregatedMetricsRequest
+		response response.SearchAggregatedMetricsResponse
+	}{
+		{
+			name: "AggregateAllRuns",
+			request: request.SearchAggregatedMetricsRequest{
+				SearchMetricsRequest: request.SearchMetricsRequest{
+					Metrics: metrics,
+				},
+			},
+			response: response.SearchAggregatedMetricsResponse{
+				{
+					Group:   map[string]*string{},
+					Name:    "loss",
+					Context: fiber.Map{"subset": "train"},
+					Values: response.SearchAggregatedMetricsValuesPartial{
+						X:      []float64{0, 10, 20},
+						Mean:   []float64{3, 4, 5},
+						Std:    []float64{math.Sqrt(8.0 / 3), math.Sqrt(8.0 / 3), math.Sqrt(8.0 / 3)},
+						Min:    []float64{1, 2, 3},
+						Max:    []float64{5, 6, 7},
+						Median: []float64{3, 4, 5},
+						Count:  []int64{3, 3, 3},
+					},
+				},
+			},
+		},
+		{
+			name: "AggregateByParam",
+			request: request.SearchAggregatedMetricsRequest{
+				SearchMetricsRequest: request.SearchMetricsRequest{
+					Metrics: metrics,
+				},
+				GroupBy: []string{"run.params.optimizer"},
+			},
+			response: response.SearchAggregatedMetricsResponse{
+				{
+					Group:   map[string]*string{"params.optimizer": common.GetPointer("adam")},
+					Name:    "loss",
+					Context: fiber.Map{"subset": "train"},
+					Values: response.SearchAggregatedMetricsValuesPartial{
+						X:      []float64{0, 10, 20},
+						Mean:   []float64{2, 3, 4},
+						Std:    []float64{1, 1, 1},
+						Min:    []float64{1, 2, 3},
+						Max:    []float64{3, 4, 5},
+						Median: []float64{2, 3, 4},
+						Count:  []int64{2, 2, 2},
+					},
+				},
+				{
+					Group:   map[string]*string{"params.optimizer": common.GetPointer("sgd")},
+					Name:    "loss",
+					Context: fiber.Map{"subset": "train"},
+					Values: response.SearchAggregatedMetricsValuesPartial{
+						X:      []float64{0, 10, 20},
+						Mean:   []float64{5, 6, 7},
+						Std:    []float64{0, 0, 0},
+						Min:    []float64{5, 6, 7},
+						Max:    []float64{5, 6, 7},
+						Median: []float64{5, 6, 7},
+						Count:  []int64{1, 1, 1},
+					},
+				},
+			},
+		},
+		{
+			name: "AggregateByExperimentAndTagWithQuery",
+			request: request.SearchAggregatedMetricsRequest{
+				SearchMetricsRequest: request.SearchMetricsRequest{
+					Metrics: metrics,
+					Query:   `run.optimizer == "adam"`,
+					Steps:   2,
+				},
+				GroupBy: []string{"experiment", "tags.team"},
+			},
+			response: response.SearchAggregatedMetricsResponse{
+				{
+					Group: map[string]*string{
+						"experiment": common.GetPointer(experiment1.Name),
+						"tags.team":  common.GetPointer("a"),
+					},
+					Name:    "loss",
+					Context: fiber.Map{"subset": "train"},
+					Values: response.SearchAggregatedMetricsValuesPartial{
+						X:      []float64{0, 20},
+						Mean:   []float64{1, 3},
+						Std:    []float64{0, 0},
+						Min:    []float64{1, 3},
+						Max:    []float64{1, 3},
+						Median: []float64{1, 3},
+						Count:  []int64{1, 1},
+					},
+				},
+				{
+					Group: map[string]*string{
+						"experiment": common.GetPointer(experiment1.Name),
+						"tags.team":  common.GetPointer("b"),
+					},
+					Name:    "loss",
+					Context: fiber.Map{"subset": "train"},
+					Values: response.SearchAggregatedMetricsValuesPartial{
+						X:      []float64{0, 20},
+						Mean:   []float64{3, 5},
+						Std:    []float64{0, 0},
+						Min:    []float64{3, 5},
+						Max:    []float64{3, 5},
+						Median: []float64{3, 5},
+						Count:  []int64{1, 1},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp response.SearchAggregatedMetricsResponse
+			s.Require().Nil(
+				s.AIMClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"/runs/search/metric/aggregate",
+				),
+			)
+			s.Require().Equal(len(tt.response), len(resp))
+			for i, series := range tt.response {
+				s.Equal(series.Group, resp[i].Group)
+				s.Equal(series.Name, resp[i].Name)
+				s.Equal(series.Context, resp[i].Context)
+				s.Equal(series.Values.X, resp[i].Values.X)
+				s.Equal(series.Values.Mean, resp[i].Values.Mean)
+				s.InDeltaSlice(series.Values.Std, resp[i].Values.Std, 1e-9)
+				s.Equal(series.Values.Min, resp[i].Values.Min)
+				s.Equal(series.Values.Max, resp[i].Values.Max)
+				s.Equal(series.Values.Median, resp[i].Values.Median)
+				s.Equal(series.Values.Count, resp[i].Values.Count)
+			}
+		})
+	}
+}
+
+func (s *SearchAggregatedMetricsTestSuite) Test_Error() {
+	tests := []struct {
+		name    string
+		request request.SearchAggregatedMetricsRequest
+		error   *api.ErrorResponse
+	}{
+		{
+			name:    "NoMetricsSelected",
+			request: request.SearchAggregatedMetricsRequest{},
+			error: &api.ErrorResponse{
+				Message:    "No metrics are selected",
+				StatusCode: http.StatusBadRequest,
+			},
+		},
+		{
+			name: "InvalidGroupByField",
+			request: request.SearchAggregatedMetricsRequest{
+				SearchMetricsRequest: request.SearchMetricsRequest{
+					Metrics: []request.MetricTuple{{Key: "loss"}},
+				},
+				GroupBy: []string{"params."},
+			},
+			error: &api.ErrorResponse{
+				Message:    `"params." is not a valid group by field`,
+				StatusCode: http.StatusBadRequest,
+			},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp api.ErrorResponse
+			s.Require().Nil(
+				s.AIMClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"/runs/search/metric/aggregate",
+				),
+			)
+			s.Equal(tt.error.Message, resp.Message)
+			s.Equal(tt.error.StatusCode, resp.StatusCode)
+		})
+	}
+}
@@ -389,6 +389,22 @@ func (s *SearchMetricsTestSuite) Test_Ok() {
 				metric2Run3,
 			},
 		},
+		{
+			name: "SearchMetricNameAndMaxAggregate",
+			request: request.SearchMetricsRequest{
+				Metrics: []request.MetricTuple{
+					{
+						Key:     "TestMetric3",
+						Context: fiber.Map{},
+					},
+				},
+				Query: `metric.name == "TestMetric3" and metric.max > 3`,
+			},
+			metrics: []*models.LatestMetric{
+				metric3Run1,
+				metric3Run2,
+			},
+		},
 		{
 			name: "SearchRunActive",
 			request: request.SearchMetricsRequest{
@@ -10,6 +10,7 @@ import (
 	"github.com/G-Research/fasttrackml/pkg/api/aim/api/response"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 	"github.com/G-Research/fasttrackml/pkg/common"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/types"
 	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
 )
 
@@ -64,6 +65,44 @@ func (s *GetProjectParamsTestSuite) Test_Ok() {
 	})
 	s.Require().Nil(err)
 
+	// create typed and nested test params.
+	_, err = s.ParamFixtures.CreateParam(context.Background(), &models.Param{
+		Key:      "epochs",
+		ValueInt: common.GetPointer(int64(10)),
+		RunID:    run.ID,
+	})
+	s.Require().Nil(err)
+	_, err = s.ParamFixtures.CreateParam(context.Background(), &models.Param{
+		Key:       "hparams",
+		ValueJSON: types.JSONB(`{"layers":[1,2],"optim":{"lr":0.001,"name":"adam","steps":5},"shuffle":true}`),
+		RunID:     run.ID,
+	})
+	s.Require().Nil(err)
+	typedParams := map[string]interface{}{
+		"epochs": map[string]interface{}{
+			"__example_type__": "<class 'int'>",
+		},
+		"hparams": map[string]interface{}{
+			"layers": map[string]interface{}{
+				"__example_type__": "<class 'list'>",
+			},
+			"optim": map[string]interface{}{
+				"lr": map[string]interface{}{
+					"__example_type__": "<class 'float'>",
+				},
+				"name": map[string]interface{}{
+					"__example_type__": "<class 'str'>",
+				},
+				"steps": map[string]interface{}{
+					"__example_type__": "<class 'int'>",
+				},
+			},
+			"shuffle": map[string]interface{}{
+				"__example_type__": "<class 'bool'>",
+			},
+		},
+	}
+
 	tests := []struct {
 		name     string
 		request  map[any]any
@@ -84,6 +123,8 @@ func (s *GetProjectParamsTestSuite) Test_Ok() {
 					param.Key: map[string]interface{}{
 						"__example_type__": "<class 'str'>",
 					},
+					"epochs":  typedParams["epochs"],
+					"hparams": typedParams["hparams"],
 					"tags": map[string]interface{}{
 						tag.Key: map[string]interface{}{
 							"__example_type__": "<class 'str'>",
@@ -109,6 +150,8 @@ func (s *GetProjectParamsTestSuite) Test_Ok() {
 					param.Key: map[string]interface{}{
 						"__example_type__": "<class 'str'>",
 					},
+					"epochs":  typedParams["epochs"],
+					"hparams": typedParams["hparams"],
 					"tags": map[string]interface{}{
 						tag.Key: map[string]interface{}{
 							"__example_type__": "<class 'str'>",
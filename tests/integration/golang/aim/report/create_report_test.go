@@ -0,0 +1,103 @@
+package report
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/api/response"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type CreateReportTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestCreateReportTestSuite(t *testing.T) {
+	suite.Run(t, new(CreateReportTestSuite))
+}
+
+func (s *CreateReportTestSuite) Test_Ok() {
+	tests := []struct {
+		name        string
+		requestBody request.CreateReportRequest
+	}{
+		{
+			name: "CreateValidReport",
+			requestBody: request.CreateReportRequest{
+				Name:        "report-name",
+				Code:        "# report code",
+				Description: "report description",
+			},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp response.Report
+			s.Require().Nil(
+				s.AIMClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.requestBody,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"/reports",
+				),
+			)
+			s.Equal(tt.requestBody.Name, resp.Name)
+			s.Equal(tt.requestBody.Code, resp.Code)
+			s.Equal(tt.requestBody.Description, resp.Description)
+			s.NotEmpty(resp.ID)
+			s.NotEmpty(resp.CreatedAt)
+			s.NotEmpty(resp.UpdatedAt)
+
+			report, err := s.ReportFixtures.GetReport(context.Background(), resp.ID)
+			s.Require().Nil(err)
+			s.Equal(s.DefaultNamespace.ID, report.NamespaceID)
+			s.Equal(tt.requestBody.Name, report.Name)
+		})
+	}
+}
+
+func (s *CreateReportTestSuite) Test_Error() {
+	tests := []struct {
+		name        string
+		requestBody any
+		error       string
+	}{
+		{
+			name: "CreateReportWithIncorrectJson",
+			requestBody: map[string]any{
+				"Name": 1,
+			},
+			error: "cannot unmarshal",
+		},
+		{
+			name: "CreateReportWithEmptyName",
+			requestBody: map[string]any{
+				"Name": "",
+			},
+			error: "is not a valid report name",
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp api.ErrorResponse
+			s.Require().Nil(
+				s.AIMClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.requestBody,
+				).WithResponse(
+					&resp,
+				).DoRequest("/reports"),
+			)
+			s.Contains(resp.Message, tt.error)
+		})
+	}
+}
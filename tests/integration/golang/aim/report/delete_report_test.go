@@ -0,0 +1,85 @@
+package report
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/database"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type DeleteReportTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestDeleteReportTestSuite(t *testing.T) {
+	suite.Run(t, new(DeleteReportTestSuite))
+}
+
+func (s *DeleteReportTestSuite) Test_Ok() {
+	report, err := s.ReportFixtures.CreateReport(context.Background(), &database.Report{
+		Name:        "report-name",
+		NamespaceID: s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+
+	s.Require().Nil(
+		s.AIMClient().WithMethod(
+			http.MethodDelete,
+		).DoRequest(
+			"/reports/%s", report.ID,
+		),
+	)
+	reports, err := s.ReportFixtures.GetReports(context.Background())
+	s.Require().Nil(err)
+	s.Equal(0, len(reports))
+
+	archivedReport, err := s.ReportFixtures.GetReport(context.Background(), report.ID)
+	s.Require().Nil(err)
+	s.True(archivedReport.IsArchived)
+}
+
+func (s *DeleteReportTestSuite) Test_Error() {
+	_, err := s.ReportFixtures.CreateReport(context.Background(), &database.Report{
+		Name:        "report-name",
+		NamespaceID: s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+
+	tests := []struct {
+		name                string
+		idParam             uuid.UUID
+		expectedReportCount int
+	}{
+		{
+			name:                "DeleteReportWithNotFoundID",
+			idParam:             uuid.New(),
+			expectedReportCount: 1,
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp api.ErrorResponse
+			s.Require().Nil(
+				s.AIMClient().WithMethod(
+					http.MethodDelete,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"/reports/%s", tt.idParam,
+				),
+			)
+			s.Contains(strings.ToLower(resp.Message), "not found")
+
+			reports, err := s.ReportFixtures.GetReports(context.Background())
+			s.Require().Nil(err)
+			s.Equal(tt.expectedReportCount, len(reports))
+		})
+	}
+}
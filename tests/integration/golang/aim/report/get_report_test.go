@@ -0,0 +1,72 @@
+package report
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/api/response"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/database"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type GetReportTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestGetReportTestSuite(t *testing.T) {
+	suite.Run(t, new(GetReportTestSuite))
+}
+
+func (s *GetReportTestSuite) Test_Ok() {
+	report, err := s.ReportFixtures.CreateReport(context.Background(), &database.Report{
+		Name:        "report-name",
+		Code:        "# report code",
+		Description: "report description",
+		NamespaceID: s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+
+	var resp response.Report
+	s.Require().Nil(s.AIMClient().WithResponse(&resp).DoRequest("/reports/%s", report.ID))
+	s.Equal(report.ID, resp.ID)
+	s.Equal(report.Name, resp.Name)
+	s.Equal(report.Code, resp.Code)
+	s.Equal(report.Description, resp.Description)
+	s.NotEmpty(resp.CreatedAt)
+	s.NotEmpty(resp.UpdatedAt)
+}
+
+func (s *GetReportTestSuite) Test_Error() {
+	archivedReport, err := s.ReportFixtures.CreateReport(context.Background(), &database.Report{
+		Name:        "archived-report",
+		NamespaceID: s.DefaultNamespace.ID,
+		IsArchived:  true,
+	})
+	s.Require().Nil(err)
+
+	tests := []struct {
+		name    string
+		idParam uuid.UUID
+	}{
+		{
+			name:    "GetReportWithNotFoundID",
+			idParam: uuid.New(),
+		},
+		{
+			name:    "GetArchivedReport",
+			idParam: archivedReport.ID,
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp api.ErrorResponse
+			s.Require().Nil(s.AIMClient().WithResponse(&resp).DoRequest("/reports/%s", tt.idParam))
+			s.Contains(strings.ToLower(resp.Message), "not found")
+		})
+	}
+}
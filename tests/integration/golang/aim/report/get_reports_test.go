@@ -0,0 +1,68 @@
+package report
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/api/response"
+	"github.com/G-Research/fasttrackml/pkg/database"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type GetReportsTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestGetReportsTestSuite(t *testing.T) {
+	suite.Run(t, &GetReportsTestSuite{
+		helpers.BaseTestSuite{
+			ResetOnSubTest: true,
+		},
+	})
+}
+
+func (s *GetReportsTestSuite) Test_Ok() {
+	tests := []struct {
+		name                string
+		expectedReportCount int
+	}{
+		{
+			name:                "GetReportsWithExistingRows",
+			expectedReportCount: 2,
+		},
+		{
+			name:                "GetReportsWithNoRows",
+			expectedReportCount: 0,
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			for idx := 0; idx < tt.expectedReportCount; idx++ {
+				_, err := s.ReportFixtures.CreateReport(context.Background(), &database.Report{
+					Name:        fmt.Sprintf("report-%d", idx),
+					NamespaceID: s.DefaultNamespace.ID,
+				})
+				s.Require().Nil(err)
+			}
+			_, err := s.ReportFixtures.CreateReport(context.Background(), &database.Report{
+				Name:        "archived-report",
+				NamespaceID: s.DefaultNamespace.ID,
+				IsArchived:  true,
+			})
+			s.Require().Nil(err)
+
+			var resp []response.Report
+			s.Require().Nil(s.AIMClient().WithResponse(&resp).DoRequest("/reports"))
+			s.Equal(tt.expectedReportCount, len(resp))
+			for _, report := range resp {
+				s.NotEqual("archived-report", report.Name)
+				s.NotEmpty(report.ID)
+				s.NotEmpty(report.CreatedAt)
+				s.NotEmpty(report.UpdatedAt)
+			}
+		})
+	}
+}
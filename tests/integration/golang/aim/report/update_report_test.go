@@ -0,0 +1,143 @@
+package report
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/api/response"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/database"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type UpdateReportTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestUpdateReportTestSuite(t *testing.T) {
+	suite.Run(t, new(UpdateReportTestSuite))
+}
+
+func (s *UpdateReportTestSuite) Test_Ok() {
+	report, err := s.ReportFixtures.CreateReport(context.Background(), &database.Report{
+		Name:        "report-name",
+		Code:        "# report code",
+		Description: "report description",
+		NamespaceID: s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+
+	tests := []struct {
+		name                string
+		requestBody         map[string]any
+		expectedName        string
+		expectedCode        string
+		expectedDescription string
+	}{
+		{
+			name: "UpdateReportCode",
+			requestBody: map[string]any{
+				"Code": "# new report code",
+			},
+			expectedName:        "report-name",
+			expectedCode:        "# new report code",
+			expectedDescription: "report description",
+		},
+		{
+			name: "UpdateReportNameAndDescription",
+			requestBody: map[string]any{
+				"Name":        "new-report-name",
+				"Description": "new report description",
+			},
+			expectedName:        "new-report-name",
+			expectedCode:        "# new report code",
+			expectedDescription: "new report description",
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp response.Report
+			s.Require().Nil(
+				s.AIMClient().WithMethod(
+					http.MethodPut,
+				).WithRequest(
+					tt.requestBody,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"/reports/%s", report.ID,
+				),
+			)
+			s.Equal(report.ID, resp.ID)
+			s.Equal(tt.expectedName, resp.Name)
+			s.Equal(tt.expectedCode, resp.Code)
+			s.Equal(tt.expectedDescription, resp.Description)
+
+			updatedReport, err := s.ReportFixtures.GetReport(context.Background(), report.ID)
+			s.Require().Nil(err)
+			s.Equal(tt.expectedName, updatedReport.Name)
+			s.Equal(tt.expectedCode, updatedReport.Code)
+			s.Equal(tt.expectedDescription, updatedReport.Description)
+		})
+	}
+}
+
+func (s *UpdateReportTestSuite) Test_Error() {
+	report, err := s.ReportFixtures.CreateReport(context.Background(), &database.Report{
+		Name:        "report-name",
+		NamespaceID: s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+
+	tests := []struct {
+		name        string
+		ID          uuid.UUID
+		requestBody any
+		error       string
+	}{
+		{
+			name: "UpdateReportWithIncorrectCode",
+			ID:   report.ID,
+			requestBody: map[string]any{
+				"Code": 1,
+			},
+			error: "cannot unmarshal",
+		},
+		{
+			name: "UpdateReportWithEmptyName",
+			ID:   report.ID,
+			requestBody: map[string]any{
+				"Name": "",
+			},
+			error: "is not a valid report name",
+		},
+		{
+			name:        "UpdateReportWithUnknownID",
+			ID:          uuid.New(),
+			requestBody: map[string]any{},
+			error:       "not found",
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp api.ErrorResponse
+			s.Require().Nil(
+				s.AIMClient().WithMethod(
+					http.MethodPut,
+				).WithRequest(
+					tt.requestBody,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"/reports/%s", tt.ID,
+				),
+			)
+			s.Contains(strings.ToLower(resp.Message), tt.error)
+		})
+	}
+}
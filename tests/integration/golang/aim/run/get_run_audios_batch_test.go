@@ -0,0 +1,74 @@
+package run
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io/fs"
+	"math"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/encoding"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type GetRunAudiosBatchTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestGetRunAudiosBatchTestSuite(t *testing.T) {
+	suite.Run(t, new(GetRunAudiosBatchTestSuite))
+}
+
+func (s *GetRunAudiosBatchTestSuite) Test_Ok() {
+	artifactDir := s.T().TempDir()
+	uris := make([]string, 2)
+	for i := range uris {
+		// test decoder reads binary blobs as float64 values, so store a single float64 value as audio content.
+		content := binary.LittleEndian.AppendUint64(nil, math.Float64bits(float64(i)+0.5))
+		uris[i] = filepath.Join(artifactDir, "audios", fmt.Sprintf("audio-%d.wav", i))
+		s.Require().Nil(os.MkdirAll(filepath.Dir(uris[i]), fs.ModePerm))
+		s.Require().Nil(os.WriteFile(uris[i], content, fs.ModePerm))
+	}
+
+	resp := new(bytes.Buffer)
+	s.Require().Nil(
+		s.AIMClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.GetRunAudiosBatchRequest(uris),
+		).WithResponseType(
+			helpers.ResponseTypeBuffer,
+		).WithResponse(
+			resp,
+		).DoRequest("/runs/audios/get-batch"),
+	)
+
+	decodedData, err := encoding.NewDecoder(resp).Decode()
+	s.Require().Nil(err)
+	s.Equal([]float64{0.5}, decodedData[uris[0]])
+	s.Equal([]float64{1.5}, decodedData[uris[1]])
+}
+
+func (s *GetRunAudiosBatchTestSuite) Test_Error() {
+	uri := filepath.Join(s.T().TempDir(), "not-existing.wav")
+	resp := api.ErrorResponse{}
+	s.Require().Nil(
+		s.AIMClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.GetRunAudiosBatchRequest{uri},
+		).WithResponse(
+			&resp,
+		).DoRequest("/runs/audios/get-batch"),
+	)
+	s.Equal(fmt.Sprintf("error getting artifact object for URI: %s", uri), resp.Message)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+}
@@ -0,0 +1,105 @@
+package run
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/encoding"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type GetRunDistributionsTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestGetRunDistributionsTestSuite(t *testing.T) {
+	suite.Run(t, new(GetRunDistributionsTestSuite))
+}
+
+func (s *GetRunDistributionsTestSuite) Test_Ok() {
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+		Name:           "TestRun",
+		Status:         models.StatusRunning,
+		SourceType:     "JOB",
+		ExperimentID:   *s.DefaultExperiment.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	for i, layer := range []string{"dense", "conv"} {
+		for step := 0; step < 3; step++ {
+			_, err = s.DistributionFixtures.CreateDistribution(context.Background(), &models.Distribution{
+				ID:       uuid.New(),
+				Name:     "weights",
+				RunID:    run.ID,
+				Step:     int64(step),
+				BinEdges: []byte(`[-1, 0, 1]`),
+				Counts:   []byte(fmt.Sprintf(`[%d, %d]`, i, step)),
+				Context:  models.Context{Json: []byte(fmt.Sprintf(`{"layer":"%s"}`, layer))},
+			})
+			s.Require().Nil(err)
+		}
+	}
+
+	resp := new(bytes.Buffer)
+	s.Require().Nil(
+		s.AIMClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.GetRunDistributionsRequest{
+				{
+					Name:    "weights",
+					Context: map[string]any{"layer": "dense"},
+				},
+			},
+		).WithResponseType(
+			helpers.ResponseTypeBuffer,
+		).WithResponse(
+			resp,
+		).DoRequest("/runs/%s/distributions/get-batch", run.ID),
+	)
+
+	decodedData, err := encoding.NewDecoder(resp).Decode()
+	s.Require().Nil(err)
+
+	s.Equal("weights", decodedData["0.name"])
+	s.Equal("dense", decodedData["0.context.layer"])
+	s.Equal(int64(0), decodedData["0.record_range.0"])
+	s.Equal(int64(3), decodedData["0.record_range.1"])
+	for step := 0; step < 3; step++ {
+		s.Equal(int64(1), decodedData[fmt.Sprintf("0.iters.%d", step)])
+		valuePrefix := fmt.Sprintf("0.values.%d", step)
+		s.Equal(int64(2), decodedData[valuePrefix+".bin_count"])
+		s.Equal(float64(-1), decodedData[valuePrefix+".range.0"])
+		s.Equal(float64(1), decodedData[valuePrefix+".range.1"])
+		s.Equal([]float64{0, float64(step)}, decodedData[valuePrefix+".data.blob"])
+		s.Equal([]float64{-1, 0, 1}, decodedData[valuePrefix+".bin_edges.blob"])
+	}
+	s.NotContains(decodedData, "1.name")
+}
+
+func (s *GetRunDistributionsTestSuite) Test_Error() {
+	resp := api.ErrorResponse{}
+	s.Require().Nil(
+		s.AIMClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.GetRunDistributionsRequest{},
+		).WithResponse(
+			&resp,
+		).DoRequest("/runs/%s/distributions/get-batch", "not-existing-run"),
+	)
+	s.Equal("run 'not-existing-run' not found", resp.Message)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+}
@@ -0,0 +1,110 @@
+package run
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/encoding"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type GetRunFiguresTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestGetRunFiguresTestSuite(t *testing.T) {
+	suite.Run(t, new(GetRunFiguresTestSuite))
+}
+
+func (s *GetRunFiguresTestSuite) Test_Ok() {
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+		Name:           "TestRun",
+		Status:         models.StatusRunning,
+		SourceType:     "JOB",
+		ExperimentID:   *s.DefaultExperiment.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	artifactDir := s.T().TempDir()
+	for _, subset := range []string{"train", "val"} {
+		for step := 0; step < 3; step++ {
+			blobURI := filepath.Join(artifactDir, fmt.Sprintf("%s-%d.json", subset, step))
+			s.Require().Nil(os.WriteFile(
+				blobURI, []byte(fmt.Sprintf(`{"layout": {"title": "%s-%d"}}`, subset, step)), fs.ModePerm,
+			))
+			_, err = s.FigureFixtures.CreateFigure(context.Background(), &models.Figure{
+				ID:      uuid.New(),
+				Name:    "loss",
+				RunID:   run.ID,
+				Step:    int64(step),
+				Caption: "loss figure",
+				BlobURI: blobURI,
+				Context: models.Context{Json: []byte(fmt.Sprintf(`{"subset":"%s"}`, subset))},
+			})
+			s.Require().Nil(err)
+		}
+	}
+
+	resp := new(bytes.Buffer)
+	s.Require().Nil(
+		s.AIMClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.GetRunFiguresRequest{
+				{
+					Name:    "loss",
+					Context: map[string]any{"subset": "val"},
+				},
+			},
+		).WithResponseType(
+			helpers.ResponseTypeBuffer,
+		).WithResponse(
+			resp,
+		).DoRequest("/runs/%s/figures/get-batch", run.ID),
+	)
+
+	decodedData, err := encoding.NewDecoder(resp).Decode()
+	s.Require().Nil(err)
+
+	s.Equal("loss", decodedData["0.name"])
+	s.Equal("val", decodedData["0.context.subset"])
+	s.Equal(int64(0), decodedData["0.record_range.0"])
+	s.Equal(int64(3), decodedData["0.record_range.1"])
+	for step := 0; step < 3; step++ {
+		valuePrefix := fmt.Sprintf("0.values.%d", step)
+		s.Equal(fmt.Sprintf("val-%d", step), decodedData[valuePrefix+".data.layout.title"])
+		s.Equal("loss figure", decodedData[valuePrefix+".caption"])
+		s.Equal(int64(step), decodedData[valuePrefix+".step"])
+	}
+	s.NotContains(decodedData, "1.name")
+}
+
+func (s *GetRunFiguresTestSuite) Test_Error() {
+	resp := api.ErrorResponse{}
+	s.Require().Nil(
+		s.AIMClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.GetRunFiguresRequest{},
+		).WithResponse(
+			&resp,
+		).DoRequest("/runs/%s/figures/get-batch", "not-existing-run"),
+	)
+	s.Equal("run 'not-existing-run' not found", resp.Message)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+}
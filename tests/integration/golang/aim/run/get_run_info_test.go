@@ -10,7 +10,9 @@ import (
 
 	"github.com/G-Research/fasttrackml/pkg/api/aim/api/response"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common"
 	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/types"
 	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
 )
 
@@ -29,6 +31,15 @@ func (s *GetRunInfoTestSuite) SetupTest() {
 	var err error
 	s.run, err = s.RunFixtures.CreateExampleRun(context.Background(), s.DefaultExperiment)
 	s.Require().Nil(err)
+
+	// create typed and nested params.
+	for _, param := range []models.Param{
+		{RunID: s.run.ID, Key: "hparams", ValueJSON: types.JSONB(`{"optim":{"lr":0.001,"name":"adam"}}`)},
+		{RunID: s.run.ID, Key: "shuffle", ValueBool: common.GetPointer(true)},
+	} {
+		_, err = s.ParamFixtures.CreateParam(context.Background(), &param)
+		s.Require().Nil(err)
+	}
 }
 
 func (s *GetRunInfoTestSuite) Test_Ok() {
@@ -57,6 +68,13 @@ func (s *GetRunInfoTestSuite) Test_Ok() {
 				expectedTags[tag.Key] = tag.Value
 			}
 			s.Equal(expectedTags, resp.Params["tags"])
+			s.Equal(map[string]interface{}{
+				"optim": map[string]interface{}{
+					"lr":   0.001,
+					"name": "adam",
+				},
+			}, resp.Params["hparams"])
+			s.Equal(true, resp.Params["shuffle"])
 		})
 	}
 }
@@ -0,0 +1,107 @@
+package run
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/encoding"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type GetRunTextsTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestGetRunTextsTestSuite(t *testing.T) {
+	suite.Run(t, new(GetRunTextsTestSuite))
+}
+
+func (s *GetRunTextsTestSuite) Test_Ok() {
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+		Name:           "TestRun",
+		Status:         models.StatusRunning,
+		SourceType:     "JOB",
+		ExperimentID:   *s.DefaultExperiment.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	for _, subset := range []string{"train", "val"} {
+		for step := 0; step < 3; step++ {
+			for index := 0; index < 2; index++ {
+				_, err = s.TextFixtures.CreateText(context.Background(), &models.Text{
+					ID:      uuid.New(),
+					Name:    "predictions",
+					RunID:   run.ID,
+					Step:    int64(step),
+					Index:   int64(index),
+					Value:   fmt.Sprintf("%s-%d-%d", subset, step, index),
+					Context: models.Context{Json: []byte(fmt.Sprintf(`{"subset":"%s"}`, subset))},
+				})
+				s.Require().Nil(err)
+			}
+		}
+	}
+
+	resp := new(bytes.Buffer)
+	s.Require().Nil(
+		s.AIMClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.GetRunTextsRequest{
+				{
+					Name:    "predictions",
+					Context: map[string]any{"subset": "val"},
+				},
+			},
+		).WithResponseType(
+			helpers.ResponseTypeBuffer,
+		).WithResponse(
+			resp,
+		).DoRequest("/runs/%s/texts/get-batch", run.ID),
+	)
+
+	decodedData, err := encoding.NewDecoder(resp).Decode()
+	s.Require().Nil(err)
+
+	s.Equal("predictions", decodedData["0.name"])
+	s.Equal("val", decodedData["0.context.subset"])
+	s.Equal(int64(0), decodedData["0.record_range.0"])
+	s.Equal(int64(3), decodedData["0.record_range.1"])
+	s.Equal(int64(2), decodedData["0.index_range.1"])
+	for step := 0; step < 3; step++ {
+		s.Equal(int64(1), decodedData[fmt.Sprintf("0.iters.%d", step)])
+		for index := 0; index < 2; index++ {
+			valuePrefix := fmt.Sprintf("0.values.%d.%d", step, index)
+			s.Equal(fmt.Sprintf("val-%d-%d", step, index), decodedData[valuePrefix+".data"])
+			s.Equal(int64(step), decodedData[valuePrefix+".step"])
+		}
+	}
+	s.NotContains(decodedData, "1.name")
+}
+
+func (s *GetRunTextsTestSuite) Test_Error() {
+	resp := api.ErrorResponse{}
+	s.Require().Nil(
+		s.AIMClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.GetRunTextsRequest{},
+		).WithResponse(
+			&resp,
+		).DoRequest("/runs/%s/texts/get-batch", "not-existing-run"),
+	)
+	s.Equal("run 'not-existing-run' not found", resp.Message)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+}
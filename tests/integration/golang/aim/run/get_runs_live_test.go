@@ -0,0 +1,100 @@
+package run
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/config"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type GetRunsLiveTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestGetRunsLiveTestSuite(t *testing.T) {
+	testSuite := new(GetRunsLiveTestSuite)
+	testSuite.Config = config.Config{
+		LiveUpdatesEnabled: true,
+	}
+	suite.Run(t, testSuite)
+}
+
+func (s *GetRunsLiveTestSuite) Test_Error() {
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             "id",
+		Name:           "name",
+		Status:         models.StatusRunning,
+		SourceType:     "JOB",
+		ExperimentID:   *s.DefaultExperiment.ID,
+		ArtifactURI:    "artifact_uri",
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	tests := []struct {
+		name    string
+		request request.GetRunsLiveRequest
+		error   *api.ErrorResponse
+	}{
+		{
+			name: "NonexistentRun",
+			request: request.GetRunsLiveRequest{
+				RunIDs: []string{run.ID, "not-found-id"},
+			},
+			error: &api.ErrorResponse{
+				Message:    "run 'not-found-id' not found",
+				StatusCode: http.StatusBadRequest,
+			},
+		},
+		{
+			name: "InvalidQuery",
+			request: request.GetRunsLiveRequest{
+				RunIDs: []string{run.ID},
+				Query:  "run.name ==",
+			},
+			error: &api.ErrorResponse{
+				Message: "error matching runs: problem parsing query: " +
+					`syntax error at (1, 13) in "(run.name ==) and (not run.archived)": invalid syntax`,
+				StatusCode: http.StatusInternalServerError,
+			},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp api.ErrorResponse
+			s.Require().Nil(
+				s.AIMClient().WithQuery(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"/runs/live",
+				),
+			)
+			s.Equal(tt.error.Message, resp.Message)
+			s.Equal(tt.error.StatusCode, resp.StatusCode)
+		})
+	}
+}
+
+type GetRunsLiveDisabledTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestGetRunsLiveDisabledTestSuite(t *testing.T) {
+	suite.Run(t, new(GetRunsLiveDisabledTestSuite))
+}
+
+func (s *GetRunsLiveDisabledTestSuite) Test_Error() {
+	var resp api.ErrorResponse
+	s.Require().Nil(s.AIMClient().WithResponse(&resp).DoRequest("/runs/live"))
+	s.Equal("live updates are disabled", resp.Message)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+}
@@ -0,0 +1,206 @@
+package run
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/api/response"
+	aimModels "github.com/G-Research/fasttrackml/pkg/api/aim/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type RunNotesTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestRunNotesTestSuite(t *testing.T) {
+	suite.Run(t, new(RunNotesTestSuite))
+}
+
+func (s *RunNotesTestSuite) Test_Ok() {
+	runs, err := s.RunFixtures.CreateExampleRuns(context.Background(), s.DefaultExperiment, 2)
+	s.Require().Nil(err)
+
+	// create new note.
+	var createResp response.NoteResponse
+	s.Require().Nil(
+		s.AIMClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			map[string]any{"content": "# run note"},
+		).WithResponse(
+			&createResp,
+		).DoRequest(
+			"/runs/%s/note", runs[0].ID,
+		),
+	)
+	s.Equal("# run note", createResp.Content)
+	s.Empty(createResp.Author)
+
+	note, err := s.NoteFixtures.GetNote(context.Background(), createResp.ID)
+	s.Require().Nil(err)
+	s.Equal(runs[0].ID, *note.RunID)
+	s.Nil(note.ExperimentID)
+	s.Equal(s.DefaultNamespace.ID, note.NamespaceID)
+
+	// get the list of run notes. notes of other runs should not be there.
+	_, err = s.NoteFixtures.CreateNote(context.Background(), &aimModels.Note{
+		Content:     "another run note",
+		RunID:       &runs[1].ID,
+		NamespaceID: s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+
+	var listResp response.GetNotesResponse
+	s.Require().Nil(
+		s.AIMClient().WithResponse(
+			&listResp,
+		).DoRequest(
+			"/runs/%s/note", runs[0].ID,
+		),
+	)
+	s.Require().Len(listResp, 1)
+	s.Equal(createResp.ID, listResp[0].ID)
+	s.Equal("# run note", listResp[0].Content)
+
+	// update the note.
+	var updateResp response.NoteResponse
+	s.Require().Nil(
+		s.AIMClient().WithMethod(
+			http.MethodPut,
+		).WithRequest(
+			map[string]any{"content": "# updated run note"},
+		).WithResponse(
+			&updateResp,
+		).DoRequest(
+			"/runs/%s/note/%s", runs[0].ID, createResp.ID,
+		),
+	)
+	s.Equal(createResp.ID, updateResp.ID)
+	s.Equal("# updated run note", updateResp.Content)
+
+	var getResp response.NoteResponse
+	s.Require().Nil(
+		s.AIMClient().WithResponse(
+			&getResp,
+		).DoRequest(
+			"/runs/%s/note/%s", runs[0].ID, createResp.ID,
+		),
+	)
+	s.Equal("# updated run note", getResp.Content)
+
+	// delete the note.
+	var deleteResp response.DeleteNoteResponse
+	s.Require().Nil(
+		s.AIMClient().WithMethod(
+			http.MethodDelete,
+		).WithResponse(
+			&deleteResp,
+		).DoRequest(
+			"/runs/%s/note/%s", runs[0].ID, createResp.ID,
+		),
+	)
+	s.Equal("OK", deleteResp.Status)
+
+	notes, err := s.NoteFixtures.GetNotes(context.Background())
+	s.Require().Nil(err)
+	s.Require().Len(notes, 1)
+	s.Equal(runs[1].ID, *notes[0].RunID)
+}
+
+func (s *RunNotesTestSuite) Test_Error() {
+	runs, err := s.RunFixtures.CreateExampleRuns(context.Background(), s.DefaultExperiment, 2)
+	s.Require().Nil(err)
+
+	// create the notes, which are not accessible from the requested run or namespace.
+	anotherRunNote, err := s.NoteFixtures.CreateNote(context.Background(), &aimModels.Note{
+		Content:     "another run note",
+		RunID:       &runs[1].ID,
+		NamespaceID: s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+
+	namespace, err := s.NamespaceFixtures.CreateNamespace(context.Background(), &models.Namespace{
+		Code:                "another-namespace",
+		DefaultExperimentID: common.GetPointer(models.DefaultExperimentID),
+	})
+	s.Require().Nil(err)
+	anotherNamespaceNote, err := s.NoteFixtures.CreateNote(context.Background(), &aimModels.Note{
+		Content:     "another namespace note",
+		RunID:       &runs[0].ID,
+		NamespaceID: namespace.ID,
+	})
+	s.Require().Nil(err)
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+	}{
+		{
+			name:   "GetNotesOfNotFoundRun",
+			method: http.MethodGet,
+			path:   "/runs/not-found-id/note",
+		},
+		{
+			name:   "CreateNoteOfNotFoundRun",
+			method: http.MethodPost,
+			path:   "/runs/not-found-id/note",
+		},
+		{
+			name:   "GetNotFoundNote",
+			method: http.MethodGet,
+			path:   "/runs/" + runs[0].ID + "/note/" + uuid.NewString(),
+		},
+		{
+			name:   "GetNoteOfAnotherRun",
+			method: http.MethodGet,
+			path:   "/runs/" + runs[0].ID + "/note/" + anotherRunNote.ID.String(),
+		},
+		{
+			name:   "UpdateNoteOfAnotherRun",
+			method: http.MethodPut,
+			path:   "/runs/" + runs[0].ID + "/note/" + anotherRunNote.ID.String(),
+		},
+		{
+			name:   "DeleteNoteFromAnotherNamespace",
+			method: http.MethodDelete,
+			path:   "/runs/" + runs[0].ID + "/note/" + anotherNamespaceNote.ID.String(),
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp api.ErrorResponse
+			s.Require().Nil(
+				s.AIMClient().WithMethod(
+					tt.method,
+				).WithRequest(
+					map[string]any{"content": "note"},
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					tt.path,
+				),
+			)
+			s.Contains(resp.Message, "Not Found")
+		})
+	}
+
+	// notes from another namespace should not be listed.
+	var listResp response.GetNotesResponse
+	s.Require().Nil(
+		s.AIMClient().WithResponse(
+			&listResp,
+		).DoRequest(
+			"/runs/%s/note", runs[0].ID,
+		),
+	)
+	s.Empty(listResp)
+}
@@ -0,0 +1,205 @@
+package run
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/encoding"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type SearchAudiosTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestSearchAudiosTestSuite(t *testing.T) {
+	suite.Run(t, new(SearchAudiosTestSuite))
+}
+
+func (s *SearchAudiosTestSuite) Test_Ok() {
+	// create test experiments.
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           uuid.New().String(),
+		LifecycleStage: models.LifecycleStageActive,
+		NamespaceID:    s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+
+	runs := make([]*models.Run, 2)
+	for i, name := range []string{"some-name", "other-name"} {
+		runs[i], err = s.RunFixtures.CreateRun(context.Background(), &models.Run{
+			ID:         strings.ReplaceAll(uuid.New().String(), "-", ""),
+			Name:       fmt.Sprintf("TestRun%d", i+1),
+			UserID:     "1",
+			Status:     models.StatusRunning,
+			SourceType: "JOB",
+			StartTime: sql.NullInt64{
+				Int64: 123456789,
+				Valid: true,
+			},
+			ExperimentID:   *experiment.ID,
+			LifecycleStage: models.LifecycleStageActive,
+		})
+		s.Require().Nil(err)
+		for step := 0; step < 5; step++ {
+			for index := 0; index < 5; index++ {
+				_, err = s.AudioFixtures.CreateAudio(context.Background(), &models.Audio{
+					ID:         uuid.New(),
+					Name:       name,
+					RunID:      runs[i].ID,
+					Step:       int64(step),
+					Index:      int64(index),
+					Format:     "wav",
+					SampleRate: 22050,
+					BlobURI:    fmt.Sprintf("s3://bucket/audio-%d-%d.wav", step, index),
+					Context:    models.Context{Json: []byte(`{"subset":"val"}`)},
+				})
+				s.Require().Nil(err)
+			}
+		}
+	}
+	run1, run2 := runs[0], runs[1]
+
+	tests := []struct {
+		name                        string
+		request                     request.SearchArtifactsRequest
+		includedRuns                []*models.Run
+		excludedRuns                []*models.Run
+		expectedRecordRangeUsedMax  int64
+		expectedIndexRangeUsedMax   int64
+		expectedAudioIndexesPresent []int
+		expectedAudioIndexesAbsent  []int
+		expectedStepIndexesPresent  []int
+		expectedStepIndexesAbsent   []int
+	}{
+		{
+			name: "SearchAudios",
+			request: request.SearchArtifactsRequest{
+				Query: `((audios.name == "some-name") or (audios.name == "other-name"))`,
+			},
+			includedRuns:                []*models.Run{run1, run2},
+			expectedRecordRangeUsedMax:  4,
+			expectedIndexRangeUsedMax:   4,
+			expectedAudioIndexesPresent: []int{0, 1, 2, 3, 4},
+			expectedStepIndexesPresent:  []int{0, 1, 2, 3, 4},
+		},
+		{
+			name: "SearchAudiosWithNameQuery",
+			request: request.SearchArtifactsRequest{
+				Query: `((audios.name == "some-name"))`,
+			},
+			includedRuns:                []*models.Run{run1},
+			excludedRuns:                []*models.Run{run2},
+			expectedRecordRangeUsedMax:  4,
+			expectedIndexRangeUsedMax:   4,
+			expectedAudioIndexesPresent: []int{0, 1, 2, 3, 4},
+			expectedStepIndexesPresent:  []int{0, 1, 2, 3, 4},
+		},
+		{
+			name: "SearchAudiosWithRecordRange",
+			request: request.SearchArtifactsRequest{
+				Query:       `((audios.name == "some-name"))`,
+				RecordRange: "0:2",
+			},
+			includedRuns:                []*models.Run{run1},
+			excludedRuns:                []*models.Run{run2},
+			expectedRecordRangeUsedMax:  2,
+			expectedIndexRangeUsedMax:   4,
+			expectedAudioIndexesPresent: []int{0, 1, 2, 3, 4},
+			expectedStepIndexesPresent:  []int{0, 1, 2},
+			expectedStepIndexesAbsent:   []int{3, 4},
+		},
+		{
+			name: "SearchAudiosWithIndexRange",
+			request: request.SearchArtifactsRequest{
+				Query:      `((audios.name == "some-name"))`,
+				IndexRange: "0:2",
+			},
+			includedRuns:                []*models.Run{run1},
+			excludedRuns:                []*models.Run{run2},
+			expectedRecordRangeUsedMax:  4,
+			expectedIndexRangeUsedMax:   2,
+			expectedAudioIndexesPresent: []int{0, 1, 2},
+			expectedAudioIndexesAbsent:  []int{3, 4},
+			expectedStepIndexesPresent:  []int{0, 1, 2, 3, 4},
+		},
+		{
+			name: "SearchAudiosWithRecordAndIndexDensity",
+			request: request.SearchArtifactsRequest{
+				Query:         `((audios.name == "other-name"))`,
+				RecordDensity: 1,
+				IndexDensity:  1,
+			},
+			includedRuns:                []*models.Run{run2},
+			excludedRuns:                []*models.Run{run1},
+			expectedRecordRangeUsedMax:  4,
+			expectedIndexRangeUsedMax:   4,
+			expectedAudioIndexesPresent: []int{0},
+			expectedAudioIndexesAbsent:  []int{1, 2, 3, 4},
+			expectedStepIndexesPresent:  []int{0},
+			expectedStepIndexesAbsent:   []int{1, 2, 3, 4},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := new(bytes.Buffer)
+			s.Require().Nil(
+				s.AIMClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponseType(
+					helpers.ResponseTypeBuffer,
+				).WithResponse(
+					resp,
+				).DoRequest("/runs/search/audios"),
+			)
+
+			decodedData, err := encoding.NewDecoder(resp).Decode()
+			s.Require().Nil(err)
+
+			for _, run := range tt.includedRuns {
+				rangesPrefix := fmt.Sprintf("%v.ranges", run.ID)
+				s.Equal(tt.expectedRecordRangeUsedMax, decodedData[rangesPrefix+".record_range_used.1"])
+				s.Equal(tt.expectedIndexRangeUsedMax, decodedData[rangesPrefix+".index_range_used.1"])
+				s.Equal(run.Name, decodedData[fmt.Sprintf("%v.props.name", run.ID)])
+
+				tracesPrefix := fmt.Sprintf("%v.traces.0", run.ID)
+				s.Equal("val", decodedData[tracesPrefix+".context.subset"])
+				for _, stepIndex := range tt.expectedStepIndexesPresent {
+					for _, audioIndex := range tt.expectedAudioIndexesPresent {
+						valuePrefix := fmt.Sprintf("%s.values.%d.%d", tracesPrefix, stepIndex, audioIndex)
+						s.Equal(
+							fmt.Sprintf("s3://bucket/audio-%d-%d.wav", stepIndex, audioIndex),
+							decodedData[valuePrefix+".blob_uri"],
+						)
+						s.Equal("wav", decodedData[valuePrefix+".format"])
+						s.Equal(int64(22050), decodedData[valuePrefix+".sample_rate"])
+						s.Equal(int64(audioIndex), decodedData[valuePrefix+".index"])
+					}
+					for _, audioIndex := range tt.expectedAudioIndexesAbsent {
+						valuePrefix := fmt.Sprintf("%s.values.%d.%d", tracesPrefix, stepIndex, audioIndex)
+						s.NotContains(decodedData, valuePrefix+".blob_uri")
+					}
+				}
+				for _, stepIndex := range tt.expectedStepIndexesAbsent {
+					valuePrefix := fmt.Sprintf("%s.values.%d.0", tracesPrefix, stepIndex)
+					s.NotContains(decodedData, valuePrefix+".blob_uri")
+				}
+			}
+			for _, run := range tt.excludedRuns {
+				s.NotContains(decodedData, fmt.Sprintf("%v.props.name", run.ID))
+			}
+		})
+	}
+}
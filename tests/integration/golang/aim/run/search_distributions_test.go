@@ -0,0 +1,167 @@
+package run
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/encoding"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type SearchDistributionsTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestSearchDistributionsTestSuite(t *testing.T) {
+	suite.Run(t, new(SearchDistributionsTestSuite))
+}
+
+func (s *SearchDistributionsTestSuite) Test_Ok() {
+	// create test experiments.
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           uuid.New().String(),
+		LifecycleStage: models.LifecycleStageActive,
+		NamespaceID:    s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+
+	runs := make([]*models.Run, 2)
+	for i, name := range []string{"some-name", "other-name"} {
+		runs[i], err = s.RunFixtures.CreateRun(context.Background(), &models.Run{
+			ID:         strings.ReplaceAll(uuid.New().String(), "-", ""),
+			Name:       fmt.Sprintf("TestRun%d", i+1),
+			UserID:     "1",
+			Status:     models.StatusRunning,
+			SourceType: "JOB",
+			StartTime: sql.NullInt64{
+				Int64: 123456789,
+				Valid: true,
+			},
+			ExperimentID:   *experiment.ID,
+			LifecycleStage: models.LifecycleStageActive,
+		})
+		s.Require().Nil(err)
+		for step := 0; step < 5; step++ {
+			_, err = s.DistributionFixtures.CreateDistribution(context.Background(), &models.Distribution{
+				ID:       uuid.New(),
+				Name:     name,
+				RunID:    runs[i].ID,
+				Step:     int64(step),
+				BinEdges: []byte(fmt.Sprintf(`[%d, %d, %d]`, step, step+1, step+2)),
+				Counts:   []byte(fmt.Sprintf(`[%d, %d]`, step*10, step*10+1)),
+				Context:  models.Context{Json: []byte(`{"layer":"dense"}`)},
+			})
+			s.Require().Nil(err)
+		}
+	}
+	run1, run2 := runs[0], runs[1]
+
+	tests := []struct {
+		name                       string
+		request                    request.SearchArtifactsRequest
+		includedRuns               []*models.Run
+		excludedRuns               []*models.Run
+		expectedRecordRangeUsedMax int64
+		expectedStepIndexesPresent []int
+		expectedStepIndexesAbsent  []int
+	}{
+		{
+			name: "SearchDistributions",
+			request: request.SearchArtifactsRequest{
+				Query: `((distributions.name == "some-name") or (distributions.name == "other-name"))`,
+			},
+			includedRuns:               []*models.Run{run1, run2},
+			expectedRecordRangeUsedMax: 4,
+			expectedStepIndexesPresent: []int{0, 1, 2, 3, 4},
+		},
+		{
+			name: "SearchDistributionsWithNameQuery",
+			request: request.SearchArtifactsRequest{
+				Query: `((distributions.name == "some-name"))`,
+			},
+			includedRuns:               []*models.Run{run1},
+			excludedRuns:               []*models.Run{run2},
+			expectedRecordRangeUsedMax: 4,
+			expectedStepIndexesPresent: []int{0, 1, 2, 3, 4},
+		},
+		{
+			name: "SearchDistributionsWithRecordRange",
+			request: request.SearchArtifactsRequest{
+				Query:       `((distributions.name == "some-name"))`,
+				RecordRange: "0:2",
+			},
+			includedRuns:               []*models.Run{run1},
+			excludedRuns:               []*models.Run{run2},
+			expectedRecordRangeUsedMax: 2,
+			expectedStepIndexesPresent: []int{0, 1, 2},
+			expectedStepIndexesAbsent:  []int{3, 4},
+		},
+		{
+			name: "SearchDistributionsWithRecordDensity",
+			request: request.SearchArtifactsRequest{
+				Query:         `((distributions.name == "other-name"))`,
+				RecordDensity: 1,
+			},
+			includedRuns:               []*models.Run{run2},
+			excludedRuns:               []*models.Run{run1},
+			expectedRecordRangeUsedMax: 4,
+			expectedStepIndexesPresent: []int{0},
+			expectedStepIndexesAbsent:  []int{1, 2, 3, 4},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := new(bytes.Buffer)
+			s.Require().Nil(
+				s.AIMClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponseType(
+					helpers.ResponseTypeBuffer,
+				).WithResponse(
+					resp,
+				).DoRequest("/runs/search/distributions"),
+			)
+
+			decodedData, err := encoding.NewDecoder(resp).Decode()
+			s.Require().Nil(err)
+
+			for _, run := range tt.includedRuns {
+				rangesPrefix := fmt.Sprintf("%v.ranges", run.ID)
+				s.Equal(tt.expectedRecordRangeUsedMax, decodedData[rangesPrefix+".record_range_used.1"])
+				s.Equal(run.Name, decodedData[fmt.Sprintf("%v.props.name", run.ID)])
+
+				tracesPrefix := fmt.Sprintf("%v.traces.0", run.ID)
+				s.Equal("dense", decodedData[tracesPrefix+".context.layer"])
+				for _, stepIndex := range tt.expectedStepIndexesPresent {
+					valuePrefix := fmt.Sprintf("%s.values.%d", tracesPrefix, stepIndex)
+					s.Equal(int64(2), decodedData[valuePrefix+".bin_count"])
+					s.Equal(float64(stepIndex), decodedData[valuePrefix+".range.0"])
+					s.Equal(float64(stepIndex+2), decodedData[valuePrefix+".range.1"])
+					s.Equal(
+						[]float64{float64(stepIndex * 10), float64(stepIndex*10 + 1)},
+						decodedData[valuePrefix+".data.blob"],
+					)
+				}
+				for _, stepIndex := range tt.expectedStepIndexesAbsent {
+					valuePrefix := fmt.Sprintf("%s.values.%d", tracesPrefix, stepIndex)
+					s.NotContains(decodedData, valuePrefix+".bin_count")
+				}
+			}
+			for _, run := range tt.excludedRuns {
+				s.NotContains(decodedData, fmt.Sprintf("%v.props.name", run.ID))
+			}
+		})
+	}
+}
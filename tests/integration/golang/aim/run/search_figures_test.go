@@ -0,0 +1,159 @@
+package run
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/encoding"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type SearchFiguresTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestSearchFiguresTestSuite(t *testing.T) {
+	suite.Run(t, new(SearchFiguresTestSuite))
+}
+
+func (s *SearchFiguresTestSuite) Test_Ok() {
+	// create test experiments.
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           uuid.New().String(),
+		LifecycleStage: models.LifecycleStageActive,
+		NamespaceID:    s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+
+	artifactDir := s.T().TempDir()
+	runs := make([]*models.Run, 2)
+	for i, name := range []string{"some-name", "other-name"} {
+		runs[i], err = s.RunFixtures.CreateRun(context.Background(), &models.Run{
+			ID:         strings.ReplaceAll(uuid.New().String(), "-", ""),
+			Name:       fmt.Sprintf("TestRun%d", i+1),
+			UserID:     "1",
+			Status:     models.StatusRunning,
+			SourceType: "JOB",
+			StartTime: sql.NullInt64{
+				Int64: 123456789,
+				Valid: true,
+			},
+			ExperimentID:   *experiment.ID,
+			LifecycleStage: models.LifecycleStageActive,
+		})
+		s.Require().Nil(err)
+		for step := 0; step < 5; step++ {
+			blobURI := filepath.Join(artifactDir, runs[i].ID, fmt.Sprintf("figure-%d.json", step))
+			s.Require().Nil(os.MkdirAll(filepath.Dir(blobURI), fs.ModePerm))
+			s.Require().Nil(os.WriteFile(
+				blobURI, []byte(fmt.Sprintf(`{"data": [{"type": "scatter", "y": [%d]}]}`, step)), fs.ModePerm,
+			))
+			_, err = s.FigureFixtures.CreateFigure(context.Background(), &models.Figure{
+				ID:      uuid.New(),
+				Name:    name,
+				RunID:   runs[i].ID,
+				Step:    int64(step),
+				BlobURI: blobURI,
+				Context: models.Context{Json: []byte(`{"subset":"val"}`)},
+			})
+			s.Require().Nil(err)
+		}
+	}
+	run1, run2 := runs[0], runs[1]
+
+	tests := []struct {
+		name                       string
+		request                    request.SearchArtifactsRequest
+		includedRuns               []*models.Run
+		excludedRuns               []*models.Run
+		expectedRecordRangeUsedMax int64
+		expectedStepIndexesPresent []int
+		expectedStepIndexesAbsent  []int
+	}{
+		{
+			name: "SearchFigures",
+			request: request.SearchArtifactsRequest{
+				Query: `((figures.name == "some-name") or (figures.name == "other-name"))`,
+			},
+			includedRuns:               []*models.Run{run1, run2},
+			expectedRecordRangeUsedMax: 4,
+			expectedStepIndexesPresent: []int{0, 1, 2, 3, 4},
+		},
+		{
+			name: "SearchFiguresWithNameQuery",
+			request: request.SearchArtifactsRequest{
+				Query: `((figures.name == "some-name"))`,
+			},
+			includedRuns:               []*models.Run{run1},
+			excludedRuns:               []*models.Run{run2},
+			expectedRecordRangeUsedMax: 4,
+			expectedStepIndexesPresent: []int{0, 1, 2, 3, 4},
+		},
+		{
+			name: "SearchFiguresWithRecordRange",
+			request: request.SearchArtifactsRequest{
+				Query:       `((figures.name == "some-name"))`,
+				RecordRange: "0:2",
+			},
+			includedRuns:               []*models.Run{run1},
+			excludedRuns:               []*models.Run{run2},
+			expectedRecordRangeUsedMax: 2,
+			expectedStepIndexesPresent: []int{0, 1, 2},
+			expectedStepIndexesAbsent:  []int{3, 4},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := new(bytes.Buffer)
+			s.Require().Nil(
+				s.AIMClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponseType(
+					helpers.ResponseTypeBuffer,
+				).WithResponse(
+					resp,
+				).DoRequest("/runs/search/figures"),
+			)
+
+			decodedData, err := encoding.NewDecoder(resp).Decode()
+			s.Require().Nil(err)
+
+			for _, run := range tt.includedRuns {
+				rangesPrefix := fmt.Sprintf("%v.ranges", run.ID)
+				s.Equal(tt.expectedRecordRangeUsedMax, decodedData[rangesPrefix+".record_range_used.1"])
+				s.Equal(run.Name, decodedData[fmt.Sprintf("%v.props.name", run.ID)])
+
+				tracesPrefix := fmt.Sprintf("%v.traces.0", run.ID)
+				s.Equal("val", decodedData[tracesPrefix+".context.subset"])
+				for _, stepIndex := range tt.expectedStepIndexesPresent {
+					valuePrefix := fmt.Sprintf("%s.values.%d", tracesPrefix, stepIndex)
+					s.Equal("scatter", decodedData[valuePrefix+".data.data.0.type"])
+					s.Equal(float64(stepIndex), decodedData[valuePrefix+".data.data.0.y.0"])
+					s.NotContains(decodedData, valuePrefix+".blob_uri")
+				}
+				for _, stepIndex := range tt.expectedStepIndexesAbsent {
+					valuePrefix := fmt.Sprintf("%s.values.%d", tracesPrefix, stepIndex)
+					s.NotContains(decodedData, valuePrefix+".data.data.0.type")
+				}
+			}
+			for _, run := range tt.excludedRuns {
+				s.NotContains(decodedData, fmt.Sprintf("%v.props.name", run.ID))
+			}
+		})
+	}
+}
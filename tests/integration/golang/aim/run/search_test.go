@@ -213,6 +213,17 @@ func (s *SearchTestSuite) SetupTest() {
 	})
 	s.Require().Nil(err)
 
+	// create typed and nested params, so they could be searched by dotted path.
+	for _, param := range []models.Param{
+		{RunID: run1.ID, Key: "hparams", ValueJSON: types.JSONB(`{"optim":{"lr":0.001,"name":"adam"},"shuffle":true}`)},
+		{RunID: run3.ID, Key: "hparams", ValueJSON: types.JSONB(`{"optim":{"lr":0.01,"name":"sgd"},"shuffle":false}`)},
+		{RunID: run1.ID, Key: "epochs", ValueInt: common.GetPointer(int64(5))},
+		{RunID: run3.ID, Key: "epochs", ValueFloat: common.GetPointer(20.5)},
+	} {
+		_, err = s.ParamFixtures.CreateParam(context.Background(), &param)
+		s.Require().Nil(err)
+	}
+
 	run4, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
 		ID:         "id4",
 		Name:       "TestRun4",
@@ -261,6 +272,22 @@ func (s *SearchTestSuite) SetupTest() {
 		ValueStr: common.GetPointer("value4"),
 	})
 	s.Require().Nil(err)
+
+	// create metric history, so metric aggregates could be searched.
+	for _, metric := range []models.Metric{
+		{RunID: run1.ID, Value: 0.5, Step: 0, Iter: 1},
+		{RunID: run1.ID, Value: 1.1, Step: 1, Iter: 2},
+		{RunID: run3.ID, Value: 2.0, Step: 0, Iter: 1},
+		{RunID: run3.ID, Value: 3.1, Step: 1, Iter: 2},
+	} {
+		metric.Key = "TestMetric"
+		metric.Timestamp = 1234567890
+		metric.Context = models.Context{
+			Json: types.JSONB(`{"key": "value"}`),
+		}
+		_, err = s.MetricFixtures.CreateMetric(context.Background(), &metric)
+		s.Require().Nil(err)
+	}
 }
 
 func (s *SearchTestSuite) TestCSVReport_Ok() {
@@ -303,6 +330,8 @@ func (s *SearchTestSuite) TestCSVReport_Ok() {
 			"duration",
 			"TestMetric {\"key\": \"value\"}",
 			"TestMetric2 {\"key\": \"value\"}",
+			"params[epochs]",
+			"params[hparams]",
 			"params[param1]",
 			"params[param3]",
 			"tags[mlflow.runName]",
@@ -315,6 +344,8 @@ func (s *SearchTestSuite) TestCSVReport_Ok() {
 			"111111111ms",
 			"3.100000",
 			"-",
+			"20.5",
+			`{"optim":{"lr":0.01,"name":"sgd"},"shuffle":false}`,
 			"-",
 			"value3",
 			"TestRunTag3",
@@ -327,6 +358,8 @@ func (s *SearchTestSuite) TestCSVReport_Ok() {
 			"0ms",
 			"1.100000",
 			"1.100000",
+			"5",
+			`{"optim":{"lr":0.001,"name":"adam"},"shuffle":true}`,
 			"value1",
 			"-",
 			"TestRunTag1",
@@ -722,6 +755,151 @@ func (s *SearchTestSuite) TestStreamData_Ok() {
 				s.run3,
 			},
 		},
+		{
+			name: "SearchRunNameOperationEndsWithTuple",
+			request: request.SearchRunsRequest{
+				Query:           `run.name.endswith(("1", "3"))`,
+				ExperimentNames: experimentNames,
+			},
+			runs: []*models.Run{
+				s.run1,
+				s.run3,
+			},
+		},
+		{
+			name: "SearchRunNameOperationLower",
+			request: request.SearchRunsRequest{
+				Query:           `run.name.lower() == "testrun1"`,
+				ExperimentNames: experimentNames,
+			},
+			runs: []*models.Run{
+				s.run1,
+			},
+		},
+		{
+			name: "SearchRunNameOperationLen",
+			request: request.SearchRunsRequest{
+				Query:           `len(run.name) == 8`,
+				ExperimentNames: experimentNames,
+			},
+			runs: []*models.Run{
+				s.run1,
+				s.run3,
+			},
+		},
+		{
+			name: "SearchRunParamOperationIn",
+			request: request.SearchRunsRequest{
+				Query:           `"value" in run.param1`,
+				ExperimentNames: experimentNames,
+			},
+			runs: []*models.Run{
+				s.run1,
+			},
+		},
+		{
+			name: "SearchRunParamOperationNotIn",
+			request: request.SearchRunsRequest{
+				Query:           `"other" not in run.param1`,
+				ExperimentNames: experimentNames,
+			},
+			runs: []*models.Run{
+				s.run1,
+				s.run3,
+			},
+		},
+		{
+			name: "SearchRunNestedParamOperationGrater",
+			request: request.SearchRunsRequest{
+				Query:           `run.hparams.optim.lr > 0.005`,
+				ExperimentNames: experimentNames,
+			},
+			runs: []*models.Run{
+				s.run3,
+			},
+		},
+		{
+			name: "SearchRunNestedParamOperationEqual",
+			request: request.SearchRunsRequest{
+				Query:           `run.hparams["optim"]["name"] == "adam"`,
+				ExperimentNames: experimentNames,
+			},
+			runs: []*models.Run{
+				s.run1,
+			},
+		},
+		{
+			name: "SearchRunNestedParamOperationBool",
+			request: request.SearchRunsRequest{
+				Query:           `run.hparams.shuffle == False`,
+				ExperimentNames: experimentNames,
+			},
+			runs: []*models.Run{
+				s.run3,
+			},
+		},
+		{
+			name: "SearchRunNestedParamOperationIn",
+			request: request.SearchRunsRequest{
+				Query:           `"lr" in run.hparams.optim`,
+				ExperimentNames: experimentNames,
+			},
+			runs: []*models.Run{
+				s.run1,
+				s.run3,
+			},
+		},
+		{
+			name: "SearchRunNestedParamOperationStartsWith",
+			request: request.SearchRunsRequest{
+				Query:           `run.hparams.optim.name.startswith("sg")`,
+				ExperimentNames: experimentNames,
+			},
+			runs: []*models.Run{
+				s.run3,
+			},
+		},
+		{
+			name: "SearchRunNestedParamOperationIsNone",
+			request: request.SearchRunsRequest{
+				Query:           `run.hparams.optim.momentum is None and run.hparams is not None`,
+				ExperimentNames: experimentNames,
+			},
+			runs: []*models.Run{
+				s.run1,
+				s.run3,
+			},
+		},
+		{
+			name: "SearchRunNumberParamOperationLess",
+			request: request.SearchRunsRequest{
+				Query:           `run.epochs < 10`,
+				ExperimentNames: experimentNames,
+			},
+			runs: []*models.Run{
+				s.run1,
+			},
+		},
+		{
+			name: "SearchRunNumberParamOperationGraterOrEqual",
+			request: request.SearchRunsRequest{
+				Query:           `run.epochs >= 10`,
+				ExperimentNames: experimentNames,
+			},
+			runs: []*models.Run{
+				s.run3,
+			},
+		},
+		{
+			name: "SearchRunTagOperationInList",
+			request: request.SearchRunsRequest{
+				Query:           `run.tags['mlflow.runName'] in ["TestRunTag3", "TestRunTag4"]`,
+				ExperimentNames: experimentNames,
+			},
+			runs: []*models.Run{
+				s.run3,
+			},
+		},
 		{
 			name: "SearchRunExperimentOperationEqual",
 			request: request.SearchRunsRequest{
@@ -804,6 +982,46 @@ func (s *SearchTestSuite) TestStreamData_Ok() {
 				s.run3,
 			},
 		},
+		{
+			name: "SearchMetricMinOperationLess",
+			request: request.SearchRunsRequest{
+				Query:           `run.metrics['TestMetric'].min < 1`,
+				ExperimentNames: experimentNames,
+			},
+			runs: []*models.Run{
+				s.run1,
+			},
+		},
+		{
+			name: "SearchMetricMaxOperationGrater",
+			request: request.SearchRunsRequest{
+				Query:           `run.metrics['TestMetric'].max > 3`,
+				ExperimentNames: experimentNames,
+			},
+			runs: []*models.Run{
+				s.run3,
+			},
+		},
+		{
+			name: "SearchMetricFirstOperationEqual",
+			request: request.SearchRunsRequest{
+				Query:           `run.metrics['TestMetric'].first == 2.0`,
+				ExperimentNames: experimentNames,
+			},
+			runs: []*models.Run{
+				s.run3,
+			},
+		},
+		{
+			name: "SearchMetricMeanOperationRange",
+			request: request.SearchRunsRequest{
+				Query:           `run.metrics['TestMetric'].mean > 0.7 and run.metrics['TestMetric'].mean < 0.9`,
+				ExperimentNames: experimentNames,
+			},
+			runs: []*models.Run{
+				s.run1,
+			},
+		},
 		{
 			name: "SearchMetricLastStepOperationEqual",
 			request: request.SearchRunsRequest{
@@ -0,0 +1,198 @@
+package run
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/encoding"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type SearchTextsTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestSearchTextsTestSuite(t *testing.T) {
+	suite.Run(t, new(SearchTextsTestSuite))
+}
+
+func (s *SearchTextsTestSuite) Test_Ok() {
+	// create test experiments.
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           uuid.New().String(),
+		LifecycleStage: models.LifecycleStageActive,
+		NamespaceID:    s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+
+	runs := make([]*models.Run, 2)
+	for i, name := range []string{"some-name", "other-name"} {
+		runs[i], err = s.RunFixtures.CreateRun(context.Background(), &models.Run{
+			ID:         strings.ReplaceAll(uuid.New().String(), "-", ""),
+			Name:       fmt.Sprintf("TestRun%d", i+1),
+			UserID:     "1",
+			Status:     models.StatusRunning,
+			SourceType: "JOB",
+			StartTime: sql.NullInt64{
+				Int64: 123456789,
+				Valid: true,
+			},
+			ExperimentID:   *experiment.ID,
+			LifecycleStage: models.LifecycleStageActive,
+		})
+		s.Require().Nil(err)
+		for step := 0; step < 5; step++ {
+			for index := 0; index < 5; index++ {
+				_, err = s.TextFixtures.CreateText(context.Background(), &models.Text{
+					ID:      uuid.New(),
+					Name:    name,
+					RunID:   runs[i].ID,
+					Step:    int64(step),
+					Index:   int64(index),
+					Value:   fmt.Sprintf("text-%d-%d", step, index),
+					Context: models.Context{Json: []byte(`{"subset":"val"}`)},
+				})
+				s.Require().Nil(err)
+			}
+		}
+	}
+	run1, run2 := runs[0], runs[1]
+
+	tests := []struct {
+		name                       string
+		request                    request.SearchArtifactsRequest
+		includedRuns               []*models.Run
+		excludedRuns               []*models.Run
+		expectedRecordRangeUsedMax int64
+		expectedIndexRangeUsedMax  int64
+		expectedTextIndexesPresent []int
+		expectedTextIndexesAbsent  []int
+		expectedStepIndexesPresent []int
+		expectedStepIndexesAbsent  []int
+	}{
+		{
+			name: "SearchTexts",
+			request: request.SearchArtifactsRequest{
+				Query: `((texts.name == "some-name") or (texts.name == "other-name"))`,
+			},
+			includedRuns:               []*models.Run{run1, run2},
+			expectedRecordRangeUsedMax: 4,
+			expectedIndexRangeUsedMax:  4,
+			expectedTextIndexesPresent: []int{0, 1, 2, 3, 4},
+			expectedStepIndexesPresent: []int{0, 1, 2, 3, 4},
+		},
+		{
+			name: "SearchTextsWithNameQuery",
+			request: request.SearchArtifactsRequest{
+				Query: `((texts.name == "some-name"))`,
+			},
+			includedRuns:               []*models.Run{run1},
+			excludedRuns:               []*models.Run{run2},
+			expectedRecordRangeUsedMax: 4,
+			expectedIndexRangeUsedMax:  4,
+			expectedTextIndexesPresent: []int{0, 1, 2, 3, 4},
+			expectedStepIndexesPresent: []int{0, 1, 2, 3, 4},
+		},
+		{
+			name: "SearchTextsWithRecordRange",
+			request: request.SearchArtifactsRequest{
+				Query:       `((texts.name == "some-name"))`,
+				RecordRange: "0:2",
+			},
+			includedRuns:               []*models.Run{run1},
+			excludedRuns:               []*models.Run{run2},
+			expectedRecordRangeUsedMax: 2,
+			expectedIndexRangeUsedMax:  4,
+			expectedTextIndexesPresent: []int{0, 1, 2, 3, 4},
+			expectedStepIndexesPresent: []int{0, 1, 2},
+			expectedStepIndexesAbsent:  []int{3, 4},
+		},
+		{
+			name: "SearchTextsWithIndexRange",
+			request: request.SearchArtifactsRequest{
+				Query:      `((texts.name == "some-name"))`,
+				IndexRange: "0:2",
+			},
+			includedRuns:               []*models.Run{run1},
+			excludedRuns:               []*models.Run{run2},
+			expectedRecordRangeUsedMax: 4,
+			expectedIndexRangeUsedMax:  2,
+			expectedTextIndexesPresent: []int{0, 1, 2},
+			expectedTextIndexesAbsent:  []int{3, 4},
+			expectedStepIndexesPresent: []int{0, 1, 2, 3, 4},
+		},
+		{
+			name: "SearchTextsWithRecordAndIndexDensity",
+			request: request.SearchArtifactsRequest{
+				Query:         `((texts.name == "other-name"))`,
+				RecordDensity: 1,
+				IndexDensity:  1,
+			},
+			includedRuns:               []*models.Run{run2},
+			excludedRuns:               []*models.Run{run1},
+			expectedRecordRangeUsedMax: 4,
+			expectedIndexRangeUsedMax:  4,
+			expectedTextIndexesPresent: []int{0},
+			expectedTextIndexesAbsent:  []int{1, 2, 3, 4},
+			expectedStepIndexesPresent: []int{0},
+			expectedStepIndexesAbsent:  []int{1, 2, 3, 4},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := new(bytes.Buffer)
+			s.Require().Nil(
+				s.AIMClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponseType(
+					helpers.ResponseTypeBuffer,
+				).WithResponse(
+					resp,
+				).DoRequest("/runs/search/texts"),
+			)
+
+			decodedData, err := encoding.NewDecoder(resp).Decode()
+			s.Require().Nil(err)
+
+			for _, run := range tt.includedRuns {
+				rangesPrefix := fmt.Sprintf("%v.ranges", run.ID)
+				s.Equal(tt.expectedRecordRangeUsedMax, decodedData[rangesPrefix+".record_range_used.1"])
+				s.Equal(tt.expectedIndexRangeUsedMax, decodedData[rangesPrefix+".index_range_used.1"])
+				s.Equal(run.Name, decodedData[fmt.Sprintf("%v.props.name", run.ID)])
+
+				tracesPrefix := fmt.Sprintf("%v.traces.0", run.ID)
+				s.Equal("val", decodedData[tracesPrefix+".context.subset"])
+				for _, stepIndex := range tt.expectedStepIndexesPresent {
+					for _, textIndex := range tt.expectedTextIndexesPresent {
+						valuePrefix := fmt.Sprintf("%s.values.%d.%d", tracesPrefix, stepIndex, textIndex)
+						s.Equal(fmt.Sprintf("text-%d-%d", stepIndex, textIndex), decodedData[valuePrefix+".data"])
+						s.Equal(int64(textIndex), decodedData[valuePrefix+".index"])
+					}
+					for _, textIndex := range tt.expectedTextIndexesAbsent {
+						valuePrefix := fmt.Sprintf("%s.values.%d.%d", tracesPrefix, stepIndex, textIndex)
+						s.NotContains(decodedData, valuePrefix+".data")
+					}
+				}
+				for _, stepIndex := range tt.expectedStepIndexesAbsent {
+					valuePrefix := fmt.Sprintf("%s.values.%d.0", tracesPrefix, stepIndex)
+					s.NotContains(decodedData, valuePrefix+".data")
+				}
+			}
+			for _, run := range tt.excludedRuns {
+				s.NotContains(decodedData, fmt.Sprintf("%v.props.name", run.ID))
+			}
+		})
+	}
+}
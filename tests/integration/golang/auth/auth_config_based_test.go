@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"net/http"
 	"os"
 	"testing"
 
@@ -237,6 +238,39 @@ func (s *ConfigAuthTestSuite) TestAIMAuth_Ok() {
 				s.Equal("FastTrackML", successResponse.Name)
 			},
 		},
+		{
+			name: "TestUser1NoteAuthor",
+			check: func() {
+				// check that note author is taken from the auth token of user1.
+				experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+					Name:           "experiment-with-note",
+					NamespaceID:    namespace1.ID,
+					LifecycleStage: models.LifecycleStageActive,
+				})
+				s.Require().Nil(err)
+
+				basicAuthToken := base64.StdEncoding.EncodeToString(
+					[]byte(fmt.Sprintf("%s:%s", "user1", "user1password")),
+				)
+				resp := aimResponse.NoteResponse{}
+				s.Require().Nil(
+					s.AIMClient().WithMethod(
+						http.MethodPost,
+					).WithRequest(
+						map[string]any{"content": "note"},
+					).WithResponse(
+						&resp,
+					).WithNamespace(
+						namespace1.Code,
+					).WithHeaders(map[string]string{
+						"Authorization": fmt.Sprintf("Basic %s", basicAuthToken),
+						"Content-Type":  "application/json",
+					}).DoRequest("/experiments/%d/note", *experiment.ID),
+				)
+				s.Equal("note", resp.Content)
+				s.Equal("user1", resp.Author)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,204 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/zeebo/assert"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	mlflowResponse "github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/config"
+	"github.com/G-Research/fasttrackml/pkg/common/config/auth"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type HtpasswdAuthTestSuite struct {
+	helpers.BaseTestSuite
+	configPath string
+}
+
+func TestHtpasswdAuthTestSuite(t *testing.T) {
+	testSuite := new(HtpasswdAuthTestSuite)
+	testSuite.configPath = fmt.Sprintf("%s/users.htpasswd", t.TempDir())
+	assert.Nil(t, writeHtpasswdUsersConfig(testSuite.configPath, "user1", "user1password"))
+
+	testSuite.Config = config.Config{
+		Auth: auth.Config{
+			AuthUsersConfig: testSuite.configPath,
+		},
+	}
+	assert.Nil(t, testSuite.Config.Validate())
+	suite.Run(t, testSuite)
+}
+
+func (s *HtpasswdAuthTestSuite) TestHashedPassword_Ok() {
+	namespace1, err := s.NamespaceFixtures.CreateNamespace(context.Background(), &models.Namespace{
+		ID:                  2,
+		Code:                "namespace1",
+		DefaultExperimentID: common.GetPointer(models.DefaultExperimentID),
+	})
+	s.Require().Nil(err)
+	_, err = s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           "experiment1",
+		NamespaceID:    namespace1.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	searchExperiments := func(username, password string) (int, error) {
+		resp := mlflowResponse.SearchExperimentsResponse{}
+		client := s.MlflowClient()
+		err := client.WithMethod(
+			http.MethodPost,
+		).WithNamespace(
+			namespace1.Code,
+		).WithHeaders(map[string]string{
+			"Authorization": fmt.Sprintf(
+				"Basic %s", base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%s", username, password))),
+			),
+			"Content-Type": "application/json",
+		}).WithRequest(
+			map[string]any{},
+		).WithResponse(
+			&resp,
+		).DoRequest("%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsSearchRoute)
+		return client.GetStatusCode(), err
+	}
+
+	statusCode, err := searchExperiments("user1", "user1password")
+	s.Require().Nil(err)
+	s.Equal(http.StatusOK, statusCode)
+
+	statusCode, err = searchExperiments("user1", "wrongpassword")
+	s.Require().Nil(err)
+	s.Equal(http.StatusNotFound, statusCode)
+
+	// password change is applied without restart, once users configuration is reloaded.
+	s.Require().Nil(writeHtpasswdUsersConfig(s.configPath, "user1", "newpassword"))
+	s.Require().Nil(s.Config.Auth.ReloadUsersConfiguration())
+
+	statusCode, err = searchExperiments("user1", "user1password")
+	s.Require().Nil(err)
+	s.Equal(http.StatusNotFound, statusCode)
+
+	statusCode, err = searchExperiments("user1", "newpassword")
+	s.Require().Nil(err)
+	s.Equal(http.StatusOK, statusCode)
+
+	// viewer level from htpasswd roles is respected.
+	errorResponse := api.ErrorResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithNamespace(
+			namespace1.Code,
+		).WithHeaders(map[string]string{
+			"Authorization": fmt.Sprintf(
+				"Basic %s", base64.StdEncoding.EncodeToString([]byte("user1:newpassword")),
+			),
+			"Content-Type": "application/json",
+		}).WithRequest(
+			map[string]any{"name": "experiment2"},
+		).WithResponse(
+			&errorResponse,
+		).DoRequest("%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsCreateRoute),
+	)
+	s.Equal(http.StatusForbidden, errorResponse.StatusCode)
+}
+
+// writeHtpasswdUsersConfig writes htpasswd users configuration with a single viewer of namespace1.
+func writeHtpasswdUsersConfig(configPath, username, password string) error {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(configPath, []byte(fmt.Sprintf("%s:%s:ns:namespace1:viewer\n", username, hash)), 0o600)
+}
+
+type HashedPasswordAuthTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestHashedPasswordAuthTestSuite(t *testing.T) {
+	hash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	assert.Nil(t, err)
+
+	// single user is configured with hashed password.
+	testSuite := new(HashedPasswordAuthTestSuite)
+	testSuite.Config = config.Config{
+		Auth: auth.Config{
+			AuthUsername: "user",
+			AuthPassword: string(hash),
+		},
+	}
+	assert.Nil(t, testSuite.Config.Validate())
+	suite.Run(t, testSuite)
+}
+
+func (s *HashedPasswordAuthTestSuite) TestHashedPassword_Ok() {
+	tests := []struct {
+		name       string
+		username   string
+		password   string
+		statusCode int
+	}{
+		{
+			name:       "CorrectPassword",
+			username:   "user",
+			password:   "password",
+			statusCode: http.StatusOK,
+		},
+		{
+			name:       "CorrectPasswordVerifiedBefore",
+			username:   "user",
+			password:   "password",
+			statusCode: http.StatusOK,
+		},
+		{
+			name:       "WrongPassword",
+			username:   "user",
+			password:   "wrongpassword",
+			statusCode: http.StatusUnauthorized,
+		},
+		{
+			name:       "WrongUsername",
+			username:   "other",
+			password:   "password",
+			statusCode: http.StatusUnauthorized,
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			client := s.MlflowClient()
+			s.Require().Nil(
+				client.WithMethod(
+					http.MethodPost,
+				).WithHeaders(map[string]string{
+					"Authorization": fmt.Sprintf(
+						"Basic %s",
+						base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%s", tt.username, tt.password))),
+					),
+					"Content-Type": "application/json",
+				}).WithRequest(
+					map[string]any{},
+				).WithResponseType(
+					helpers.ResponseTypeBuffer,
+				).WithResponse(
+					new(bytes.Buffer),
+				).DoRequest("%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsSearchRoute),
+			)
+			s.Equal(tt.statusCode, client.GetStatusCode())
+		})
+	}
+}
@@ -0,0 +1,296 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/oauth2-proxy/mockoidc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	mlflowResponse "github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/config"
+	"github.com/G-Research/fasttrackml/pkg/common/config/auth"
+	commonModels "github.com/G-Research/fasttrackml/pkg/common/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers/oidc"
+)
+
+const (
+	jwtTestIssuer   = "https://idp.example.com"
+	jwtTestAudience = "fasttrackml"
+)
+
+type JWTAuthTestSuite struct {
+	helpers.BaseTestSuite
+	jwtIssuer *oidc.JWTIssuer
+}
+
+func TestJWTAuthTestSuite(t *testing.T) {
+	jwtIssuer, err := oidc.NewJWTIssuer(jwtTestIssuer)
+	assert.Nil(t, err)
+	jwksPath := fmt.Sprintf("%s/jwks.json", t.TempDir())
+	assert.Nil(t, jwtIssuer.WriteJWKS(jwksPath))
+
+	// bearer JWT is the only authentication and keys are loaded from the local file.
+	testSuite := new(JWTAuthTestSuite)
+	testSuite.Config = config.Config{
+		Auth: auth.Config{
+			AuthOIDCAdminRole:  "admin",
+			AuthOIDCClaimRoles: "groups",
+			AuthJWTAudience:    jwtTestAudience,
+			AuthJWTIssuer:      jwtTestIssuer,
+			AuthJWTJWKSFile:    jwksPath,
+		},
+	}
+	assert.Nil(t, testSuite.Config.Validate())
+	testSuite.jwtIssuer = jwtIssuer
+	suite.Run(t, testSuite)
+}
+
+func (s *JWTAuthTestSuite) TestJWT_Ok() {
+	namespace1, err := s.NamespaceFixtures.CreateNamespace(context.Background(), &models.Namespace{
+		ID:                  2,
+		Code:                "namespace1",
+		DefaultExperimentID: common.GetPointer(models.DefaultExperimentID),
+	})
+	s.Require().Nil(err)
+	_, err = s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           "experiment1",
+		NamespaceID:    namespace1.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	viewersRole := models.Role{Name: "viewers"}
+	s.Require().Nil(s.RolesFixtures.CreateRole(context.Background(), &viewersRole))
+	s.Require().Nil(s.RolesFixtures.AttachNamespaceToRoleWithLevel(
+		context.Background(), &viewersRole, namespace1, commonModels.PermissionLevelViewer,
+	))
+
+	viewerToken, err := s.jwtIssuer.Issue("batch-job", jwtTestAudience, time.Hour, map[string]any{
+		"groups": []string{"viewers"},
+	})
+	s.Require().Nil(err)
+	adminToken, err := s.jwtIssuer.Issue("admin-job", jwtTestAudience, time.Hour, map[string]any{
+		"groups": "admin",
+	})
+	s.Require().Nil(err)
+
+	// viewer could read namespace resources.
+	searchResponse := mlflowResponse.SearchExperimentsResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithNamespace(
+			namespace1.Code,
+		).WithHeaders(
+			bearerHeaders(viewerToken),
+		).WithRequest(
+			map[string]any{},
+		).WithResponse(
+			&searchResponse,
+		).DoRequest("%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsSearchRoute),
+	)
+	s.NotEmpty(searchResponse.Experiments)
+
+	// but roles are mapped to the permission levels in the same way as for OIDC users.
+	errorResponse := api.ErrorResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithNamespace(
+			namespace1.Code,
+		).WithHeaders(
+			bearerHeaders(viewerToken),
+		).WithRequest(
+			request.CreateExperimentRequest{Name: "experiment2"},
+		).WithResponse(
+			&errorResponse,
+		).DoRequest("%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsCreateRoute),
+	)
+	s.Equal(http.StatusForbidden, errorResponse.StatusCode)
+
+	// viewer has no access to the namespace without role.
+	errorResponse = api.ErrorResponse{}
+	s.Require().Nil(
+		s.AIMClient().WithHeaders(
+			bearerHeaders(viewerToken),
+		).WithResponse(
+			&errorResponse,
+		).DoRequest("/experiments/"),
+	)
+	s.Equal(http.StatusNotFound, errorResponse.StatusCode)
+
+	// viewer has no access to admin resources.
+	errorResponse = api.ErrorResponse{}
+	s.Require().Nil(
+		s.AdminClient().WithHeaders(
+			bearerHeaders(viewerToken),
+		).WithResponse(
+			&errorResponse,
+		).DoRequest("/api/tokens"),
+	)
+	s.Equal(http.StatusNotFound, errorResponse.StatusCode)
+
+	// admin has access to everything.
+	createResponse := mlflowResponse.CreateExperimentResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithNamespace(
+			namespace1.Code,
+		).WithHeaders(
+			bearerHeaders(adminToken),
+		).WithRequest(
+			request.CreateExperimentRequest{Name: "experiment2"},
+		).WithResponse(
+			&createResponse,
+		).DoRequest("%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsCreateRoute),
+	)
+	s.NotEmpty(createResponse.ID)
+
+	var tokens []map[string]any
+	s.Require().Nil(
+		s.AdminClient().WithHeaders(
+			bearerHeaders(adminToken),
+		).WithResponse(
+			&tokens,
+		).DoRequest("/api/tokens"),
+	)
+	s.Empty(tokens)
+}
+
+func (s *JWTAuthTestSuite) TestJWT_Error() {
+	otherIssuer, err := oidc.NewJWTIssuer(jwtTestIssuer)
+	s.Require().Nil(err)
+	wrongIssuer, err := oidc.NewJWTIssuer("https://other.example.com")
+	s.Require().Nil(err)
+
+	claims := map[string]any{"groups": "admin"}
+	issue := func(issuer *oidc.JWTIssuer, audience string, expiresIn time.Duration) string {
+		token, err := issuer.Issue("admin-job", audience, expiresIn, claims)
+		s.Require().Nil(err)
+		return token
+	}
+
+	tests := []struct {
+		name    string
+		headers map[string]string
+		error   *api.ErrorResponse
+	}{
+		{
+			name:    "NoToken",
+			headers: map[string]string{},
+			error:   api.NewUnauthenticatedError("bearer token is required"),
+		},
+		{
+			name:    "MalformedToken",
+			headers: bearerHeaders("not-a-jwt"),
+			error:   api.NewUnauthenticatedError("bearer token is invalid or expired"),
+		},
+		{
+			name:    "UnknownSigningKey",
+			headers: bearerHeaders(issue(otherIssuer, jwtTestAudience, time.Hour)),
+			error:   api.NewUnauthenticatedError("bearer token is invalid or expired"),
+		},
+		{
+			name:    "WrongIssuer",
+			headers: bearerHeaders(issue(wrongIssuer, jwtTestAudience, time.Hour)),
+			error:   api.NewUnauthenticatedError("bearer token is invalid or expired"),
+		},
+		{
+			name:    "WrongAudience",
+			headers: bearerHeaders(issue(s.jwtIssuer, "other-service", time.Hour)),
+			error:   api.NewUnauthenticatedError("bearer token is invalid or expired"),
+		},
+		{
+			name:    "ExpiredToken",
+			headers: bearerHeaders(issue(s.jwtIssuer, jwtTestAudience, -time.Hour)),
+			error:   api.NewUnauthenticatedError("bearer token is invalid or expired"),
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			errorResponse := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithHeaders(
+					tt.headers,
+				).WithRequest(
+					map[string]any{},
+				).WithResponse(
+					&errorResponse,
+				).DoRequest("%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsSearchRoute),
+			)
+			s.Equal(http.StatusUnauthorized, errorResponse.StatusCode)
+			s.Equal(tt.error.Error(), errorResponse.Error())
+		})
+	}
+}
+
+type JWTDiscoveryAuthTestSuite struct {
+	helpers.BaseTestSuite
+	oidcMockServer *oidc.MockServer
+}
+
+func TestJWTDiscoveryAuthTestSuite(t *testing.T) {
+	oidcMockServer, err := oidc.NewMockServer()
+	assert.Nil(t, err)
+
+	// keys and issuer are discovered from the OIDC provider.
+	testSuite := new(JWTDiscoveryAuthTestSuite)
+	testSuite.Config = config.Config{
+		Auth: auth.Config{
+			AuthOIDCAdminRole:        "admin",
+			AuthOIDCClaimRoles:       "groups",
+			AuthOIDCProviderEndpoint: oidcMockServer.Address(),
+			AuthJWTAudience:          oidcMockServer.ClientID(),
+		},
+	}
+	assert.Nil(t, testSuite.Config.Validate())
+	testSuite.oidcMockServer = oidcMockServer
+	suite.Run(t, testSuite)
+}
+
+func (s *JWTDiscoveryAuthTestSuite) TestJWT_Ok() {
+	token, err := s.oidcMockServer.Login(
+		context.Background(),
+		&mockoidc.MockUser{
+			Email:  "batch.job@example.com",
+			Groups: []string{"admin"},
+		}, []string{"openid", "groups"},
+	)
+	s.Require().Nil(err)
+
+	searchResponse := mlflowResponse.SearchExperimentsResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithHeaders(
+			bearerHeaders(token),
+		).WithRequest(
+			map[string]any{},
+		).WithResponse(
+			&searchResponse,
+		).DoRequest("%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsSearchRoute),
+	)
+	s.NotEmpty(searchResponse.Experiments)
+}
+
+// bearerHeaders returns headers to authenticate request with bearer token.
+func bearerHeaders(token string) map[string]string {
+	return map[string]string{
+		"Authorization": fmt.Sprintf("Bearer %s", token),
+		"Content-Type":  "application/json",
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/G-Research/fasttrackml/pkg/common/api"
 	"github.com/G-Research/fasttrackml/pkg/common/config"
 	"github.com/G-Research/fasttrackml/pkg/common/config/auth"
+	commonModels "github.com/G-Research/fasttrackml/pkg/common/dao/models"
 	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
 	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers/oidc"
 )
@@ -32,6 +33,7 @@ type OIDCAuthTestSuite struct {
 	user2Token     string
 	user3Token     string
 	user4Token     string
+	user5Token     string
 	oidcMockServer *oidc.MockServer
 }
 
@@ -96,6 +98,12 @@ func (s *OIDCAuthTestSuite) SetupTestSuite() {
 	s.Nil(s.RolesFixtures.AttachNamespaceToRole(context.Background(), &group2Role, namespace2))
 	s.Nil(s.RolesFixtures.AttachNamespaceToRole(context.Background(), &group2Role, namespace3))
 
+	group3Role := models.Role{Name: "group3"}
+	s.Nil(s.RolesFixtures.CreateRole(context.Background(), &group3Role))
+	s.Nil(s.RolesFixtures.AttachNamespaceToRoleWithLevel(
+		context.Background(), &group3Role, namespace1, commonModels.PermissionLevelViewer,
+	))
+
 	// create test users and obtain theirs tokens.
 	user1Token, err := s.oidcMockServer.Login(
 		context.Background(),
@@ -136,6 +144,59 @@ func (s *OIDCAuthTestSuite) SetupTestSuite() {
 	)
 	s.Nil(err)
 	s.user4Token = user4Token
+
+	user5Token, err := s.oidcMockServer.Login(
+		context.Background(),
+		&mockoidc.MockUser{
+			Email:  "test.user@example.com",
+			Groups: []string{"group3"},
+		}, []string{"openid", "groups"},
+	)
+	s.Nil(err)
+	s.user5Token = user5Token
+}
+
+func (s *OIDCAuthTestSuite) TestPermissionLevel_Ok() {
+	s.SetupTestSuite()
+
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           "experiment1",
+		NamespaceID:    s.namespace1.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	// check that user5 with `viewer` role level is able to read namespace1 resources.
+	successResponse := aimResponse.GetProjectResponse{}
+	s.Require().Nil(
+		s.AIMClient().WithResponse(
+			&successResponse,
+		).WithNamespace(
+			s.namespace1.Code,
+		).WithCookie(
+			"access_token", s.user5Token,
+		).DoRequest("/projects"),
+	)
+	s.Equal("FastTrackML", successResponse.Name)
+
+	// check that user5 is unable to delete namespace1 resources.
+	errorResponse := api.ErrorResponse{}
+	s.Require().Nil(
+		s.AIMClient().WithMethod(
+			http.MethodDelete,
+		).WithResponse(
+			&errorResponse,
+		).WithNamespace(
+			s.namespace1.Code,
+		).WithCookie(
+			"access_token", s.user5Token,
+		).DoRequest("/experiments/%d", *experiment.ID),
+	)
+	s.Equal(http.StatusForbidden, errorResponse.StatusCode)
+	s.Equal(
+		"PERMISSION_DENIED: `owner` permission level to namespace namespace1 is required, but `viewer` is granted",
+		errorResponse.Error(),
+	)
 }
 
 func (s *OIDCAuthTestSuite) TestAIMAuth_Ok() {
@@ -0,0 +1,292 @@
+package auth
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/zeebo/assert"
+	"gopkg.in/yaml.v3"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	mlflowResponse "github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/config"
+	"github.com/G-Research/fasttrackml/pkg/common/config/auth"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type PermissionLevelAuthTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestPermissionLevelAuthTestSuite(t *testing.T) {
+	data, err := yaml.Marshal(auth.YamlConfig{
+		Users: []auth.YamlUserConfig{
+			{
+				Name:     "viewer",
+				Roles:    []string{"ns:namespace1:viewer"},
+				Password: "viewerpassword",
+			},
+			{
+				Name:     "editor",
+				Roles:    []string{"ns:namespace1:editor"},
+				Password: "editorpassword",
+			},
+			{
+				Name:     "owner",
+				Roles:    []string{"ns:namespace1"},
+				Password: "ownerpassword",
+			},
+		},
+	})
+	assert.Nil(t, err)
+
+	configPath := fmt.Sprintf("%s/users-config.yaml", t.TempDir())
+	assert.Nil(t, os.WriteFile(configPath, data, 0o600))
+
+	testSuite := new(PermissionLevelAuthTestSuite)
+	testSuite.Config = config.Config{
+		Auth: auth.Config{
+			AuthUsersConfig: configPath,
+		},
+		ServeArtifacts:       true,
+		ArtifactsDestination: t.TempDir(),
+	}
+	assert.Nil(t, testSuite.Config.Validate())
+	suite.Run(t, testSuite)
+}
+
+func (s *PermissionLevelAuthTestSuite) TestPermissionLevels() {
+	namespace1, err := s.NamespaceFixtures.CreateNamespace(context.Background(), &models.Namespace{
+		ID:                  2,
+		Code:                "namespace1",
+		DefaultExperimentID: common.GetPointer(models.DefaultExperimentID),
+	})
+	s.Require().Nil(err)
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           "experiment1",
+		NamespaceID:    namespace1.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	headers := func(user string) map[string]string {
+		return map[string]string{
+			"Authorization": fmt.Sprintf(
+				"Basic %s", base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%spassword", user, user))),
+			),
+			"Content-Type": "application/json",
+		}
+	}
+
+	tests := []struct {
+		name  string
+		check func()
+	}{
+		{
+			name: "ViewerCanOnlyRead",
+			check: func() {
+				searchResponse := mlflowResponse.SearchExperimentsResponse{}
+				s.Require().Nil(
+					s.MlflowClient().WithMethod(
+						http.MethodPost,
+					).WithNamespace(
+						namespace1.Code,
+					).WithHeaders(
+						headers("viewer"),
+					).WithRequest(
+						map[string]any{},
+					).WithResponse(
+						&searchResponse,
+					).DoRequest("%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsSearchRoute),
+				)
+				s.NotEmpty(searchResponse.Experiments)
+
+				errorResponse := api.ErrorResponse{}
+				s.Require().Nil(
+					s.MlflowClient().WithMethod(
+						http.MethodPost,
+					).WithNamespace(
+						namespace1.Code,
+					).WithHeaders(
+						headers("viewer"),
+					).WithRequest(
+						request.CreateExperimentRequest{Name: "viewer-experiment"},
+					).WithResponse(
+						&errorResponse,
+					).DoRequest("%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsCreateRoute),
+				)
+				s.Equal(http.StatusForbidden, errorResponse.StatusCode)
+				s.Equal(
+					"PERMISSION_DENIED: `editor` permission level to namespace namespace1 is required, "+
+						"but `viewer` is granted",
+					errorResponse.Error(),
+				)
+
+				errorResponse = api.ErrorResponse{}
+				s.Require().Nil(
+					s.AIMClient().WithMethod(
+						http.MethodDelete,
+					).WithNamespace(
+						namespace1.Code,
+					).WithHeaders(
+						headers("viewer"),
+					).WithResponse(
+						&errorResponse,
+					).DoRequest("/experiments/%d", *experiment.ID),
+				)
+				s.Equal(http.StatusForbidden, errorResponse.StatusCode)
+				s.Equal(
+					"PERMISSION_DENIED: `owner` permission level to namespace namespace1 is required, "+
+						"but `viewer` is granted",
+					errorResponse.Error(),
+				)
+
+				// required permission level doesn't depend on the user-supplied artifact path.
+				for _, path := range []string{
+					"%d/run/artifacts/search/model.bin",
+					"%d/run/artifacts/get-model",
+				} {
+					errorResponse = api.ErrorResponse{}
+					s.Require().Nil(
+						s.MlflowArtifactsClient().WithMethod(
+							http.MethodPut,
+						).WithNamespace(
+							namespace1.Code,
+						).WithHeaders(
+							headers("viewer"),
+						).WithRequest(
+							strings.NewReader("content"),
+						).WithResponse(
+							&errorResponse,
+						).DoRequest("%s/"+path, mlflow.ProxyArtifactsRoutePrefix, *experiment.ID),
+					)
+					s.Equal(http.StatusForbidden, errorResponse.StatusCode)
+					s.Equal(
+						"PERMISSION_DENIED: `editor` permission level to namespace namespace1 is required, "+
+							"but `viewer` is granted",
+						errorResponse.Error(),
+					)
+				}
+
+				// Aim remote tracking protocol is used only to write the data.
+				errorResponse = api.ErrorResponse{}
+				s.Require().Nil(
+					s.TrackingClient().WithNamespace(
+						namespace1.Code,
+					).WithHeaders(
+						headers("viewer"),
+					).WithResponse(
+						&errorResponse,
+					).DoRequest("/client/connect/%s/", "viewer-client"),
+				)
+				s.Equal(http.StatusForbidden, errorResponse.StatusCode)
+				s.Equal(
+					"PERMISSION_DENIED: `editor` permission level to namespace namespace1 is required, "+
+						"but `viewer` is granted",
+					errorResponse.Error(),
+				)
+			},
+		},
+		{
+			name: "EditorCanCreateButNotDelete",
+			check: func() {
+				createResponse := mlflowResponse.CreateExperimentResponse{}
+				s.Require().Nil(
+					s.MlflowClient().WithMethod(
+						http.MethodPost,
+					).WithNamespace(
+						namespace1.Code,
+					).WithHeaders(
+						headers("editor"),
+					).WithRequest(
+						request.CreateExperimentRequest{Name: "editor-experiment"},
+					).WithResponse(
+						&createResponse,
+					).DoRequest("%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsCreateRoute),
+				)
+				s.NotEmpty(createResponse.ID)
+
+				s.Require().Nil(
+					s.TrackingClient().WithNamespace(
+						namespace1.Code,
+					).WithHeaders(
+						headers("editor"),
+					).DoRequest("/client/connect/%s/", "editor-client"),
+				)
+
+				s.Require().Nil(
+					s.MlflowArtifactsClient().WithMethod(
+						http.MethodPut,
+					).WithNamespace(
+						namespace1.Code,
+					).WithHeaders(
+						headers("editor"),
+					).WithRequest(
+						strings.NewReader("content"),
+					).WithResponse(
+						&map[string]any{},
+					).DoRequest("%s/%d/run/artifacts/delete-me", mlflow.ProxyArtifactsRoutePrefix, *experiment.ID),
+				)
+
+				errorResponse := api.ErrorResponse{}
+				s.Require().Nil(
+					s.MlflowClient().WithMethod(
+						http.MethodPost,
+					).WithNamespace(
+						namespace1.Code,
+					).WithHeaders(
+						headers("editor"),
+					).WithRequest(
+						request.DeleteExperimentRequest{ID: createResponse.ID},
+					).WithResponse(
+						&errorResponse,
+					).DoRequest("%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsDeleteRoute),
+				)
+				s.Equal(http.StatusForbidden, errorResponse.StatusCode)
+				s.Equal(
+					"PERMISSION_DENIED: `owner` permission level to namespace namespace1 is required, "+
+						"but `editor` is granted",
+					errorResponse.Error(),
+				)
+			},
+		},
+		{
+			name: "OwnerCanDelete",
+			check: func() {
+				s.Require().Nil(
+					s.MlflowClient().WithMethod(
+						http.MethodPost,
+					).WithNamespace(
+						namespace1.Code,
+					).WithHeaders(
+						headers("owner"),
+					).WithRequest(
+						request.DeleteExperimentRequest{ID: fmt.Sprintf("%d", *experiment.ID)},
+					).DoRequest("%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsDeleteRoute),
+				)
+
+				deleted, err := s.ExperimentFixtures.GetByNamespaceIDAndExperimentID(
+					context.Background(), namespace1.ID, *experiment.ID,
+				)
+				s.Require().Nil(err)
+				s.Equal(models.LifecycleStageDeleted, deleted.LifecycleStage)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.check()
+		})
+	}
+}
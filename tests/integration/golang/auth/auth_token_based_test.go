@@ -0,0 +1,206 @@
+package auth
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/zeebo/assert"
+	"gopkg.in/yaml.v3"
+
+	aimResponse "github.com/G-Research/fasttrackml/pkg/api/aim/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/config"
+	"github.com/G-Research/fasttrackml/pkg/common/config/auth"
+	commonModels "github.com/G-Research/fasttrackml/pkg/common/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/ui/admin/response"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type AccessTokenAuthTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestAccessTokenAuthTestSuite(t *testing.T) {
+	// access tokens work alongside users configuration.
+	data, err := yaml.Marshal(auth.YamlConfig{
+		Users: []auth.YamlUserConfig{
+			{
+				Name:     "user1",
+				Roles:    []string{"ns:namespace1"},
+				Password: "user1password",
+			},
+		},
+	})
+	assert.Nil(t, err)
+
+	configPath := fmt.Sprintf("%s/users-config.yaml", t.TempDir())
+	assert.Nil(t, os.WriteFile(configPath, data, 0o600))
+
+	testSuite := new(AccessTokenAuthTestSuite)
+	testSuite.Config = config.Config{
+		Auth: auth.Config{
+			AuthUsersConfig: configPath,
+		},
+	}
+	assert.Nil(t, testSuite.Config.Validate())
+	suite.Run(t, testSuite)
+}
+
+func (s *AccessTokenAuthTestSuite) TestAccessTokenAuth_Ok() {
+	namespace1, err := s.NamespaceFixtures.CreateNamespace(context.Background(), &models.Namespace{
+		ID:                  2,
+		Code:                "namespace1",
+		DefaultExperimentID: common.GetPointer(models.DefaultExperimentID),
+	})
+	s.Require().Nil(err)
+	namespace2, err := s.NamespaceFixtures.CreateNamespace(context.Background(), &models.Namespace{
+		ID:                  3,
+		Code:                "namespace2",
+		DefaultExperimentID: common.GetPointer(models.DefaultExperimentID),
+	})
+	s.Require().Nil(err)
+
+	userToken, err := s.AccessTokenFixtures.CreateAccessToken(context.Background(), &commonModels.AccessToken{
+		Name:  "user-token",
+		Roles: []string{"ns:namespace1"},
+	}, "ftml_user_token")
+	s.Require().Nil(err)
+	_, err = s.AccessTokenFixtures.CreateAccessToken(context.Background(), &commonModels.AccessToken{
+		Name:  "admin-token",
+		Roles: []string{"admin"},
+	}, "ftml_admin_token")
+	s.Require().Nil(err)
+	_, err = s.AccessTokenFixtures.CreateAccessToken(context.Background(), &commonModels.AccessToken{
+		Name:      "expired-token",
+		Roles:     []string{"ns:namespace1"},
+		ExpiresAt: common.GetPointer(time.Now().Add(-time.Minute)),
+	}, "ftml_expired_token")
+	s.Require().Nil(err)
+
+	tests := []struct {
+		name  string
+		check func()
+	}{
+		{
+			name: "TestUserTokenNamespaceAccessLimits",
+			check: func() {
+				successResponse := aimResponse.GetProjectResponse{}
+				s.Require().Nil(
+					s.AIMClient().WithResponse(
+						&successResponse,
+					).WithNamespace(
+						namespace1.Code,
+					).WithHeaders(map[string]string{
+						"Authorization": "Bearer ftml_user_token",
+					}).DoRequest("/projects"),
+				)
+				s.Equal("FastTrackML", successResponse.Name)
+
+				// last used time has been recorded.
+				token, err := s.AccessTokenFixtures.GetAccessToken(context.Background(), userToken.ID.String())
+				s.Require().Nil(err)
+				s.NotNil(token.LastUsedAt)
+
+				errorResponse := api.ErrorResponse{}
+				s.Require().Nil(
+					s.AIMClient().WithResponse(
+						&errorResponse,
+					).WithNamespace(
+						namespace2.Code,
+					).WithHeaders(map[string]string{
+						"Authorization": "Bearer ftml_user_token",
+					}).DoRequest("/projects"),
+				)
+				s.Equal(http.StatusNotFound, errorResponse.StatusCode)
+				s.Equal(
+					"RESOURCE_DOES_NOT_EXIST: unable to find namespace with code: namespace2", errorResponse.Error(),
+				)
+
+				// user token has no access to admin resources.
+				s.Require().Nil(
+					s.AdminClient().WithResponse(
+						&errorResponse,
+					).WithHeaders(map[string]string{
+						"Authorization": "Bearer ftml_user_token",
+					}).DoRequest("/api/tokens"),
+				)
+				s.Equal(http.StatusNotFound, errorResponse.StatusCode)
+			},
+		},
+		{
+			name: "TestAdminTokenAccess",
+			check: func() {
+				successResponse := aimResponse.GetProjectResponse{}
+				s.Require().Nil(
+					s.AIMClient().WithResponse(
+						&successResponse,
+					).WithNamespace(
+						namespace2.Code,
+					).WithHeaders(map[string]string{
+						"Authorization": "Bearer ftml_admin_token",
+					}).DoRequest("/projects"),
+				)
+				s.Equal("FastTrackML", successResponse.Name)
+
+				var tokens []response.AccessToken
+				s.Require().Nil(
+					s.AdminClient().WithResponse(
+						&tokens,
+					).WithHeaders(map[string]string{
+						"Authorization": "Bearer ftml_admin_token",
+					}).DoRequest("/api/tokens"),
+				)
+				s.Equal(3, len(tokens))
+			},
+		},
+		{
+			name: "TestInvalidTokens",
+			check: func() {
+				for _, token := range []string{"ftml_expired_token", "ftml_unknown_token"} {
+					errorResponse := api.ErrorResponse{}
+					s.Require().Nil(
+						s.AIMClient().WithResponse(
+							&errorResponse,
+						).WithNamespace(
+							namespace1.Code,
+						).WithHeaders(map[string]string{
+							"Authorization": fmt.Sprintf("Bearer %s", token),
+						}).DoRequest("/projects"),
+					)
+					s.Equal(http.StatusUnauthorized, errorResponse.StatusCode)
+					s.Equal("UNAUTHENTICATED: access token is invalid or expired", errorResponse.Error())
+				}
+			},
+		},
+		{
+			name: "TestBasicAuthStillWorks",
+			check: func() {
+				basicAuthToken := base64.StdEncoding.EncodeToString([]byte("user1:user1password"))
+				successResponse := aimResponse.GetProjectResponse{}
+				s.Require().Nil(
+					s.AIMClient().WithResponse(
+						&successResponse,
+					).WithNamespace(
+						namespace1.Code,
+					).WithHeaders(map[string]string{
+						"Authorization": fmt.Sprintf("Basic %s", basicAuthToken),
+					}).DoRequest("/projects"),
+				)
+				s.Equal("FastTrackML", successResponse.Name)
+			},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.check()
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	"gorm.io/gorm"
 
+	aimModels "github.com/G-Research/fasttrackml/pkg/api/aim/dao/models"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 	"github.com/G-Research/fasttrackml/pkg/database"
@@ -29,6 +30,8 @@ type rowCounts struct {
 	apps                     int
 	sharedTags               int
 	runSharedTags            int
+	notes                    int
+	reports                  int
 }
 
 type ImportTestSuite struct {
@@ -98,6 +101,12 @@ func (s *ImportTestSuite) populateDB(db *gorm.DB) {
 	sharedTagsFixtures, err := fixtures.NewSharedTagFixtures(db)
 	s.Require().Nil(err)
 
+	noteFixtures, err := fixtures.NewNoteFixtures(db)
+	s.Require().Nil(err)
+
+	reportFixtures, err := fixtures.NewReportFixtures(db)
+	s.Require().Nil(err)
+
 	namespace, err := namespaceFixtures.CreateNamespace(context.Background(), &models.Namespace{
 		Code:                "source-namespace",
 		DefaultExperimentID: common.GetPointer(models.DefaultExperimentID),
@@ -116,6 +125,14 @@ func (s *ImportTestSuite) populateDB(db *gorm.DB) {
 	s.Require().Nil(err)
 	s.runs = runs
 
+	// experiment note
+	_, err = noteFixtures.CreateNote(context.Background(), &aimModels.Note{
+		Content:      "experiment note",
+		ExperimentID: experiment.ID,
+		NamespaceID:  1,
+	})
+	s.Require().Nil(err)
+
 	// experiment 2
 	experiment, err = experimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
 		Name:           uuid.New().String(),
@@ -186,6 +203,25 @@ func (s *ImportTestSuite) populateDB(db *gorm.DB) {
 	s.Require().Nil(err)
 
 	s.Require().Nil(sharedTagsFixtures.Associate(context.Background(), sharedTag2.ID.String(), runs[0].ID))
+
+	// run note
+	_, err = noteFixtures.CreateNote(context.Background(), &aimModels.Note{
+		Content:     "run note",
+		Author:      "user",
+		RunID:       &runs[0].ID,
+		NamespaceID: namespace.ID,
+	})
+	s.Require().Nil(err)
+
+	// one report per namespace
+	for _, namespaceID := range []uint{1, namespace.ID} {
+		_, err = reportFixtures.CreateReport(context.Background(), &database.Report{
+			Name:        uuid.New().String(),
+			Code:        "# report",
+			NamespaceID: namespaceID,
+		})
+		s.Require().Nil(err)
+	}
 }
 
 func (s *ImportTestSuite) TearDownSubTest() {
@@ -214,6 +250,8 @@ func (s *ImportTestSuite) TestGlobalScopeImport_Ok() {
 					apps:                     3,
 					sharedTags:               2,
 					runSharedTags:            2,
+					notes:                    2,
+					reports:                  2,
 				})
 
 				// initially, dest DB is empty
@@ -237,6 +275,8 @@ func (s *ImportTestSuite) TestGlobalScopeImport_Ok() {
 					apps:                     3,
 					sharedTags:               2,
 					runSharedTags:            2,
+					notes:                    2,
+					reports:                  2,
 				})
 
 				// invoke the Importer.Import method a 2nd time
@@ -256,6 +296,8 @@ func (s *ImportTestSuite) TestGlobalScopeImport_Ok() {
 					apps:                     3,
 					sharedTags:               2,
 					runSharedTags:            2,
+					notes:                    2,
+					reports:                  2,
 				})
 
 				// confirm row-for-row equality
@@ -271,6 +313,8 @@ func (s *ImportTestSuite) TestGlobalScopeImport_Ok() {
 					"latest_metrics",
 					"shared_tags",
 					"run_shared_tags",
+					"notes",
+					"reports",
 				} {
 					s.validateTable(s.inputDB, s.outputDB, table)
 				}
@@ -309,6 +353,8 @@ func (s *ImportTestSuite) TestNamespaceScopeImport_Ok() {
 					apps:                     3,
 					sharedTags:               2,
 					runSharedTags:            2,
+					notes:                    2,
+					reports:                  2,
 				})
 
 				// initially, dest DB is empty
@@ -337,6 +383,8 @@ func (s *ImportTestSuite) TestNamespaceScopeImport_Ok() {
 					apps:                     1,
 					sharedTags:               1,
 					runSharedTags:            1,
+					notes:                    1,
+					reports:                  1,
 				})
 
 				// invoke the Importer.Import method a 2nd time
@@ -356,6 +404,8 @@ func (s *ImportTestSuite) TestNamespaceScopeImport_Ok() {
 					apps:                     1,
 					sharedTags:               1,
 					runSharedTags:            1,
+					notes:                    1,
+					reports:                  1,
 				})
 			})
 		}
@@ -402,6 +452,12 @@ func (s *ImportTestSuite) validateRowCounts(db *gorm.DB, counts rowCounts) {
 
 	s.Require().Nil(db.Model(&database.RunSharedTag{}).Count(&countVal).Error)
 	s.Equal(counts.runSharedTags, int(countVal), "Run shared tag count incorrect")
+
+	s.Require().Nil(db.Model(&database.Note{}).Count(&countVal).Error)
+	s.Equal(counts.notes, int(countVal), "Note count incorrect")
+
+	s.Require().Nil(db.Model(&database.Report{}).Count(&countVal).Error)
+	s.Equal(counts.reports, int(countVal), "Report count incorrect")
 }
 
 // validateTable will scan source and dest table and confirm they are identical
@@ -0,0 +1,51 @@
+package fixtures
+
+import (
+	"context"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/common/dao/models"
+)
+
+// AccessTokenFixtures represents data fixtures object.
+type AccessTokenFixtures struct {
+	baseFixtures
+}
+
+// NewAccessTokenFixtures creates new instance of AccessTokenFixtures.
+func NewAccessTokenFixtures(db *gorm.DB) (*AccessTokenFixtures, error) {
+	return &AccessTokenFixtures{
+		baseFixtures: baseFixtures{db: db},
+	}, nil
+}
+
+// CreateAccessToken creates a new test Access Token from the raw token value.
+func (f AccessTokenFixtures) CreateAccessToken(
+	ctx context.Context, token *models.AccessToken, rawToken string,
+) (*models.AccessToken, error) {
+	token.TokenHash = models.HashAccessToken(rawToken)
+	if err := f.db.WithContext(ctx).Create(token).Error; err != nil {
+		return nil, eris.Wrap(err, "error creating test access token")
+	}
+	return token, nil
+}
+
+// GetAccessToken returns Access Token by requested ID.
+func (f AccessTokenFixtures) GetAccessToken(ctx context.Context, id string) (*models.AccessToken, error) {
+	var token models.AccessToken
+	if err := f.db.WithContext(ctx).Where("id = ?", id).First(&token).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting access token by id: %s", id)
+	}
+	return &token, nil
+}
+
+// GetAccessTokens returns all the existing Access Tokens.
+func (f AccessTokenFixtures) GetAccessTokens(ctx context.Context) ([]models.AccessToken, error) {
+	var tokens []models.AccessToken
+	if err := f.db.WithContext(ctx).Order("created_at").Find(&tokens).Error; err != nil {
+		return nil, eris.Wrap(err, "error getting access tokens")
+	}
+	return tokens, nil
+}
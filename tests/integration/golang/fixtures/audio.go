@@ -0,0 +1,46 @@
+package fixtures
+
+import (
+	"context"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+)
+
+// AudioFixtures represents data fixtures object.
+type AudioFixtures struct {
+	baseFixtures
+	audioRepository repositories.AudioRepositoryProvider
+}
+
+// NewAudioFixtures creates new instance of AudioFixtures.
+func NewAudioFixtures(db *gorm.DB) (*AudioFixtures, error) {
+	return &AudioFixtures{
+		baseFixtures:    baseFixtures{db: db},
+		audioRepository: repositories.NewAudioRepository(db),
+	}, nil
+}
+
+// CreateAudio creates a new test Audio together with its context.
+func (f AudioFixtures) CreateAudio(ctx context.Context, audio *models.Audio) (*models.Audio, error) {
+	if err := f.audioRepository.Create(ctx, audio); err != nil {
+		return nil, eris.Wrap(err, "error creating test audio")
+	}
+	return audio, nil
+}
+
+// GetByRunID returns audio collection by requested Run ID.
+func (f AudioFixtures) GetByRunID(ctx context.Context, runID string) ([]models.Audio, error) {
+	var audios []models.Audio
+	if err := f.db.WithContext(ctx).Preload(
+		"Context",
+	).Where(
+		models.Audio{RunID: runID},
+	).Order("step").Order(`"index"`).Find(&audios).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting audios by run id: %s", runID)
+	}
+	return audios, nil
+}
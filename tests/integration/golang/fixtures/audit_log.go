@@ -0,0 +1,39 @@
+package fixtures
+
+import (
+	"context"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/common/dao/models"
+)
+
+// AuditLogFixtures represents data fixtures object.
+type AuditLogFixtures struct {
+	baseFixtures
+}
+
+// NewAuditLogFixtures creates new instance of AuditLogFixtures.
+func NewAuditLogFixtures(db *gorm.DB) (*AuditLogFixtures, error) {
+	return &AuditLogFixtures{
+		baseFixtures: baseFixtures{db: db},
+	}, nil
+}
+
+// CreateAuditLog creates a new test Audit Log record.
+func (f AuditLogFixtures) CreateAuditLog(ctx context.Context, auditLog *models.AuditLog) (*models.AuditLog, error) {
+	if err := f.db.WithContext(ctx).Create(auditLog).Error; err != nil {
+		return nil, eris.Wrap(err, "error creating test audit log")
+	}
+	return auditLog, nil
+}
+
+// GetAuditLogs returns all the existing Audit Log records.
+func (f AuditLogFixtures) GetAuditLogs(ctx context.Context) ([]models.AuditLog, error) {
+	var auditLogs []models.AuditLog
+	if err := f.db.WithContext(ctx).Order("created_at").Find(&auditLogs).Error; err != nil {
+		return nil, eris.Wrap(err, "error getting audit logs")
+	}
+	return auditLogs, nil
+}
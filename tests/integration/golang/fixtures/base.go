@@ -6,6 +6,7 @@ import (
 
 	aimModels "github.com/G-Research/fasttrackml/pkg/api/aim/dao/models"
 	mlflowModels "github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	commonModels "github.com/G-Research/fasttrackml/pkg/common/dao/models"
 )
 
 // baseFixtures represents base fixtures object.
@@ -21,14 +22,32 @@ func (f baseFixtures) TruncateTables() error {
 		return errors.Wrap(err, "error deleting from many2many table")
 	}
 	for _, table := range []interface{}{
+		aimModels.Note{},
 		aimModels.Dashboard{},
+		aimModels.Report{},
 		aimModels.App{},
 		aimModels.SharedTag{},
 		mlflowModels.Artifact{},
+		mlflowModels.ModelVersionTag{},
+		mlflowModels.ModelVersion{},
+		mlflowModels.RegisteredModelAlias{},
+		mlflowModels.RegisteredModelTag{},
+		mlflowModels.RegisteredModel{},
+		mlflowModels.TraceSpan{},
+		mlflowModels.TraceRequestMetadata{},
+		mlflowModels.TraceTag{},
+		mlflowModels.Trace{},
+		mlflowModels.InputTag{},
+		mlflowModels.Input{},
+		mlflowModels.Dataset{},
 		mlflowModels.Tag{},
 		mlflowModels.Param{},
 		mlflowModels.LatestMetric{},
 		mlflowModels.Metric{},
+		mlflowModels.Text{},
+		mlflowModels.Distribution{},
+		mlflowModels.Figure{},
+		mlflowModels.Audio{},
 		mlflowModels.Context{},
 		mlflowModels.Log{},
 		mlflowModels.Run{},
@@ -37,6 +56,8 @@ func (f baseFixtures) TruncateTables() error {
 		mlflowModels.Namespace{},
 		mlflowModels.RoleNamespace{},
 		mlflowModels.Role{},
+		commonModels.AccessToken{},
+		commonModels.AuditLog{},
 	} {
 		if err := f.db.Session(
 			&gorm.Session{AllowGlobalUpdate: true},
@@ -0,0 +1,48 @@
+package fixtures
+
+import (
+	"context"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+)
+
+// DistributionFixtures represents data fixtures object.
+type DistributionFixtures struct {
+	baseFixtures
+	distributionRepository repositories.DistributionRepositoryProvider
+}
+
+// NewDistributionFixtures creates new instance of DistributionFixtures.
+func NewDistributionFixtures(db *gorm.DB) (*DistributionFixtures, error) {
+	return &DistributionFixtures{
+		baseFixtures:           baseFixtures{db: db},
+		distributionRepository: repositories.NewDistributionRepository(db),
+	}, nil
+}
+
+// CreateDistribution creates a new test Distribution together with its context.
+func (f DistributionFixtures) CreateDistribution(
+	ctx context.Context, distribution *models.Distribution,
+) (*models.Distribution, error) {
+	if err := f.distributionRepository.Create(ctx, distribution); err != nil {
+		return nil, eris.Wrap(err, "error creating test distribution")
+	}
+	return distribution, nil
+}
+
+// GetByRunID returns distribution collection by requested Run ID.
+func (f DistributionFixtures) GetByRunID(ctx context.Context, runID string) ([]models.Distribution, error) {
+	var distributions []models.Distribution
+	if err := f.db.WithContext(ctx).Preload(
+		"Context",
+	).Where(
+		models.Distribution{RunID: runID},
+	).Order("step").Find(&distributions).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting distributions by run id: %s", runID)
+	}
+	return distributions, nil
+}
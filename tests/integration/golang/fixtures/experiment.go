@@ -34,6 +34,16 @@ func (f ExperimentFixtures) CreateExperiment(
 	return experiment, nil
 }
 
+// UpdateExperiment updates existing test Experiment.
+func (f ExperimentFixtures) UpdateExperiment(
+	ctx context.Context, experiment *models.Experiment,
+) (*models.Experiment, error) {
+	if err := f.experimentRepository.Update(ctx, experiment); err != nil {
+		return nil, eris.Wrap(err, "error updating test experiment")
+	}
+	return experiment, nil
+}
+
 // GetExperiments fetches all experiments.
 func (f ExperimentFixtures) GetExperiments(
 	ctx context.Context,
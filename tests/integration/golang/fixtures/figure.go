@@ -0,0 +1,46 @@
+package fixtures
+
+import (
+	"context"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+)
+
+// FigureFixtures represents data fixtures object.
+type FigureFixtures struct {
+	baseFixtures
+	figureRepository repositories.FigureRepositoryProvider
+}
+
+// NewFigureFixtures creates new instance of FigureFixtures.
+func NewFigureFixtures(db *gorm.DB) (*FigureFixtures, error) {
+	return &FigureFixtures{
+		baseFixtures:     baseFixtures{db: db},
+		figureRepository: repositories.NewFigureRepository(db),
+	}, nil
+}
+
+// CreateFigure creates a new test Figure together with its context.
+func (f FigureFixtures) CreateFigure(ctx context.Context, figure *models.Figure) (*models.Figure, error) {
+	if err := f.figureRepository.Create(ctx, figure); err != nil {
+		return nil, eris.Wrap(err, "error creating test figure")
+	}
+	return figure, nil
+}
+
+// GetByRunID returns figure collection by requested Run ID.
+func (f FigureFixtures) GetByRunID(ctx context.Context, runID string) ([]models.Figure, error) {
+	var figures []models.Figure
+	if err := f.db.WithContext(ctx).Preload(
+		"Context",
+	).Where(
+		models.Figure{RunID: runID},
+	).Order("step").Find(&figures).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting figures by run id: %s", runID)
+	}
+	return figures, nil
+}
@@ -0,0 +1,58 @@
+package fixtures
+
+import (
+	"context"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+)
+
+// ModelFixtures represents data fixtures object.
+type ModelFixtures struct {
+	baseFixtures
+	modelVersionRepository    repositories.ModelVersionRepositoryProvider
+	registeredModelRepository repositories.RegisteredModelRepositoryProvider
+}
+
+// NewModelFixtures creates new instance of ModelFixtures.
+func NewModelFixtures(db *gorm.DB) (*ModelFixtures, error) {
+	return &ModelFixtures{
+		baseFixtures:              baseFixtures{db: db},
+		modelVersionRepository:    repositories.NewModelVersionRepository(db),
+		registeredModelRepository: repositories.NewRegisteredModelRepository(db),
+	}, nil
+}
+
+// CreateRegisteredModel creates a new test RegisteredModel.
+func (f ModelFixtures) CreateRegisteredModel(
+	ctx context.Context, registeredModel *models.RegisteredModel,
+) (*models.RegisteredModel, error) {
+	if err := f.registeredModelRepository.Create(ctx, registeredModel); err != nil {
+		return nil, eris.Wrap(err, "error creating test registered model")
+	}
+	return registeredModel, nil
+}
+
+// CreateModelVersion creates a new test ModelVersion.
+func (f ModelFixtures) CreateModelVersion(
+	ctx context.Context, modelVersion *models.ModelVersion,
+) (*models.ModelVersion, error) {
+	if err := f.modelVersionRepository.Create(ctx, modelVersion); err != nil {
+		return nil, eris.Wrap(err, "error creating test model version")
+	}
+	return modelVersion, nil
+}
+
+// GetRegisteredModel returns the registered model by Namespace ID and its name.
+func (f ModelFixtures) GetRegisteredModel(
+	ctx context.Context, namespaceID uint, name string,
+) (*models.RegisteredModel, error) {
+	registeredModel, err := f.registeredModelRepository.GetByNamespaceIDAndName(ctx, namespaceID, name)
+	if err != nil {
+		return nil, eris.Wrapf(err, "error getting registered model with name %s", name)
+	}
+	return registeredModel, nil
+}
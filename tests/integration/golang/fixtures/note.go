@@ -0,0 +1,54 @@
+package fixtures
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/dao/repositories"
+)
+
+// NoteFixtures represents data fixtures object.
+type NoteFixtures struct {
+	baseFixtures
+	noteRepository repositories.NoteRepositoryProvider
+}
+
+// NewNoteFixtures creates new instance of NoteFixtures.
+func NewNoteFixtures(db *gorm.DB) (*NoteFixtures, error) {
+	return &NoteFixtures{
+		baseFixtures:   baseFixtures{db: db},
+		noteRepository: repositories.NewNoteRepository(db),
+	}, nil
+}
+
+// CreateNote creates new test Note.
+func (f NoteFixtures) CreateNote(ctx context.Context, note *models.Note) (*models.Note, error) {
+	if err := f.noteRepository.Create(ctx, note); err != nil {
+		return nil, eris.Wrap(err, "error creating test note")
+	}
+	return note, nil
+}
+
+// GetNotes returns all the Notes.
+func (f NoteFixtures) GetNotes(ctx context.Context) ([]models.Note, error) {
+	var notes []models.Note
+	if err := f.db.WithContext(ctx).Order("created_at").Find(&notes).Error; err != nil {
+		return nil, eris.Wrap(err, "error getting notes")
+	}
+	return notes, nil
+}
+
+// GetNote returns Note by requested ID.
+func (f NoteFixtures) GetNote(ctx context.Context, id uuid.UUID) (*models.Note, error) {
+	var note models.Note
+	if err := f.db.WithContext(ctx).Where(
+		models.Note{ID: id},
+	).First(&note).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting note by id: %s", id)
+	}
+	return &note, nil
+}
@@ -0,0 +1,55 @@
+package fixtures
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/database"
+)
+
+// ReportFixtures represents data fixtures object.
+type ReportFixtures struct {
+	baseFixtures
+}
+
+// NewReportFixtures creates new instance of ReportFixtures.
+func NewReportFixtures(db *gorm.DB) (*ReportFixtures, error) {
+	return &ReportFixtures{
+		baseFixtures: baseFixtures{db: db},
+	}, nil
+}
+
+// CreateReport creates a new test Report.
+func (f ReportFixtures) CreateReport(
+	ctx context.Context, report *database.Report,
+) (*database.Report, error) {
+	if err := f.db.WithContext(ctx).Create(report).Error; err != nil {
+		return nil, eris.Wrap(err, "error creating test report")
+	}
+	return report, nil
+}
+
+// GetReport returns Report by requested ID.
+func (f ReportFixtures) GetReport(ctx context.Context, id uuid.UUID) (*database.Report, error) {
+	var report database.Report
+	if err := f.db.WithContext(ctx).Where(
+		"id = ?", id,
+	).First(&report).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting report by id: %s", id)
+	}
+	return &report, nil
+}
+
+// GetReports fetches all reports which are not archived.
+func (f ReportFixtures) GetReports(ctx context.Context) ([]database.Report, error) {
+	reports := []database.Report{}
+	if err := f.db.WithContext(ctx).
+		Where("NOT is_archived").
+		Find(&reports).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting 'report' entities")
+	}
+	return reports, nil
+}
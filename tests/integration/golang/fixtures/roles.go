@@ -7,6 +7,7 @@ import (
 	"gorm.io/gorm"
 
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	commonModels "github.com/G-Research/fasttrackml/pkg/common/dao/models"
 )
 
 // RoleFixtures represents data fixtures object.
@@ -29,15 +30,41 @@ func (f RoleFixtures) CreateRole(ctx context.Context, role *models.Role) error {
 	return nil
 }
 
-// AttachNamespaceToRole attaches a Role to provided Namespace.
+// AttachNamespaceToRole attaches a Role to provided Namespace with the owner permission level.
 func (f RoleFixtures) AttachNamespaceToRole(
 	ctx context.Context, role *models.Role, namespace *models.Namespace,
+) error {
+	return f.AttachNamespaceToRoleWithLevel(ctx, role, namespace, commonModels.PermissionLevelOwner)
+}
+
+// AttachNamespaceToRoleWithLevel attaches a Role to provided Namespace with provided permission level.
+func (f RoleFixtures) AttachNamespaceToRoleWithLevel(
+	ctx context.Context, role *models.Role, namespace *models.Namespace, level commonModels.PermissionLevel,
 ) error {
 	if err := f.db.WithContext(ctx).Create(&models.RoleNamespace{
 		RoleID:      role.ID,
 		NamespaceID: namespace.ID,
+		Level:       level,
 	}).Error; err != nil {
 		return eris.Wrap(err, "error attaching namespace to role ")
 	}
 	return nil
 }
+
+// GetRoleByName returns a Role by its name.
+func (f RoleFixtures) GetRoleByName(ctx context.Context, name string) (*models.Role, error) {
+	var role models.Role
+	if err := f.db.WithContext(ctx).Where("name = ?", name).First(&role).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting role by name: %s", name)
+	}
+	return &role, nil
+}
+
+// GetRoleNamespaces returns all the namespace relations of a Role.
+func (f RoleFixtures) GetRoleNamespaces(ctx context.Context, role *models.Role) ([]models.RoleNamespace, error) {
+	var roleNamespaces []models.RoleNamespace
+	if err := f.db.WithContext(ctx).Where("role_id = ?", role.ID).Find(&roleNamespaces).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting namespaces of role with id: %s", role.ID)
+	}
+	return roleNamespaces, nil
+}
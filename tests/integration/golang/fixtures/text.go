@@ -0,0 +1,46 @@
+package fixtures
+
+import (
+	"context"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+)
+
+// TextFixtures represents data fixtures object.
+type TextFixtures struct {
+	baseFixtures
+	textRepository repositories.TextRepositoryProvider
+}
+
+// NewTextFixtures creates new instance of TextFixtures.
+func NewTextFixtures(db *gorm.DB) (*TextFixtures, error) {
+	return &TextFixtures{
+		baseFixtures:   baseFixtures{db: db},
+		textRepository: repositories.NewTextRepository(db),
+	}, nil
+}
+
+// CreateText creates a new test Text together with its context.
+func (f TextFixtures) CreateText(ctx context.Context, text *models.Text) (*models.Text, error) {
+	if err := f.textRepository.Create(ctx, text); err != nil {
+		return nil, eris.Wrap(err, "error creating test text")
+	}
+	return text, nil
+}
+
+// GetByRunID returns text collection by requested Run ID.
+func (f TextFixtures) GetByRunID(ctx context.Context, runID string) ([]models.Text, error) {
+	var texts []models.Text
+	if err := f.db.WithContext(ctx).Preload(
+		"Context",
+	).Where(
+		models.Text{RunID: runID},
+	).Order("step").Order(`"index"`).Find(&texts).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting texts by run id: %s", runID)
+	}
+	return texts, nil
+}
@@ -0,0 +1,42 @@
+package fixtures
+
+import (
+	"context"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+)
+
+// TraceFixtures represents data fixtures object.
+type TraceFixtures struct {
+	baseFixtures
+	traceRepository repositories.TraceRepositoryProvider
+}
+
+// NewTraceFixtures creates new instance of TraceFixtures.
+func NewTraceFixtures(db *gorm.DB) (*TraceFixtures, error) {
+	return &TraceFixtures{
+		baseFixtures:    baseFixtures{db: db},
+		traceRepository: repositories.NewTraceRepository(db),
+	}, nil
+}
+
+// CreateTrace creates a new test Trace.
+func (f TraceFixtures) CreateTrace(ctx context.Context, trace *models.Trace) (*models.Trace, error) {
+	if err := f.traceRepository.Create(ctx, trace); err != nil {
+		return nil, eris.Wrap(err, "error creating test trace")
+	}
+	return trace, nil
+}
+
+// GetTrace returns the trace by Namespace ID and its Request ID.
+func (f TraceFixtures) GetTrace(ctx context.Context, namespaceID uint, requestID string) (*models.Trace, error) {
+	trace, err := f.traceRepository.GetByNamespaceIDAndRequestID(ctx, namespaceID, requestID)
+	if err != nil {
+		return nil, eris.Wrapf(err, "error getting trace with request_id %s", requestID)
+	}
+	return trace, nil
+}
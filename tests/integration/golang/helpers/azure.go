@@ -0,0 +1,85 @@
+package helpers
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
+	"github.com/rotisserie/eris"
+)
+
+// Azurite well-known development storage account.
+const (
+	AzuriteAccountName = "devstoreaccount1"
+	AzuriteAccountKey  = "Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFPTOtr/KBHBeksoGMGw=="
+)
+
+type AzureTestSuite struct {
+	BaseTestSuite
+	Client         *azblob.Client
+	testContainers []string
+}
+
+// NewAzureTestSuite creates a new instance of AzureTestSuite.
+func NewAzureTestSuite(testContainers ...string) AzureTestSuite {
+	return AzureTestSuite{
+		testContainers: testContainers,
+	}
+}
+
+func (s *AzureTestSuite) SetupSuite() {
+	s.BaseTestSuite.SetupSuite()
+
+	client, err := NewAzureClient(GetAzureEndpointUri())
+	s.Require().Nil(err)
+	s.Client = client
+
+	s.AddSetupHook(func() {
+		s.Require().Nil(s.CreateTestContainers())
+	})
+	s.AddTearDownHook(func() {
+		s.Require().Nil(s.DeleteTestContainers())
+	})
+}
+
+// NewAzureClient creates new instance of Azure Blob Storage client for Azurite.
+func NewAzureClient(endpoint string) (*azblob.Client, error) {
+	credential, err := azblob.NewSharedKeyCredential(AzuriteAccountName, AzuriteAccountKey)
+	if err != nil {
+		return nil, eris.Wrap(err, "error creating Azure credential")
+	}
+	client, err := azblob.NewClientWithSharedKeyCredential(endpoint, credential, nil)
+	if err != nil {
+		return nil, eris.Wrap(err, "error creating Azure client")
+	}
+	return client, nil
+}
+
+// CreateTestContainers creates the test containers.
+func (s *AzureTestSuite) CreateTestContainers() error {
+	for _, container := range s.testContainers {
+		if _, err := s.Client.CreateContainer(context.Background(), container, nil); err != nil {
+			return eris.Wrapf(err, "failed to create container %q", container)
+		}
+	}
+	return nil
+}
+
+// DeleteTestContainers deletes the test containers together with all their blobs.
+func (s *AzureTestSuite) DeleteTestContainers() error {
+	for _, container := range s.testContainers {
+		if _, err := s.Client.DeleteContainer(context.Background(), container, nil); err != nil &&
+			!bloberror.HasCode(err, bloberror.ContainerNotFound) {
+			return eris.Wrapf(err, "failed to delete container %q", container)
+		}
+	}
+	return nil
+}
+
+// UploadTestBlob uploads the content to the blob of the test container.
+func (s *AzureTestSuite) UploadTestBlob(container, name, content string) error {
+	if _, err := s.Client.UploadBuffer(context.Background(), container, name, []byte(content), nil); err != nil {
+		return eris.Wrapf(err, "failed to upload blob %q into container %q", name, container)
+	}
+	return nil
+}
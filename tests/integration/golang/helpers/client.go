@@ -31,17 +31,18 @@ const (
 
 // HttpClient represents HTTP client.
 type HttpClient struct {
-	server       server.Server
-	basePath     string
-	namespace    string
-	method       string
-	params       any
-	headers      map[string]string
-	cookies      map[string]string
-	request      any
-	response     any
-	responseType ResponseType
-	statusCode   int
+	server          server.Server
+	basePath        string
+	namespace       string
+	method          string
+	params          any
+	headers         map[string]string
+	cookies         map[string]string
+	request         any
+	response        any
+	responseType    ResponseType
+	statusCode      int
+	responseHeaders http.Header
 }
 
 // NewClient creates a new preconfigured HTTP client.
@@ -63,6 +64,11 @@ func NewMlflowApiClient(server server.Server) *HttpClient {
 	return NewClient(server, "/api/2.0/mlflow")
 }
 
+// NewMlflowArtifactsApiClient creates a new HTTP client for the mlflow-artifacts proxy api
+func NewMlflowArtifactsApiClient(server server.Server) *HttpClient {
+	return NewClient(server, "/api/2.0/mlflow-artifacts")
+}
+
 // NewAimApiClient creates a new HTTP client for the aim api
 func NewAimApiClient(server server.Server) *HttpClient {
 	return NewClient(server, "/aim/api")
@@ -78,6 +84,11 @@ func NewChooserApiClient(server server.Server) *HttpClient {
 	return NewClient(server, "/chooser")
 }
 
+// NewTrackingApiClient creates a new HTTP client for the Aim remote tracking api
+func NewTrackingApiClient(server server.Server) *HttpClient {
+	return NewClient(server, "")
+}
+
 // WithMethod sets the HTTP method.
 func (c *HttpClient) WithMethod(method string) *HttpClient {
 	c.method = method
@@ -131,13 +142,23 @@ func (c *HttpClient) GetStatusCode() int {
 	return c.statusCode
 }
 
+// GetResponseHeader returns HTTP header value of the last response, if available.
+func (c *HttpClient) GetResponseHeader(name string) string {
+	return c.responseHeaders.Get(name)
+}
+
 // DoRequest do actual HTTP request based on provided parameters.
 // nolint:gocyclo
 func (c *HttpClient) DoRequest(uri string, values ...any) error {
 	// 1. check if request object were provided. if provided then marshal it.
+	// io.Reader request object is sent as a raw request body.
 	var requestBody io.Reader
-	if c.request != nil {
-		data, err := json.Marshal(c.request)
+	switch request := c.request.(type) {
+	case nil:
+	case io.Reader:
+		requestBody = request
+	default:
+		data, err := json.Marshal(request)
 		if err != nil {
 			return eris.Wrap(err, "error marshaling request object")
 		}
@@ -216,6 +237,7 @@ func (c *HttpClient) DoRequest(uri string, values ...any) error {
 	defer resp.Body.Close()
 
 	c.statusCode = resp.StatusCode
+	c.responseHeaders = resp.Header
 
 	// 9. read and check response data.
 	if c.response != nil {
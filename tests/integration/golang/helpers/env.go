@@ -43,6 +43,14 @@ func GetGSEndpointUri() string {
 	return "http://localhost:4443/storage/v1/"
 }
 
+func GetAzureEndpointUri() string {
+	uri, ok := os.LookupEnv("FML_AZURE_ENDPOINT_URI")
+	if ok {
+		return uri
+	}
+	return "http://localhost:10000/" + AzuriteAccountName
+}
+
 func GetS3EndpointUri() string {
 	uri, ok := os.LookupEnv("FML_S3_ENDPOINT_URI")
 	if ok {
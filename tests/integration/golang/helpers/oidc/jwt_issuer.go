@@ -0,0 +1,71 @@
+package oidc
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"os"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/rotisserie/eris"
+)
+
+// JWTIssuer represents identity provider which issues signed JWT without any network access.
+type JWTIssuer struct {
+	issuer string
+	key    *rsa.PrivateKey
+	signer jose.Signer
+}
+
+// NewJWTIssuer creates new JWT issuer with a freshly generated RSA key.
+func NewJWTIssuer(issuer string) (*JWTIssuer, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, eris.Wrap(err, "error generating rsa key")
+	}
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.RS256, Key: jose.JSONWebKey{Key: key, KeyID: "test-key"}},
+		(&jose.SignerOptions{}).WithType("JWT"),
+	)
+	if err != nil {
+		return nil, eris.Wrap(err, "error creating signer")
+	}
+	return &JWTIssuer{
+		issuer: issuer,
+		key:    key,
+		signer: signer,
+	}, nil
+}
+
+// WriteJWKS writes public key of the issuer into JWKS file.
+func (i JWTIssuer) WriteJWKS(path string) error {
+	data, err := json.Marshal(jose.JSONWebKeySet{
+		Keys: []jose.JSONWebKey{
+			{Key: &i.key.PublicKey, KeyID: "test-key", Algorithm: string(jose.RS256), Use: "sig"},
+		},
+	})
+	if err != nil {
+		return eris.Wrap(err, "error marshaling jwks")
+	}
+	return os.WriteFile(path, data, 0o600)
+}
+
+// Issue issues signed JWT for the subject with provided audience, expiry and custom claims.
+func (i JWTIssuer) Issue(
+	subject, audience string, expiresIn time.Duration, claims map[string]any,
+) (string, error) {
+	now := time.Now()
+	token, err := jwt.Signed(i.signer).Claims(jwt.Claims{
+		Issuer:   i.issuer,
+		Subject:  subject,
+		Audience: jwt.Audience{audience},
+		IssuedAt: jwt.NewNumericDate(now),
+		Expiry:   jwt.NewNumericDate(now.Add(expiresIn)),
+	}).Claims(claims).Serialize()
+	if err != nil {
+		return "", eris.Wrap(err, "error signing jwt")
+	}
+	return token, nil
+}
@@ -7,6 +7,7 @@ import (
 	"dario.cat/mergo"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
 
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
@@ -27,8 +28,10 @@ type BaseTestSuite struct {
 	tearDownHooks               []func()
 	AIMClient                   func() *HttpClient
 	MlflowClient                func() *HttpClient
+	MlflowArtifactsClient       func() *HttpClient
 	AdminClient                 func() *HttpClient
 	ChooserClient               func() *HttpClient
+	TrackingClient              func() *HttpClient
 	AppFixtures                 *fixtures.AppFixtures
 	RunFixtures                 *fixtures.RunFixtures
 	LogFixtures                 *fixtures.LogFixtures
@@ -37,6 +40,12 @@ type BaseTestSuite struct {
 	SharedTagFixtures           *fixtures.SharedTagFixtures
 	RolesFixtures               *fixtures.RoleFixtures
 	MetricFixtures              *fixtures.MetricFixtures
+	ModelFixtures               *fixtures.ModelFixtures
+	TraceFixtures               *fixtures.TraceFixtures
+	TextFixtures                *fixtures.TextFixtures
+	DistributionFixtures        *fixtures.DistributionFixtures
+	FigureFixtures              *fixtures.FigureFixtures
+	AudioFixtures               *fixtures.AudioFixtures
 	ContextFixtures             *fixtures.ContextFixtures
 	ParamFixtures               *fixtures.ParamFixtures
 	ProjectFixtures             *fixtures.ProjectFixtures
@@ -44,6 +53,10 @@ type BaseTestSuite struct {
 	ExperimentFixtures          *fixtures.ExperimentFixtures
 	DefaultExperiment           *models.Experiment
 	NamespaceFixtures           *fixtures.NamespaceFixtures
+	NoteFixtures                *fixtures.NoteFixtures
+	ReportFixtures              *fixtures.ReportFixtures
+	AccessTokenFixtures         *fixtures.AccessTokenFixtures
+	AuditLogFixtures            *fixtures.AuditLogFixtures
 	DefaultNamespace            *models.Namespace
 	ResetOnSubTest              bool
 	SkipCreateDefaultNamespace  bool
@@ -100,6 +113,10 @@ func (s *BaseTestSuite) initFixtures() {
 	s.Require().Nil(err)
 	s.MetricFixtures = metricFixtures
 
+	modelFixtures, err := fixtures.NewModelFixtures(db)
+	s.Require().Nil(err)
+	s.ModelFixtures = modelFixtures
+
 	rolesFixtures, err := fixtures.NewRoleFixtures(db)
 	s.Require().Nil(err)
 	s.RolesFixtures = rolesFixtures
@@ -139,6 +156,47 @@ func (s *BaseTestSuite) initFixtures() {
 	logFixtures, err := fixtures.NewLogFixtures(db)
 	s.Require().Nil(err)
 	s.LogFixtures = logFixtures
+
+	traceFixtures, err := fixtures.NewTraceFixtures(db)
+	s.Require().Nil(err)
+	s.TraceFixtures = traceFixtures
+
+	textFixtures, err := fixtures.NewTextFixtures(db)
+	s.Require().Nil(err)
+	s.TextFixtures = textFixtures
+
+	distributionFixtures, err := fixtures.NewDistributionFixtures(db)
+	s.Require().Nil(err)
+	s.DistributionFixtures = distributionFixtures
+
+	figureFixtures, err := fixtures.NewFigureFixtures(db)
+	s.Require().Nil(err)
+	s.FigureFixtures = figureFixtures
+
+	audioFixtures, err := fixtures.NewAudioFixtures(db)
+	s.Require().Nil(err)
+	s.AudioFixtures = audioFixtures
+
+	noteFixtures, err := fixtures.NewNoteFixtures(db)
+	s.Require().Nil(err)
+	s.NoteFixtures = noteFixtures
+
+	reportFixtures, err := fixtures.NewReportFixtures(db)
+	s.Require().Nil(err)
+	s.ReportFixtures = reportFixtures
+
+	accessTokenFixtures, err := fixtures.NewAccessTokenFixtures(db)
+	s.Require().Nil(err)
+	s.AccessTokenFixtures = accessTokenFixtures
+
+	auditLogFixtures, err := fixtures.NewAuditLogFixtures(db)
+	s.Require().Nil(err)
+	s.AuditLogFixtures = auditLogFixtures
+}
+
+// GormDB returns the database connection used by the test suite.
+func (s *BaseTestSuite) GormDB() *gorm.DB {
+	return s.db.GormDB()
 }
 
 func (s *BaseTestSuite) closeDB() {
@@ -147,14 +205,16 @@ func (s *BaseTestSuite) closeDB() {
 
 func (s *BaseTestSuite) startServer() {
 	cfg := config.Config{
-		DatabaseURI:           s.db.Dsn(),
-		DatabasePoolMax:       10,
-		DatabaseSlowThreshold: 1 * time.Second,
-		DatabaseMigrate:       true,
-		DefaultArtifactRoot:   s.T().TempDir(),
-		S3EndpointURI:         GetS3EndpointUri(),
-		GSEndpointURI:         GetGSEndpointUri(),
-		RunLogOutputMax:       MaxLogRows,
+		DatabaseURI:            s.db.Dsn(),
+		DatabasePoolMax:        10,
+		DatabaseSlowThreshold:  1 * time.Second,
+		DatabaseMigrate:        true,
+		DefaultArtifactRoot:    s.T().TempDir(),
+		S3EndpointURI:          GetS3EndpointUri(),
+		GSEndpointURI:          GetGSEndpointUri(),
+		AzureEndpointURI:       GetAzureEndpointUri(),
+		AzureStorageAccountKey: AzuriteAccountKey,
+		RunLogOutputMax:        MaxLogRows,
 	}
 	s.Require().Nil(mergo.Merge(&cfg, s.Config))
 
@@ -168,12 +228,18 @@ func (s *BaseTestSuite) startServer() {
 	s.MlflowClient = func() *HttpClient {
 		return NewMlflowApiClient(s.server)
 	}
+	s.MlflowArtifactsClient = func() *HttpClient {
+		return NewMlflowArtifactsApiClient(s.server)
+	}
 	s.AdminClient = func() *HttpClient {
 		return NewAdminApiClient(s.server)
 	}
 	s.ChooserClient = func() *HttpClient {
 		return NewChooserApiClient(s.server)
 	}
+	s.TrackingClient = func() *HttpClient {
+		return NewTrackingApiClient(s.server)
+	}
 }
 
 func (s *BaseTestSuite) stopServer() {
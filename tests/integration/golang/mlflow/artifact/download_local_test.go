@@ -0,0 +1,228 @@
+package artifact
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/api/request"
+	"github.com/G-Research/fasttrackml/pkg/common/services/artifact"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type DownloadArtifactsLocalTestSuite struct {
+	helpers.BaseTestSuite
+	run *models.Run
+}
+
+func TestDownloadArtifactsLocalTestSuite(t *testing.T) {
+	suite.Run(t, new(DownloadArtifactsLocalTestSuite))
+}
+
+func (s *DownloadArtifactsLocalTestSuite) SetupTest() {
+	s.BaseTestSuite.SetupTest()
+
+	// 1. create test experiment.
+	experimentArtifactDir := s.T().TempDir()
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:             fmt.Sprintf("Test Experiment In Path %s", experimentArtifactDir),
+		NamespaceID:      s.DefaultNamespace.ID,
+		LifecycleStage:   models.LifecycleStageActive,
+		ArtifactLocation: experimentArtifactDir,
+	})
+	s.Require().Nil(err)
+
+	// 2. create test run.
+	runID := strings.ReplaceAll(uuid.New().String(), "-", "")
+	runArtifactDir := filepath.Join(experimentArtifactDir, runID, "artifacts")
+	s.run, err = s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             runID,
+		Status:         models.StatusRunning,
+		SourceType:     "JOB",
+		ExperimentID:   *experiment.ID,
+		ArtifactURI:    runArtifactDir,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	// 3. create artifacts.
+	checkpointDir := filepath.Join(runArtifactDir, "checkpoint")
+	s.Require().Nil(os.MkdirAll(filepath.Join(checkpointDir, "nested"), fs.ModePerm))
+	s.Require().Nil(os.WriteFile(filepath.Join(runArtifactDir, "artifact.file"), []byte("content"), fs.ModePerm))
+	s.Require().Nil(os.WriteFile(filepath.Join(checkpointDir, "shard-1"), []byte("shard-1"), fs.ModePerm))
+	s.Require().Nil(os.WriteFile(filepath.Join(checkpointDir, "nested", "shard-2"), []byte("shard-22"), fs.ModePerm))
+}
+
+func (s *DownloadArtifactsLocalTestSuite) Test_Ok() {
+	tests := []struct {
+		name             string
+		request          request.DownloadArtifactsRequest
+		expectedFilename string
+		expectedContent  map[string]string
+	}{
+		{
+			name: "RootDirAsZip",
+			request: request.DownloadArtifactsRequest{
+				RunID: s.run.ID,
+			},
+			expectedFilename: s.run.ID + ".zip",
+			expectedContent: map[string]string{
+				"artifact.file":             "content",
+				"checkpoint/shard-1":        "shard-1",
+				"checkpoint/nested/shard-2": "shard-22",
+			},
+		},
+		{
+			name: "SubDirAsZip",
+			request: request.DownloadArtifactsRequest{
+				RunID:  s.run.ID,
+				Path:   "checkpoint",
+				Format: artifact.ArchiveFormatZip,
+			},
+			expectedFilename: "checkpoint.zip",
+			expectedContent: map[string]string{
+				"shard-1":        "shard-1",
+				"nested/shard-2": "shard-22",
+			},
+		},
+		{
+			name: "SubDirAsTarGz",
+			request: request.DownloadArtifactsRequest{
+				RunID:  s.run.ID,
+				Path:   "checkpoint",
+				Format: artifact.ArchiveFormatTarGz,
+			},
+			expectedFilename: "checkpoint.tar.gz",
+			expectedContent: map[string]string{
+				"shard-1":        "shard-1",
+				"nested/shard-2": "shard-22",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := new(bytes.Buffer)
+			client := s.MlflowClient()
+			s.Require().Nil(client.WithQuery(
+				tt.request,
+			).WithResponseType(
+				helpers.ResponseTypeBuffer,
+			).WithResponse(
+				resp,
+			).DoRequest(
+				"%s%s", mlflow.ArtifactsRoutePrefix, mlflow.ArtifactsDownloadRoute,
+			))
+			s.Equal(
+				fmt.Sprintf(`attachment; filename="%s"`, tt.expectedFilename),
+				client.GetResponseHeader("Content-Disposition"),
+			)
+
+			content := map[string]string{}
+			if tt.request.Format == artifact.ArchiveFormatTarGz {
+				gzipReader, err := gzip.NewReader(resp)
+				s.Require().Nil(err)
+				tarReader := tar.NewReader(gzipReader)
+				for {
+					header, err := tarReader.Next()
+					if err == io.EOF {
+						break
+					}
+					s.Require().Nil(err)
+					data, err := io.ReadAll(tarReader)
+					s.Require().Nil(err)
+					content[header.Name] = string(data)
+				}
+			} else {
+				zipReader, err := zip.NewReader(bytes.NewReader(resp.Bytes()), int64(resp.Len()))
+				s.Require().Nil(err)
+				for _, file := range zipReader.File {
+					reader, err := file.Open()
+					s.Require().Nil(err)
+					data, err := io.ReadAll(reader)
+					s.Require().Nil(err)
+					content[file.Name] = string(data)
+				}
+			}
+			s.Equal(tt.expectedContent, content)
+		})
+	}
+}
+
+func (s *DownloadArtifactsLocalTestSuite) Test_Error() {
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.DownloadArtifactsRequest
+	}{
+		{
+			name:    "EmptyOrIncorrectRunIDOrRunUUID",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'"),
+			request: request.DownloadArtifactsRequest{},
+		},
+		{
+			name: "IncorrectFormat",
+			error: api.NewInvalidParameterValueError(
+				"Invalid value for parameter 'format' supplied. Supported values are: zip, tar.gz",
+			),
+			request: request.DownloadArtifactsRequest{
+				RunID:  s.run.ID,
+				Format: "rar",
+			},
+		},
+		{
+			name:  "IncorrectPathProvided",
+			error: api.NewInvalidParameterValueError("Invalid path"),
+			request: request.DownloadArtifactsRequest{
+				RunID: s.run.ID,
+				Path:  "checkpoint/../../",
+			},
+		},
+		{
+			name: "NonExistentDir",
+			error: api.NewResourceDoesNotExistError(
+				fmt.Sprintf("unable to find artifacts for URI: %s/non-existent-dir", s.run.ArtifactURI),
+			),
+			request: request.DownloadArtifactsRequest{
+				RunID: s.run.ID,
+				Path:  "non-existent-dir",
+			},
+		},
+		{
+			name:  "NonExistentRun",
+			error: api.NewResourceDoesNotExistError("unable to find run 'non-existent-run'"),
+			request: request.DownloadArtifactsRequest{
+				RunID: "non-existent-run",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(s.MlflowClient().WithQuery(
+				tt.request,
+			).WithResponse(
+				&resp,
+			).DoRequest(
+				"%s%s", mlflow.ArtifactsRoutePrefix, mlflow.ArtifactsDownloadRoute,
+			))
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
@@ -0,0 +1,234 @@
+package artifact
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/api/request"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type GetArtifactAzureTestSuite struct {
+	helpers.AzureTestSuite
+}
+
+func TestGetArtifactAzureTestSuite(t *testing.T) {
+	suite.Run(t, &GetArtifactAzureTestSuite{
+		helpers.NewAzureTestSuite("container1", "container2"),
+	})
+}
+
+func (s *GetArtifactAzureTestSuite) Test_Ok() {
+	tests := []struct {
+		name      string
+		container string
+	}{
+		{
+			name:      "TestWithContainer1",
+			container: "container1",
+		},
+		{
+			name:      "TestWithContainer2",
+			container: "container2",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			// create test experiment
+			experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+				Name:             fmt.Sprintf("Test Experiment In Container %s", tt.container),
+				NamespaceID:      s.DefaultNamespace.ID,
+				LifecycleStage:   models.LifecycleStageActive,
+				ArtifactLocation: fmt.Sprintf("wasbs://%s@%s.blob.core.windows.net/1", tt.container, helpers.AzuriteAccountName),
+			})
+			s.Require().Nil(err)
+
+			// create test run
+			runID := strings.ReplaceAll(uuid.New().String(), "-", "")
+			run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+				ID:             runID,
+				Status:         models.StatusRunning,
+				SourceType:     "JOB",
+				ExperimentID:   *experiment.ID,
+				ArtifactURI:    fmt.Sprintf("%s/%s/artifacts", experiment.ArtifactLocation, runID),
+				LifecycleStage: models.LifecycleStageActive,
+			})
+			s.Require().Nil(err)
+
+			// upload artifact root object to Azure
+			s.Require().Nil(s.UploadTestBlob(
+				tt.container, fmt.Sprintf("1/%s/artifacts/artifact.txt", runID), "content",
+			))
+
+			// upload artifact subdir object to Azure
+			s.Require().Nil(s.UploadTestBlob(
+				tt.container, fmt.Sprintf("1/%s/artifacts/artifact/artifact.txt", runID), "subdir-object-content",
+			))
+
+			// make API call for root object
+			query := request.GetArtifactRequest{
+				RunID: run.ID,
+				Path:  "artifact.txt",
+			}
+
+			resp := new(bytes.Buffer)
+			s.Require().Nil(s.MlflowClient().WithQuery(
+				query,
+			).WithResponseType(
+				helpers.ResponseTypeBuffer,
+			).WithResponse(
+				resp,
+			).DoRequest(
+				fmt.Sprintf("%s%s", mlflow.ArtifactsRoutePrefix, mlflow.ArtifactsGetRoute),
+			))
+			s.Equal("content", resp.String())
+
+			// make API call for subdir object
+			query = request.GetArtifactRequest{
+				RunID: run.ID,
+				Path:  "artifact/artifact.txt",
+			}
+
+			resp = new(bytes.Buffer)
+			s.Require().Nil(s.MlflowClient().WithQuery(
+				query,
+			).WithResponseType(
+				helpers.ResponseTypeBuffer,
+			).WithResponse(
+				resp,
+			).DoRequest(
+				"%s%s", mlflow.ArtifactsRoutePrefix, mlflow.ArtifactsGetRoute,
+			))
+			s.Equal("subdir-object-content", resp.String())
+		})
+	}
+}
+
+func (s *GetArtifactAzureTestSuite) Test_Error() {
+	// create test experiment
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:             "Test Experiment In Container container1",
+		NamespaceID:      s.DefaultNamespace.ID,
+		LifecycleStage:   models.LifecycleStageActive,
+		ArtifactLocation: fmt.Sprintf("wasbs://container1@%s.blob.core.windows.net/1", helpers.AzuriteAccountName),
+	})
+	s.Require().Nil(err)
+
+	// create test run
+	runID := strings.ReplaceAll(uuid.New().String(), "-", "")
+	_, err = s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             runID,
+		Status:         models.StatusRunning,
+		SourceType:     "JOB",
+		ExperimentID:   *experiment.ID,
+		ArtifactURI:    fmt.Sprintf("%s/%s/artifacts", experiment.ArtifactLocation, runID),
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	// upload artifact subdir object to Azure
+	s.Require().Nil(s.UploadTestBlob(
+		"container1", fmt.Sprintf("1/%s/artifacts/artifact/artifact.file", runID), "content",
+	))
+
+	// artifact URI as it is reported back in error messages.
+	runArtifactURI := fmt.Sprintf(
+		"wasbs:/container1@%s.blob.core.windows.net/1/%s/artifacts", helpers.AzuriteAccountName, runID,
+	)
+
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.GetArtifactRequest
+	}{
+		{
+			name:    "EmptyOrIncorrectRunIDOrRunUUID",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'"),
+			request: request.GetArtifactRequest{},
+		},
+		{
+			name:  "IncorrectPathProvidedCase1",
+			error: api.NewInvalidParameterValueError("Invalid path"),
+			request: request.GetArtifactRequest{
+				RunID: "run_id",
+				Path:  "..",
+			},
+		},
+		{
+			name:  "IncorrectPathProvidedCase2",
+			error: api.NewInvalidParameterValueError("Invalid path"),
+			request: request.GetArtifactRequest{
+				RunID: "run_id",
+				Path:  "./..",
+			},
+		},
+		{
+			name:  "IncorrectPathProvidedCase3",
+			error: api.NewInvalidParameterValueError("Invalid path"),
+			request: request.GetArtifactRequest{
+				RunID: "run_id",
+				Path:  "./../",
+			},
+		},
+		{
+			name:  "IncorrectPathProvidedCase4",
+			error: api.NewInvalidParameterValueError("Invalid path"),
+			request: request.GetArtifactRequest{
+				RunID: "run_id",
+				Path:  "foo/../bar",
+			},
+		},
+		{
+			name:  "IncorrectPathProvidedCase5",
+			error: api.NewInvalidParameterValueError("Invalid path"),
+			request: request.GetArtifactRequest{
+				RunID: "run_id",
+				Path:  "/foo/../bar",
+			},
+		},
+		{
+			name: "AzureIncompletePath",
+			error: api.NewResourceDoesNotExistError(
+				fmt.Sprintf("error getting artifact object for URI: %s/artifact", runArtifactURI),
+			),
+			request: request.GetArtifactRequest{
+				RunID: runID,
+				Path:  "artifact",
+			},
+		},
+		{
+			name: "NonExistentFile",
+			error: api.NewResourceDoesNotExistError(
+				fmt.Sprintf("error getting artifact object for URI: %s/non-existent-file", runArtifactURI),
+			),
+			request: request.GetArtifactRequest{
+				RunID: runID,
+				Path:  "non-existent-file",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(s.MlflowClient().WithQuery(
+				tt.request,
+			).WithResponse(
+				&resp,
+			).DoRequest(
+				"%s%s", mlflow.ArtifactsRoutePrefix, mlflow.ArtifactsGetRoute,
+			))
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
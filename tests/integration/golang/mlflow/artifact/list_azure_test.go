@@ -0,0 +1,227 @@
+package artifact
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/api/request"
+	"github.com/G-Research/fasttrackml/pkg/common/api/response"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type ListArtifactAzureTestSuite struct {
+	helpers.AzureTestSuite
+}
+
+func TestListArtifactAzureTestSuite(t *testing.T) {
+	suite.Run(t, &ListArtifactAzureTestSuite{
+		helpers.NewAzureTestSuite("container1", "container2"),
+	})
+}
+
+func (s *ListArtifactAzureTestSuite) Test_Ok() {
+	tests := []struct {
+		name      string
+		container string
+	}{
+		{
+			name:      "TestWithContainer1",
+			container: "container1",
+		},
+		{
+			name:      "TestWithContainer2",
+			container: "container2",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			// 1. create test experiment.
+			experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+				Name:             fmt.Sprintf("Test Experiment In Container %s", tt.container),
+				NamespaceID:      s.DefaultNamespace.ID,
+				LifecycleStage:   models.LifecycleStageActive,
+				ArtifactLocation: fmt.Sprintf("wasbs://%s@%s.blob.core.windows.net/1", tt.container, helpers.AzuriteAccountName),
+			})
+			s.Require().Nil(err)
+
+			// 2. create test run.
+			runID := strings.ReplaceAll(uuid.New().String(), "-", "")
+			run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+				ID:             runID,
+				Status:         models.StatusRunning,
+				SourceType:     "JOB",
+				ExperimentID:   *experiment.ID,
+				ArtifactURI:    fmt.Sprintf("%s/%s/artifacts", experiment.ArtifactLocation, runID),
+				LifecycleStage: models.LifecycleStageActive,
+			})
+			s.Require().Nil(err)
+
+			// 3. upload artifact objects to Azure.
+			s.Require().Nil(s.UploadTestBlob(
+				tt.container, fmt.Sprintf("1/%s/artifacts/artifact.txt", runID), "contentX",
+			))
+			s.Require().Nil(s.UploadTestBlob(
+				tt.container, fmt.Sprintf("1/%s/artifacts/artifact/artifact.txt", runID), "contentXX",
+			))
+
+			// 4. make actual API call for root dir.
+			rootDirQuery := request.ListArtifactsRequest{
+				RunID: run.ID,
+			}
+
+			rootDirResp := response.ListArtifactsResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithQuery(
+					rootDirQuery,
+				).WithResponse(
+					&rootDirResp,
+				).DoRequest(
+					"%s%s", mlflow.ArtifactsRoutePrefix, mlflow.ArtifactsListRoute,
+				),
+			)
+
+			s.Equal(run.ArtifactURI, rootDirResp.RootURI)
+			s.Equal(2, len(rootDirResp.Files))
+			s.Equal([]response.FilePartialResponse{
+				{
+					Path:     "artifact",
+					IsDir:    true,
+					FileSize: 0,
+				},
+				{
+					Path:     "artifact.txt",
+					IsDir:    false,
+					FileSize: 8,
+				},
+			}, rootDirResp.Files)
+			s.Require().Nil(err)
+
+			// 5. make actual API call for sub dir.
+			subDirQuery := request.ListArtifactsRequest{
+				RunID: run.ID,
+				Path:  "artifact",
+			}
+
+			subDirResp := response.ListArtifactsResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithQuery(
+					subDirQuery,
+				).WithResponse(
+					&subDirResp,
+				).DoRequest(
+					"%s%s", mlflow.ArtifactsRoutePrefix, mlflow.ArtifactsListRoute,
+				),
+			)
+
+			s.Equal(run.ArtifactURI, subDirResp.RootURI)
+			s.Equal(1, len(subDirResp.Files))
+			s.Equal(response.FilePartialResponse{
+				Path:     "artifact/artifact.txt",
+				IsDir:    false,
+				FileSize: 9,
+			}, subDirResp.Files[0])
+			s.Require().Nil(err)
+
+			// 6. make actual API call for non-existing dir.
+			nonExistingDirQuery := request.ListArtifactsRequest{
+				RunID: run.ID,
+				Path:  "non-existing-dir",
+			}
+			s.Require().Nil(err)
+
+			nonExistingDirResp := response.ListArtifactsResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithQuery(
+					nonExistingDirQuery,
+				).WithResponse(
+					&nonExistingDirResp,
+				).DoRequest(
+					"%s%s", mlflow.ArtifactsRoutePrefix, mlflow.ArtifactsListRoute,
+				),
+			)
+
+			s.Equal(run.ArtifactURI, nonExistingDirResp.RootURI)
+			s.Equal(0, len(nonExistingDirResp.Files))
+			s.Require().Nil(err)
+		})
+	}
+}
+
+func (s *ListArtifactAzureTestSuite) Test_Error() {
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.ListArtifactsRequest
+	}{
+		{
+			name:    "EmptyOrIncorrectRunIDOrRunUUID",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'"),
+			request: request.ListArtifactsRequest{},
+		},
+		{
+			name:  "IncorrectPathProvidedCase1",
+			error: api.NewInvalidParameterValueError("Invalid path"),
+			request: request.ListArtifactsRequest{
+				RunID: "run_id",
+				Path:  "..",
+			},
+		},
+		{
+			name:  "IncorrectPathProvidedCase2",
+			error: api.NewInvalidParameterValueError("Invalid path"),
+			request: request.ListArtifactsRequest{
+				RunID: "run_id",
+				Path:  "./..",
+			},
+		},
+		{
+			name:  "IncorrectPathProvidedCase3",
+			error: api.NewInvalidParameterValueError("Invalid path"),
+			request: request.ListArtifactsRequest{
+				RunID: "run_id",
+				Path:  "./../",
+			},
+		},
+		{
+			name:  "IncorrectPathProvidedCase4",
+			error: api.NewInvalidParameterValueError("Invalid path"),
+			request: request.ListArtifactsRequest{
+				RunID: "run_id",
+				Path:  "foo/../bar",
+			},
+		},
+		{
+			name:  "IncorrectPathProvidedCase5",
+			error: api.NewInvalidParameterValueError("Invalid path"),
+			request: request.ListArtifactsRequest{
+				RunID: "run_id",
+				Path:  "/foo/../bar",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithQuery(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.ArtifactsRoutePrefix, mlflow.ArtifactsListRoute,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
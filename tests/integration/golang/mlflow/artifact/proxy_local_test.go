@@ -0,0 +1,377 @@
+package artifact
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/api/request"
+	"github.com/G-Research/fasttrackml/pkg/common/api/response"
+	"github.com/G-Research/fasttrackml/pkg/common/config"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type ProxyArtifactLocalTestSuite struct {
+	helpers.BaseTestSuite
+	destination string
+}
+
+func TestProxyArtifactLocalTestSuite(t *testing.T) {
+	destination := t.TempDir()
+	testSuite := &ProxyArtifactLocalTestSuite{
+		destination: destination,
+	}
+	testSuite.Config = config.Config{
+		ServeArtifacts:       true,
+		ArtifactsDestination: destination,
+	}
+	suite.Run(t, testSuite)
+}
+
+func (s *ProxyArtifactLocalTestSuite) Test_Ok() {
+	// 1. upload artifact through the proxy.
+	s.Require().Nil(
+		s.MlflowArtifactsClient().WithMethod(
+			http.MethodPut,
+		).WithRequest(
+			strings.NewReader("content"),
+		).WithResponse(
+			&map[string]any{},
+		).DoRequest(
+			"%s/%s", mlflow.ProxyArtifactsRoutePrefix, "0/artifact.dir/artifact.file",
+		),
+	)
+	content, err := os.ReadFile(filepath.Join(s.destination, "0", "artifact.dir", "artifact.file"))
+	s.Require().Nil(err)
+	s.Equal("content", string(content))
+
+	// 2. list uploaded artifacts.
+	rootResp := response.ListProxyArtifactsResponse{}
+	s.Require().Nil(
+		s.MlflowArtifactsClient().WithQuery(
+			request.ListProxyArtifactsRequest{Path: "0"},
+		).WithResponse(
+			&rootResp,
+		).DoRequest(
+			"%s%s", mlflow.ProxyArtifactsRoutePrefix, mlflow.ProxyArtifactsListRoute,
+		),
+	)
+	s.Equal([]response.ProxyFilePartialResponse{
+		{Path: "artifact.dir", IsDir: true},
+	}, rootResp.Files)
+
+	subDirResp := response.ListProxyArtifactsResponse{}
+	s.Require().Nil(
+		s.MlflowArtifactsClient().WithQuery(
+			request.ListProxyArtifactsRequest{Path: "0/artifact.dir"},
+		).WithResponse(
+			&subDirResp,
+		).DoRequest(
+			"%s%s", mlflow.ProxyArtifactsRoutePrefix, mlflow.ProxyArtifactsListRoute,
+		),
+	)
+	s.Equal([]response.ProxyFilePartialResponse{
+		{Path: "artifact.file", IsDir: false, FileSize: 7},
+	}, subDirResp.Files)
+
+	// 3. download artifact through the proxy.
+	var buffer bytes.Buffer
+	s.Require().Nil(
+		s.MlflowArtifactsClient().WithResponseType(
+			helpers.ResponseTypeBuffer,
+		).WithResponse(
+			&buffer,
+		).DoRequest(
+			"%s/%s", mlflow.ProxyArtifactsRoutePrefix, "0/artifact.dir/artifact.file",
+		),
+	)
+	s.Equal("content", buffer.String())
+
+	// 4. upload artifact using multipart upload.
+	createResp := response.CreateMultipartUploadResponse{}
+	s.Require().Nil(
+		s.MlflowArtifactsClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.CreateMultipartUploadRequest{Path: "0/artifact.dir/model.bin", NumParts: 2},
+		).WithResponse(
+			&createResp,
+		).DoRequest(
+			"%s/create/%s", mlflow.ProxyMultipartRoutePrefix, "0/artifact.dir/model.bin",
+		),
+	)
+	s.NotEmpty(createResp.UploadID)
+	s.Require().Len(createResp.Credentials, 2)
+	s.Contains(createResp.Credentials[1].URL, "/api/2.0/mlflow-artifacts/mpu/upload/0/artifact.dir/model.bin?")
+	s.Equal(2, createResp.Credentials[1].PartNumber)
+
+	parts := make([]request.MultipartUploadPartPartialRequest, 0, 2)
+	for i, data := range []string{"first-", "second"} {
+		s.Require().Nil(
+			s.MlflowArtifactsClient().WithMethod(
+				http.MethodPut,
+			).WithQuery(
+				request.UploadMultipartPartRequest{UploadID: createResp.UploadID, PartNumber: i + 1},
+			).WithRequest(
+				strings.NewReader(data),
+			).WithResponse(
+				&map[string]any{},
+			).DoRequest(
+				"%s/upload/%s", mlflow.ProxyMultipartRoutePrefix, "0/artifact.dir/model.bin",
+			),
+		)
+		hash := md5.Sum([]byte(data))
+		parts = append(parts, request.MultipartUploadPartPartialRequest{
+			PartNumber: i + 1,
+			ETag:       hex.EncodeToString(hash[:]),
+		})
+	}
+
+	s.Require().Nil(
+		s.MlflowArtifactsClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.CompleteMultipartUploadRequest{
+				Path:     "0/artifact.dir/model.bin",
+				UploadID: createResp.UploadID,
+				Parts:    []request.MultipartUploadPartPartialRequest{parts[1], parts[0]},
+			},
+		).WithResponse(
+			&map[string]any{},
+		).DoRequest(
+			"%s/complete/%s", mlflow.ProxyMultipartRoutePrefix, "0/artifact.dir/model.bin",
+		),
+	)
+	content, err = os.ReadFile(filepath.Join(s.destination, "0", "artifact.dir", "model.bin"))
+	s.Require().Nil(err)
+	s.Equal("first-second", string(content))
+
+	// 5. delete directory with artifacts.
+	s.Require().Nil(
+		s.MlflowArtifactsClient().WithMethod(
+			http.MethodDelete,
+		).WithResponse(
+			&map[string]any{},
+		).DoRequest(
+			"%s/%s", mlflow.ProxyArtifactsRoutePrefix, "0/artifact.dir",
+		),
+	)
+	_, err = os.Stat(filepath.Join(s.destination, "0", "artifact.dir"))
+	s.ErrorIs(err, fs.ErrNotExist)
+}
+
+func (s *ProxyArtifactLocalTestSuite) Test_LargeArtifact() {
+	// artifact is streamed, so it isn't limited by the request body limit.
+	data := bytes.Repeat([]byte("0123456789abcdef"), 17*1024*1024/16)
+	s.Require().Nil(
+		s.MlflowArtifactsClient().WithMethod(
+			http.MethodPut,
+		).WithRequest(
+			bytes.NewReader(data),
+		).WithResponse(
+			&map[string]any{},
+		).DoRequest(
+			"%s/%s", mlflow.ProxyArtifactsRoutePrefix, "0/large.bin",
+		),
+	)
+	content, err := os.ReadFile(filepath.Join(s.destination, "0", "large.bin"))
+	s.Require().Nil(err)
+	s.Equal(len(data), len(content))
+	s.True(bytes.Equal(data, content))
+	s.Require().Nil(os.Remove(filepath.Join(s.destination, "0", "large.bin")))
+}
+
+func (s *ProxyArtifactLocalTestSuite) Test_RunArtifacts() {
+	// 1. create test run which artifacts are stored behind the proxy.
+	runID := strings.ReplaceAll(uuid.New().String(), "-", "")
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             runID,
+		Status:         models.StatusRunning,
+		SourceType:     "JOB",
+		ExperimentID:   *s.DefaultExperiment.ID,
+		ArtifactURI:    "mlflow-artifacts:/0/" + runID + "/artifacts",
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	// 2. create artifact in artifacts destination.
+	runArtifactDir := filepath.Join(s.destination, "0", runID, "artifacts")
+	s.Require().Nil(os.MkdirAll(runArtifactDir, fs.ModePerm))
+	s.Require().Nil(os.WriteFile(filepath.Join(runArtifactDir, "artifact.file"), []byte("content"), fs.ModePerm))
+
+	// 3. list run artifacts through the regular api.
+	resp := response.ListArtifactsResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithQuery(
+			request.ListArtifactsRequest{RunID: run.ID},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.ArtifactsRoutePrefix, mlflow.ArtifactsListRoute,
+		),
+	)
+	s.Equal(run.ArtifactURI, resp.RootURI)
+	s.Equal([]response.FilePartialResponse{
+		{Path: "artifact.file", IsDir: false, FileSize: 7},
+	}, resp.Files)
+}
+
+func (s *ProxyArtifactLocalTestSuite) Test_Namespace() {
+	namespace, err := s.NamespaceFixtures.CreateNamespace(context.Background(), &models.Namespace{
+		Code:                "custom",
+		DefaultExperimentID: common.GetPointer(models.DefaultExperimentID),
+	})
+	s.Require().Nil(err)
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           uuid.New().String(),
+		NamespaceID:    namespace.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	// 1. artifacts of the namespace experiment are served.
+	path := fmt.Sprintf("%d/artifact.file", *experiment.ID)
+	s.Require().Nil(
+		s.MlflowArtifactsClient().WithMethod(
+			http.MethodPut,
+		).WithNamespace(
+			namespace.Code,
+		).WithRequest(
+			strings.NewReader("content"),
+		).WithResponse(
+			&map[string]any{},
+		).DoRequest(
+			"%s/%s", mlflow.ProxyArtifactsRoutePrefix, path,
+		),
+	)
+	content, err := os.ReadFile(filepath.Join(s.destination, fmt.Sprintf("%d", *experiment.ID), "artifact.file"))
+	s.Require().Nil(err)
+	s.Equal("content", string(content))
+
+	// 2. artifacts of the experiments of another namespace can't be reached.
+	for _, tt := range []struct {
+		namespace string
+		path      string
+	}{
+		{namespace: namespace.Code, path: "0/artifact.file"},
+		{namespace: "", path: path},
+	} {
+		resp := api.ErrorResponse{}
+		s.Require().Nil(
+			s.MlflowArtifactsClient().WithMethod(
+				http.MethodDelete,
+			).WithNamespace(
+				tt.namespace,
+			).WithResponse(
+				&resp,
+			).DoRequest(
+				"%s/%s", mlflow.ProxyArtifactsRoutePrefix, tt.path,
+			),
+		)
+		s.Equal(
+			api.NewResourceDoesNotExistError("unable to find experiment of artifact path '%s'", tt.path).Error(),
+			resp.Error(),
+		)
+	}
+	_, err = os.Stat(filepath.Join(s.destination, fmt.Sprintf("%d", *experiment.ID), "artifact.file"))
+	s.Nil(err)
+}
+
+func (s *ProxyArtifactLocalTestSuite) Test_Error() {
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		method  string
+		path    string
+		request any
+		query   any
+	}{
+		{
+			name:   "UploadArtifactWithIncorrectPath",
+			error:  api.NewInvalidParameterValueError("Invalid path"),
+			method: http.MethodPut,
+			path:   "/artifacts/artifact.dir/%2E%2E/%2E%2E/artifact.file",
+		},
+		{
+			name:   "DeleteArtifactsDestination",
+			error:  api.NewInvalidParameterValueError("Invalid path"),
+			method: http.MethodDelete,
+			path:   "/artifacts/%2E/",
+		},
+		{
+			name:   "UploadArtifactOutsideOfExperiment",
+			error:  api.NewResourceDoesNotExistError("unable to find experiment of artifact path 'artifact.file'"),
+			method: http.MethodPut,
+			path:   "/artifacts/artifact.file",
+		},
+		{
+			name:   "UploadArtifactOfNotExistingExperiment",
+			error:  api.NewResourceDoesNotExistError("unable to find experiment of artifact path '123/artifact.file'"),
+			method: http.MethodPut,
+			path:   "/artifacts/123/artifact.file",
+		},
+		{
+			name:   "DownloadNotExistingArtifact",
+			error:  api.NewResourceDoesNotExistError("error getting artifact object for path: 0/not-existing.file"),
+			method: http.MethodGet,
+			path:   "/artifacts/0/not-existing.file",
+		},
+		{
+			name: "CreateMultipartUploadWithIncorrectNumParts",
+			error: api.NewInvalidParameterValueError(
+				"Invalid value for parameter 'num_parts' supplied. It must be between 1 and 10000",
+			),
+			method:  http.MethodPost,
+			path:    "/mpu/create/artifact.file",
+			request: request.CreateMultipartUploadRequest{},
+		},
+		{
+			name:   "UploadPartWithIncorrectUploadID",
+			error:  api.NewInvalidParameterValueError("Invalid value for parameter 'upload_id' supplied"),
+			method: http.MethodPut,
+			path:   "/mpu/upload/artifact.file",
+			query:  request.UploadMultipartPartRequest{UploadID: "../../etc", PartNumber: 1},
+		},
+		{
+			name: "CompleteNotExistingMultipartUpload",
+			error: api.NewResourceDoesNotExistError(
+				"unable to find multipart upload '00000000-0000-0000-0000-000000000000'",
+			),
+			method: http.MethodPost,
+			path:   "/mpu/complete/0/artifact.file",
+			request: request.CompleteMultipartUploadRequest{
+				UploadID: "00000000-0000-0000-0000-000000000000",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			client := s.MlflowArtifactsClient().WithMethod(tt.method)
+			if tt.request != nil {
+				client = client.WithRequest(tt.request)
+			}
+			if tt.query != nil {
+				client = client.WithQuery(tt.query)
+			}
+			resp := api.ErrorResponse{}
+			s.Require().Nil(client.WithResponse(&resp).DoRequest("%s", tt.path))
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
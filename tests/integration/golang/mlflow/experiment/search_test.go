@@ -161,8 +161,10 @@ func (s *SearchExperimentsTestSuite) Test_Error() {
 			},
 		},
 		{
-			name:  "MalformedFilter",
-			error: api.NewInvalidParameterValueError("malformed filter 'invalid_filter'"),
+			name: "MalformedFilter",
+			error: api.NewInvalidParameterValueError(
+				"malformed filter 'invalid_filter': unexpected end of filter, expected comparison operator at position 14",
+			),
 			request: request.SearchExperimentsRequest{
 				Filter: "invalid_filter",
 			},
@@ -0,0 +1,266 @@
+package gc
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/services/gc"
+	"github.com/G-Research/fasttrackml/pkg/common/config"
+	"github.com/G-Research/fasttrackml/pkg/common/services/artifact/storage"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type PurgeTestSuite struct {
+	helpers.BaseTestSuite
+	service *gc.Service
+}
+
+func TestPurgeTestSuite(t *testing.T) {
+	suite.Run(t, new(PurgeTestSuite))
+}
+
+func (s *PurgeTestSuite) SetupTest() {
+	s.BaseTestSuite.SetupTest()
+
+	artifactStorageFactory, err := storage.NewArtifactStorageFactory(&config.Config{})
+	s.Require().Nil(err)
+	s.service = gc.NewService(
+		repositories.NewGCRepository(s.GormDB()),
+		repositories.NewNamespaceRepository(s.GormDB()),
+		artifactStorageFactory,
+	)
+}
+
+func (s *PurgeTestSuite) Test_Ok() {
+	oldDeletedTime := sql.NullInt64{Int64: time.Now().Add(-48 * time.Hour).UnixMilli(), Valid: true}
+
+	// create a run which was deleted a long time ago together with all its related data.
+	oldRun := s.createRun("old", *s.DefaultExperiment.ID, models.LifecycleStageDeleted, oldDeletedTime)
+	// create a run which was deleted recently.
+	recentRun := s.createRun(
+		"recent",
+		*s.DefaultExperiment.ID,
+		models.LifecycleStageDeleted,
+		sql.NullInt64{Int64: time.Now().UnixMilli(), Valid: true},
+	)
+	// create an active run.
+	activeRun := s.createRun("active", *s.DefaultExperiment.ID, models.LifecycleStageActive, sql.NullInt64{})
+
+	// create an experiment which was deleted a long time ago together with its active run and trace.
+	deletedExperiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:             "deleted",
+		NamespaceID:      s.DefaultNamespace.ID,
+		LifecycleStage:   models.LifecycleStageDeleted,
+		LastUpdateTime:   oldDeletedTime,
+		ArtifactLocation: s.createArtifactLocation("deleted"),
+	})
+	s.Require().Nil(err)
+	experimentRun := s.createRun("experiment", *deletedExperiment.ID, models.LifecycleStageActive, sql.NullInt64{})
+	traceArtifactLocation := s.createArtifactLocation("trace")
+	_, err = s.TraceFixtures.CreateTrace(context.Background(), &models.Trace{
+		ID:           "tr-" + strings.ReplaceAll(uuid.NewString(), "-", ""),
+		ExperimentID: *deletedExperiment.ID,
+		TimestampMs:  1234567890,
+		Status:       models.TraceStatusOK,
+		Tags: []models.TraceTag{
+			{Key: models.TraceTagArtifactLocation, Value: traceArtifactLocation},
+		},
+	})
+	s.Require().Nil(err)
+
+	// create an experiment which was deleted a long time ago, but its artifact location is shared.
+	sharedArtifactLocation := s.createArtifactLocation("shared")
+	sharedExperiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:             "shared",
+		NamespaceID:      s.DefaultNamespace.ID,
+		LifecycleStage:   models.LifecycleStageDeleted,
+		LastUpdateTime:   oldDeletedTime,
+		ArtifactLocation: sharedArtifactLocation,
+	})
+	s.Require().Nil(err)
+	_, err = s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:             "nested",
+		NamespaceID:      s.DefaultNamespace.ID,
+		LifecycleStage:   models.LifecycleStageActive,
+		ArtifactLocation: filepath.Join(sharedArtifactLocation, "nested"),
+	})
+	s.Require().Nil(err)
+
+	// create a run which was deleted a long time ago in the other namespace.
+	namespace, err := s.NamespaceFixtures.CreateNamespace(context.Background(), &models.Namespace{
+		Code:                "custom",
+		DefaultExperimentID: common.GetPointer(models.DefaultExperimentID),
+	})
+	s.Require().Nil(err)
+	customExperiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           "custom",
+		NamespaceID:    namespace.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+	customRun := s.createRun("custom", *customExperiment.ID, models.LifecycleStageDeleted, oldDeletedTime)
+
+	// dry run doesn't delete anything.
+	result, err := s.service.Purge(context.Background(), gc.PurgeOptions{
+		NamespaceCode: models.DefaultNamespaceCode,
+		OlderThan:     24 * time.Hour,
+		DryRun:        true,
+	})
+	s.Require().Nil(err)
+	s.Require().Len(result.Runs, 1)
+	s.Equal(oldRun.ID, result.Runs[0].ID)
+	s.Require().Len(result.Experiments, 2)
+	s.Equal(*deletedExperiment.ID, *result.Experiments[0].ID)
+	s.Equal(deletedExperiment.ArtifactLocation, result.Experiments[0].ArtifactLocation)
+	s.Equal(*sharedExperiment.ID, *result.Experiments[1].ID)
+	for _, run := range []*models.Run{oldRun, recentRun, activeRun, experimentRun, customRun} {
+		s.assertRunExists(run)
+	}
+	for _, location := range []string{deletedExperiment.ArtifactLocation, traceArtifactLocation} {
+		s.FileExists(filepath.Join(location, "artifact.txt"))
+	}
+
+	// purge the default namespace.
+	result, err = s.service.Purge(context.Background(), gc.PurgeOptions{
+		NamespaceCode: models.DefaultNamespaceCode,
+		OlderThan:     24 * time.Hour,
+	})
+	s.Require().Nil(err)
+	s.Len(result.Runs, 1)
+	s.Len(result.Experiments, 2)
+	s.assertRunPurged(oldRun)
+	s.assertRunPurged(experimentRun)
+	s.NoDirExists(deletedExperiment.ArtifactLocation)
+	s.NoDirExists(traceArtifactLocation)
+	s.FileExists(filepath.Join(sharedArtifactLocation, "artifact.txt"))
+	s.assertRunExists(recentRun)
+	s.assertRunExists(activeRun)
+	s.assertRunExists(customRun)
+	_, err = s.ExperimentFixtures.GetByNamespaceIDAndExperimentID(
+		context.Background(), s.DefaultNamespace.ID, *deletedExperiment.ID,
+	)
+	s.NotNil(err)
+
+	// purge all the namespaces.
+	result, err = s.service.Purge(context.Background(), gc.PurgeOptions{OlderThan: 24 * time.Hour})
+	s.Require().Nil(err)
+	s.Len(result.Runs, 1)
+	s.Empty(result.Experiments)
+	s.assertRunPurged(customRun)
+	s.assertRunExists(recentRun)
+	s.assertRunExists(activeRun)
+}
+
+func (s *PurgeTestSuite) Test_Error() {
+	_, err := s.service.Purge(context.Background(), gc.PurgeOptions{NamespaceCode: "unknown"})
+	s.EqualError(err, "namespace with code 'unknown' not found")
+}
+
+// createArtifactLocation creates artifact location with a single artifact.
+func (s *PurgeTestSuite) createArtifactLocation(name string) string {
+	location := filepath.Join(s.T().TempDir(), name)
+	s.Require().Nil(os.MkdirAll(location, 0o755))
+	s.Require().Nil(os.WriteFile(filepath.Join(location, "artifact.txt"), []byte("content"), 0o600))
+	return location
+}
+
+// createRun creates run with metrics, params, tags, logs and artifacts.
+func (s *PurgeTestSuite) createRun(
+	name string, experimentID int32, lifecycleStage models.LifecycleStage, deletedTime sql.NullInt64,
+) *models.Run {
+	artifactURI := s.createArtifactLocation(name)
+
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             strings.ReplaceAll(uuid.NewString(), "-", ""),
+		Name:           name,
+		Status:         models.StatusFinished,
+		SourceType:     "JOB",
+		ExperimentID:   experimentID,
+		ArtifactURI:    artifactURI,
+		LifecycleStage: lifecycleStage,
+		DeletedTime:    deletedTime,
+	})
+	s.Require().Nil(err)
+
+	_, err = s.MetricFixtures.CreateMetric(context.Background(), &models.Metric{
+		Key:       "key",
+		Value:     1.1,
+		Timestamp: 1234567890,
+		RunID:     run.ID,
+		Step:      1,
+		Iter:      1,
+	})
+	s.Require().Nil(err)
+	_, err = s.MetricFixtures.CreateLatestMetric(context.Background(), &models.LatestMetric{
+		Key:       "key",
+		Value:     1.1,
+		Timestamp: 1234567890,
+		RunID:     run.ID,
+		Step:      1,
+		LastIter:  1,
+	})
+	s.Require().Nil(err)
+	_, err = s.ParamFixtures.CreateParam(context.Background(), &models.Param{
+		Key:      "param",
+		ValueStr: common.GetPointer("value"),
+		RunID:    run.ID,
+	})
+	s.Require().Nil(err)
+	_, err = s.TagFixtures.CreateTag(context.Background(), &models.Tag{
+		Key:   "tag",
+		Value: "value",
+		RunID: run.ID,
+	})
+	s.Require().Nil(err)
+	_, err = s.LogFixtures.CreateLog(context.Background(), &models.Log{
+		RunID:     run.ID,
+		Value:     "log",
+		Timestamp: 1234567890,
+	})
+	s.Require().Nil(err)
+	_, err = s.ArtifactFixtures.CreateArtifact(context.Background(), &models.Artifact{
+		ID:      uuid.New(),
+		Name:    "image",
+		RunID:   run.ID,
+		BlobURI: "image.png",
+	})
+	s.Require().Nil(err)
+	return run
+}
+
+// assertRunExists asserts that run and its related data still exist.
+func (s *PurgeTestSuite) assertRunExists(run *models.Run) {
+	_, err := s.RunFixtures.GetRun(context.Background(), run.ID)
+	s.Nil(err)
+	metrics, err := s.MetricFixtures.GetMetricsByRunID(context.Background(), run.ID)
+	s.Require().Nil(err)
+	s.Len(metrics, 1)
+	s.FileExists(filepath.Join(run.ArtifactURI, "artifact.txt"))
+}
+
+// assertRunPurged asserts that run, its related data and artifacts have been removed.
+func (s *PurgeTestSuite) assertRunPurged(run *models.Run) {
+	_, err := s.RunFixtures.GetRun(context.Background(), run.ID)
+	s.NotNil(err)
+	metrics, err := s.MetricFixtures.GetMetricsByRunID(context.Background(), run.ID)
+	s.Require().Nil(err)
+	s.Empty(metrics)
+	logs, err := s.LogFixtures.GetByRunID(context.Background(), run.ID)
+	s.Require().Nil(err)
+	s.Empty(logs)
+	artifact, err := s.ArtifactFixtures.GetArtifactByRunID(context.Background(), run.ID)
+	s.Require().Nil(err)
+	s.Equal(uuid.Nil, artifact.ID)
+	s.NoDirExists(run.ArtifactURI)
+}
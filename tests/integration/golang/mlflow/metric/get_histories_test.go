@@ -11,6 +11,7 @@ import (
 
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/controller"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/services/metric"
 	"github.com/G-Research/fasttrackml/pkg/common/api"
@@ -217,6 +218,84 @@ func (s *GetHistoriesTestSuite) Test_Ok() {
 	}
 }
 
+func (s *GetHistoriesTestSuite) Test_PaginationAndDownsampling() {
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           "Test Experiment",
+		NamespaceID:    s.DefaultNamespace.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             "run1",
+		Name:           "chill-run",
+		Status:         models.StatusScheduled,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageActive,
+		ExperimentID:   *experiment.ID,
+	})
+	s.Require().Nil(err)
+
+	for step := 0; step < 10; step++ {
+		_, err = s.MetricFixtures.CreateMetric(context.Background(), &models.Metric{
+			Key:       "key1",
+			Value:     float64(step%4) * 1.5,
+			Timestamp: 1234567890 + int64(step),
+			RunID:     run.ID,
+			Step:      int64(step),
+			Iter:      int64(step + 1),
+		})
+		s.Require().Nil(err)
+	}
+
+	getMetricHistories := func(req *request.GetMetricHistoriesRequest) ([]int64, string) {
+		resp := new(bytes.Buffer)
+		client := s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			req,
+		).WithResponseType(
+			helpers.ResponseTypeBuffer,
+		).WithResponse(
+			resp,
+		)
+		s.Require().Nil(client.DoRequest("%s%s", mlflow.MetricsRoutePrefix, mlflow.MetricsGetHistoriesRoute))
+
+		metrics, err := helpers.DecodeArrowMetrics(resp)
+		s.Require().Nil(err)
+		steps := make([]int64, len(metrics))
+		for n, metric := range metrics {
+			steps[n] = metric.Step
+		}
+		return steps, client.GetResponseHeader(controller.NextPageTokenHeader)
+	}
+
+	// check that metrics can be read page by page.
+	var steps []int64
+	req := request.GetMetricHistoriesRequest{
+		RunIDs:     []string{run.ID},
+		MaxResults: 4,
+	}
+	for page := 0; page < 3; page++ {
+		pageSteps, nextPageToken := getMetricHistories(&req)
+		steps = append(steps, pageSteps...)
+		req.PageToken = nextPageToken
+	}
+	s.Empty(req.PageToken)
+	s.Equal([]int64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, steps)
+
+	// check that metrics are downsampled.
+	steps, nextPageToken := getMetricHistories(&request.GetMetricHistoriesRequest{
+		RunIDs: []string{run.ID},
+		MetricDownsamplingPartialRequest: request.MetricDownsamplingPartialRequest{
+			Downsampling: request.DownsamplingMethodLTTB,
+			Points:       3,
+		},
+	})
+	s.Empty(nextPageToken)
+	s.Equal([]int64{0, 3, 9}, steps)
+}
+
 func (s *GetHistoriesTestSuite) Test_Error() {
 	tests := []struct {
 		name    string
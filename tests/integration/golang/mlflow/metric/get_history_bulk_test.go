@@ -107,6 +107,28 @@ func (s *GetHistoriesBulkTestSuite) Test_Ok() {
 			},
 		},
 	}, resp)
+
+	// check that metrics can be read page by page.
+	var metrics []response.MetricPartialResponseBulk
+	req.MaxResults = 1
+	for page := 0; page < 2; page++ {
+		resp = response.GetMetricHistoryBulkResponse{}
+		s.Require().Nil(
+			s.MlflowClient().WithQuery(
+				req,
+			).WithResponse(
+				&resp,
+			).DoRequest(
+				"%s%s", mlflow.MetricsRoutePrefix, mlflow.MetricsGetHistoryBulkRoute,
+			),
+		)
+		s.Len(resp.Metrics, 1)
+		metrics = append(metrics, resp.Metrics...)
+		req.PageToken = resp.NextPageToken
+	}
+	s.Empty(req.PageToken)
+	s.Equal(run1.ID, metrics[0].RunID)
+	s.Equal(run2.ID, metrics[1].RunID)
 }
 
 func (s *GetHistoriesBulkTestSuite) Test_Error() {
@@ -9,6 +9,7 @@ import (
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 	"github.com/G-Research/fasttrackml/pkg/common/api"
 	"github.com/G-Research/fasttrackml/pkg/common/dao/types"
@@ -86,6 +87,186 @@ func (s *GetHistoryTestSuite) Test_Ok() {
 	}, resp)
 }
 
+func (s *GetHistoryTestSuite) Test_Context() {
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             "id",
+		Name:           "chill-run",
+		Status:         models.StatusScheduled,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageActive,
+		ExperimentID:   *s.DefaultExperiment.ID,
+	})
+	s.Require().Nil(err)
+
+	for i, metricContext := range []string{`{"subset": "train"}`, `{"subset": "val", "fold": {"id": "1"}}`} {
+		_, err = s.MetricFixtures.CreateMetric(context.Background(), &models.Metric{
+			Key:       "key",
+			Value:     float64(i),
+			Timestamp: 1234567890,
+			RunID:     run.ID,
+			Step:      int64(i),
+			Iter:      int64(i + 1),
+			Context: models.Context{
+				Json: types.JSONB(metricContext),
+			},
+		})
+		s.Require().Nil(err)
+	}
+
+	tests := []struct {
+		name          string
+		context       string
+		expectedSteps []int64
+	}{
+		{
+			name:          "WithoutContext",
+			expectedSteps: []int64{0, 1},
+		},
+		{
+			name:          "WithContext",
+			context:       `{"subset": "val"}`,
+			expectedSteps: []int64{1},
+		},
+		{
+			name:          "WithNestedContext",
+			context:       `{"fold": {"id": "1"}}`,
+			expectedSteps: []int64{1},
+		},
+		{
+			name:          "WithNotMatchingContext",
+			context:       `{"subset": "test"}`,
+			expectedSteps: nil,
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := response.GetMetricHistoryResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithQuery(
+					request.GetMetricHistoryRequest{
+						RunID:     run.ID,
+						MetricKey: "key",
+						Context:   tt.context,
+					},
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.MetricsRoutePrefix, mlflow.MetricsGetHistoryRoute,
+				),
+			)
+			var steps []int64
+			for _, metric := range resp.Metrics {
+				steps = append(steps, metric.Step)
+			}
+			s.Equal(tt.expectedSteps, steps)
+		})
+	}
+}
+
+func (s *GetHistoryTestSuite) Test_Pagination() {
+	run := s.createRunWithMetrics(10)
+
+	var steps []int64
+	pageToken := ""
+	for page := 0; page < 3; page++ {
+		resp := response.GetMetricHistoryResponse{}
+		s.Require().Nil(
+			s.MlflowClient().WithQuery(
+				request.GetMetricHistoryRequest{
+					RunID:      run.ID,
+					MetricKey:  "key",
+					MaxResults: 4,
+					PageToken:  pageToken,
+				},
+			).WithResponse(
+				&resp,
+			).DoRequest(
+				"%s%s", mlflow.MetricsRoutePrefix, mlflow.MetricsGetHistoryRoute,
+			),
+		)
+		for _, metric := range resp.Metrics {
+			steps = append(steps, metric.Step)
+		}
+		pageToken = resp.NextPageToken
+		if page < 2 {
+			s.Len(resp.Metrics, 4)
+			s.NotEmpty(pageToken)
+		} else {
+			s.Len(resp.Metrics, 2)
+			s.Empty(pageToken)
+		}
+	}
+	s.Equal([]int64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, steps)
+}
+
+func (s *GetHistoryTestSuite) Test_Downsampling() {
+	run := s.createRunWithMetrics(10)
+
+	tests := []struct {
+		name          string
+		request       request.GetMetricHistoryRequest
+		expectedSteps []int64
+	}{
+		{
+			name: "StepRange",
+			request: request.GetMetricHistoryRequest{
+				RunID:     run.ID,
+				MetricKey: "key",
+				MetricDownsamplingPartialRequest: request.MetricDownsamplingPartialRequest{
+					StartStep: common.GetPointer(int64(2)),
+					EndStep:   common.GetPointer(int64(5)),
+				},
+			},
+			expectedSteps: []int64{2, 3, 4, 5},
+		},
+		{
+			name: "LTTB",
+			request: request.GetMetricHistoryRequest{
+				RunID:     run.ID,
+				MetricKey: "key",
+				MetricDownsamplingPartialRequest: request.MetricDownsamplingPartialRequest{
+					Downsampling: request.DownsamplingMethodLTTB,
+					Points:       3,
+				},
+			},
+			expectedSteps: []int64{0, 3, 9},
+		},
+		{
+			name: "MinMaxWithStepRange",
+			request: request.GetMetricHistoryRequest{
+				RunID:     run.ID,
+				MetricKey: "key",
+				MetricDownsamplingPartialRequest: request.MetricDownsamplingPartialRequest{
+					Downsampling: request.DownsamplingMethodMinMax,
+					Points:       4,
+					StartStep:    common.GetPointer(int64(2)),
+				},
+			},
+			expectedSteps: []int64{3, 4, 7, 8},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := response.GetMetricHistoryResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithQuery(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.MetricsRoutePrefix, mlflow.MetricsGetHistoryRoute,
+				),
+			)
+			steps := make([]int64, len(resp.Metrics))
+			for n, metric := range resp.Metrics {
+				steps[n] = metric.Step
+			}
+			s.Equal(tt.expectedSteps, steps)
+			s.Empty(resp.NextPageToken)
+		})
+	}
+}
+
 func (s *GetHistoryTestSuite) Test_Error() {
 	tests := []struct {
 		name    string
@@ -104,6 +285,28 @@ func (s *GetHistoryTestSuite) Test_Error() {
 			},
 			error: api.NewInvalidParameterValueError("Missing value for required parameter 'metric_key'"),
 		},
+		{
+			name: "IncorrectDownsampling",
+			request: request.GetMetricHistoryRequest{
+				RunID:     "id",
+				MetricKey: "key",
+				MetricDownsamplingPartialRequest: request.MetricDownsamplingPartialRequest{
+					Downsampling: "unknown",
+				},
+			},
+			error: api.NewInvalidParameterValueError("Invalid downsampling 'unknown'"),
+		},
+		{
+			name: "IncorrectContext",
+			request: request.GetMetricHistoryRequest{
+				RunID:     "id",
+				MetricKey: "key",
+				Context:   `["subset"]`,
+			},
+			error: api.NewInvalidParameterValueError(
+				"invalid context '[\"subset\"]': json: cannot unmarshal array into Go value of type map[string]interface {}",
+			),
+		},
 	}
 	for _, tt := range tests {
 		s.Run(tt.name, func() {
@@ -121,3 +324,37 @@ func (s *GetHistoryTestSuite) Test_Error() {
 		})
 	}
 }
+
+// createRunWithMetrics creates run with metric `key` which has provided number of steps.
+// Values form a sawtooth, so that min and max of each bucket are different.
+func (s *GetHistoryTestSuite) createRunWithMetrics(count int) *models.Run {
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           "Test Experiment",
+		NamespaceID:    s.DefaultNamespace.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             "id",
+		Name:           "chill-run",
+		Status:         models.StatusScheduled,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageActive,
+		ExperimentID:   *experiment.ID,
+	})
+	s.Require().Nil(err)
+
+	for step := 0; step < count; step++ {
+		_, err = s.MetricFixtures.CreateMetric(context.Background(), &models.Metric{
+			Key:       "key",
+			Value:     float64(step%4) * 1.5,
+			Timestamp: 1234567890 + int64(step),
+			RunID:     run.ID,
+			Step:      int64(step),
+			Iter:      int64(step + 1),
+		})
+		s.Require().Nil(err)
+	}
+	return run
+}
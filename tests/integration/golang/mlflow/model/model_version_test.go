@@ -0,0 +1,263 @@
+package model
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type ModelVersionTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestModelVersionTestSuite(t *testing.T) {
+	suite.Run(t, new(ModelVersionTestSuite))
+}
+
+func (s *ModelVersionTestSuite) Test_Ok() {
+	// 1. prepare a database with test data.
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+		Name:           "TestRun",
+		Status:         models.StatusRunning,
+		SourceType:     "JOB",
+		ArtifactURI:    "s3://bucket/1/artifacts",
+		ExperimentID:   *s.DefaultExperiment.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+	_, err = s.ModelFixtures.CreateRegisteredModel(context.Background(), &models.RegisteredModel{
+		Name:        "model",
+		NamespaceID: s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+
+	// 2. create two model versions and check that the run source has been resolved.
+	for i := 0; i < 2; i++ {
+		resp := response.ModelVersionResponse{}
+		s.Require().Nil(
+			s.MlflowClient().WithMethod(
+				http.MethodPost,
+			).WithRequest(
+				request.CreateModelVersionRequest{
+					Name:   "model",
+					Source: "runs:/" + run.ID + "/model",
+					Tags: []request.ModelVersionTagPartialRequest{
+						{Key: "key", Value: "value"},
+					},
+				},
+			).WithResponse(
+				&resp,
+			).DoRequest(
+				"%s%s", mlflow.ModelVersionsRoutePrefix, mlflow.ModelVersionsCreateRoute,
+			),
+		)
+		s.Equal([]string{"1", "2"}[i], resp.ModelVersion.Version)
+		s.Equal(run.ID, resp.ModelVersion.RunID)
+		s.Equal(string(models.ModelVersionStageNone), resp.ModelVersion.CurrentStage)
+		s.Equal(string(models.ModelVersionStatusReady), resp.ModelVersion.Status)
+	}
+
+	downloadResp := response.GetModelVersionDownloadURIResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithQuery(
+			request.GetModelVersionDownloadURIRequest{
+				Name:    "model",
+				Version: "1",
+			},
+		).WithResponse(
+			&downloadResp,
+		).DoRequest(
+			"%s%s", mlflow.ModelVersionsRoutePrefix, mlflow.ModelVersionsGetDownloadURIRoute,
+		),
+	)
+	s.Equal("s3://bucket/1/artifacts/model", downloadResp.ArtifactURI)
+
+	// 3. move both versions to production, archiving the existing one.
+	for _, version := range []string{"1", "2"} {
+		resp := response.ModelVersionResponse{}
+		s.Require().Nil(
+			s.MlflowClient().WithMethod(
+				http.MethodPost,
+			).WithRequest(
+				request.TransitionModelVersionStageRequest{
+					Name:                    "model",
+					Version:                 version,
+					Stage:                   "production",
+					ArchiveExistingVersions: true,
+				},
+			).WithResponse(
+				&resp,
+			).DoRequest(
+				"%s%s", mlflow.ModelVersionsRoutePrefix, mlflow.ModelVersionsTransitionStageRoute,
+			),
+		)
+		s.Equal(string(models.ModelVersionStageProduction), resp.ModelVersion.CurrentStage)
+	}
+
+	latestResp := response.GetLatestVersionsResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.GetLatestVersionsRequest{
+				Name: "model",
+			},
+		).WithResponse(
+			&latestResp,
+		).DoRequest(
+			"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsGetLatestVersionsRoute,
+		),
+	)
+	s.Require().Len(latestResp.ModelVersions, 2)
+	s.Equal("2", latestResp.ModelVersions[0].Version)
+	s.Equal(string(models.ModelVersionStageProduction), latestResp.ModelVersions[0].CurrentStage)
+	s.Equal("1", latestResp.ModelVersions[1].Version)
+	s.Equal(string(models.ModelVersionStageArchived), latestResp.ModelVersions[1].CurrentStage)
+
+	// 4. set alias and get model version by it.
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.SetRegisteredModelAliasRequest{
+				Name:    "model",
+				Alias:   "champion",
+				Version: "2",
+			},
+		).WithResponse(
+			&map[string]any{},
+		).DoRequest(
+			"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsAliasRoute,
+		),
+	)
+	aliasResp := response.ModelVersionResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithQuery(
+			request.GetModelVersionByAliasRequest{
+				Name:  "model",
+				Alias: "champion",
+			},
+		).WithResponse(
+			&aliasResp,
+		).DoRequest(
+			"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsAliasRoute,
+		),
+	)
+	s.Equal("2", aliasResp.ModelVersion.Version)
+	s.Equal([]string{"champion"}, aliasResp.ModelVersion.Aliases)
+
+	// 5. delete the model version and check that its number is not reused.
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodDelete,
+		).WithRequest(
+			request.DeleteModelVersionRequest{
+				Name:    "model",
+				Version: "2",
+			},
+		).WithResponse(
+			&map[string]any{},
+		).DoRequest(
+			"%s%s", mlflow.ModelVersionsRoutePrefix, mlflow.ModelVersionsDeleteRoute,
+		),
+	)
+	errResp := api.ErrorResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithQuery(
+			request.GetModelVersionByAliasRequest{
+				Name:  "model",
+				Alias: "champion",
+			},
+		).WithResponse(
+			&errResp,
+		).DoRequest(
+			"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsAliasRoute,
+		),
+	)
+	s.Equal(
+		api.NewResourceDoesNotExistError("unable to find alias 'champion' for registered model 'model'").Error(),
+		errResp.Error(),
+	)
+
+	createResp := response.ModelVersionResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.CreateModelVersionRequest{
+				Name:   "model",
+				Source: "s3://bucket/model",
+			},
+		).WithResponse(
+			&createResp,
+		).DoRequest(
+			"%s%s", mlflow.ModelVersionsRoutePrefix, mlflow.ModelVersionsCreateRoute,
+		),
+	)
+	s.Equal("3", createResp.ModelVersion.Version)
+}
+
+func (s *ModelVersionTestSuite) Test_Error() {
+	_, err := s.ModelFixtures.CreateRegisteredModel(context.Background(), &models.RegisteredModel{
+		Name:        "model",
+		NamespaceID: s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.GetModelVersionRequest
+	}{
+		{
+			name:    "EmptyVersion",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'version'"),
+			request: request.GetModelVersionRequest{Name: "model"},
+		},
+		{
+			name: "IncorrectVersion",
+			error: api.NewInvalidParameterValueError(
+				`unable to parse model version 'incorrect': strconv.ParseInt: parsing "incorrect": invalid syntax`,
+			),
+			request: request.GetModelVersionRequest{Name: "model", Version: "incorrect"},
+		},
+		{
+			name:    "NotFoundRegisteredModel",
+			error:   api.NewResourceDoesNotExistError("unable to find registered model 'not-found'"),
+			request: request.GetModelVersionRequest{Name: "not-found", Version: "1"},
+		},
+		{
+			name:    "NotFoundModelVersion",
+			error:   api.NewResourceDoesNotExistError("unable to find model version '1' of registered model 'model'"),
+			request: request.GetModelVersionRequest{Name: "model", Version: "1"},
+		},
+	}
+
+	for _, tt := range testData {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithQuery(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.ModelVersionsRoutePrefix, mlflow.ModelVersionsGetRoute,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
@@ -0,0 +1,219 @@
+package model
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type RegisteredModelTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestRegisteredModelTestSuite(t *testing.T) {
+	suite.Run(t, new(RegisteredModelTestSuite))
+}
+
+func (s *RegisteredModelTestSuite) Test_Ok() {
+	// 1. create registered model.
+	createResp := response.RegisteredModelResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.CreateRegisteredModelRequest{
+				Name:        "model",
+				Description: "description",
+				Tags: []request.RegisteredModelTagPartialRequest{
+					{Key: "key1", Value: "value1"},
+				},
+			},
+		).WithResponse(
+			&createResp,
+		).DoRequest(
+			"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsCreateRoute,
+		),
+	)
+	s.Equal("model", createResp.RegisteredModel.Name)
+	s.Equal("description", createResp.RegisteredModel.Description)
+	s.NotEmpty(createResp.RegisteredModel.CreationTimestamp)
+	s.Equal([]response.RegisteredModelTagPartialResponse{
+		{Key: "key1", Value: "value1"},
+	}, createResp.RegisteredModel.Tags)
+
+	// 2. update description and set one more tag.
+	updateResp := response.RegisteredModelResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPatch,
+		).WithRequest(
+			request.UpdateRegisteredModelRequest{
+				Name:        "model",
+				Description: common.GetPointer("new description"),
+			},
+		).WithResponse(
+			&updateResp,
+		).DoRequest(
+			"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsUpdateRoute,
+		),
+	)
+	s.Equal("new description", updateResp.RegisteredModel.Description)
+
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.SetRegisteredModelTagRequest{
+				Name:  "model",
+				Key:   "key2",
+				Value: "value2",
+			},
+		).WithResponse(
+			&map[string]any{},
+		).DoRequest(
+			"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsSetTagRoute,
+		),
+	)
+
+	// 3. rename registered model and check that it is available under the new name only.
+	renameResp := response.RegisteredModelResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.RenameRegisteredModelRequest{
+				Name:    "model",
+				NewName: "renamed",
+			},
+		).WithResponse(
+			&renameResp,
+		).DoRequest(
+			"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsRenameRoute,
+		),
+	)
+	s.Equal("renamed", renameResp.RegisteredModel.Name)
+
+	getResp := response.RegisteredModelResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithQuery(
+			request.GetRegisteredModelRequest{
+				Name: "renamed",
+			},
+		).WithResponse(
+			&getResp,
+		).DoRequest(
+			"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsGetRoute,
+		),
+	)
+	s.Equal("renamed", getResp.RegisteredModel.Name)
+	s.Equal("new description", getResp.RegisteredModel.Description)
+	s.ElementsMatch([]response.RegisteredModelTagPartialResponse{
+		{Key: "key1", Value: "value1"},
+		{Key: "key2", Value: "value2"},
+	}, getResp.RegisteredModel.Tags)
+
+	errResp := api.ErrorResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithQuery(
+			request.GetRegisteredModelRequest{
+				Name: "model",
+			},
+		).WithResponse(
+			&errResp,
+		).DoRequest(
+			"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsGetRoute,
+		),
+	)
+	s.Equal(api.NewResourceDoesNotExistError("unable to find registered model 'model'").Error(), errResp.Error())
+
+	// 4. delete tag and registered model itself.
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodDelete,
+		).WithRequest(
+			request.DeleteRegisteredModelTagRequest{
+				Name: "renamed",
+				Key:  "key1",
+			},
+		).WithResponse(
+			&map[string]any{},
+		).DoRequest(
+			"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsDeleteTagRoute,
+		),
+	)
+	registeredModel, err := s.ModelFixtures.GetRegisteredModel(context.Background(), s.DefaultNamespace.ID, "renamed")
+	s.Require().Nil(err)
+	s.Equal([]models.RegisteredModelTag{
+		{Key: "key2", Value: "value2", RegisteredModelID: registeredModel.ID},
+	}, registeredModel.Tags)
+
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodDelete,
+		).WithRequest(
+			request.DeleteRegisteredModelRequest{
+				Name: "renamed",
+			},
+		).WithResponse(
+			&map[string]any{},
+		).DoRequest(
+			"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsDeleteRoute,
+		),
+	)
+	registeredModel, err = s.ModelFixtures.GetRegisteredModel(context.Background(), s.DefaultNamespace.ID, "renamed")
+	s.Require().Nil(err)
+	s.Nil(registeredModel)
+}
+
+func (s *RegisteredModelTestSuite) Test_Error() {
+	_, err := s.ModelFixtures.CreateRegisteredModel(context.Background(), &models.RegisteredModel{
+		Name:        "existing",
+		NamespaceID: s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.CreateRegisteredModelRequest
+	}{
+		{
+			name:    "EmptyName",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'name'"),
+			request: request.CreateRegisteredModelRequest{},
+		},
+		{
+			name:    "AlreadyExists",
+			error:   api.NewResourceAlreadyExistsError("registered model(name=existing) already exists"),
+			request: request.CreateRegisteredModelRequest{Name: "existing"},
+		},
+	}
+
+	for _, tt := range testData {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsCreateRoute,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
@@ -0,0 +1,215 @@
+package model
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type SearchTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestSearchTestSuite(t *testing.T) {
+	suite.Run(t, new(SearchTestSuite))
+}
+
+func (s *SearchTestSuite) Test_Ok() {
+	// 1. prepare a database with test data.
+	for _, name := range []string{"model-c", "model-a", "other-b"} {
+		registeredModel, err := s.ModelFixtures.CreateRegisteredModel(context.Background(), &models.RegisteredModel{
+			Name:        name,
+			NamespaceID: s.DefaultNamespace.ID,
+			Tags: []models.RegisteredModelTag{
+				{Key: "team", Value: name[len(name)-1:]},
+			},
+		})
+		s.Require().Nil(err)
+		for i := 0; i < 2; i++ {
+			_, err := s.ModelFixtures.CreateModelVersion(context.Background(), &models.ModelVersion{
+				RegisteredModelID: registeredModel.ID,
+				CurrentStage:      models.ModelVersionStageNone,
+				Status:            models.ModelVersionStatusReady,
+				Source:            "s3://bucket/" + name,
+				RunID:             name,
+			})
+			s.Require().Nil(err)
+		}
+	}
+
+	// 2. search registered models.
+	registeredModelsTestData := []struct {
+		name     string
+		request  request.SearchRegisteredModelsRequest
+		expected []string
+		hasToken bool
+	}{
+		{
+			name:     "All",
+			request:  request.SearchRegisteredModelsRequest{},
+			expected: []string{"model-a", "model-c", "other-b"},
+		},
+		{
+			name:     "NameLike",
+			request:  request.SearchRegisteredModelsRequest{Filter: "name LIKE 'model-%'"},
+			expected: []string{"model-a", "model-c"},
+		},
+		{
+			name:     "Tag",
+			request:  request.SearchRegisteredModelsRequest{Filter: "tags.team = 'b'"},
+			expected: []string{"other-b"},
+		},
+		{
+			name: "OrWithParentheses",
+			request: request.SearchRegisteredModelsRequest{
+				Filter: "(name = 'model-a' OR tags.team = 'b') AND name != 'model-c'",
+			},
+			expected: []string{"model-a", "other-b"},
+		},
+		{
+			name:     "TagIsNull",
+			request:  request.SearchRegisteredModelsRequest{Filter: "tags.owner IS NULL AND name LIKE 'model-%'"},
+			expected: []string{"model-a", "model-c"},
+		},
+		{
+			name:     "OrderByNameDesc",
+			request:  request.SearchRegisteredModelsRequest{OrderBy: []string{"name DESC"}},
+			expected: []string{"other-b", "model-c", "model-a"},
+		},
+		{
+			name:     "Paginated",
+			request:  request.SearchRegisteredModelsRequest{MaxResults: 2},
+			expected: []string{"model-a", "model-c"},
+			hasToken: true,
+		},
+	}
+	for _, tt := range registeredModelsTestData {
+		s.Run(tt.name, func() {
+			resp := response.SearchRegisteredModelsResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithQuery(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsSearchRoute,
+				),
+			)
+			names := make([]string, 0, len(resp.RegisteredModels))
+			for _, registeredModel := range resp.RegisteredModels {
+				names = append(names, registeredModel.Name)
+			}
+			s.Equal(tt.expected, names)
+			s.Equal(tt.hasToken, resp.NextPageToken != "")
+		})
+	}
+
+	// 3. search model versions.
+	modelVersionsTestData := []struct {
+		name     string
+		request  request.SearchModelVersionsRequest
+		expected int
+	}{
+		{
+			name:     "All",
+			request:  request.SearchModelVersionsRequest{},
+			expected: 6,
+		},
+		{
+			name:     "Name",
+			request:  request.SearchModelVersionsRequest{Filter: "name = 'model-a'"},
+			expected: 2,
+		},
+		{
+			name:     "RunIDIn",
+			request:  request.SearchModelVersionsRequest{Filter: "run_id IN ('model-a', 'other-b')"},
+			expected: 4,
+		},
+		{
+			name: "VersionNumber",
+			request: request.SearchModelVersionsRequest{
+				Filter: "name ILIKE 'MODEL-%' AND version_number > 1",
+			},
+			expected: 2,
+		},
+		{
+			name: "OrWithParentheses",
+			request: request.SearchModelVersionsRequest{
+				Filter: "version_number = 1 AND (name = 'model-a' OR source_path = 's3://bucket/other-b')",
+			},
+			expected: 2,
+		},
+	}
+	for _, tt := range modelVersionsTestData {
+		s.Run(tt.name, func() {
+			resp := response.SearchModelVersionsResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithQuery(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.ModelVersionsRoutePrefix, mlflow.ModelVersionsSearchRoute,
+				),
+			)
+			s.Len(resp.ModelVersions, tt.expected)
+		})
+	}
+}
+
+func (s *SearchTestSuite) Test_Error() {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.SearchRegisteredModelsRequest
+	}{
+		{
+			name: "IncorrectMaxResults",
+			error: api.NewInvalidParameterValueError(
+				"Invalid value for parameter 'max_results' supplied. It must be at most 1000",
+			),
+			request: request.SearchRegisteredModelsRequest{MaxResults: 1001},
+		},
+		{
+			name:    "IncorrectAttribute",
+			error:   api.NewInvalidParameterValueError("invalid attribute 'source'. Valid values are ['name']"),
+			request: request.SearchRegisteredModelsRequest{Filter: "source = 'value'"},
+		},
+		{
+			name: "MalformedFilter",
+			error: api.NewInvalidParameterValueError(
+				"malformed filter 'invalid_filter': unexpected end of filter, expected comparison operator at position 14",
+			),
+			request: request.SearchRegisteredModelsRequest{Filter: "invalid_filter"},
+		},
+		{
+			name:    "IncorrectStringOperator",
+			error:   api.NewInvalidParameterValueError("invalid string comparison operator '>'"),
+			request: request.SearchRegisteredModelsRequest{Filter: "name > 'value'"},
+		},
+	}
+
+	for _, tt := range testData {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithQuery(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsSearchRoute,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
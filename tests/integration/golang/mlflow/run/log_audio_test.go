@@ -0,0 +1,166 @@
+package run
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type LogAudioTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestLogAudioTestSuite(t *testing.T) {
+	suite.Run(t, new(LogAudioTestSuite))
+}
+
+func (s *LogAudioTestSuite) Test_Ok() {
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+		ExperimentID:   *s.DefaultExperiment.ID,
+		SourceType:     "JOB",
+		ArtifactURI:    "/tmp/artifacts/1/run/artifacts",
+		LifecycleStage: models.LifecycleStageActive,
+		Status:         models.StatusRunning,
+	})
+	s.Require().Nil(err)
+
+	requests := []request.LogAudioRequest{
+		{
+			RunID:   run.ID,
+			Name:    "samples",
+			BlobURI: "audios/first.wav",
+		},
+		{
+			RunID:      run.ID,
+			Name:       "samples",
+			Step:       1,
+			Index:      2,
+			Caption:    "second sample",
+			Format:     "wav",
+			SampleRate: 22050,
+			BlobURI:    "audios/second.wav",
+			Context: map[string]any{
+				"subset": "val",
+			},
+		},
+	}
+	for _, req := range requests {
+		s.Require().Nil(
+			s.MlflowClient().WithMethod(
+				http.MethodPost,
+			).WithRequest(
+				req,
+			).DoRequest(
+				"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsLogAudioRoute,
+			),
+		)
+	}
+
+	audios, err := s.AudioFixtures.GetByRunID(context.Background(), run.ID)
+	s.Require().Nil(err)
+	s.Require().Len(audios, 2)
+	s.Equal("samples", audios[0].Name)
+	s.Equal(int64(0), audios[0].Step)
+	s.Equal(int64(1), audios[0].Iter)
+	s.Equal("/tmp/artifacts/1/run/artifacts/audios/first.wav", audios[0].BlobURI)
+	s.JSONEq(`{}`, string(audios[0].Context.Json))
+	s.Equal(int64(1), audios[1].Step)
+	s.Equal(int64(2), audios[1].Index)
+	s.Equal("second sample", audios[1].Caption)
+	s.Equal("wav", audios[1].Format)
+	s.Equal(int64(22050), audios[1].SampleRate)
+	s.Equal("/tmp/artifacts/1/run/artifacts/audios/second.wav", audios[1].BlobURI)
+	s.JSONEq(`{"subset": "val"}`, string(audios[1].Context.Json))
+}
+
+func (s *LogAudioTestSuite) Test_Error() {
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+		ExperimentID:   *s.DefaultExperiment.ID,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageActive,
+		Status:         models.StatusRunning,
+	})
+	s.Require().Nil(err)
+	tests := []struct {
+		name    string
+		request request.LogAudioRequest
+		error   *api.ErrorResponse
+	}{
+		{
+			name: "MissingRunID",
+			request: request.LogAudioRequest{
+				Name: "samples",
+			},
+			error: &api.ErrorResponse{
+				Message:    "Missing value for required parameter 'run_id'",
+				StatusCode: http.StatusBadRequest,
+			},
+		},
+		{
+			name: "NegativeSampleRate",
+			request: request.LogAudioRequest{
+				RunID:      run.ID,
+				Name:       "samples",
+				SampleRate: -1,
+			},
+			error: &api.ErrorResponse{
+				Message:    "Invalid value for parameter 'sample_rate': must be non-negative",
+				StatusCode: http.StatusBadRequest,
+			},
+		},
+		{
+			name: "ParentDirectoryBlobURI",
+			request: request.LogAudioRequest{
+				RunID:   run.ID,
+				Name:    "samples",
+				BlobURI: "audios/../../other-run/audio.wav",
+			},
+			error: &api.ErrorResponse{
+				Message:    "Invalid value for parameter 'blob_uri': must be a path relative to the run artifact root",
+				StatusCode: http.StatusBadRequest,
+			},
+		},
+		{
+			name: "NotFoundRun",
+			request: request.LogAudioRequest{
+				RunID:   "not-existing-run",
+				Name:    "samples",
+				BlobURI: "audios/audio.wav",
+			},
+			error: &api.ErrorResponse{
+				Message:    "unable to find run 'not-existing-run'",
+				StatusCode: http.StatusNotFound,
+			},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsLogAudioRoute,
+				),
+			)
+			s.Equal(tt.error.Message, resp.Message)
+			s.Equal(tt.error.StatusCode, resp.StatusCode)
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package run
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type LogDistributionTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestLogDistributionTestSuite(t *testing.T) {
+	suite.Run(t, new(LogDistributionTestSuite))
+}
+
+func (s *LogDistributionTestSuite) Test_Ok() {
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+		ExperimentID:   *s.DefaultExperiment.ID,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageActive,
+		Status:         models.StatusRunning,
+	})
+	s.Require().Nil(err)
+
+	requests := []request.LogDistributionRequest{
+		{
+			RunID:    run.ID,
+			Name:     "weights",
+			BinEdges: []float64{0, 1, 2},
+			Counts:   []float64{3, 4},
+		},
+		{
+			RunID:    run.ID,
+			Name:     "weights",
+			Step:     1,
+			BinEdges: []float64{-1.5, 0, 1.5},
+			Counts:   []float64{5, 6},
+			Context: map[string]any{
+				"layer": "dense",
+			},
+		},
+	}
+	for _, req := range requests {
+		s.Require().Nil(
+			s.MlflowClient().WithMethod(
+				http.MethodPost,
+			).WithRequest(
+				req,
+			).DoRequest(
+				"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsLogDistributionRoute,
+			),
+		)
+	}
+
+	distributions, err := s.DistributionFixtures.GetByRunID(context.Background(), run.ID)
+	s.Require().Nil(err)
+	s.Require().Len(distributions, 2)
+	s.Equal("weights", distributions[0].Name)
+	s.Equal(int64(0), distributions[0].Step)
+	s.Equal(int64(1), distributions[0].Iter)
+	s.JSONEq(`[0, 1, 2]`, string(distributions[0].BinEdges))
+	s.JSONEq(`[3, 4]`, string(distributions[0].Counts))
+	s.JSONEq(`{}`, string(distributions[0].Context.Json))
+	s.Equal(int64(1), distributions[1].Step)
+	s.JSONEq(`[-1.5, 0, 1.5]`, string(distributions[1].BinEdges))
+	s.JSONEq(`[5, 6]`, string(distributions[1].Counts))
+	s.JSONEq(`{"layer": "dense"}`, string(distributions[1].Context.Json))
+}
+
+func (s *LogDistributionTestSuite) Test_Error() {
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+		ExperimentID:   *s.DefaultExperiment.ID,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageActive,
+		Status:         models.StatusRunning,
+	})
+	s.Require().Nil(err)
+	tests := []struct {
+		name    string
+		request request.LogDistributionRequest
+		error   *api.ErrorResponse
+	}{
+		{
+			name: "MissingRunID",
+			request: request.LogDistributionRequest{
+				Name: "weights",
+			},
+			error: &api.ErrorResponse{
+				Message:    "Missing value for required parameter 'run_id'",
+				StatusCode: http.StatusBadRequest,
+			},
+		},
+		{
+			name: "MissingName",
+			request: request.LogDistributionRequest{
+				RunID: run.ID,
+			},
+			error: &api.ErrorResponse{
+				Message:    "Missing value for required parameter 'name'",
+				StatusCode: http.StatusBadRequest,
+			},
+		},
+		{
+			name: "MissingCounts",
+			request: request.LogDistributionRequest{
+				RunID: run.ID,
+				Name:  "weights",
+			},
+			error: &api.ErrorResponse{
+				Message:    "Missing value for required parameter 'counts'",
+				StatusCode: http.StatusBadRequest,
+			},
+		},
+		{
+			name: "IncorrectBinEdgesNumber",
+			request: request.LogDistributionRequest{
+				RunID:    run.ID,
+				Name:     "weights",
+				BinEdges: []float64{0, 1},
+				Counts:   []float64{1, 2},
+			},
+			error: &api.ErrorResponse{
+				Message:    "Invalid value for parameter 'bin_edges': expected 3 edges for 2 counts",
+				StatusCode: http.StatusBadRequest,
+			},
+		},
+		{
+			name: "UnsortedBinEdges",
+			request: request.LogDistributionRequest{
+				RunID:    run.ID,
+				Name:     "weights",
+				BinEdges: []float64{0, 2, 1},
+				Counts:   []float64{1, 2},
+			},
+			error: &api.ErrorResponse{
+				Message:    "Invalid value for parameter 'bin_edges': must be sorted in ascending order",
+				StatusCode: http.StatusBadRequest,
+			},
+		},
+		{
+			name: "NotFoundRun",
+			request: request.LogDistributionRequest{
+				RunID:    "not-existing-run",
+				Name:     "weights",
+				BinEdges: []float64{0, 1},
+				Counts:   []float64{1},
+			},
+			error: &api.ErrorResponse{
+				Message:    "unable to find run 'not-existing-run'",
+				StatusCode: http.StatusNotFound,
+			},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsLogDistributionRoute,
+				),
+			)
+			s.Equal(tt.error.Message, resp.Message)
+			s.Equal(tt.error.StatusCode, resp.StatusCode)
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package run
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type LogFigureTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestLogFigureTestSuite(t *testing.T) {
+	suite.Run(t, new(LogFigureTestSuite))
+}
+
+func (s *LogFigureTestSuite) Test_Ok() {
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+		ExperimentID:   *s.DefaultExperiment.ID,
+		SourceType:     "JOB",
+		ArtifactURI:    "s3://bucket/1/run/artifacts",
+		LifecycleStage: models.LifecycleStageActive,
+		Status:         models.StatusRunning,
+	})
+	s.Require().Nil(err)
+
+	requests := []request.LogFigureRequest{
+		{
+			RunID:   run.ID,
+			Name:    "loss",
+			BlobURI: "figures/first.json",
+		},
+		{
+			RunID:   run.ID,
+			Name:    "loss",
+			Step:    1,
+			Caption: "second figure",
+			BlobURI: "figures/second.json",
+			Context: map[string]any{
+				"subset": "val",
+			},
+		},
+	}
+	for _, req := range requests {
+		s.Require().Nil(
+			s.MlflowClient().WithMethod(
+				http.MethodPost,
+			).WithRequest(
+				req,
+			).DoRequest(
+				"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsLogFigureRoute,
+			),
+		)
+	}
+
+	figures, err := s.FigureFixtures.GetByRunID(context.Background(), run.ID)
+	s.Require().Nil(err)
+	s.Require().Len(figures, 2)
+	s.Equal("loss", figures[0].Name)
+	s.Equal(int64(0), figures[0].Step)
+	s.Equal(int64(1), figures[0].Iter)
+	s.Equal("s3://bucket/1/run/artifacts/figures/first.json", figures[0].BlobURI)
+	s.JSONEq(`{}`, string(figures[0].Context.Json))
+	s.Equal(int64(1), figures[1].Step)
+	s.Equal("second figure", figures[1].Caption)
+	s.Equal("s3://bucket/1/run/artifacts/figures/second.json", figures[1].BlobURI)
+	s.JSONEq(`{"subset": "val"}`, string(figures[1].Context.Json))
+}
+
+func (s *LogFigureTestSuite) Test_Error() {
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+		ExperimentID:   *s.DefaultExperiment.ID,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageActive,
+		Status:         models.StatusRunning,
+	})
+	s.Require().Nil(err)
+	tests := []struct {
+		name    string
+		request request.LogFigureRequest
+		error   *api.ErrorResponse
+	}{
+		{
+			name: "MissingRunID",
+			request: request.LogFigureRequest{
+				Name: "loss",
+			},
+			error: &api.ErrorResponse{
+				Message:    "Missing value for required parameter 'run_id'",
+				StatusCode: http.StatusBadRequest,
+			},
+		},
+		{
+			name: "MissingName",
+			request: request.LogFigureRequest{
+				RunID: run.ID,
+			},
+			error: &api.ErrorResponse{
+				Message:    "Missing value for required parameter 'name'",
+				StatusCode: http.StatusBadRequest,
+			},
+		},
+		{
+			name: "MissingBlobURI",
+			request: request.LogFigureRequest{
+				RunID: run.ID,
+				Name:  "loss",
+			},
+			error: &api.ErrorResponse{
+				Message:    "Missing value for required parameter 'blob_uri'",
+				StatusCode: http.StatusBadRequest,
+			},
+		},
+		{
+			name: "AbsoluteBlobURI",
+			request: request.LogFigureRequest{
+				RunID:   run.ID,
+				Name:    "loss",
+				BlobURI: "s3://other-bucket/figure.json",
+			},
+			error: &api.ErrorResponse{
+				Message:    "Invalid value for parameter 'blob_uri': must be a path relative to the run artifact root",
+				StatusCode: http.StatusBadRequest,
+			},
+		},
+		{
+			name: "NotFoundRun",
+			request: request.LogFigureRequest{
+				RunID:   "not-existing-run",
+				Name:    "loss",
+				BlobURI: "figures/figure.json",
+			},
+			error: &api.ErrorResponse{
+				Message:    "unable to find run 'not-existing-run'",
+				StatusCode: http.StatusNotFound,
+			},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsLogFigureRoute,
+				),
+			)
+			s.Equal(tt.error.Message, resp.Message)
+			s.Equal(tt.error.StatusCode, resp.StatusCode)
+		})
+	}
+}
@@ -0,0 +1,244 @@
+package run
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type LogInputsTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestLogInputsTestSuite(t *testing.T) {
+	suite.Run(t, new(LogInputsTestSuite))
+}
+
+func (s *LogInputsTestSuite) Test_Ok() {
+	run1, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+		ExperimentID:   *s.DefaultExperiment.ID,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageActive,
+		Status:         models.StatusRunning,
+	})
+	s.Require().Nil(err)
+	run2, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+		ExperimentID:   *s.DefaultExperiment.ID,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageActive,
+		Status:         models.StatusRunning,
+	})
+	s.Require().Nil(err)
+
+	trainingDataset := request.DatasetInputPartialRequest{
+		Tags: []request.InputTagPartialRequest{{
+			Key:   models.InputTagContext,
+			Value: "training",
+		}},
+		Dataset: request.DatasetPartialRequest{
+			Name:       "iris",
+			Digest:     "5f2a9c1e",
+			SourceType: "local",
+			Source:     `{"uri": "/data/iris.csv"}`,
+			Schema:     `{"mlflow_colspec": []}`,
+		},
+	}
+	validationDataset := request.DatasetInputPartialRequest{
+		Tags: []request.InputTagPartialRequest{{
+			Key:   models.InputTagContext,
+			Value: "validation",
+		}},
+		Dataset: request.DatasetPartialRequest{
+			Name:       "iris-validation",
+			Digest:     "7b3d0e4f",
+			SourceType: "local",
+			Source:     `{"uri": "/data/iris-validation.csv"}`,
+		},
+	}
+
+	// log the same inputs twice to make sure that datasets and inputs are reused.
+	for _, req := range []request.LogInputsRequest{
+		{RunID: run1.ID, Datasets: []request.DatasetInputPartialRequest{trainingDataset, validationDataset}},
+		{RunID: run1.ID, Datasets: []request.DatasetInputPartialRequest{trainingDataset}},
+		{RunID: run2.ID, Datasets: []request.DatasetInputPartialRequest{validationDataset}},
+	} {
+		resp := map[string]any{}
+		s.Require().Nil(
+			s.MlflowClient().WithMethod(
+				http.MethodPost,
+			).WithRequest(
+				req,
+			).WithResponse(
+				&resp,
+			).DoRequest(
+				"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsLogInputsRoute,
+			),
+		)
+		s.Empty(resp)
+	}
+
+	resp := response.GetRunResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithQuery(
+			request.GetRunRequest{RunID: run1.ID},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsGetRoute,
+		),
+	)
+	s.ElementsMatch([]response.DatasetInputPartialResponse{
+		{
+			Tags: []response.InputTagPartialResponse{{
+				Key:   models.InputTagContext,
+				Value: "training",
+			}},
+			Dataset: response.DatasetPartialResponse{
+				Name:       "iris",
+				Digest:     "5f2a9c1e",
+				SourceType: "local",
+				Source:     `{"uri": "/data/iris.csv"}`,
+				Schema:     `{"mlflow_colspec": []}`,
+			},
+		},
+		{
+			Tags: []response.InputTagPartialResponse{{
+				Key:   models.InputTagContext,
+				Value: "validation",
+			}},
+			Dataset: response.DatasetPartialResponse{
+				Name:       "iris-validation",
+				Digest:     "7b3d0e4f",
+				SourceType: "local",
+				Source:     `{"uri": "/data/iris-validation.csv"}`,
+			},
+		},
+	}, resp.Run.Inputs.DatasetInputs)
+
+	tests := []struct {
+		name         string
+		filter       string
+		expectedRuns []string
+	}{
+		{
+			name:         "DatasetName",
+			filter:       `dataset.name = 'iris'`,
+			expectedRuns: []string{run1.ID},
+		},
+		{
+			name:         "DatasetDigest",
+			filter:       `dataset.digest IN ('5f2a9c1e', '7b3d0e4f')`,
+			expectedRuns: []string{run1.ID, run2.ID},
+		},
+		{
+			name:         "DatasetContext",
+			filter:       `dataset.context = 'validation'`,
+			expectedRuns: []string{run1.ID, run2.ID},
+		},
+		{
+			name:         "DatasetNameAndContext",
+			filter:       `dataset.name LIKE 'iris%' AND NOT dataset.context = 'training'`,
+			expectedRuns: []string{run2.ID},
+		},
+	}
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			resp := response.SearchRunsResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					request.SearchRunsRequest{
+						ExperimentIDs: []string{fmt.Sprintf("%d", *s.DefaultExperiment.ID)},
+						Filter:        tt.filter,
+					},
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsSearchRoute,
+				),
+			)
+			runIDs := make([]string, len(resp.Runs))
+			for i, run := range resp.Runs {
+				runIDs[i] = run.Info.ID
+			}
+			s.ElementsMatch(tt.expectedRuns, runIDs)
+		})
+	}
+}
+
+func (s *LogInputsTestSuite) Test_Error() {
+	tests := []struct {
+		name    string
+		request request.LogInputsRequest
+		error   *api.ErrorResponse
+	}{
+		{
+			name:    "MissingRunID",
+			request: request.LogInputsRequest{},
+			error: &api.ErrorResponse{
+				Message:    "Missing value for required parameter 'run_id'",
+				StatusCode: http.StatusBadRequest,
+			},
+		},
+		{
+			name: "MissingDatasetDigest",
+			request: request.LogInputsRequest{
+				RunID: "id",
+				Datasets: []request.DatasetInputPartialRequest{{
+					Dataset: request.DatasetPartialRequest{
+						Name:       "iris",
+						SourceType: "local",
+						Source:     "source",
+					},
+				}},
+			},
+			error: &api.ErrorResponse{
+				Message:    "Invalid value for parameter 'datasets' supplied",
+				StatusCode: http.StatusBadRequest,
+			},
+		},
+		{
+			name: "NotFoundRun",
+			request: request.LogInputsRequest{
+				RunID: "id",
+			},
+			error: &api.ErrorResponse{
+				Message:    "Run 'id' not found",
+				StatusCode: http.StatusNotFound,
+			},
+		},
+	}
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsLogInputsRoute,
+				),
+			)
+			s.Equal(tt.error.Message, resp.Message)
+			s.Equal(tt.error.StatusCode, resp.StatusCode)
+		})
+	}
+}
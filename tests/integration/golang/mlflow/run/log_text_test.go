@@ -0,0 +1,157 @@
+package run
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type LogTextTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestLogTextTestSuite(t *testing.T) {
+	suite.Run(t, new(LogTextTestSuite))
+}
+
+func (s *LogTextTestSuite) Test_Ok() {
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+		ExperimentID:   *s.DefaultExperiment.ID,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageActive,
+		Status:         models.StatusRunning,
+	})
+	s.Require().Nil(err)
+
+	requests := []request.LogTextRequest{
+		{
+			RunID: run.ID,
+			Name:  "predictions",
+			Text:  "first prediction",
+		},
+		{
+			RunID: run.ID,
+			Name:  "predictions",
+			Text:  "second prediction",
+			Step:  1,
+			Index: 2,
+			Context: map[string]any{
+				"subset": "val",
+			},
+		},
+	}
+	for _, req := range requests {
+		s.Require().Nil(
+			s.MlflowClient().WithMethod(
+				http.MethodPost,
+			).WithRequest(
+				req,
+			).DoRequest(
+				"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsLogTextRoute,
+			),
+		)
+	}
+
+	texts, err := s.TextFixtures.GetByRunID(context.Background(), run.ID)
+	s.Require().Nil(err)
+	s.Require().Len(texts, 2)
+	s.Equal("predictions", texts[0].Name)
+	s.Equal("first prediction", texts[0].Value)
+	s.Equal(int64(0), texts[0].Step)
+	s.Equal(int64(0), texts[0].Index)
+	s.Equal(int64(1), texts[0].Iter)
+	s.JSONEq(`{}`, string(texts[0].Context.Json))
+	s.Equal("second prediction", texts[1].Value)
+	s.Equal(int64(1), texts[1].Step)
+	s.Equal(int64(2), texts[1].Index)
+	s.JSONEq(`{"subset": "val"}`, string(texts[1].Context.Json))
+}
+
+func (s *LogTextTestSuite) Test_Error() {
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+		ExperimentID:   *s.DefaultExperiment.ID,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageActive,
+		Status:         models.StatusRunning,
+	})
+	s.Require().Nil(err)
+	tests := []struct {
+		name    string
+		request request.LogTextRequest
+		error   *api.ErrorResponse
+	}{
+		{
+			name: "MissingRunID",
+			request: request.LogTextRequest{
+				Name: "predictions",
+			},
+			error: &api.ErrorResponse{
+				Message:    "Missing value for required parameter 'run_id'",
+				StatusCode: http.StatusBadRequest,
+			},
+		},
+		{
+			name: "MissingName",
+			request: request.LogTextRequest{
+				RunID: run.ID,
+			},
+			error: &api.ErrorResponse{
+				Message:    "Missing value for required parameter 'name'",
+				StatusCode: http.StatusBadRequest,
+			},
+		},
+		{
+			name: "NegativeStep",
+			request: request.LogTextRequest{
+				RunID: run.ID,
+				Name:  "predictions",
+				Step:  -1,
+			},
+			error: &api.ErrorResponse{
+				Message:    "Invalid value for parameter 'step': must be non-negative",
+				StatusCode: http.StatusBadRequest,
+			},
+		},
+		{
+			name: "NotFoundRun",
+			request: request.LogTextRequest{
+				RunID: "not-existing-run",
+				Name:  "predictions",
+			},
+			error: &api.ErrorResponse{
+				Message:    "unable to find run 'not-existing-run'",
+				StatusCode: http.StatusNotFound,
+			},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsLogTextRoute,
+				),
+			)
+			s.Equal(tt.error.Message, resp.Message)
+			s.Equal(tt.error.StatusCode, resp.StatusCode)
+		})
+	}
+}
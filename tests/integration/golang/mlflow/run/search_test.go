@@ -17,6 +17,7 @@ import (
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/types"
 	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
 )
 
@@ -77,6 +78,87 @@ func (s *SearchTestSuite) Test_CustomNamespaceExperimentZero_Ok() {
 	s.testCases(namespace, experiment, true, int32(0))
 }
 
+func (s *SearchTestSuite) Test_MetricContext_Ok() {
+	// create runs with the same metric logged with different contexts.
+	runs := make([]*models.Run, 0, 3)
+	for i, metricContext := range []string{`{"subset": "train"}`, `{"subset": "val"}`, `{"subset": "val"}`} {
+		run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+			ID:             fmt.Sprintf("id%d", i),
+			Name:           fmt.Sprintf("TestRun%d", i),
+			Status:         models.StatusRunning,
+			SourceType:     "JOB",
+			ExperimentID:   *s.DefaultExperiment.ID,
+			ArtifactURI:    "artifact_uri",
+			LifecycleStage: models.LifecycleStageActive,
+		})
+		s.Require().Nil(err)
+		_, err = s.MetricFixtures.CreateLatestMetric(context.Background(), &models.LatestMetric{
+			Key:       "loss",
+			Value:     0.1 * float64(i+1),
+			Timestamp: 1234567890,
+			Step:      1,
+			RunID:     run.ID,
+			LastIter:  1,
+			Context:   models.Context{Json: types.JSONB(metricContext)},
+		})
+		s.Require().Nil(err)
+		runs = append(runs, run)
+	}
+
+	tests := []struct {
+		name           string
+		filter         string
+		expectedRunIDs []string
+	}{
+		{
+			name:           "WithoutContext",
+			filter:         `metrics.loss < 0.25`,
+			expectedRunIDs: []string{runs[0].ID, runs[1].ID},
+		},
+		{
+			name:           "WithContext",
+			filter:         `metrics.loss{subset="val"} < 0.25`,
+			expectedRunIDs: []string{runs[1].ID},
+		},
+		{
+			name:           "WithContextNoMatch",
+			filter:         `metrics.loss{subset="test"} < 0.25`,
+			expectedRunIDs: []string{},
+		},
+		{
+			name:           "WithContextAndOtherComparison",
+			filter:         `metrics.loss{subset="val"} > 0.15 and attributes.run_id = 'id2'`,
+			expectedRunIDs: []string{runs[2].ID},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := response.SearchRunsResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					request.SearchRunsRequest{
+						ExperimentIDs: []string{fmt.Sprintf("%d", *s.DefaultExperiment.ID)},
+						Filter:        tt.filter,
+					},
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsSearchRoute,
+				),
+			)
+			runIDs := make([]string, 0, len(resp.Runs))
+			for _, run := range resp.Runs {
+				runIDs = append(runIDs, run.Info.ID)
+				s.Require().Len(run.Data.Metrics, 1)
+				s.NotEmpty(run.Data.Metrics[0].Context)
+			}
+			s.ElementsMatch(tt.expectedRunIDs, runIDs)
+		})
+	}
+}
+
 func (s *SearchTestSuite) testCases(
 	namespace *models.Namespace,
 	experiment *models.Experiment,
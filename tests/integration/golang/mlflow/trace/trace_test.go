@@ -0,0 +1,437 @@
+package trace
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type TraceTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestTraceTestSuite(t *testing.T) {
+	suite.Run(t, new(TraceTestSuite))
+}
+
+func (s *TraceTestSuite) Test_Ok() {
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:             "traces",
+		NamespaceID:      s.DefaultNamespace.ID,
+		LifecycleStage:   models.LifecycleStageActive,
+		ArtifactLocation: s.T().TempDir(),
+	})
+	s.Require().Nil(err)
+	experimentID := fmt.Sprint(*experiment.ID)
+
+	// 1. start two traces.
+	startResp := response.TraceInfoResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.StartTraceRequest{
+				ExperimentID: experimentID,
+				TimestampMs:  1000,
+				RequestMetadata: []request.TraceRequestMetadataPartialRequest{
+					{Key: "mlflow.traceInputs", Value: `{"question": "1+1"}`},
+				},
+				Tags: []request.TraceTagPartialRequest{
+					{Key: "mlflow.traceName", Value: "predict"},
+				},
+			},
+		).WithResponse(
+			&startResp,
+		).DoRequest(
+			"%s%s", mlflow.TracesRoutePrefix, mlflow.TracesRoute,
+		),
+	)
+	trace := startResp.TraceInfo
+	s.Regexp("^tr-[0-9a-f]{32}$", trace.RequestID)
+	s.Equal(experimentID, trace.ExperimentID)
+	s.Equal(int64(1000), trace.TimestampMs)
+	s.Equal(string(models.TraceStatusInProgress), trace.Status)
+	s.Nil(trace.ExecutionTimeMs)
+	s.ElementsMatch([]response.TraceTagPartialResponse{
+		{Key: "mlflow.traceName", Value: "predict"},
+		{
+			Key:   models.TraceTagArtifactLocation,
+			Value: filepath.Join(experiment.ArtifactLocation, "traces", trace.RequestID, "artifacts"),
+		},
+	}, trace.Tags)
+
+	otherResp := response.TraceInfoResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.StartTraceRequest{
+				ExperimentID: experimentID,
+				TimestampMs:  2000,
+			},
+		).WithResponse(
+			&otherResp,
+		).DoRequest(
+			"%s%s", mlflow.TracesRoutePrefix, mlflow.TracesRoute,
+		),
+	)
+	otherTrace := otherResp.TraceInfo
+
+	// 2. end the first trace together with its spans.
+	otherLocation := s.T().TempDir()
+	endResp := response.TraceInfoResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPatch,
+		).WithRequest(
+			request.EndTraceRequest{
+				TimestampMs: 1500,
+				Status:      string(models.TraceStatusOK),
+				RequestMetadata: []request.TraceRequestMetadataPartialRequest{
+					{Key: "mlflow.traceOutputs", Value: `"2"`},
+				},
+				// location of the trace data can't be overridden by the client.
+				Tags: []request.TraceTagPartialRequest{
+					{Key: models.TraceTagArtifactLocation, Value: otherLocation},
+				},
+				Spans: []request.TraceSpanPartialRequest{
+					{
+						Name:       "predict",
+						Context:    request.TraceSpanContextPartialRequest{SpanID: "span-1", TraceID: "trace"},
+						StartTime:  1000000000,
+						EndTime:    1500000000,
+						StatusCode: "OK",
+						Attributes: map[string]any{"mlflow.spanType": "CHAIN"},
+					},
+					{
+						Name:       "llm",
+						Context:    request.TraceSpanContextPartialRequest{SpanID: "span-2", TraceID: "trace"},
+						ParentID:   "span-1",
+						StartTime:  1100000000,
+						EndTime:    1400000000,
+						StatusCode: "OK",
+					},
+				},
+			},
+		).WithResponse(
+			&endResp,
+		).DoRequest(
+			"%s/%s", mlflow.TracesRoutePrefix, trace.RequestID,
+		),
+	)
+	s.Equal(string(models.TraceStatusOK), endResp.TraceInfo.Status)
+	s.Require().NotNil(endResp.TraceInfo.ExecutionTimeMs)
+	s.Equal(int64(500), *endResp.TraceInfo.ExecutionTimeMs)
+	s.ElementsMatch([]response.TraceRequestMetadataPartialResponse{
+		{Key: "mlflow.traceInputs", Value: `{"question": "1+1"}`},
+		{Key: "mlflow.traceOutputs", Value: `"2"`},
+	}, endResp.TraceInfo.RequestMetadata)
+	s.ElementsMatch(trace.Tags, endResp.TraceInfo.Tags)
+	s.FileExists(filepath.Join(
+		experiment.ArtifactLocation, "traces", trace.RequestID, "artifacts", models.TraceDataFileName,
+	))
+	s.NoFileExists(filepath.Join(otherLocation, models.TraceDataFileName))
+
+	// 3. get trace info and trace data.
+	infoResp := response.TraceInfoResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithResponse(
+			&infoResp,
+		).DoRequest(
+			"%s/%s/info", mlflow.TracesRoutePrefix, trace.RequestID,
+		),
+	)
+	s.Equal(endResp.TraceInfo, infoResp.TraceInfo)
+
+	getResp := response.GetTraceResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithResponse(
+			&getResp,
+		).DoRequest(
+			"%s/%s", mlflow.TracesRoutePrefix, trace.RequestID,
+		),
+	)
+	s.Equal(endResp.TraceInfo, getResp.Trace.Info)
+	s.Require().Len(getResp.Trace.Data.Spans, 2)
+	s.Equal("predict", getResp.Trace.Data.Spans[0].Name)
+	s.JSONEq(`{"mlflow.spanType": "CHAIN"}`, getResp.Trace.Data.Spans[0].Attributes.String())
+	s.Equal("llm", getResp.Trace.Data.Spans[1].Name)
+	s.Equal("span-1", getResp.Trace.Data.Spans[1].ParentID)
+
+	// 4. set and delete tags.
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPatch,
+		).WithRequest(
+			request.SetTraceTagRequest{Key: "env", Value: "test"},
+		).DoRequest(
+			"%s/%s/tags", mlflow.TracesRoutePrefix, trace.RequestID,
+		),
+	)
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodDelete,
+		).WithQuery(
+			request.DeleteTraceTagRequest{Key: "mlflow.traceName"},
+		).DoRequest(
+			"%s/%s/tags", mlflow.TracesRoutePrefix, trace.RequestID,
+		),
+	)
+	storedTrace, err := s.TraceFixtures.GetTrace(context.Background(), s.DefaultNamespace.ID, trace.RequestID)
+	s.Require().Nil(err)
+	value, ok := storedTrace.GetTagValue("env")
+	s.True(ok)
+	s.Equal("test", value)
+	_, ok = storedTrace.GetTagValue("mlflow.traceName")
+	s.False(ok)
+
+	// 5. search traces.
+	tests := []struct {
+		name             string
+		request          request.SearchTracesRequest
+		expectedRequests []string
+	}{
+		{
+			name: "DefaultOrder",
+			request: request.SearchTracesRequest{
+				ExperimentIDs: []string{experimentID},
+			},
+			expectedRequests: []string{otherTrace.RequestID, trace.RequestID},
+		},
+		{
+			name: "OrderByTimestamp",
+			request: request.SearchTracesRequest{
+				ExperimentIDs: []string{experimentID},
+				OrderBy:       []string{"timestamp_ms ASC"},
+			},
+			expectedRequests: []string{trace.RequestID, otherTrace.RequestID},
+		},
+		{
+			name: "FilterByStatus",
+			request: request.SearchTracesRequest{
+				ExperimentIDs: []string{experimentID},
+				Filter:        `attributes.status = 'OK'`,
+			},
+			expectedRequests: []string{trace.RequestID},
+		},
+		{
+			name: "FilterByTagAndTimestamp",
+			request: request.SearchTracesRequest{
+				ExperimentIDs: []string{experimentID},
+				Filter:        `tags.env = 'test' OR timestamp_ms > 1500`,
+			},
+			expectedRequests: []string{otherTrace.RequestID, trace.RequestID},
+		},
+		{
+			name: "FilterByRequestMetadata",
+			request: request.SearchTracesRequest{
+				ExperimentIDs: []string{experimentID},
+				Filter:        `request_metadata.mlflow.traceOutputs IS NULL`,
+			},
+			expectedRequests: []string{otherTrace.RequestID},
+		},
+		{
+			name: "OtherExperiment",
+			request: request.SearchTracesRequest{
+				ExperimentIDs: []string{fmt.Sprint(*s.DefaultExperiment.ID)},
+			},
+			expectedRequests: []string{},
+		},
+	}
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			resp := response.SearchTracesResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.TracesRoutePrefix, mlflow.TracesSearchRoute,
+				),
+			)
+			requestIDs := make([]string, len(resp.Traces))
+			for i, trace := range resp.Traces {
+				requestIDs[i] = trace.RequestID
+			}
+			s.Equal(tt.expectedRequests, requestIDs)
+		})
+	}
+
+	// 6. delete traces.
+	deleteResp := response.DeleteTracesResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.DeleteTracesRequest{
+				ExperimentID:       experimentID,
+				MaxTimestampMillis: 3000,
+				MaxTraces:          1,
+			},
+		).WithResponse(
+			&deleteResp,
+		).DoRequest(
+			"%s%s", mlflow.TracesRoutePrefix, mlflow.TracesDeleteTracesRoute,
+		),
+	)
+	s.Equal(int64(1), deleteResp.TracesDeleted)
+
+	deletedTrace, err := s.TraceFixtures.GetTrace(context.Background(), s.DefaultNamespace.ID, trace.RequestID)
+	s.Require().Nil(err)
+	s.Nil(deletedTrace)
+	s.NoDirExists(filepath.Join(experiment.ArtifactLocation, "traces", trace.RequestID, "artifacts"))
+
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.DeleteTracesRequest{
+				ExperimentID: experimentID,
+				RequestIDs:   []string{otherTrace.RequestID},
+			},
+		).WithResponse(
+			&deleteResp,
+		).DoRequest(
+			"%s%s", mlflow.TracesRoutePrefix, mlflow.TracesDeleteTracesRoute,
+		),
+	)
+	s.Equal(int64(1), deleteResp.TracesDeleted)
+}
+
+func (s *TraceTestSuite) Test_GetTraceFromArtifacts() {
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:             "traces",
+		NamespaceID:      s.DefaultNamespace.ID,
+		LifecycleStage:   models.LifecycleStageActive,
+		ArtifactLocation: s.T().TempDir(),
+	})
+	s.Require().Nil(err)
+
+	// trace data could be uploaded by the client directly into the artifact storage.
+	artifactLocation := filepath.Join(experiment.ArtifactLocation, "traces", "tr-1", "artifacts")
+	trace, err := s.TraceFixtures.CreateTrace(context.Background(), &models.Trace{
+		ID:           "tr-1",
+		ExperimentID: *experiment.ID,
+		TimestampMs:  1000,
+		Status:       models.TraceStatusOK,
+		Tags: []models.TraceTag{
+			{Key: models.TraceTagArtifactLocation, Value: artifactLocation},
+		},
+	})
+	s.Require().Nil(err)
+	s.Require().Nil(os.MkdirAll(artifactLocation, os.ModePerm))
+	s.Require().Nil(os.WriteFile(
+		filepath.Join(artifactLocation, models.TraceDataFileName),
+		[]byte(`{"spans": [{"name": "predict", "context": {"span_id": "span-1", "trace_id": "trace"}}]}`),
+		0o600,
+	))
+
+	resp := response.GetTraceResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithResponse(
+			&resp,
+		).DoRequest(
+			"%s/%s", mlflow.TracesRoutePrefix, trace.ID,
+		),
+	)
+	s.Equal(trace.ID, resp.Trace.Info.RequestID)
+	s.Require().Len(resp.Trace.Data.Spans, 1)
+	s.Equal("predict", resp.Trace.Data.Spans[0].Name)
+	s.Equal("span-1", resp.Trace.Data.Spans[0].Context.SpanID)
+}
+
+func (s *TraceTestSuite) Test_Error() {
+	tests := []struct {
+		name    string
+		method  string
+		route   string
+		request any
+		error   *api.ErrorResponse
+	}{
+		{
+			name:    "StartTraceWithNotFoundExperiment",
+			method:  http.MethodPost,
+			route:   mlflow.TracesRoute,
+			request: request.StartTraceRequest{ExperimentID: "123", TimestampMs: 1000},
+			error: &api.ErrorResponse{
+				Message: "unable to find experiment with id '123': " +
+					"error getting experiment by id: 123: record not found",
+				StatusCode: http.StatusNotFound,
+			},
+		},
+		{
+			name:    "EndNotFoundTrace",
+			method:  http.MethodPatch,
+			route:   "/tr-unknown",
+			request: request.EndTraceRequest{TimestampMs: 1000, Status: string(models.TraceStatusOK)},
+			error: &api.ErrorResponse{
+				Message:    "Trace with request_id 'tr-unknown' not found",
+				StatusCode: http.StatusNotFound,
+			},
+		},
+		{
+			name:    "SetArtifactLocationTag",
+			method:  http.MethodPatch,
+			route:   "/tr-unknown/tags",
+			request: request.SetTraceTagRequest{Key: models.TraceTagArtifactLocation, Value: "file:///tmp"},
+			error: &api.ErrorResponse{
+				Message:    "Tag 'mlflow.artifactLocation' is managed by the server and can't be changed",
+				StatusCode: http.StatusBadRequest,
+			},
+		},
+		{
+			name:    "SearchWithMalformedFilter",
+			method:  http.MethodPost,
+			route:   mlflow.TracesSearchRoute,
+			request: request.SearchTracesRequest{ExperimentIDs: []string{"0"}, Filter: "attributes.unknown = 1"},
+			error: &api.ErrorResponse{
+				Message: "invalid attribute 'unknown'. " +
+					"Valid values are ['request_id', 'timestamp_ms', 'timestamp', 'execution_time_ms', 'status']",
+				StatusCode: http.StatusBadRequest,
+			},
+		},
+		{
+			name:    "DeleteTracesWithoutConditions",
+			method:  http.MethodPost,
+			route:   mlflow.TracesDeleteTracesRoute,
+			request: request.DeleteTracesRequest{ExperimentID: "0"},
+			error: &api.ErrorResponse{
+				Message:    "Exactly one of 'max_timestamp_millis' and 'request_ids' must be specified",
+				StatusCode: http.StatusBadRequest,
+			},
+		},
+	}
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					tt.method,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.TracesRoutePrefix, tt.route,
+				),
+			)
+			s.Equal(tt.error.Message, resp.Message)
+			s.Equal(tt.error.StatusCode, resp.StatusCode)
+		})
+	}
+}
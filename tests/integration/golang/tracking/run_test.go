@@ -0,0 +1,352 @@
+package tracking
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/encoding"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/tracking/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/tracking/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/tracking/services/tracking"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type TrackRunTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestTrackRunTestSuite(t *testing.T) {
+	suite.Run(t, new(TrackRunTestSuite))
+}
+
+func (s *TrackRunTestSuite) Test_Ok() {
+	// artifacts written by the client have to be kept outside of the source tree.
+	s.DefaultExperiment.ArtifactLocation = s.T().TempDir()
+	_, err := s.ExperimentFixtures.UpdateExperiment(context.Background(), s.DefaultExperiment)
+	s.Require().Nil(err)
+
+	version := response.GetVersionResponse{}
+	s.Require().Nil(s.TrackingClient().WithResponse(&version).DoRequest("/client/get-version/"))
+	s.Equal(tracking.AimVersion, version.Version)
+
+	clientURI := uuid.New().String()
+	s.Require().Nil(s.TrackingClient().DoRequest("/client/connect/%s/", clientURI))
+
+	hash := "8d9a2f0c1b2e4f6a8c0d2e4f"
+	runHandler := s.getResource("", clientURI, tracking.ResourceTypeStructuredRun, map[string]any{
+		"hash":      hash,
+		"read_only": false,
+	})
+	metaHandler := s.getResource("", clientURI, tracking.ResourceTypeTreeView, map[string]any{
+		"name":      "meta",
+		"sub":       hash,
+		"read_only": false,
+	})
+	seqsHandler := s.getResource("", clientURI, tracking.ResourceTypeTreeView, map[string]any{
+		"name":      "seqs",
+		"sub":       hash,
+		"read_only": false,
+	})
+
+	// the run becomes visible right after it has been created by the client.
+	run, err := s.RunFixtures.GetRun(context.Background(), hash)
+	s.Require().Nil(err)
+	s.Equal(models.StatusRunning, run.Status)
+	s.Equal(*s.DefaultExperiment.ID, run.ExperimentID)
+
+	s.writeInstructions("", clientURI, request.WriteInstructionsRequest{
+		s.newInstruction(runHandler, "name", "aim-run"),
+		s.newInstruction(runHandler, "experiment", "aim-experiment"),
+		s.newInstruction(metaHandler, "__setitem__", []any{"meta", "chunks", hash, "attrs", "hparams"}, map[string]any{
+			"lr":         0.01,
+			"batch_size": 32,
+			"model": map[string]any{
+				"name": "resnet",
+			},
+		}),
+		s.newInstruction(metaHandler, "__setitem__", []any{"meta", "contexts", 1234}, map[string]any{
+			"subset": "train",
+		}),
+		s.newInstruction(seqsHandler, "__setitem__", []any{"seqs", "chunks", hash, 1234, "loss", "val", 0}, 0.5),
+		s.newInstruction(seqsHandler, "__setitem__", []any{"seqs", "chunks", hash, 1234, "loss", "time", 0}, 1700000000.0),
+		s.newInstruction(seqsHandler, "__setitem__", []any{"seqs", "chunks", hash, 1234, "loss", "val", 1}, 0.25),
+		s.newInstruction(seqsHandler, "__setitem__", []any{"seqs", "chunks", hash, 1234, "images", "val", 1}, []any{
+			map[string]any{
+				"data":    []byte("image"),
+				"caption": "first image",
+				"format":  "PNG",
+				"width":   16,
+				"height":  8,
+			},
+		}),
+	})
+
+	// values written by the client could be read back.
+	result := new(bytes.Buffer)
+	s.Require().Nil(
+		s.TrackingClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			s.newInstruction(metaHandler, "__getitem__", []any{"meta", "chunks", hash, "attrs", "hparams", "lr"}),
+		).WithResponseType(
+			helpers.ResponseTypeBuffer,
+		).WithResponse(
+			result,
+		).DoRequest("/tracking/%s/read-instruction/", clientURI),
+	)
+	value, err := encoding.DecodeTree(result)
+	s.Require().Nil(err)
+	s.Equal(0.01, value)
+
+	s.writeInstructions("", clientURI, request.WriteInstructionsRequest{
+		s.newInstruction(metaHandler, "__setitem__", []any{"meta", "chunks", hash, "end_time"}, 1700000100.5),
+	})
+
+	run, err = s.RunFixtures.GetRun(context.Background(), hash)
+	s.Require().Nil(err)
+	s.Equal("aim-run", run.Name)
+	s.Equal(models.StatusFinished, run.Status)
+	s.Equal(int64(1700000100500), run.EndTime.Int64)
+	experiments, err := s.ExperimentFixtures.GetExperiments(context.Background())
+	s.Require().Nil(err)
+	var experiment *models.Experiment
+	for i := range experiments {
+		if experiments[i].Name == "aim-experiment" {
+			experiment = &experiments[i]
+		}
+	}
+	s.Require().NotNil(experiment)
+	s.Equal(*experiment.ID, run.ExperimentID)
+
+	params, err := s.ParamFixtures.GetParamsByRunID(context.Background(), hash)
+	s.Require().Nil(err)
+	values := map[string]any{}
+	for _, param := range params {
+		values[param.Key] = param.ValueAny()
+	}
+	s.Equal(map[string]any{
+		"hparams": map[string]any{
+			"lr":         0.01,
+			"batch_size": float64(32),
+			"model": map[string]any{
+				"name": "resnet",
+			},
+		},
+	}, values)
+
+	metrics, err := s.MetricFixtures.GetMetricsByContext(context.Background(), map[string]string{
+		"subset": "train",
+	})
+	s.Require().Nil(err)
+	s.Require().Len(metrics, 2)
+	s.Equal("loss", metrics[0].Key)
+	s.Equal(int64(0), metrics[0].Step)
+	s.Equal(0.5, metrics[0].Value)
+	s.Equal(int64(1700000000000), metrics[0].Timestamp)
+	s.Equal(int64(1), metrics[1].Step)
+	s.Equal(0.25, metrics[1].Value)
+
+	artifact, err := s.ArtifactFixtures.GetArtifactByRunID(context.Background(), hash)
+	s.Require().Nil(err)
+	s.Equal("images", artifact.Name)
+	s.Equal(int64(1), artifact.Step)
+	s.Equal("first image", artifact.Caption)
+	s.Equal("png", artifact.Format)
+	s.Equal(int64(16), artifact.Width)
+	s.Equal(int64(8), artifact.Height)
+	s.Equal(filepath.Join(run.ArtifactURI, "aim", "images", "images", "1-0.png"), artifact.BlobURI)
+	data, err := os.ReadFile(artifact.BlobURI)
+	s.Require().Nil(err)
+	s.Equal([]byte("image"), data)
+
+	s.Require().Nil(s.TrackingClient().DoRequest("/tracking/%s/release-resource/%s/", clientURI, seqsHandler))
+	s.Require().Nil(s.TrackingClient().DoRequest("/client/disconnect/%s/", clientURI))
+}
+
+func (s *TrackRunTestSuite) Test_CustomNamespace_Ok() {
+	namespace, err := s.NamespaceFixtures.CreateNamespace(context.Background(), &models.Namespace{
+		Code:                "custom",
+		DefaultExperimentID: common.GetPointer(models.DefaultExperimentID),
+	})
+	s.Require().Nil(err)
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:             uuid.New().String(),
+		NamespaceID:      namespace.ID,
+		LifecycleStage:   models.LifecycleStageActive,
+		ArtifactLocation: s.T().TempDir(),
+	})
+	s.Require().Nil(err)
+	namespace.DefaultExperimentID = experiment.ID
+	_, err = s.NamespaceFixtures.UpdateNamespace(context.Background(), namespace)
+	s.Require().Nil(err)
+
+	clientURI := uuid.New().String()
+	s.Require().Nil(s.TrackingClient().WithNamespace("custom").DoRequest("/client/connect/%s/", clientURI))
+
+	// client is bound to the namespace it has been connected to.
+	resp := api.ErrorResponse{}
+	s.Require().Nil(s.TrackingClient().WithResponse(&resp).DoRequest("/client/heartbeat/%s/", clientURI))
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	hash := "0a1b2c3d4e5f60718293a4b5"
+	s.getResource("custom", clientURI, tracking.ResourceTypeStructuredRun, map[string]any{
+		"hash": hash,
+	})
+
+	run, err := s.RunFixtures.GetRun(context.Background(), hash)
+	s.Require().Nil(err)
+	s.Equal(*experiment.ID, run.ExperimentID)
+	s.Equal(fmt.Sprintf("%s/%s/artifacts", experiment.ArtifactLocation, hash), run.ArtifactURI)
+}
+
+func (s *TrackRunTestSuite) Test_Error() {
+	clientURI := uuid.New().String()
+	s.Require().Nil(s.TrackingClient().DoRequest("/client/connect/%s/", clientURI))
+
+	// session of the connected client can't be replaced by connecting the same client URI again.
+	resp := api.ErrorResponse{}
+	s.Require().Nil(s.TrackingClient().WithResponse(&resp).DoRequest("/client/connect/%s/", clientURI))
+	s.Equal(fmt.Sprintf("client '%s' is already connected", clientURI), resp.Message)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	tests := []struct {
+		name     string
+		uri      string
+		request  any
+		error    *api.ErrorResponse
+		endpoint string
+	}{
+		{
+			name: "ClientNotConnected",
+			uri:  "not-connected",
+			request: request.GetResourceRequest{
+				ResourceType: tracking.ResourceTypeStructuredRun,
+			},
+			error:    api.NewResourceDoesNotExistError("client 'not-connected' is not connected"),
+			endpoint: "get-resource",
+		},
+		{
+			name:     "MissingResourceType",
+			uri:      clientURI,
+			request:  request.GetResourceRequest{},
+			error:    api.NewInvalidParameterValueError("Missing value for required parameter 'resource_type'"),
+			endpoint: "get-resource",
+		},
+		{
+			name: "IncorrectArgs",
+			uri:  clientURI,
+			request: request.GetResourceRequest{
+				ResourceType: tracking.ResourceTypeStructuredRun,
+				Args:         "not base64",
+			},
+			error: api.NewInvalidParameterValueError(
+				"Invalid value for parameter 'args': illegal base64 data at input byte 3",
+			),
+			endpoint: "get-resource",
+		},
+		{
+			name: "IncorrectRunHash",
+			uri:  clientURI,
+			request: request.GetResourceRequest{
+				ResourceType: tracking.ResourceTypeStructuredRun,
+				Args:         s.encodeArgs(map[string]any{"hash": "../run"}),
+			},
+			error:    api.NewInvalidParameterValueError("Invalid value for run hash '../run'"),
+			endpoint: "get-resource",
+		},
+		{
+			name:     "UnknownResource",
+			uri:      clientURI,
+			request:  s.newInstruction("100", "__getitem__", "key"),
+			error:    api.NewResourceDoesNotExistError("resource '100' not found"),
+			endpoint: "read-instruction",
+		},
+		{
+			name:     "MissingMethodName",
+			uri:      clientURI,
+			request:  request.WriteInstructionsRequest{{ResourceHandler: "1"}},
+			error:    api.NewInvalidParameterValueError("Missing value for required parameter 'method_name'"),
+			endpoint: "write-instruction",
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.TrackingClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest("/tracking/%s/%s/", tt.uri, tt.endpoint),
+			)
+			s.Equal(tt.error.Message, resp.Message)
+			s.Equal(tt.error.StatusCode, resp.StatusCode)
+		})
+	}
+}
+
+func (s *TrackRunTestSuite) getResource(namespace, clientURI, resourceType string, args map[string]any) string {
+	resp := response.GetResourceResponse{}
+	s.Require().Nil(
+		s.TrackingClient().WithMethod(
+			http.MethodPost,
+		).WithNamespace(
+			namespace,
+		).WithRequest(
+			request.GetResourceRequest{
+				ResourceType: resourceType,
+				Args:         s.encodeArgs(args),
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest("/tracking/%s/get-resource/", clientURI),
+	)
+	s.Require().NotEmpty(resp.Handler)
+	return resp.Handler
+}
+
+func (s *TrackRunTestSuite) writeInstructions(
+	namespace, clientURI string, req request.WriteInstructionsRequest,
+) {
+	resp := map[string]any{}
+	s.Require().Nil(
+		s.TrackingClient().WithMethod(
+			http.MethodPost,
+		).WithNamespace(
+			namespace,
+		).WithRequest(
+			req,
+		).WithResponse(
+			&resp,
+		).DoRequest("/tracking/%s/write-instruction/", clientURI),
+	)
+	s.Equal(map[string]any{}, resp)
+}
+
+func (s *TrackRunTestSuite) newInstruction(handler, method string, args ...any) request.InstructionRequest {
+	return request.InstructionRequest{
+		ResourceHandler: handler,
+		MethodName:      method,
+		Args:            s.encodeArgs(args),
+	}
+}
+
+func (s *TrackRunTestSuite) encodeArgs(args any) string {
+	buf := new(bytes.Buffer)
+	s.Require().Nil(encoding.Encode(buf, args))
+	return base64.StdEncoding.EncodeToString(buf.Bytes())
+}